@@ -0,0 +1,60 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+)
+
+const clusterResourceType = "Microsoft.RedHatOpenShift/hcpOpenShiftClusters"
+
+// HCPOptions identifies the hosted control plane an HCP scoped command
+// operates on.
+type HCPOptions struct {
+	ResourceID string
+}
+
+func DefaultHCPOptions() *HCPOptions {
+	return &HCPOptions{}
+}
+
+func (o *HCPOptions) BindFlags(cmd *cobra.Command) error {
+	cmd.Flags().StringVar(&o.ResourceID, "resource-id", o.ResourceID, "ARM resource ID of the HCP cluster")
+	if err := cmd.MarkFlagRequired("resource-id"); err != nil {
+		return fmt.Errorf("failed to mark flag %q as required: %w", "resource-id", err)
+	}
+	return nil
+}
+
+// Validate ensures the resource ID refers to an HCP cluster and returns it in
+// its canonical form.
+func (o *HCPOptions) Validate() (string, error) {
+	if o.ResourceID == "" {
+		return "", fmt.Errorf("resource-id cannot be empty")
+	}
+	resourceID, err := arm.ParseResourceID(o.ResourceID)
+	if err != nil {
+		return "", fmt.Errorf("invalid resource-id %q: %w", o.ResourceID, err)
+	}
+	if !strings.EqualFold(resourceID.ResourceType.String(), clusterResourceType) {
+		return "", fmt.Errorf("resource-id %q is not a %s resource", o.ResourceID, clusterResourceType)
+	}
+	return resourceID.String(), nil
+}
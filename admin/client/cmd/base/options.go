@@ -21,6 +21,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/Azure/ARO-HCP/admin/client/pkg/auth"
+	adminClient "github.com/Azure/ARO-HCP/admin/client/pkg/client"
 )
 
 func DefaultAuthOptions() *RawAuthOptions {
@@ -142,3 +143,19 @@ func (o *validatedAuthOptions) Complete(ctx context.Context) (*AuthOptions, erro
 		},
 	}, nil
 }
+
+// NewClient validates and completes the authentication options and returns an
+// Admin API client that uses the minted bearer token.
+func NewClient(ctx context.Context, opts *RawAuthOptions) (adminClient.Client, error) {
+	validated, err := opts.Validate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	completed, err := validated.Complete(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return adminClient.NewClient(completed.Endpoint, completed.Host, completed.Token, completed.Insecure, false), nil
+}
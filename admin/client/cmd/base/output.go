@@ -0,0 +1,104 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	OutputFormatTable = "table"
+	OutputFormatJSON  = "json"
+	OutputFormatYAML  = "yaml"
+)
+
+// OutputOptions selects how a command renders its result.
+type OutputOptions struct {
+	Format string
+}
+
+func DefaultOutputOptions() *OutputOptions {
+	return &OutputOptions{Format: OutputFormatTable}
+}
+
+func (o *OutputOptions) BindFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.Format, "output", "o", o.Format, fmt.Sprintf("Output format, one of %s, %s or %s", OutputFormatTable, OutputFormatJSON, OutputFormatYAML))
+}
+
+func (o *OutputOptions) Validate() error {
+	switch o.Format {
+	case OutputFormatTable, OutputFormatJSON, OutputFormatYAML:
+		return nil
+	}
+	return fmt.Errorf("invalid output format %q, must be one of %s, %s or %s", o.Format, OutputFormatTable, OutputFormatJSON, OutputFormatYAML)
+}
+
+// Table is the tabular rendering of a result.
+type Table struct {
+	Headers []string
+	Rows    [][]string
+}
+
+// Print writes obj as JSON or YAML, or writes table when the table format is
+// selected. A nil table falls back to JSON.
+func (o *OutputOptions) Print(out io.Writer, obj any, table *Table) error {
+	switch {
+	case o.Format == OutputFormatYAML:
+		return PrintYAML(out, obj)
+	case o.Format == OutputFormatTable && table != nil:
+		return PrintTable(out, *table)
+	default:
+		return PrintJSON(out, obj)
+	}
+}
+
+func PrintJSON(out io.Writer, obj any) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(obj); err != nil {
+		return fmt.Errorf("failed to encode output as JSON: %w", err)
+	}
+	return nil
+}
+
+func PrintYAML(out io.Writer, obj any) error {
+	yamlBytes, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to encode output as YAML: %w", err)
+	}
+	_, err = out.Write(yamlBytes)
+	return err
+}
+
+func PrintTable(out io.Writer, table Table) error {
+	writer := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	if _, err := fmt.Fprintln(writer, strings.Join(table.Headers, "\t")); err != nil {
+		return err
+	}
+	for _, row := range table.Rows {
+		if _, err := fmt.Fprintln(writer, strings.Join(row, "\t")); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
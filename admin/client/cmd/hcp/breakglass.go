@@ -0,0 +1,299 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+
+	adminClient "github.com/Azure/ARO-HCP/admin/client/pkg/client"
+)
+
+const defaultBreakglassPollInterval = 5 * time.Second
+
+func newBreakglassCommand() (*cobra.Command, error) {
+	cmd := &cobra.Command{
+		Use:   "breakglass",
+		Short: "Request breakglass access to a hosted control plane",
+	}
+
+	for _, newCmd := range []func() (*cobra.Command, error){
+		newBreakglassCreateCommand,
+		newBreakglassApproveCommand,
+		newBreakglassExtendCommand,
+		newBreakglassRevokeCommand,
+		newBreakglassKubeconfigCommand,
+	} {
+		subCmd, err := newCmd()
+		if err != nil {
+			return nil, err
+		}
+		cmd.AddCommand(subCmd)
+	}
+
+	return cmd, nil
+}
+
+// kubeconfigOptions controls how a breakglass kubeconfig is awaited and where
+// it is written.
+type kubeconfigOptions struct {
+	Wait       bool
+	Timeout    time.Duration
+	OutputFile string
+}
+
+func (o *kubeconfigOptions) BindFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&o.Wait, "wait", o.Wait, "Wait until the session is ready and write its kubeconfig")
+	cmd.Flags().DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait for the session to become ready")
+	cmd.Flags().StringVar(&o.OutputFile, "kubeconfig-file", o.OutputFile, "File to write the kubeconfig to, defaults to stdout")
+}
+
+func newBreakglassCreateCommand() (*cobra.Command, error) {
+	session := adminClient.BreakglassSession{TTL: time.Hour}
+	kubeconfigOpts := &kubeconfigOptions{Timeout: 10 * time.Minute}
+
+	cmd, err := newHCPLeafCommand(&cobra.Command{
+		Use:   "create",
+		Short: "Create a breakglass session for a cluster",
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID string) error {
+		if session.Group == "" {
+			return fmt.Errorf("group cannot be empty")
+		}
+		if session.RequireApproval && (session.Justification == "" || session.IncidentID == "") {
+			return fmt.Errorf("justification and incident-id are required with require-approval")
+		}
+		if !session.RequireApproval && (session.Justification != "" || session.IncidentID != "") {
+			return fmt.Errorf("justification and incident-id are only accepted with require-approval")
+		}
+		sessionName, err := client.CreateBreakglassSession(ctx, resourceID, session)
+		if err != nil {
+			return err
+		}
+		if !kubeconfigOpts.Wait {
+			message := fmt.Sprintf("Breakglass session %s created", sessionName)
+			if session.RequireApproval {
+				message += ", it must be approved by another principal before it is provisioned"
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), message)
+			return err
+		}
+		return waitForKubeconfig(ctx, cmd, client, resourceID, sessionName, kubeconfigOpts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Flags().StringVar(&session.Group, "group", session.Group, "Group to grant the breakglass session")
+	cmd.Flags().DurationVar(&session.TTL, "ttl", session.TTL, "Lifetime of the breakglass session")
+	cmd.Flags().BoolVar(&session.RequireApproval, "require-approval", session.RequireApproval, "Provision the session only after another principal has approved it")
+	cmd.Flags().StringVar(&session.Justification, "justification", session.Justification, "Why the session is needed, required with --require-approval")
+	cmd.Flags().StringVar(&session.IncidentID, "incident-id", session.IncidentID, "Incident the session is needed for, required with --require-approval")
+	if err := cmd.MarkFlagRequired("group"); err != nil {
+		return nil, fmt.Errorf("failed to mark flag %q as required: %w", "group", err)
+	}
+	kubeconfigOpts.BindFlags(cmd)
+
+	return cmd, nil
+}
+
+func newBreakglassApproveCommand() (*cobra.Command, error) {
+	var sessionName string
+
+	cmd, err := newHCPLeafCommand(&cobra.Command{
+		Use:   "approve",
+		Short: "Approve a breakglass session requested by another principal",
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID string) error {
+		if sessionName == "" {
+			return fmt.Errorf("session cannot be empty")
+		}
+		if err := client.ApproveBreakglassSession(ctx, resourceID, sessionName); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintf(cmd.OutOrStdout(), "Breakglass session %s approved\n", sessionName)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Flags().StringVar(&sessionName, "session", sessionName, "Name of the breakglass session")
+	if err := cmd.MarkFlagRequired("session"); err != nil {
+		return nil, fmt.Errorf("failed to mark flag %q as required: %w", "session", err)
+	}
+
+	return cmd, nil
+}
+
+func newBreakglassExtendCommand() (*cobra.Command, error) {
+	var (
+		sessionName string
+		ttl         time.Duration
+	)
+
+	cmd, err := newHCPLeafCommand(&cobra.Command{
+		Use:   "extend",
+		Short: "Extend an active breakglass session",
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID string) error {
+		if sessionName == "" {
+			return fmt.Errorf("session cannot be empty")
+		}
+		if ttl <= 0 {
+			return fmt.Errorf("ttl must be positive")
+		}
+		if err := client.ExtendBreakglassSession(ctx, resourceID, sessionName, ttl); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintf(cmd.OutOrStdout(), "Breakglass session %s extended by %s\n", sessionName, ttl)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Flags().StringVar(&sessionName, "session", sessionName, "Name of the breakglass session")
+	cmd.Flags().DurationVar(&ttl, "ttl", ttl, "Duration to extend the breakglass session by")
+	for _, name := range []string{"session", "ttl"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			return nil, fmt.Errorf("failed to mark flag %q as required: %w", name, err)
+		}
+	}
+
+	return cmd, nil
+}
+
+func newBreakglassRevokeCommand() (*cobra.Command, error) {
+	var sessionName, reason string
+
+	cmd, err := newHCPLeafCommand(&cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke a breakglass session immediately",
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID string) error {
+		if sessionName == "" {
+			return fmt.Errorf("session cannot be empty")
+		}
+		if strings.TrimSpace(reason) == "" {
+			return fmt.Errorf("reason cannot be empty")
+		}
+		if err := client.RevokeBreakglassSession(ctx, resourceID, sessionName, reason); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintf(cmd.OutOrStdout(), "Breakglass session %s revoked\n", sessionName)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Flags().StringVar(&sessionName, "session", sessionName, "Name of the breakglass session")
+	cmd.Flags().StringVar(&reason, "reason", reason, "Why the breakglass session is revoked")
+	for _, name := range []string{"session", "reason"} {
+		if err := cmd.MarkFlagRequired(name); err != nil {
+			return nil, fmt.Errorf("failed to mark flag %q as required: %w", name, err)
+		}
+	}
+
+	return cmd, nil
+}
+
+func newBreakglassKubeconfigCommand() (*cobra.Command, error) {
+	var sessionName string
+	kubeconfigOpts := &kubeconfigOptions{Timeout: 10 * time.Minute}
+
+	cmd, err := newHCPLeafCommand(&cobra.Command{
+		Use:   "kubeconfig",
+		Short: "Retrieve the kubeconfig of a breakglass session",
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID string) error {
+		if sessionName == "" {
+			return fmt.Errorf("session cannot be empty")
+		}
+		if kubeconfigOpts.Wait {
+			return waitForKubeconfig(ctx, cmd, client, resourceID, sessionName, kubeconfigOpts)
+		}
+
+		result, err := client.GetBreakglassKubeconfig(ctx, resourceID, sessionName)
+		if err != nil {
+			return err
+		}
+		if !result.Ready {
+			return fmt.Errorf("breakglass session %s is not ready: %v", sessionName, result.Status)
+		}
+		return writeKubeconfig(cmd, sessionName, result, kubeconfigOpts.OutputFile)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Flags().StringVar(&sessionName, "session", sessionName, "Name of the breakglass session")
+	if err := cmd.MarkFlagRequired("session"); err != nil {
+		return nil, fmt.Errorf("failed to mark flag %q as required: %w", "session", err)
+	}
+	kubeconfigOpts.BindFlags(cmd)
+
+	return cmd, nil
+}
+
+// waitForKubeconfig polls the session until it is ready, honoring the
+// Retry-After hint of the server, and writes the kubeconfig.
+func waitForKubeconfig(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID, sessionName string, opts *kubeconfigOptions) error {
+	logger := logr.FromContextOrDiscard(ctx)
+
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	for {
+		result, err := client.GetBreakglassKubeconfig(ctx, resourceID, sessionName)
+		if err != nil {
+			return err
+		}
+		if result.Ready {
+			return writeKubeconfig(cmd, sessionName, result, opts.OutputFile)
+		}
+
+		retryAfter := result.RetryAfter
+		if retryAfter <= 0 {
+			retryAfter = defaultBreakglassPollInterval
+		}
+		logger.Info("Breakglass session is not ready yet", "session", sessionName, "status", result.Status, "retryAfter", retryAfter)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for breakglass session %s: %w", sessionName, ctx.Err())
+		case <-time.After(retryAfter):
+		}
+	}
+}
+
+func writeKubeconfig(cmd *cobra.Command, sessionName string, result *adminClient.BreakglassKubeconfig, outputFile string) error {
+	if outputFile == "" {
+		_, err := cmd.OutOrStdout().Write(result.Kubeconfig)
+		return err
+	}
+
+	if err := os.WriteFile(outputFile, result.Kubeconfig, 0600); err != nil {
+		return fmt.Errorf("failed to write kubeconfig to %s: %w", outputFile, err)
+	}
+	message := fmt.Sprintf("Kubeconfig of breakglass session %s written to %s", sessionName, outputFile)
+	if result.ExpiresAt != nil {
+		message += fmt.Sprintf(", expires at %s", result.ExpiresAt.Format(time.RFC3339))
+	}
+	_, err := fmt.Fprintln(cmd.ErrOrStderr(), message)
+	return err
+}
@@ -0,0 +1,228 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Azure/ARO-HCP/admin/client/cmd/base"
+	adminClient "github.com/Azure/ARO-HCP/admin/client/pkg/client"
+)
+
+// NewHCPCommand groups the commands operating on a single hosted control
+// plane, identified by its ARM resource ID.
+func NewHCPCommand() (*cobra.Command, error) {
+	cmd := &cobra.Command{
+		Use:   "hcp",
+		Short: "Operate on a hosted control plane",
+	}
+
+	for _, newCmd := range []func() (*cobra.Command, error){
+		newBreakglassCommand,
+		newCosmosDumpCommand,
+		newBillingDumpCommand,
+		newSerialConsoleCommand,
+		newDesiredControlPlaneSizeCommand,
+		newHistoryCommand,
+		newHelloWorldCommand,
+		newLoadBalancersCommand,
+	} {
+		subCmd, err := newCmd()
+		if err != nil {
+			return nil, err
+		}
+		cmd.AddCommand(subCmd)
+	}
+
+	return cmd, nil
+}
+
+// hcpRunFunc executes an HCP scoped command against the validated cluster
+// resource ID.
+type hcpRunFunc func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID string) error
+
+// newHCPLeafCommand binds the authentication and resource ID flags to cmd and
+// wires run as its RunE.
+func newHCPLeafCommand(cmd *cobra.Command, run hcpRunFunc) (*cobra.Command, error) {
+	authOpts := base.DefaultAuthOptions()
+	hcpOpts := base.DefaultHCPOptions()
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		resourceID, err := hcpOpts.Validate()
+		if err != nil {
+			return err
+		}
+		client, err := base.NewClient(ctx, authOpts)
+		if err != nil {
+			return err
+		}
+		if err := run(ctx, cmd, client, resourceID); err != nil {
+			return fmt.Errorf("%s failed: %w", cmd.CommandPath(), err)
+		}
+		return nil
+	}
+
+	if err := authOpts.BindFlags(cmd); err != nil {
+		return nil, err
+	}
+	if err := hcpOpts.BindFlags(cmd); err != nil {
+		return nil, err
+	}
+
+	return cmd, nil
+}
+
+func newCosmosDumpCommand() (*cobra.Command, error) {
+	outputOpts := base.DefaultOutputOptions()
+	outputOpts.Format = base.OutputFormatJSON
+	cmd, err := newHCPLeafCommand(&cobra.Command{
+		Use:   "cosmosdump",
+		Short: "Dump the Cosmos DB documents of a cluster",
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID string) error {
+		if err := outputOpts.Validate(); err != nil {
+			return err
+		}
+		dump, err := client.CosmosDump(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		return outputOpts.Print(cmd.OutOrStdout(), dump, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	outputOpts.BindFlags(cmd)
+	return cmd, nil
+}
+
+func newBillingDumpCommand() (*cobra.Command, error) {
+	outputOpts := base.DefaultOutputOptions()
+	outputOpts.Format = base.OutputFormatJSON
+	cmd, err := newHCPLeafCommand(&cobra.Command{
+		Use:   "billingdump",
+		Short: "Dump the billing documents of a cluster",
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID string) error {
+		if err := outputOpts.Validate(); err != nil {
+			return err
+		}
+		dump, err := client.BillingDump(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		return outputOpts.Print(cmd.OutOrStdout(), dump, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	outputOpts.BindFlags(cmd)
+	return cmd, nil
+}
+
+func newSerialConsoleCommand() (*cobra.Command, error) {
+	var vmName string
+	cmd, err := newHCPLeafCommand(&cobra.Command{
+		Use:   "serial-console",
+		Short: "Print the serial console log of a node VM of a cluster",
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID string) error {
+		if vmName == "" {
+			return fmt.Errorf("vm-name cannot be empty")
+		}
+		log, err := client.SerialConsole(ctx, resourceID, vmName)
+		if err != nil {
+			return err
+		}
+		_, err = cmd.OutOrStdout().Write(log)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	cmd.Flags().StringVar(&vmName, "vm-name", vmName, "Name of the VM in the managed resource group of the cluster")
+	if err := cmd.MarkFlagRequired("vm-name"); err != nil {
+		return nil, fmt.Errorf("failed to mark flag %q as required: %w", "vm-name", err)
+	}
+	return cmd, nil
+}
+
+func newDesiredControlPlaneSizeCommand() (*cobra.Command, error) {
+	var (
+		size      string
+		clearSize bool
+		pinned    = true
+	)
+	cmd, err := newHCPLeafCommand(&cobra.Command{
+		Use:   "desired-control-plane-size",
+		Short: "Override or clear the control plane size of a cluster",
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID string) error {
+		var desired *string
+		switch {
+		case clearSize && size != "":
+			return fmt.Errorf("size and clear are mutually exclusive")
+		case !clearSize && size == "":
+			return fmt.Errorf("one of size or clear is required")
+		case !clearSize:
+			desired = &size
+		}
+		result, err := client.SetDesiredControlPlaneSize(ctx, resourceID, desired, pinned)
+		if err != nil {
+			return err
+		}
+		if result.Size == nil {
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), "Desired control plane size cleared")
+			return err
+		}
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "Desired control plane size set to %s (pinned=%t)\n", *result.Size, result.Pinned != nil && *result.Pinned)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	cmd.Flags().StringVar(&size, "size", size, "Control plane size to request for the cluster")
+	cmd.Flags().BoolVar(&clearSize, "clear", clearSize, "Clear the control plane size override")
+	cmd.Flags().BoolVar(&pinned, "pin", pinned, "Pin the size so control plane right-sizing does not change it")
+	return cmd, nil
+}
+
+func newHelloWorldCommand() (*cobra.Command, error) {
+	return newHCPLeafCommand(&cobra.Command{
+		Use:   "hello-world",
+		Short: "Execute the HCP scoped Hello World Admin API endpoint",
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID string) error {
+		result, err := client.HCPHelloWorld(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		return base.PrintJSON(cmd.OutOrStdout(), result)
+	})
+}
+
+func newLoadBalancersCommand() (*cobra.Command, error) {
+	return newHCPLeafCommand(&cobra.Command{
+		Use:   "load-balancers",
+		Short: "List the load balancers of the managed resource group of a cluster",
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID string) error {
+		result, err := client.HCPLoadBalancers(ctx, resourceID)
+		if err != nil {
+			return err
+		}
+		return base.PrintJSON(cmd.OutOrStdout(), result)
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Azure/ARO-HCP/admin/client/cmd/base"
+	adminClient "github.com/Azure/ARO-HCP/admin/client/pkg/client"
+)
+
+func newHistoryCommand() (*cobra.Command, error) {
+	var (
+		target adminClient.HistoryTarget
+		top    int32
+		all    bool
+	)
+	outputOpts := base.DefaultOutputOptions()
+
+	cmd, err := newHCPLeafCommand(&cobra.Command{
+		Use:   "history",
+		Short: "Show the change history of a cluster, or of one of its node pools or external auths",
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, resourceID string) error {
+		if err := outputOpts.Validate(); err != nil {
+			return err
+		}
+		if len(target.NodePoolName) > 0 && len(target.ExternalAuthName) > 0 {
+			return fmt.Errorf("node-pool and external-auth are mutually exclusive")
+		}
+
+		changes := []adminClient.ResourceChange{}
+		skipToken := ""
+		for {
+			page, err := client.History(ctx, resourceID, target, top, skipToken)
+			if err != nil {
+				return err
+			}
+			changes = append(changes, page.Value...)
+
+			skipToken, err = adminClient.SkipTokenFromNextLink(page.NextLink)
+			if err != nil {
+				return err
+			}
+			if !all || skipToken == "" {
+				break
+			}
+		}
+
+		table := &base.Table{Headers: []string{"CHANGED AT", "TARGET", "OPERATION", "MODIFIED BY", "CHANGES"}}
+		for _, change := range changes {
+			modifiedBy, _ := change.SystemData["lastModifiedBy"].(string)
+			paths := make([]string, 0, len(change.Changes))
+			for _, propertyChange := range change.Changes {
+				paths = append(paths, fmt.Sprintf("%s (%s)", propertyChange.Path, propertyChange.ChangeType))
+			}
+			table.Rows = append(table.Rows, []string{
+				change.ChangedAt.Format(time.RFC3339),
+				change.TargetResourceID,
+				change.OperationID,
+				modifiedBy,
+				strings.Join(paths, ", "),
+			})
+		}
+		return outputOpts.Print(cmd.OutOrStdout(), changes, table)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Flags().StringVar(&target.NodePoolName, "node-pool", target.NodePoolName, "Only show the history of this node pool")
+	cmd.Flags().StringVar(&target.ExternalAuthName, "external-auth", target.ExternalAuthName, "Only show the history of this external auth")
+	cmd.Flags().Int32Var(&top, "top", top, "Number of records to request per page, defaults to the server page size")
+	cmd.Flags().BoolVar(&all, "all", all, "Follow next links until the full history has been retrieved")
+	outputOpts.BindFlags(cmd)
+
+	return cmd, nil
+}
@@ -0,0 +1,267 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stamp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Azure/ARO-HCP/admin/client/cmd/base"
+	adminClient "github.com/Azure/ARO-HCP/admin/client/pkg/client"
+)
+
+// NewStampCommand groups the commands operating on fleet stamps.
+func NewStampCommand() (*cobra.Command, error) {
+	cmd := &cobra.Command{
+		Use:   "stamp",
+		Short: "Inspect, approve and drain fleet stamps",
+	}
+
+	for _, newCmd := range []func() (*cobra.Command, error){
+		newListCommand,
+		newGetCommand,
+		newApproveCommand,
+		newRevokeCommand,
+		newManagementClusterCommand,
+		newDrainCommand,
+		newUndrainCommand,
+	} {
+		subCmd, err := newCmd()
+		if err != nil {
+			return nil, err
+		}
+		cmd.AddCommand(subCmd)
+	}
+
+	return cmd, nil
+}
+
+type runFunc func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, args []string) error
+
+// newLeafCommand binds the authentication flags to cmd and wires run as its
+// RunE.
+func newLeafCommand(cmd *cobra.Command, run runFunc) (*cobra.Command, error) {
+	authOpts := base.DefaultAuthOptions()
+
+	cmd.SilenceErrors = true
+	cmd.SilenceUsage = true
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		client, err := base.NewClient(ctx, authOpts)
+		if err != nil {
+			return err
+		}
+		if err := run(ctx, cmd, client, args); err != nil {
+			return fmt.Errorf("%s failed: %w", cmd.CommandPath(), err)
+		}
+		return nil
+	}
+
+	if err := authOpts.BindFlags(cmd); err != nil {
+		return nil, err
+	}
+
+	return cmd, nil
+}
+
+func conditionColumns(conditions []adminClient.Condition, conditionType string) []string {
+	condition := adminClient.FindCondition(conditions, conditionType)
+	if condition == nil {
+		return []string{"Unknown", "", ""}
+	}
+	return []string{condition.Status, condition.Reason, condition.Message}
+}
+
+func stampsTable(stamps []adminClient.Stamp) *base.Table {
+	table := &base.Table{Headers: []string{"RESOURCE ID", "APPROVED", "REASON", "MESSAGE", "APPROVERS", "PRODUCTION READY", "CAPACITY SCORE"}}
+	for _, stamp := range stamps {
+		row := append([]string{stamp.ResourceID}, conditionColumns(stamp.Status.Conditions, adminClient.StampConditionApproved)...)
+		productionReady := ""
+		if condition := adminClient.FindCondition(stamp.Status.Conditions, adminClient.StampConditionProductionReady); condition != nil {
+			productionReady = condition.Status
+		}
+		table.Rows = append(table.Rows, append(row, stamp.Status.Approvers(), productionReady, stamp.ManagementClusterCapacity.CapacityScore()))
+	}
+	return table
+}
+
+func newListCommand() (*cobra.Command, error) {
+	outputOpts := base.DefaultOutputOptions()
+	cmd, err := newLeafCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List all stamps",
+		Args:  cobra.NoArgs,
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, args []string) error {
+		if err := outputOpts.Validate(); err != nil {
+			return err
+		}
+		stamps, err := client.ListStamps(ctx)
+		if err != nil {
+			return err
+		}
+		return outputOpts.Print(cmd.OutOrStdout(), stamps, stampsTable(stamps))
+	})
+	if err != nil {
+		return nil, err
+	}
+	outputOpts.BindFlags(cmd)
+	return cmd, nil
+}
+
+func newGetCommand() (*cobra.Command, error) {
+	outputOpts := base.DefaultOutputOptions()
+	cmd, err := newLeafCommand(&cobra.Command{
+		Use:   "get STAMP",
+		Short: "Show a single stamp",
+		Args:  cobra.ExactArgs(1),
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, args []string) error {
+		if err := outputOpts.Validate(); err != nil {
+			return err
+		}
+		stamp, err := client.GetStamp(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		return outputOpts.Print(cmd.OutOrStdout(), stamp, stampsTable([]adminClient.Stamp{*stamp}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	outputOpts.BindFlags(cmd)
+	return cmd, nil
+}
+
+func newApproveCommand() (*cobra.Command, error) {
+	return newApprovalCommand(true, "approve STAMP", "Approve a stamp for rollout")
+}
+
+func newRevokeCommand() (*cobra.Command, error) {
+	return newApprovalCommand(false, "revoke STAMP", "Revoke the approval of a stamp")
+}
+
+func newApprovalCommand(approved bool, use, short string) (*cobra.Command, error) {
+	var reason, message string
+	cmd, err := newLeafCommand(&cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, args []string) error {
+		if reason == "" || message == "" {
+			return fmt.Errorf("reason and message cannot be empty")
+		}
+		if err := client.SetStampApproval(ctx, args[0], adminClient.StampApproval{
+			Approved: approved,
+			Reason:   reason,
+			Message:  message,
+		}); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintf(cmd.OutOrStdout(), "Stamp %s approved=%t\n", args[0], approved)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Flags().StringVar(&reason, "reason", reason, "CamelCase reason recorded on the Approved condition")
+	cmd.Flags().StringVar(&message, "message", message, "Human readable message recorded on the Approved condition")
+	for _, flag := range []string{"reason", "message"} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			return nil, fmt.Errorf("failed to mark flag %q as required: %w", flag, err)
+		}
+	}
+	return cmd, nil
+}
+
+func newManagementClusterCommand() (*cobra.Command, error) {
+	outputOpts := base.DefaultOutputOptions()
+	cmd, err := newLeafCommand(&cobra.Command{
+		Use:   "management-cluster STAMP NAME",
+		Short: "Show a management cluster of a stamp",
+		Args:  cobra.ExactArgs(2),
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, args []string) error {
+		if err := outputOpts.Validate(); err != nil {
+			return err
+		}
+		managementCluster, err := client.GetManagementCluster(ctx, args[0], args[1])
+		if err != nil {
+			return err
+		}
+		table := &base.Table{
+			Headers: []string{"RESOURCE ID", "AKS RESOURCE ID", "PROVISION SHARD", "MAESTRO CONSUMER", "CAPACITY SCORE"},
+			Rows: [][]string{{
+				managementCluster.ResourceID,
+				managementCluster.Status.AKSResourceID,
+				managementCluster.Status.ClusterServiceProvisionShardID,
+				managementCluster.Status.MaestroConsumerName,
+				managementCluster.Status.Capacity.CapacityScore(),
+			}},
+		}
+		return outputOpts.Print(cmd.OutOrStdout(), managementCluster, table)
+	})
+	if err != nil {
+		return nil, err
+	}
+	outputOpts.BindFlags(cmd)
+	return cmd, nil
+}
+
+func newDrainCommand() (*cobra.Command, error) {
+	var drain adminClient.ManagementClusterDrain
+	cmd, err := newLeafCommand(&cobra.Command{
+		Use:   "drain STAMP NAME",
+		Short: "Cordon a management cluster and migrate its hosted control planes away",
+		Long: "Cordon a management cluster and migrate its hosted control planes to other management clusters.\n" +
+			"Progress is reported in the Drained condition and drain status shown by management-cluster -o json.",
+		Args: cobra.ExactArgs(2),
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, args []string) error {
+		if drain.Reason == "" {
+			return fmt.Errorf("reason cannot be empty")
+		}
+		if err := client.DrainManagementCluster(ctx, args[0], args[1], drain); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintf(cmd.OutOrStdout(), "Management cluster %s/%s is draining\n", args[0], args[1])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Flags().StringVar(&drain.Reason, "reason", drain.Reason, "Why the management cluster is drained, e.g. an incident or decommissioning ticket")
+	cmd.Flags().Int32Var(&drain.MaxConcurrentMigrations, "max-concurrent-migrations", drain.MaxConcurrentMigrations, "Maximum number of hosted control planes migrated at the same time (0 uses the server default)")
+	if err := cmd.MarkFlagRequired("reason"); err != nil {
+		return nil, fmt.Errorf("failed to mark flag %q as required: %w", "reason", err)
+	}
+	return cmd, nil
+}
+
+func newUndrainCommand() (*cobra.Command, error) {
+	return newLeafCommand(&cobra.Command{
+		Use:   "undrain STAMP NAME",
+		Short: "Withdraw the drain of a management cluster",
+		Long: "Withdraw the drain of a management cluster and lift the cordon it placed, unless the capacity controller holds it cordoned.\n" +
+			"Hosted control planes already migrated stay on their new management clusters.",
+		Args: cobra.ExactArgs(2),
+	}, func(ctx context.Context, cmd *cobra.Command, client adminClient.Client, args []string) error {
+		if err := client.UndrainManagementCluster(ctx, args[0], args[1]); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintf(cmd.OutOrStdout(), "Management cluster %s/%s is no longer draining\n", args[0], args[1])
+		return err
+	})
+}
@@ -25,7 +25,9 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
 
+	"github.com/Azure/ARO-HCP/admin/client/cmd/hcp"
 	"github.com/Azure/ARO-HCP/admin/client/cmd/helloworld"
+	"github.com/Azure/ARO-HCP/admin/client/cmd/stamp"
 )
 
 func main() {
@@ -54,6 +56,8 @@ func main() {
 	// Add subcommands
 	subcommands := []func() (*cobra.Command, error){
 		helloworld.NewHelloWorldCommand,
+		hcp.NewHCPCommand,
+		stamp.NewStampCommand,
 	}
 
 	for _, newCmd := range subcommands {
@@ -18,15 +18,63 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httputil"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 )
 
+// Client wraps the endpoints registered by the Admin API server. HCP scoped
+// methods take the ARM resource ID of the cluster. Responses outside of the
+// 2xx range are returned as *CloudError.
 type Client interface {
 	HelloWorld(ctx context.Context) error
+
+	HCPHelloWorld(ctx context.Context, resourceID string) (json.RawMessage, error)
+	HCPLoadBalancers(ctx context.Context, resourceID string) (json.RawMessage, error)
+	// CreateBreakglassSession requests a new breakglass session and returns
+	// the name of the session. A session that requires approval is not
+	// provisioned until another principal has approved it.
+	CreateBreakglassSession(ctx context.Context, resourceID string, session BreakglassSession) (string, error)
+	// ApproveBreakglassSession approves a session that was created with
+	// RequireApproval. The approver must not be the owner of the session.
+	ApproveBreakglassSession(ctx context.Context, resourceID string, sessionName string) error
+	// ExtendBreakglassSession extends an active session by ttl. The total
+	// lifetime of the session must not exceed the maximum session TTL.
+	ExtendBreakglassSession(ctx context.Context, resourceID string, sessionName string, ttl time.Duration) error
+	// RevokeBreakglassSession ends a session immediately.
+	RevokeBreakglassSession(ctx context.Context, resourceID string, sessionName string, reason string) error
+	// GetBreakglassKubeconfig polls the kubeconfig of a breakglass session
+	// once. The result is not ready until the session has been provisioned.
+	GetBreakglassKubeconfig(ctx context.Context, resourceID string, sessionName string) (*BreakglassKubeconfig, error)
+	CosmosDump(ctx context.Context, resourceID string) (json.RawMessage, error)
+	BillingDump(ctx context.Context, resourceID string) (json.RawMessage, error)
+	SerialConsole(ctx context.Context, resourceID string, vmName string) ([]byte, error)
+	// SetDesiredControlPlaneSize overrides the control plane size of the
+	// cluster. A nil size clears the override. A pinned size is not changed
+	// by control plane right-sizing.
+	SetDesiredControlPlaneSize(ctx context.Context, resourceID string, size *string, pinned bool) (*DesiredControlPlaneSize, error)
+	History(ctx context.Context, resourceID string, target HistoryTarget, top int32, skipToken string) (*HistoryPage, error)
+
+	ListStamps(ctx context.Context) ([]Stamp, error)
+	GetStamp(ctx context.Context, stampIdentifier string) (*Stamp, error)
+	GetManagementCluster(ctx context.Context, stampIdentifier string, managementClusterName string) (*ManagementCluster, error)
+	SetStampApproval(ctx context.Context, stampIdentifier string, approval StampApproval) error
+	// DrainManagementCluster cordons the management cluster and starts
+	// migrating its hosted control planes. Progress is reported in the
+	// management cluster Drained condition and drain status.
+	DrainManagementCluster(ctx context.Context, stampIdentifier string, managementClusterName string, drain ManagementClusterDrain) error
+	// UndrainManagementCluster withdraws a drain and lifts the cordon it
+	// placed. Migrations already started are not rolled back.
+	UndrainManagementCluster(ctx context.Context, stampIdentifier string, managementClusterName string) error
 }
 
 type httpClient interface {
@@ -99,39 +147,307 @@ func (d *debuggingRoundTripper) Do(request *http.Request) (*http.Response, error
 
 var _ httpClient = (*debuggingRoundTripper)(nil)
 
-func (c *client) newGetRequest(ctx context.Context, resource string) (*http.Request, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s%s", c.endpoint, resource), http.NoBody)
+func (c *client) newRequest(ctx context.Context, method string, resource string, body any) (*http.Request, error) {
+	var bodyReader io.Reader = http.NoBody
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		bodyReader = bytes.NewReader(bodyBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s%s", c.endpoint, resource), bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 	req.Host = c.hostHeader
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.token))
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
 	return req, nil
 }
 
-func (c *client) HelloWorld(ctx context.Context) error {
-	logger, err := logr.FromContext(ctx)
+func (c *client) newGetRequest(ctx context.Context, resource string) (*http.Request, error) {
+	return c.newRequest(ctx, http.MethodGet, resource, nil)
+}
+
+// do sends the request and returns the response along with its fully read
+// body. Responses outside of the 2xx range are converted into a *CloudError.
+func (c *client) do(req *http.Request) (*http.Response, []byte, error) {
+	resp, err := c.client.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to create logger: %w", err)
+		return nil, nil, fmt.Errorf("failed to send request %s: %w", req.URL.String(), err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			logr.FromContextOrDiscard(req.Context()).Error(err, "Failed to close body.")
+		}
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response body of %s: %w", req.URL.String(), err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp, body, newCloudError(resp.StatusCode, body)
+	}
+	return resp, body, nil
+}
+
+// doJSON sends a request and decodes the response body into out, unless out
+// is nil.
+func (c *client) doJSON(ctx context.Context, method string, resource string, body any, out any) error {
+	req, err := c.newRequest(ctx, method, resource, body)
+	if err != nil {
+		return err
+	}
+	_, respBody, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to decode response of %s: %w", req.URL.String(), err)
 	}
+	return nil
+}
+
+func hcpPath(resourceID string, suffix string) string {
+	return "/admin/v1/hcp/" + strings.TrimPrefix(resourceID, "/") + suffix
+}
+
+func stampPath(stampIdentifier string, suffix string) string {
+	return "/admin/v1/stamps/" + url.PathEscape(stampIdentifier) + suffix
+}
+
+func (c *client) HelloWorld(ctx context.Context) error {
 	req, err := c.newGetRequest(ctx, "/admin/helloworld")
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
-	resp, err := c.client.Do(req)
+	if _, _, err := c.do(req); err != nil {
+		return fmt.Errorf("failed to get hello world: %w", err)
+	}
+	return nil
+}
+
+func (c *client) HCPHelloWorld(ctx context.Context, resourceID string) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.doJSON(ctx, http.MethodGet, hcpPath(resourceID, "/helloworld"), nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *client) HCPLoadBalancers(ctx context.Context, resourceID string) (json.RawMessage, error) {
+	var out json.RawMessage
+	// The server registers this route with the misspelled "hellworld" segment.
+	if err := c.doJSON(ctx, http.MethodGet, hcpPath(resourceID, "/hellworld/lbs"), nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *client) CreateBreakglassSession(ctx context.Context, resourceID string, session BreakglassSession) (string, error) {
+	req, err := c.newRequest(ctx, http.MethodPost, hcpPath(resourceID, "/breakglass"), struct {
+		Group           string `json:"group"`
+		TTL             string `json:"ttl"`
+		RequireApproval bool   `json:"requireApproval,omitempty"`
+		Justification   string `json:"justification,omitempty"`
+		IncidentID      string `json:"incidentId,omitempty"`
+	}{
+		Group:           session.Group,
+		TTL:             session.TTL.String(),
+		RequireApproval: session.RequireApproval,
+		Justification:   session.Justification,
+		IncidentID:      session.IncidentID,
+	})
 	if err != nil {
-		return fmt.Errorf("failed to send request %s: %w", req.URL.String(), err)
+		return "", err
 	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			logger.Error(err, "Failed to close body.")
+	resp, _, err := c.do(req)
+	if err != nil {
+		return "", err
+	}
+	// The Location header points at <resourceID>/breakglass/<sessionName>/kubeconfig.
+	location := resp.Header.Get("Location")
+	sessionName := path.Base(path.Dir(location))
+	if len(location) == 0 || path.Base(location) != "kubeconfig" || sessionName == "breakglass" {
+		return "", fmt.Errorf("breakglass session was created but the response has an unexpected Location header %q", location)
+	}
+	return sessionName, nil
+}
+
+func (c *client) ApproveBreakglassSession(ctx context.Context, resourceID string, sessionName string) error {
+	return c.doJSON(ctx, http.MethodPost, hcpPath(resourceID, "/breakglass/"+url.PathEscape(sessionName)+"/approve"), nil, nil)
+}
+
+func (c *client) ExtendBreakglassSession(ctx context.Context, resourceID string, sessionName string, ttl time.Duration) error {
+	return c.doJSON(ctx, http.MethodPost, hcpPath(resourceID, "/breakglass/"+url.PathEscape(sessionName)+"/extend"), map[string]string{
+		"ttl": ttl.String(),
+	}, nil)
+}
+
+func (c *client) RevokeBreakglassSession(ctx context.Context, resourceID string, sessionName string, reason string) error {
+	return c.doJSON(ctx, http.MethodPost, hcpPath(resourceID, "/breakglass/"+url.PathEscape(sessionName)+"/revoke"), map[string]string{
+		"reason": reason,
+	}, nil)
+}
+
+func (c *client) GetBreakglassKubeconfig(ctx context.Context, resourceID string, sessionName string) (*BreakglassKubeconfig, error) {
+	req, err := c.newGetRequest(ctx, hcpPath(resourceID, "/breakglass/"+url.PathEscape(sessionName)+"/kubeconfig"))
+	if err != nil {
+		return nil, err
+	}
+	resp, body, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BreakglassKubeconfig{}
+	if resp.StatusCode == http.StatusAccepted {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			result.RetryAfter = time.Duration(seconds) * time.Second
 		}
-	}()
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &result.Status); err != nil {
+				return nil, fmt.Errorf("failed to decode session status: %w", err)
+			}
+		}
+		return result, nil
+	}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to get hello world: %d", resp.StatusCode)
+	result.Ready = true
+	result.Kubeconfig = body
+	if expires := resp.Header.Get("Expires"); len(expires) > 0 {
+		expiresAt, err := time.Parse(time.RFC3339, expires)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse Expires header %q: %w", expires, err)
+		}
+		result.ExpiresAt = &expiresAt
 	}
-	return nil
+	return result, nil
+}
+
+func (c *client) CosmosDump(ctx context.Context, resourceID string) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.doJSON(ctx, http.MethodGet, hcpPath(resourceID, "/cosmosdump"), nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *client) BillingDump(ctx context.Context, resourceID string) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.doJSON(ctx, http.MethodGet, hcpPath(resourceID, "/billingdump"), nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *client) SerialConsole(ctx context.Context, resourceID string, vmName string) ([]byte, error) {
+	req, err := c.newGetRequest(ctx, hcpPath(resourceID, "/serialconsole?vmName="+url.QueryEscape(vmName)))
+	if err != nil {
+		return nil, err
+	}
+	_, body, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func (c *client) SetDesiredControlPlaneSize(ctx context.Context, resourceID string, size *string, pinned bool) (*DesiredControlPlaneSize, error) {
+	in := DesiredControlPlaneSize{Size: size}
+	if size != nil {
+		in.Pinned = &pinned
+	}
+	out := &DesiredControlPlaneSize{}
+	if err := c.doJSON(ctx, http.MethodPost, hcpPath(resourceID, "/desiredcontrolplanesize"), in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *client) History(ctx context.Context, resourceID string, target HistoryTarget, top int32, skipToken string) (*HistoryPage, error) {
+	suffix := "/history"
+	switch {
+	case len(target.NodePoolName) > 0:
+		suffix = "/nodepools/" + url.PathEscape(target.NodePoolName) + suffix
+	case len(target.ExternalAuthName) > 0:
+		suffix = "/externalauths/" + url.PathEscape(target.ExternalAuthName) + suffix
+	}
+
+	query := url.Values{}
+	if top > 0 {
+		query.Set("$top", strconv.Itoa(int(top)))
+	}
+	if len(skipToken) > 0 {
+		query.Set("$skipToken", skipToken)
+	}
+	if len(query) > 0 {
+		suffix += "?" + query.Encode()
+	}
+
+	page := &HistoryPage{}
+	if err := c.doJSON(ctx, http.MethodGet, hcpPath(resourceID, suffix), nil, page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func (c *client) ListStamps(ctx context.Context) ([]Stamp, error) {
+	stamps := []Stamp{}
+	if err := c.doJSON(ctx, http.MethodGet, "/admin/v1/stamps", nil, &stamps); err != nil {
+		return nil, err
+	}
+	return stamps, nil
+}
+
+func (c *client) GetStamp(ctx context.Context, stampIdentifier string) (*Stamp, error) {
+	stamp := &Stamp{}
+	if err := c.doJSON(ctx, http.MethodGet, stampPath(stampIdentifier, ""), nil, stamp); err != nil {
+		return nil, err
+	}
+	return stamp, nil
+}
+
+func (c *client) GetManagementCluster(ctx context.Context, stampIdentifier string, managementClusterName string) (*ManagementCluster, error) {
+	managementCluster := &ManagementCluster{}
+	if err := c.doJSON(ctx, http.MethodGet, stampPath(stampIdentifier, "/managementclusters/"+url.PathEscape(managementClusterName)), nil, managementCluster); err != nil {
+		return nil, err
+	}
+	return managementCluster, nil
+}
+
+func (c *client) SetStampApproval(ctx context.Context, stampIdentifier string, approval StampApproval) error {
+	return c.doJSON(ctx, http.MethodPost, stampPath(stampIdentifier, "/approval"), approval, nil)
+}
+
+func (c *client) DrainManagementCluster(ctx context.Context, stampIdentifier string, managementClusterName string, drain ManagementClusterDrain) error {
+	return c.doJSON(ctx, http.MethodPost, stampPath(stampIdentifier, "/managementclusters/"+url.PathEscape(managementClusterName)+"/drain"), drain, nil)
+}
+
+func (c *client) UndrainManagementCluster(ctx context.Context, stampIdentifier string, managementClusterName string) error {
+	return c.doJSON(ctx, http.MethodPost, stampPath(stampIdentifier, "/managementclusters/"+url.PathEscape(managementClusterName)+"/undrain"), nil, nil)
+}
+
+// SkipTokenFromNextLink extracts the $skipToken query parameter from a
+// nextLink returned by a paged endpoint.
+func SkipTokenFromNextLink(nextLink string) (string, error) {
+	if len(nextLink) == 0 {
+		return "", nil
+	}
+	parsed, err := url.Parse(nextLink)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse nextLink %q: %w", nextLink, err)
+	}
+	return parsed.Query().Get("$skipToken"), nil
 }
@@ -0,0 +1,262 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const testClusterResourceID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.RedHatOpenShift/hcpOpenShiftClusters/cluster"
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("unexpected Authorization header %q", got)
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return NewClient(server.URL, "", "token", false, false)
+}
+
+func TestCloudError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":{"code":"InvalidRequestContent","message":"bad","target":"reason","details":[{"code":"Inner","message":"nested"}]}}`))
+	})
+
+	err := client.SetStampApproval(context.Background(), "1", StampApproval{})
+	var cloudError *CloudError
+	if !errors.As(err, &cloudError) {
+		t.Fatalf("expected CloudError, got %v", err)
+	}
+	if cloudError.StatusCode != http.StatusBadRequest || cloudError.Body.Code != "InvalidRequestContent" || len(cloudError.Body.Details) != 1 {
+		t.Errorf("unexpected CloudError %#v", cloudError)
+	}
+	expected := "400 Bad Request: InvalidRequestContent (target \"reason\"): bad\n  -: Inner: nested"
+	if err.Error() != expected {
+		t.Errorf("unexpected error message\nwant: %q\ngot:  %q", expected, err.Error())
+	}
+}
+
+func TestCloudErrorPlainText(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not authorized", http.StatusForbidden)
+	})
+
+	_, err := client.ListStamps(context.Background())
+	var cloudError *CloudError
+	if !errors.As(err, &cloudError) {
+		t.Fatalf("expected CloudError, got %v", err)
+	}
+	if cloudError.Body.Message != "not authorized" {
+		t.Errorf("unexpected message %q", cloudError.Body.Message)
+	}
+}
+
+func TestBreakglass(t *testing.T) {
+	ready := false
+	approved := false
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/admin/v1/hcp"+testClusterResourceID+"/breakglass":
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			if body["group"] != "aro-sre" || body["ttl"] != "1h0m0s" || body["requireApproval"] != true || body["justification"] != "node is wedged" || body["incidentId"] != "IcM-1" {
+				t.Errorf("unexpected body %v", body)
+			}
+			w.Header().Set("Location", r.URL.Path+"/session-1/kubeconfig")
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodPost && r.URL.Path == "/admin/v1/hcp"+testClusterResourceID+"/breakglass/session-1/approve":
+			approved = true
+			w.Header().Set("Location", "/admin/v1/hcp"+testClusterResourceID+"/breakglass/session-1/kubeconfig")
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodPost && r.URL.Path == "/admin/v1/hcp"+testClusterResourceID+"/breakglass/session-1/extend":
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			if body["ttl"] != "30m0s" {
+				t.Errorf("unexpected body %v", body)
+			}
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodPost && r.URL.Path == "/admin/v1/hcp"+testClusterResourceID+"/breakglass/session-1/revoke":
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			if body["reason"] != "incident resolved" {
+				t.Errorf("unexpected body %v", body)
+			}
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodGet && r.URL.Path == "/admin/v1/hcp"+testClusterResourceID+"/breakglass/session-1/kubeconfig":
+			if !ready {
+				w.Header().Set("Retry-After", "7")
+				w.WriteHeader(http.StatusAccepted)
+				_, _ = w.Write([]byte(`{"status":"Session is not ready"}`))
+				return
+			}
+			w.Header().Set("Expires", "2026-03-01T12:00:00Z")
+			_, _ = w.Write([]byte("apiVersion: v1\n"))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	sessionName, err := client.CreateBreakglassSession(ctx, testClusterResourceID, BreakglassSession{
+		Group:           "aro-sre",
+		TTL:             time.Hour,
+		RequireApproval: true,
+		Justification:   "node is wedged",
+		IncidentID:      "IcM-1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sessionName != "session-1" {
+		t.Fatalf("unexpected session name %q", sessionName)
+	}
+
+	if err := client.ApproveBreakglassSession(ctx, testClusterResourceID, sessionName); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !approved {
+		t.Errorf("session was not approved")
+	}
+
+	result, err := client.GetBreakglassKubeconfig(ctx, testClusterResourceID, sessionName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Ready || result.RetryAfter != 7*time.Second || result.Status["status"] != "Session is not ready" {
+		t.Errorf("unexpected pending result %#v", result)
+	}
+
+	ready = true
+	result, err = client.GetBreakglassKubeconfig(ctx, testClusterResourceID, sessionName)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Ready || string(result.Kubeconfig) != "apiVersion: v1\n" || result.ExpiresAt == nil || !result.ExpiresAt.Equal(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected ready result %#v", result)
+	}
+
+	if err := client.ExtendBreakglassSession(ctx, testClusterResourceID, sessionName, 30*time.Minute); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.RevokeBreakglassSession(ctx, testClusterResourceID, sessionName, "incident resolved"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/admin/v1/hcp"+testClusterResourceID+"/nodepools/np-1/history" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("$top") != "10" || r.URL.Query().Get("$skipToken") != "abc" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write([]byte(`{"value":[{"targetResourceId":"np","changedAt":"2026-03-01T12:00:00Z","changes":[{"path":"tags.env","changeType":"Modified"}]}],"nextLink":"https://admin/history?%24skipToken=def&%24top=10"}`))
+	})
+
+	page, err := client.History(context.Background(), testClusterResourceID, HistoryTarget{NodePoolName: "np-1"}, 10, "abc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Value) != 1 || page.Value[0].Changes[0].Path != "tags.env" {
+		t.Errorf("unexpected page %#v", page)
+	}
+	skipToken, err := SkipTokenFromNextLink(page.NextLink)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if skipToken != "def" {
+		t.Errorf("unexpected skip token %q", skipToken)
+	}
+}
+
+func TestStamps(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/admin/v1/stamps":
+			_, _ = w.Write([]byte(`[{"resourceId":"/stamps/1","status":{"conditions":[{"type":"Approved","status":"True","reason":"Reviewed","message":"ok"}],"approvals":[{"approver":"alice@example.com","approvedTime":"2026-03-01T12:00:00Z"},{"approver":"bob@example.com","approvedTime":"2026-03-01T13:00:00Z"}]},"managementClusterCapacity":{"score":91,"cordoned":true}}]`))
+		case r.Method == http.MethodPost && r.URL.Path == "/admin/v1/stamps/1/managementclusters/default/drain":
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			if body["reason"] != "decommission" || body["maxConcurrentMigrations"] != float64(3) {
+				t.Errorf("unexpected body %v", body)
+			}
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodPost && r.URL.Path == "/admin/v1/stamps/1/managementclusters/default/undrain":
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodPost && r.URL.Path == "/admin/v1/stamps/1/approval":
+			var body StampApproval
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			if !body.Approved || body.Reason != "Reviewed" || body.Message != "ok" {
+				t.Errorf("unexpected body %#v", body)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	stamps, err := client.ListStamps(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stamps) != 1 {
+		t.Fatalf("unexpected stamps %#v", stamps)
+	}
+	if condition := FindCondition(stamps[0].Status.Conditions, StampConditionApproved); condition == nil || condition.Status != "True" {
+		t.Errorf("unexpected approved condition %#v", condition)
+	}
+	if approvers := stamps[0].Status.Approvers(); approvers != "alice@example.com,bob@example.com" {
+		t.Errorf("unexpected approvers %q", approvers)
+	}
+	if score := stamps[0].ManagementClusterCapacity.CapacityScore(); score != "91 (cordoned)" {
+		t.Errorf("unexpected capacity score %q", score)
+	}
+
+	if err := client.SetStampApproval(ctx, "1", StampApproval{Approved: true, Reason: "Reviewed", Message: "ok"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := client.DrainManagementCluster(ctx, "1", "default", ManagementClusterDrain{Reason: "decommission", MaxConcurrentMigrations: 3}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := client.UndrainManagementCluster(ctx, "1", "default"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// CloudErrorBody mirrors the ARM error body returned by the Admin API.
+type CloudErrorBody struct {
+	Code    string           `json:"code"`
+	Message string           `json:"message"`
+	Target  string           `json:"target,omitempty"`
+	Details []CloudErrorBody `json:"details,omitempty"`
+}
+
+// CloudError is returned for every response outside of the 2xx range. When
+// the response body is not an ARM error, the body text is used as the message.
+type CloudError struct {
+	StatusCode int
+	Body       CloudErrorBody
+}
+
+var _ error = (*CloudError)(nil)
+
+func (e *CloudError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	writeCloudErrorBody(&b, e.Body, "")
+	return b.String()
+}
+
+func writeCloudErrorBody(b *strings.Builder, body CloudErrorBody, indent string) {
+	if len(body.Code) > 0 {
+		fmt.Fprintf(b, ": %s", body.Code)
+	}
+	if len(body.Target) > 0 {
+		fmt.Fprintf(b, " (target %q)", body.Target)
+	}
+	if len(body.Message) > 0 {
+		fmt.Fprintf(b, ": %s", body.Message)
+	}
+	for _, detail := range body.Details {
+		fmt.Fprintf(b, "\n%s  -", indent)
+		writeCloudErrorBody(b, detail, indent+"  ")
+	}
+}
+
+// newCloudError builds a CloudError from a non-2xx response body.
+func newCloudError(statusCode int, body []byte) *CloudError {
+	cloudError := &CloudError{StatusCode: statusCode}
+
+	var wrapper struct {
+		Error *CloudErrorBody `json:"error"`
+	}
+	if err := json.Unmarshal(body, &wrapper); err == nil && wrapper.Error != nil {
+		cloudError.Body = *wrapper.Error
+		return cloudError
+	}
+	cloudError.Body.Message = strings.TrimSpace(string(body))
+	return cloudError
+}
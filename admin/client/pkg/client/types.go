@@ -0,0 +1,204 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// The types in this file mirror the wire shapes of the Admin API handlers in
+// admin/server/handlers. They are duplicated rather than imported so that the
+// CLI does not depend on the server's storage packages.
+
+// Condition mirrors metav1.Condition.
+type Condition struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"`
+	ObservedGeneration int64     `json:"observedGeneration,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+	Reason             string    `json:"reason"`
+	Message            string    `json:"message"`
+}
+
+// FindCondition returns the condition of the given type, or nil.
+func FindCondition(conditions []Condition, conditionType string) *Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+const (
+	StampConditionApproved        = "Approved"
+	StampConditionProductionReady = "ProductionReady"
+)
+
+type Stamp struct {
+	ResourceID                string                     `json:"resourceId"`
+	Spec                      json.RawMessage            `json:"spec,omitempty"`
+	Status                    StampStatus                `json:"status"`
+	ManagementClusterCapacity *ManagementClusterCapacity `json:"managementClusterCapacity,omitempty"`
+}
+
+type StampStatus struct {
+	Conditions []Condition           `json:"conditions,omitempty"`
+	Approvals  []StampApprovalRecord `json:"approvals,omitempty"`
+}
+
+// StampApprovalRecord mirrors fleetapi.StampApproval: one approver's
+// sign-off on a stamp.
+type StampApprovalRecord struct {
+	Approver     string    `json:"approver"`
+	Reason       string    `json:"reason,omitempty"`
+	Message      string    `json:"message,omitempty"`
+	ApprovedTime time.Time `json:"approvedTime"`
+}
+
+// Approvers returns the comma-separated identities that approved the stamp.
+func (s StampStatus) Approvers() string {
+	approvers := make([]string, 0, len(s.Approvals))
+	for _, approval := range s.Approvals {
+		approvers = append(approvers, approval.Approver)
+	}
+	return strings.Join(approvers, ",")
+}
+
+type ManagementCluster struct {
+	ResourceID string                  `json:"resourceId"`
+	Spec       json.RawMessage         `json:"spec,omitempty"`
+	Status     ManagementClusterStatus `json:"status"`
+}
+
+type ManagementClusterStatus struct {
+	Conditions                                           []Condition                `json:"conditions,omitempty"`
+	AKSResourceID                                        string                     `json:"aksResourceID"`
+	PublicDNSZoneResourceID                              string                     `json:"publicDNSZoneResourceID"`
+	HostedClustersSecretsKeyVaultURL                     string                     `json:"hostedClustersSecretsKeyVaultURL,omitempty"`
+	HostedClustersManagedIdentitiesKeyVaultURL           string                     `json:"hostedClustersManagedIdentitiesKeyVaultURL,omitempty"`
+	HostedClustersSecretsKeyVaultManagedIdentityClientID string                     `json:"hostedClustersSecretsKeyVaultManagedIdentityClientID,omitempty"`
+	MaestroConsumerName                                  string                     `json:"maestroConsumerName,omitempty"`
+	MaestroRESTAPIURL                                    string                     `json:"maestroRESTAPIURL,omitempty"`
+	MaestroGRPCTarget                                    string                     `json:"maestroGRPCTarget,omitempty"`
+	ClusterServiceProvisionShardID                       string                     `json:"clusterServiceProvisionShardID"`
+	KubeApplierCosmosContainerName                       string                     `json:"kubeApplierCosmosContainerName,omitempty"`
+	Capacity                                             *ManagementClusterCapacity `json:"capacity,omitempty"`
+}
+
+// ManagementClusterCapacity mirrors fleetapi.ManagementClusterCapacity. All
+// dimensions are utilization percentages; Score is the highest of them.
+type ManagementClusterCapacity struct {
+	Score                      int32                           `json:"score"`
+	HostedControlPlanes        int32                           `json:"hostedControlPlanes"`
+	HostedControlPlanesPercent int32                           `json:"hostedControlPlanesPercent"`
+	CPURequestedPercent        int32                           `json:"cpuRequestedPercent"`
+	MemoryRequestedPercent     int32                           `json:"memoryRequestedPercent"`
+	Zones                      []ManagementClusterZoneCapacity `json:"zones,omitempty"`
+	AMWIngestionPercent        int32                           `json:"amwIngestionPercent"`
+	Cordoned                   bool                            `json:"cordoned,omitempty"`
+	LastUpdateTime             time.Time                       `json:"lastUpdateTime"`
+}
+
+type ManagementClusterZoneCapacity struct {
+	Zone                   string `json:"zone"`
+	CPURequestedPercent    int32  `json:"cpuRequestedPercent"`
+	MemoryRequestedPercent int32  `json:"memoryRequestedPercent"`
+}
+
+// CapacityScore formats the capacity score for tables, or returns an empty
+// string when the management cluster has not been scored yet.
+func (c *ManagementClusterCapacity) CapacityScore() string {
+	if c == nil {
+		return ""
+	}
+	if c.Cordoned {
+		return fmt.Sprintf("%d (cordoned)", c.Score)
+	}
+	return fmt.Sprintf("%d", c.Score)
+}
+
+// StampApproval is the request body of the stamp approval endpoint.
+type StampApproval struct {
+	Approved bool   `json:"approved"`
+	Reason   string `json:"reason"`
+	Message  string `json:"message"`
+}
+
+// ManagementClusterDrain is the request body of the management cluster drain
+// endpoint. A zero MaxConcurrentMigrations uses the server default.
+type ManagementClusterDrain struct {
+	Reason                  string `json:"reason"`
+	MaxConcurrentMigrations int32  `json:"maxConcurrentMigrations,omitempty"`
+}
+
+// DesiredControlPlaneSize is the control plane size override of a cluster.
+// A nil Size means no override is set.
+type DesiredControlPlaneSize struct {
+	Size   *string `json:"size"`
+	Pinned *bool   `json:"pinned,omitempty"`
+}
+
+// BreakglassSession describes a breakglass session to create. Justification
+// and IncidentID are required, and only accepted, when RequireApproval is set.
+type BreakglassSession struct {
+	Group           string
+	TTL             time.Duration
+	RequireApproval bool
+	Justification   string
+	IncidentID      string
+}
+
+// BreakglassKubeconfig is the result of polling a breakglass session. Until
+// the session is ready, Kubeconfig is empty and Status describes the session.
+type BreakglassKubeconfig struct {
+	Ready      bool
+	Kubeconfig []byte
+	ExpiresAt  *time.Time
+	RetryAfter time.Duration
+	Status     map[string]any
+}
+
+// HistoryTarget narrows the change history to a single child resource of the
+// cluster. The zero value returns the history of the cluster and all of its
+// child resources.
+type HistoryTarget struct {
+	NodePoolName     string
+	ExternalAuthName string
+}
+
+type ResourceChange struct {
+	TargetResourceID string                   `json:"targetResourceId"`
+	ChangedAt        time.Time                `json:"changedAt"`
+	OperationID      string                   `json:"operationId,omitempty"`
+	SystemData       map[string]any           `json:"systemData,omitempty"`
+	CorrelationData  map[string]any           `json:"correlationData,omitempty"`
+	Changes          []ResourcePropertyChange `json:"changes"`
+}
+
+type ResourcePropertyChange struct {
+	Path       string          `json:"path"`
+	ChangeType string          `json:"changeType"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+}
+
+type HistoryPage struct {
+	Value    []ResourceChange `json:"value"`
+	NextLink string           `json:"nextLink,omitempty"`
+}
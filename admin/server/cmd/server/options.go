@@ -38,6 +38,7 @@ import (
 
 	sdk "github.com/openshift-online/ocm-sdk-go"
 
+	"github.com/Azure/ARO-HCP/admin/server/middleware"
 	"github.com/Azure/ARO-HCP/admin/server/server"
 	"github.com/Azure/ARO-HCP/internal/audit"
 	"github.com/Azure/ARO-HCP/internal/azsdk"
@@ -71,6 +72,7 @@ func DefaultOptions() *RawOptions {
 		MinSessionTTL:           getEnvDuration("MIN_SESSION_TTL", 10*time.Minute),
 		MaxSessionTTL:           getEnvDuration("MAX_SESSION_TTL", 24*time.Hour),
 		AllowedBreakglassGroups: []string{"aro-sre-pso", "aro-sre-csa"},
+		AuthorizationPolicyFile: os.Getenv("AUTHORIZATION_POLICY_FILE"),
 	}
 }
 
@@ -93,6 +95,7 @@ type RawOptions struct {
 	MinSessionTTL           time.Duration
 	MaxSessionTTL           time.Duration
 	AllowedBreakglassGroups []string
+	AuthorizationPolicyFile string
 }
 
 func (opts *RawOptions) BindOptions(cmd *cobra.Command) error {
@@ -112,6 +115,7 @@ func (opts *RawOptions) BindOptions(cmd *cobra.Command) error {
 	cmd.Flags().DurationVar(&opts.MinSessionTTL, "min-session-ttl", opts.MinSessionTTL, "Minimum breakglass session TTL.")
 	cmd.Flags().DurationVar(&opts.MaxSessionTTL, "max-session-ttl", opts.MaxSessionTTL, "Maximum breakglass session TTL.")
 	cmd.Flags().StringSliceVar(&opts.AllowedBreakglassGroups, "allowed-breakglass-groups", opts.AllowedBreakglassGroups, "Allowed breakglass groups.")
+	cmd.Flags().StringVar(&opts.AuthorizationPolicyFile, "authorization-policy-file", opts.AuthorizationPolicyFile, "Path to the authorization policy mapping client principals to admin routes. Reloaded on change.")
 	return nil
 }
 
@@ -151,6 +155,7 @@ type completedOptions struct {
 	MinSessionTTL           time.Duration
 	MaxSessionTTL           time.Duration
 	AllowedBreakglassGroups set.Set[string]
+	Authorizer              *middleware.Authorizer
 	Registry                *prometheus.Registry
 }
 
@@ -181,6 +186,9 @@ func (o *RawOptions) Validate() (*ValidatedOptions, error) {
 	if o.MaxSessionTTL < o.MinSessionTTL {
 		return nil, fmt.Errorf("max-session-ttl must be greater than min-session-ttl")
 	}
+	if o.AuthorizationPolicyFile == "" {
+		return nil, fmt.Errorf("authorization-policy-file is required")
+	}
 	return &ValidatedOptions{
 		validatedOptions: &validatedOptions{
 			RawOptions: o,
@@ -291,6 +299,15 @@ func (o *ValidatedOptions) Complete(ctx context.Context) (*Options, error) {
 
 	sessionClient := sessiongateClientset.SessiongateV1alpha1().Sessions(o.SessiongateNamespace)
 
+	// Authorization policy, reloaded when the mounted file changes
+	authorizer, err := middleware.NewAuthorizer(o.AuthorizationPolicyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load authorization policy: %w", err)
+	}
+	if err := authorizer.Run(ctx, 30*time.Second); err != nil {
+		return nil, fmt.Errorf("failed to watch authorization policy: %w", err)
+	}
+
 	return &Options{
 		completedOptions: &completedOptions{
 			Port:                    o.Port,
@@ -308,6 +325,7 @@ func (o *ValidatedOptions) Complete(ctx context.Context) (*Options, error) {
 			MinSessionTTL:           o.MinSessionTTL,
 			MaxSessionTTL:           o.MaxSessionTTL,
 			AllowedBreakglassGroups: set.New[string](o.AllowedBreakglassGroups...),
+			Authorizer:              authorizer,
 			Registry:                registry,
 		},
 	}, nil
@@ -364,6 +382,7 @@ func (opts *Options) Run(ctx context.Context) error {
 		opts.MinSessionTTL,
 		opts.MaxSessionTTL,
 		opts.AllowedBreakglassGroups,
+		opts.Authorizer,
 		opts.Registry,
 	)
 
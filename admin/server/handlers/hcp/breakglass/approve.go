@@ -0,0 +1,110 @@
+// Copyright 2025 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package breakglass
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+
+	"github.com/Azure/ARO-HCP/admin/server/middleware"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/utils"
+	sessiongateapiv1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+	sessiongateclientv1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/generated/clientset/versioned/typed/sessiongate/v1alpha1"
+)
+
+// HCPBreakglassSessionApprovalHandler handles requests to approve breakglass sessions that were
+// created with requireApproval. The approver must be a different principal than the session owner.
+// This endpoint is accessed exclusively via Geneva Actions. See package documentation for security model.
+type HCPBreakglassSessionApprovalHandler struct {
+	sessionClient sessiongateclientv1alpha1.SessionInterface
+	clock         clock.PassiveClock
+}
+
+func NewHCPBreakglassSessionApprovalHandler(sessionClient sessiongateclientv1alpha1.SessionInterface) *HCPBreakglassSessionApprovalHandler {
+	return &HCPBreakglassSessionApprovalHandler{
+		sessionClient: sessionClient,
+		clock:         clock.RealClock{},
+	}
+}
+
+func (h *HCPBreakglassSessionApprovalHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) error {
+	resourceID, err := utils.ResourceIDFromContext(request.Context())
+	if err != nil {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "invalid resource identifier in request")
+	}
+
+	sessionName := request.PathValue("sessionName")
+	if sessionName == "" {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "session parameter is required")
+	}
+
+	clientPrincipalReference, err := middleware.ClientPrincipalFromContext(request.Context())
+	if err != nil {
+		return coreapi.NewCloudError(http.StatusUnauthorized, "Unauthorized", "", "missing client principal AAD reference")
+	}
+	approverName, approverType, err := mapGenevaActionClientReference(clientPrincipalReference)
+	if err != nil {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "%s", err.Error())
+	}
+	approver := sessiongateapiv1alpha1.Principal{Name: approverName, Type: approverType}
+
+	// read the session from the API server rather than the lister, the
+	// approval must be based on the latest state of the session
+	session, err := h.sessionClient.Get(request.Context(), sessionName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return coreapi.NewCloudError(http.StatusNotFound, coreapi.CloudErrorCodeNotFound, "", "session %q not found", sessionName)
+	} else if err != nil {
+		return fmt.Errorf("failed to get session %q: %w", sessionName, err)
+	}
+	// sessions of other HCPs are reported as missing rather than leaking their existence
+	if !strings.EqualFold(session.Spec.HostedControlPlane.ResourceID, resourceID.String()) {
+		return coreapi.NewCloudError(http.StatusNotFound, coreapi.CloudErrorCodeNotFound, "", "session %q not found", sessionName)
+	}
+
+	switch {
+	case !session.RequiresApproval():
+		return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "session %q does not require approval", sessionName)
+	case session.Status.Approval != nil:
+		return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "session %q has already been approved by %s", sessionName, session.Status.Approval.Approver.Name)
+	case session.IsOwner(approver):
+		return coreapi.NewCloudError(http.StatusForbidden, "Forbidden", "", "%q requested session %q and cannot approve it", approverName, sessionName)
+	case h.clock.Now().After(session.ApprovalDeadline()):
+		return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "session %q was not approved before %s", sessionName, session.ApprovalDeadline().UTC().Format(time.RFC3339))
+	}
+
+	session.Status.Approval = &sessiongateapiv1alpha1.Approval{
+		Approver:   approver,
+		ApprovedAt: metav1.NewTime(h.clock.Now()),
+	}
+	if _, err := h.sessionClient.UpdateStatus(request.Context(), session, metav1.UpdateOptions{}); err != nil {
+		if apierrors.IsConflict(err) {
+			return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "session %q was modified concurrently, retry the operation", sessionName)
+		}
+		return fmt.Errorf("failed to approve session %q: %w", sessionName, err)
+	}
+
+	// the kubeconfig becomes available once sessiongate has provisioned the approved session
+	locationURL := fmt.Sprintf("%s/kubeconfig", strings.TrimSuffix(request.URL.Path, "/approve"))
+	writer.Header().Set("Location", locationURL)
+	writer.WriteHeader(http.StatusAccepted)
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package breakglass
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/Azure/ARO-HCP/admin/server/middleware"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/apitesting/coreapitesting"
+	"github.com/Azure/ARO-HCP/internal/utils"
+	sessiongateapiv1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+	sessiongatefake "github.com/Azure/ARO-HCP/sessiongate/pkg/generated/clientset/versioned/fake"
+)
+
+func TestHCPBreakglassSessionApprovalHandler(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	owner := sessiongateapiv1alpha1.Principal{Type: sessiongateapiv1alpha1.PrincipalTypeAzureUser, Name: "alice@example.com"}
+
+	newSession := func(resourceID string, approval *sessiongateapiv1alpha1.ApprovalRequest, status sessiongateapiv1alpha1.SessionStatus) *sessiongateapiv1alpha1.Session {
+		return &sessiongateapiv1alpha1.Session{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "breakglass-abc",
+				CreationTimestamp: metav1.NewTime(now.Add(-10 * time.Minute)),
+			},
+			Spec: sessiongateapiv1alpha1.SessionSpec{
+				TTL:                metav1.Duration{Duration: time.Hour},
+				HostedControlPlane: sessiongateapiv1alpha1.HostedControlPlane{ResourceID: resourceID},
+				Owner:              owner,
+				Approval:           approval,
+			},
+			Status: status,
+		}
+	}
+	approvalRequest := func(timeout time.Duration) *sessiongateapiv1alpha1.ApprovalRequest {
+		return &sessiongateapiv1alpha1.ApprovalRequest{
+			Justification: "cluster operator degraded",
+			IncidentID:    "123456789",
+			Timeout:       metav1.Duration{Duration: timeout},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		session            *sessiongateapiv1alpha1.Session
+		approver           string
+		expectedStatusCode int
+	}{
+		{
+			name:               "approved by another principal",
+			session:            newSession(coreapitesting.TestClusterResourceID, approvalRequest(time.Hour), sessiongateapiv1alpha1.SessionStatus{}),
+			approver:           "bob@example.com",
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			name:               "owner cannot approve their own session",
+			session:            newSession(coreapitesting.TestClusterResourceID, approvalRequest(time.Hour), sessiongateapiv1alpha1.SessionStatus{}),
+			approver:           "Alice@example.com",
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name:               "session without approval",
+			session:            newSession(coreapitesting.TestClusterResourceID, nil, sessiongateapiv1alpha1.SessionStatus{}),
+			approver:           "bob@example.com",
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name: "session already approved",
+			session: newSession(coreapitesting.TestClusterResourceID, approvalRequest(time.Hour), sessiongateapiv1alpha1.SessionStatus{
+				Approval: &sessiongateapiv1alpha1.Approval{
+					Approver:   sessiongateapiv1alpha1.Principal{Type: sessiongateapiv1alpha1.PrincipalTypeAzureUser, Name: "carol@example.com"},
+					ApprovedAt: metav1.NewTime(now.Add(-time.Minute)),
+				},
+			}),
+			approver:           "bob@example.com",
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "approval deadline passed",
+			session:            newSession(coreapitesting.TestClusterResourceID, approvalRequest(5*time.Minute), sessiongateapiv1alpha1.SessionStatus{}),
+			approver:           "bob@example.com",
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "session of another cluster",
+			session:            newSession(coreapitesting.TestClusterResourceID+"-other", approvalRequest(time.Hour), sessiongateapiv1alpha1.SessionStatus{}),
+			approver:           "bob@example.com",
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resourceID, err := azcorearm.ParseResourceID(coreapitesting.TestClusterResourceID)
+			require.NoError(t, err)
+
+			ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+			ctx = utils.ContextWithResourceID(ctx, resourceID)
+			ctx = middleware.ContextWithClientPrincipal(ctx, middleware.ClientPrincipalReference{
+				Name: tt.approver,
+				Type: middleware.PrincipalTypeDSTSUser,
+			})
+
+			sessionClient := sessiongatefake.NewSimpleClientset(tt.session).SessiongateV1alpha1().Sessions("")
+			handler := NewHCPBreakglassSessionApprovalHandler(sessionClient)
+			handler.clock = clocktesting.NewFakePassiveClock(now)
+
+			path := "/admin/v1/hcp" + coreapitesting.TestClusterResourceID + "/breakglass/breakglass-abc/approve"
+			req := httptest.NewRequest(http.MethodPost, path, nil).WithContext(ctx)
+			req.SetPathValue("sessionName", "breakglass-abc")
+			recorder := httptest.NewRecorder()
+
+			err = handler.ServeHTTP(recorder, req)
+
+			session, getErr := sessionClient.Get(ctx, "breakglass-abc", metav1.GetOptions{})
+			require.NoError(t, getErr)
+
+			if tt.expectedStatusCode >= 400 {
+				var cloudErr *coreapi.CloudError
+				require.True(t, errors.As(err, &cloudErr), "expected CloudError but got %T: %v", err, err)
+				assert.Equal(t, tt.expectedStatusCode, cloudErr.StatusCode)
+				assert.Equal(t, tt.session.Status.Approval, session.Status.Approval, "a rejected approval must not change the session")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatusCode, recorder.Code)
+			assert.Equal(t, "/admin/v1/hcp"+coreapitesting.TestClusterResourceID+"/breakglass/breakglass-abc/kubeconfig", recorder.Header().Get("Location"))
+			require.NotNil(t, session.Status.Approval)
+			assert.Equal(t, "bob@example.com", session.Status.Approval.Approver.Name)
+			assert.Equal(t, sessiongateapiv1alpha1.PrincipalTypeAzureUser, session.Status.Approval.Approver.Type)
+			assert.True(t, session.Status.Approval.ApprovedAt.Time.Equal(now))
+		})
+	}
+}
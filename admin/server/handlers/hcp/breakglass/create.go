@@ -90,7 +90,7 @@ func (h *HCPBreakglassSessionCreationHandler) ServeHTTP(writer http.ResponseWrit
 		return hcphelpers.ClusterServiceError(err, "provision shard")
 	}
 
-	group, ttl, err := h.validateSessionParameters(request)
+	params, err := h.validateSessionParameters(request)
 	if err != nil {
 		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "%s", err.Error())
 	}
@@ -110,7 +110,7 @@ func (h *HCPBreakglassSessionCreationHandler) ServeHTTP(writer http.ResponseWrit
 			GenerateName: "breakglass-",
 		},
 		Spec: sessiongateapiv1alpha1.SessionSpec{
-			TTL: metav1.Duration{Duration: ttl},
+			TTL: metav1.Duration{Duration: params.ttl},
 			ManagementCluster: sessiongateapiv1alpha1.ManagementCluster{
 				ResourceID: provisionShard.AzureShard().AksManagementClusterResourceId(),
 			},
@@ -119,12 +119,14 @@ func (h *HCPBreakglassSessionCreationHandler) ServeHTTP(writer http.ResponseWrit
 				Namespace:  clusterHypershiftDetails.HCPNamespace(),
 			},
 			AccessLevel: sessiongateapiv1alpha1.AccessLevel{
-				Group: group,
+				Group:  params.group,
+				Policy: params.accessPolicy,
 			},
 			Owner: sessiongateapiv1alpha1.Principal{
 				Name: principalName,
 				Type: principalType,
 			},
+			Approval: params.approval,
 		},
 	}
 	createdSession, err := h.sessionClient.Create(request.Context(), session, metav1.CreateOptions{})
@@ -151,18 +153,34 @@ func mapGenevaActionClientReference(clientPrincipalReference middleware.ClientPr
 	return "", "", fmt.Errorf("invalid client principal reference type: %s", clientPrincipalReference.Type)
 }
 
+// sessionApprovalTimeout is how long a session that requires approval waits
+// for it before sessiongate deletes the session.
+const sessionApprovalTimeout = time.Hour
+
 // sessionCreationRequest represents the JSON body for creating a breakglass session.
 type sessionCreationRequest struct {
-	Group string `json:"group"`
-	TTL   string `json:"ttl"`
+	Group           string `json:"group"`
+	TTL             string `json:"ttl"`
+	AccessPolicy    string `json:"accessPolicy,omitempty"`
+	RequireApproval bool   `json:"requireApproval,omitempty"`
+	Justification   string `json:"justification,omitempty"`
+	IncidentID      string `json:"incidentId,omitempty"`
+}
+
+// sessionParameters are the validated parameters of a session creation request.
+type sessionParameters struct {
+	group        string
+	accessPolicy sessiongateapiv1alpha1.AccessPolicy
+	ttl          time.Duration
+	approval     *sessiongateapiv1alpha1.ApprovalRequest
 }
 
-// validateSessionParameters validates the group and TTL parameters for session creation
-// by reading them from the request body.
-func (h *HCPBreakglassSessionCreationHandler) validateSessionParameters(request *http.Request) (string, time.Duration, error) {
+// validateSessionParameters validates the group, access policy, TTL and approval
+// parameters for session creation by reading them from the request body.
+func (h *HCPBreakglassSessionCreationHandler) validateSessionParameters(request *http.Request) (*sessionParameters, error) {
 	var body sessionCreationRequest
 	if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
-		return "", 0, fmt.Errorf("failed to decode request body: %v", err)
+		return nil, fmt.Errorf("failed to decode request body: %v", err)
 	}
 
 	var errs []error
@@ -174,6 +192,15 @@ func (h *HCPBreakglassSessionCreationHandler) validateSessionParameters(request
 		errs = append(errs, fmt.Errorf("group %q is not in the allowed list %v", body.Group, h.AllowedBreakglassGroups.SortedList()))
 	}
 
+	// access policy - optional, sessiongate treats an empty policy as Full
+	accessPolicy := sessiongateapiv1alpha1.AccessPolicy(body.AccessPolicy)
+	switch accessPolicy {
+	case "", sessiongateapiv1alpha1.AccessPolicyFull, sessiongateapiv1alpha1.AccessPolicyReadOnly, sessiongateapiv1alpha1.AccessPolicyReadOnlyNoSecrets:
+	default:
+		errs = append(errs, fmt.Errorf("invalid accessPolicy %q: must be one of %s, %s or %s", body.AccessPolicy,
+			sessiongateapiv1alpha1.AccessPolicyFull, sessiongateapiv1alpha1.AccessPolicyReadOnly, sessiongateapiv1alpha1.AccessPolicyReadOnlyNoSecrets))
+	}
+
 	// get TTL from body field
 	var ttl time.Duration
 	if body.TTL == "" {
@@ -192,6 +219,24 @@ func (h *HCPBreakglassSessionCreationHandler) validateSessionParameters(request
 		}
 	}
 
+	// approval - optional, a justification and incident ID are required with it
+	var approval *sessiongateapiv1alpha1.ApprovalRequest
+	if body.RequireApproval {
+		if body.Justification == "" {
+			errs = append(errs, fmt.Errorf("justification field is required when requireApproval is set"))
+		}
+		if body.IncidentID == "" {
+			errs = append(errs, fmt.Errorf("incidentId field is required when requireApproval is set"))
+		}
+		approval = &sessiongateapiv1alpha1.ApprovalRequest{
+			Justification: body.Justification,
+			IncidentID:    body.IncidentID,
+			Timeout:       metav1.Duration{Duration: sessionApprovalTimeout},
+		}
+	} else if body.Justification != "" || body.IncidentID != "" {
+		errs = append(errs, fmt.Errorf("justification and incidentId fields are only accepted when requireApproval is set"))
+	}
+
 	// not every group accepted as payload has a corresponding RBAC group within HCPs
 	// so we rewrite the actual group here. this is a temporary measure until
 	// the geneva action will start adopting the target group names.
@@ -200,5 +245,13 @@ func (h *HCPBreakglassSessionCreationHandler) validateSessionParameters(request
 		group = rewrittenGroup
 	}
 
-	return group, ttl, utilerrors.NewAggregate(errs)
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		return nil, err
+	}
+	return &sessionParameters{
+		group:        group,
+		accessPolicy: accessPolicy,
+		ttl:          ttl,
+		approval:     approval,
+	}, nil
 }
@@ -18,7 +18,7 @@
 //
 // The breakglass package has two distinct security models depending on the endpoint:
 //
-// # Session Creation and Kubeconfig Endpoints (create.go, kubeconfig.go)
+// # Session Creation, Approval and Kubeconfig Endpoints (create.go, approve.go, kubeconfig.go)
 //
 // These endpoints are accessed exclusively via Geneva Actions:
 //
@@ -58,4 +58,9 @@
 //   - Sessions are created with a TTL between 1 minute and 24 hours
 //   - The sessiongate controller monitors session expiry
 //   - Expired sessions are automatically deleted by the controller
+//
+// Sessions created with requireApproval carry a justification and incident ID and
+// are held in the PendingApproval condition until a principal other than the owner
+// approves them. Credentials are only provisioned, and the TTL only starts, once
+// the session is approved. Sessions that are not approved within an hour are deleted.
 package breakglass
@@ -0,0 +1,142 @@
+// Copyright 2025 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package breakglass
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+
+	"github.com/Azure/ARO-HCP/admin/server/middleware"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/utils"
+	sessiongateapiv1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+	sessiongateclientv1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/generated/clientset/versioned/typed/sessiongate/v1alpha1"
+)
+
+// HCPBreakglassSessionExtensionHandler handles requests to extend active breakglass sessions.
+// The total lifetime of a session, its TTL and all of its extensions, must not exceed MaxSessionTTL.
+// The kubeconfig of the session stays valid, sessiongate renews its certificate when needed.
+// This endpoint is accessed exclusively via Geneva Actions. See package documentation for security model.
+type HCPBreakglassSessionExtensionHandler struct {
+	sessionClient sessiongateclientv1alpha1.SessionInterface
+	clock         clock.PassiveClock
+	MaxSessionTTL time.Duration
+}
+
+func NewHCPBreakglassSessionExtensionHandler(sessionClient sessiongateclientv1alpha1.SessionInterface, maxSessionTTL time.Duration) *HCPBreakglassSessionExtensionHandler {
+	return &HCPBreakglassSessionExtensionHandler{
+		sessionClient: sessionClient,
+		clock:         clock.RealClock{},
+		MaxSessionTTL: maxSessionTTL,
+	}
+}
+
+// maxSessionExtensions matches the maximum number of extensions the Session API accepts.
+const maxSessionExtensions = 16
+
+// sessionExtensionRequest represents the JSON body for extending a breakglass session.
+type sessionExtensionRequest struct {
+	TTL string `json:"ttl"`
+}
+
+func (h *HCPBreakglassSessionExtensionHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) error {
+	resourceID, err := utils.ResourceIDFromContext(request.Context())
+	if err != nil {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "invalid resource identifier in request")
+	}
+
+	sessionName := request.PathValue("sessionName")
+	if sessionName == "" {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "session parameter is required")
+	}
+
+	var body sessionExtensionRequest
+	if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "failed to decode request body: %v", err)
+	}
+	if body.TTL == "" {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "ttl field is required")
+	}
+	ttl, err := time.ParseDuration(body.TTL)
+	if err != nil {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "invalid ttl field: %v", err)
+	}
+	if ttl <= 0 {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "ttl must be positive")
+	}
+
+	clientPrincipalReference, err := middleware.ClientPrincipalFromContext(request.Context())
+	if err != nil {
+		return coreapi.NewCloudError(http.StatusUnauthorized, "Unauthorized", "", "missing client principal AAD reference")
+	}
+	extenderName, extenderType, err := mapGenevaActionClientReference(clientPrincipalReference)
+	if err != nil {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "%s", err.Error())
+	}
+
+	// read the session from the API server rather than the lister, the
+	// extension must be based on the latest state of the session
+	session, err := h.sessionClient.Get(request.Context(), sessionName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return coreapi.NewCloudError(http.StatusNotFound, coreapi.CloudErrorCodeNotFound, "", "session %q not found", sessionName)
+	} else if err != nil {
+		return fmt.Errorf("failed to get session %q: %w", sessionName, err)
+	}
+	// sessions of other HCPs are reported as missing rather than leaking their existence
+	if !strings.EqualFold(session.Spec.HostedControlPlane.ResourceID, resourceID.String()) {
+		return coreapi.NewCloudError(http.StatusNotFound, coreapi.CloudErrorCodeNotFound, "", "session %q not found", sessionName)
+	}
+
+	switch {
+	case session.IsRevoked():
+		return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "session %q has been revoked", sessionName)
+	case session.Status.ExpiresAt == nil:
+		// the expiration is set once a session is approved, extending it before would
+		// only stretch the approval wait
+		return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "session %q is not active yet", sessionName)
+	case !h.clock.Now().Before(session.Status.ExpiresAt.Time):
+		return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "session %q expired at %s", sessionName, session.Status.ExpiresAt.UTC().Format(time.RFC3339))
+	case session.TotalTTL()+ttl > h.MaxSessionTTL:
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "",
+			"session %q has a total lifetime of %v, extending it by %v would exceed the maximum of %v", sessionName, session.TotalTTL(), ttl, h.MaxSessionTTL)
+	case len(session.Status.Extensions) >= maxSessionExtensions:
+		return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "session %q has already been extended %d times", sessionName, len(session.Status.Extensions))
+	}
+
+	session.Status.Extensions = append(session.Status.Extensions, sessiongateapiv1alpha1.Extension{
+		ExtendedBy: sessiongateapiv1alpha1.Principal{Name: extenderName, Type: extenderType},
+		Duration:   metav1.Duration{Duration: ttl},
+		ExtendedAt: metav1.NewTime(h.clock.Now()),
+	})
+	if _, err := h.sessionClient.UpdateStatus(request.Context(), session, metav1.UpdateOptions{}); err != nil {
+		if apierrors.IsConflict(err) {
+			return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "session %q was modified concurrently, retry the operation", sessionName)
+		}
+		return fmt.Errorf("failed to extend session %q: %w", sessionName, err)
+	}
+
+	// sessiongate moves the expiration once it observes the extension
+	locationURL := fmt.Sprintf("%s/kubeconfig", strings.TrimSuffix(request.URL.Path, "/extend"))
+	writer.Header().Set("Location", locationURL)
+	writer.WriteHeader(http.StatusAccepted)
+	return nil
+}
@@ -0,0 +1,198 @@
+// Copyright 2025 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package breakglass
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/Azure/ARO-HCP/admin/server/middleware"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/apitesting/coreapitesting"
+	"github.com/Azure/ARO-HCP/internal/utils"
+	sessiongateapiv1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+	sessiongatefake "github.com/Azure/ARO-HCP/sessiongate/pkg/generated/clientset/versioned/fake"
+)
+
+func TestHCPBreakglassSessionExtensionHandler(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	owner := sessiongateapiv1alpha1.Principal{Type: sessiongateapiv1alpha1.PrincipalTypeAzureUser, Name: "alice@example.com"}
+	previousExtension := sessiongateapiv1alpha1.Extension{
+		ExtendedBy: owner,
+		Duration:   metav1.Duration{Duration: time.Hour},
+		ExtendedAt: metav1.NewTime(now.Add(-5 * time.Minute)),
+	}
+
+	newSession := func(resourceID string, status sessiongateapiv1alpha1.SessionStatus) *sessiongateapiv1alpha1.Session {
+		return &sessiongateapiv1alpha1.Session{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "breakglass-abc",
+				CreationTimestamp: metav1.NewTime(now.Add(-50 * time.Minute)),
+			},
+			Spec: sessiongateapiv1alpha1.SessionSpec{
+				TTL:                metav1.Duration{Duration: time.Hour},
+				HostedControlPlane: sessiongateapiv1alpha1.HostedControlPlane{ResourceID: resourceID},
+				Owner:              owner,
+			},
+			Status: status,
+		}
+	}
+	active := sessiongateapiv1alpha1.SessionStatus{
+		ExpiresAt: &metav1.Time{Time: now.Add(10 * time.Minute)},
+	}
+
+	tests := []struct {
+		name               string
+		session            *sessiongateapiv1alpha1.Session
+		body               string
+		expectedStatusCode int
+	}{
+		{
+			name:               "active session is extended",
+			session:            newSession(coreapitesting.TestClusterResourceID, active),
+			body:               `{"ttl":"2h"}`,
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			name: "extended session is extended again",
+			session: newSession(coreapitesting.TestClusterResourceID, sessiongateapiv1alpha1.SessionStatus{
+				ExpiresAt:  &metav1.Time{Time: now.Add(70 * time.Minute)},
+				Extensions: []sessiongateapiv1alpha1.Extension{previousExtension},
+			}),
+			body:               `{"ttl":"2h"}`,
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			name:               "extension exceeding the maximum session TTL",
+			session:            newSession(coreapitesting.TestClusterResourceID, active),
+			body:               `{"ttl":"4h"}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "extensions accumulate towards the maximum session TTL",
+			session: newSession(coreapitesting.TestClusterResourceID, sessiongateapiv1alpha1.SessionStatus{
+				ExpiresAt:  &metav1.Time{Time: now.Add(70 * time.Minute)},
+				Extensions: []sessiongateapiv1alpha1.Extension{previousExtension},
+			}),
+			body:               `{"ttl":"3h"}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "missing ttl",
+			session:            newSession(coreapitesting.TestClusterResourceID, active),
+			body:               `{}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "negative ttl",
+			session:            newSession(coreapitesting.TestClusterResourceID, active),
+			body:               `{"ttl":"-1h"}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "session not active yet",
+			session:            newSession(coreapitesting.TestClusterResourceID, sessiongateapiv1alpha1.SessionStatus{}),
+			body:               `{"ttl":"1h"}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name: "expired session",
+			session: newSession(coreapitesting.TestClusterResourceID, sessiongateapiv1alpha1.SessionStatus{
+				ExpiresAt: &metav1.Time{Time: now.Add(-time.Minute)},
+			}),
+			body:               `{"ttl":"1h"}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name: "revoked session",
+			session: newSession(coreapitesting.TestClusterResourceID, sessiongateapiv1alpha1.SessionStatus{
+				ExpiresAt: &metav1.Time{Time: now.Add(10 * time.Minute)},
+				Revocation: &sessiongateapiv1alpha1.Revocation{
+					RevokedBy: sessiongateapiv1alpha1.Principal{Type: sessiongateapiv1alpha1.PrincipalTypeAzureUser, Name: "bob@example.com"},
+					RevokedAt: metav1.NewTime(now.Add(-time.Minute)),
+					Reason:    "incident resolved",
+				},
+			}),
+			body:               `{"ttl":"1h"}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "session of another cluster",
+			session:            newSession(coreapitesting.TestClusterResourceID+"-other", active),
+			body:               `{"ttl":"1h"}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resourceID, err := azcorearm.ParseResourceID(coreapitesting.TestClusterResourceID)
+			require.NoError(t, err)
+
+			ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+			ctx = utils.ContextWithResourceID(ctx, resourceID)
+			ctx = middleware.ContextWithClientPrincipal(ctx, middleware.ClientPrincipalReference{
+				Name: "alice@example.com",
+				Type: middleware.PrincipalTypeDSTSUser,
+			})
+
+			sessionClient := sessiongatefake.NewSimpleClientset(tt.session).SessiongateV1alpha1().Sessions("")
+			handler := NewHCPBreakglassSessionExtensionHandler(sessionClient, 4*time.Hour)
+			handler.clock = clocktesting.NewFakePassiveClock(now)
+
+			path := "/admin/v1/hcp" + coreapitesting.TestClusterResourceID + "/breakglass/breakglass-abc/extend"
+			req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(tt.body)).WithContext(ctx)
+			req.SetPathValue("sessionName", "breakglass-abc")
+			recorder := httptest.NewRecorder()
+
+			err = handler.ServeHTTP(recorder, req)
+
+			session, getErr := sessionClient.Get(ctx, "breakglass-abc", metav1.GetOptions{})
+			require.NoError(t, getErr)
+
+			if tt.expectedStatusCode >= 400 {
+				var cloudErr *coreapi.CloudError
+				require.True(t, errors.As(err, &cloudErr), "expected CloudError but got %T: %v", err, err)
+				assert.Equal(t, tt.expectedStatusCode, cloudErr.StatusCode)
+				assert.Equal(t, tt.session.Status.Extensions, session.Status.Extensions, "a rejected extension must not change the session")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatusCode, recorder.Code)
+			assert.Equal(t, "/admin/v1/hcp"+coreapitesting.TestClusterResourceID+"/breakglass/breakglass-abc/kubeconfig", recorder.Header().Get("Location"))
+			require.Len(t, session.Status.Extensions, len(tt.session.Status.Extensions)+1)
+			assert.Equal(t, tt.session.Status.Extensions, session.Status.Extensions[:len(tt.session.Status.Extensions)], "existing extensions must be kept")
+			extension := session.Status.Extensions[len(session.Status.Extensions)-1]
+			assert.Equal(t, owner, extension.ExtendedBy)
+			assert.Equal(t, 2*time.Hour, extension.Duration.Duration)
+			assert.True(t, extension.ExtendedAt.Time.Equal(now))
+		})
+	}
+}
@@ -67,6 +67,11 @@ func (h *HCPBreakglassSessionKubeconfigHandler) ServeHTTP(writer http.ResponseWr
 		return fmt.Errorf("failed to get session %q: %w", sessionName, err)
 	}
 
+	// a revoked session never becomes ready again, so clients must not keep polling it
+	if session.IsRevoked() {
+		return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "session %q has been revoked", sessionName)
+	}
+
 	if !session.IsReady() {
 		details := GetSessionNotReadyDetails(session)
 		return h.writeRetryResponse(writer, details, sessionNotReadyRetryAfterSeconds)
@@ -136,6 +141,15 @@ func GetSessionNotReadyDetails(session *sessiongateapiv1alpha1.Session) map[stri
 		sessiongateapiv1alpha1.SessionConditionTypeNetworkPathAvailable,
 	}
 
+	// unlike the other conditions, PendingApproval blocks the session while it is True
+	if condition := session.GetCondition(sessiongateapiv1alpha1.SessionConditionTypePendingApproval); condition != nil && condition.Status == metav1.ConditionTrue {
+		details[string(sessiongateapiv1alpha1.SessionConditionTypePendingApproval)] = map[string]string{
+			"status":  string(condition.Status),
+			"reason":  condition.Reason,
+			"message": condition.Message,
+		}
+	}
+
 	for _, conditionType := range conditionsToCheck {
 		if condition := session.GetCondition(conditionType); condition != nil && condition.Status != metav1.ConditionTrue {
 			details[string(conditionType)] = map[string]string{
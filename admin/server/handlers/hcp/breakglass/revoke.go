@@ -0,0 +1,111 @@
+// Copyright 2025 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package breakglass
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+
+	"github.com/Azure/ARO-HCP/admin/server/middleware"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/utils"
+	sessiongateapiv1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+	sessiongateclientv1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/generated/clientset/versioned/typed/sessiongate/v1alpha1"
+)
+
+// HCPBreakglassSessionRevocationHandler handles requests to revoke breakglass sessions before they
+// expire. sessiongate closes all connections of a revoked session, deletes its credentials and then
+// the session itself. This endpoint is accessed exclusively via Geneva Actions. See package
+// documentation for security model.
+type HCPBreakglassSessionRevocationHandler struct {
+	sessionClient sessiongateclientv1alpha1.SessionInterface
+	clock         clock.PassiveClock
+}
+
+func NewHCPBreakglassSessionRevocationHandler(sessionClient sessiongateclientv1alpha1.SessionInterface) *HCPBreakglassSessionRevocationHandler {
+	return &HCPBreakglassSessionRevocationHandler{
+		sessionClient: sessionClient,
+		clock:         clock.RealClock{},
+	}
+}
+
+// sessionRevocationRequest represents the JSON body for revoking a breakglass session.
+type sessionRevocationRequest struct {
+	Reason string `json:"reason"`
+}
+
+func (h *HCPBreakglassSessionRevocationHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) error {
+	resourceID, err := utils.ResourceIDFromContext(request.Context())
+	if err != nil {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "invalid resource identifier in request")
+	}
+
+	sessionName := request.PathValue("sessionName")
+	if sessionName == "" {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "session parameter is required")
+	}
+
+	var body sessionRevocationRequest
+	if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "failed to decode request body: %v", err)
+	}
+	if strings.TrimSpace(body.Reason) == "" {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "reason field is required")
+	}
+
+	clientPrincipalReference, err := middleware.ClientPrincipalFromContext(request.Context())
+	if err != nil {
+		return coreapi.NewCloudError(http.StatusUnauthorized, "Unauthorized", "", "missing client principal AAD reference")
+	}
+	revokerName, revokerType, err := mapGenevaActionClientReference(clientPrincipalReference)
+	if err != nil {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "%s", err.Error())
+	}
+
+	session, err := h.sessionClient.Get(request.Context(), sessionName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return coreapi.NewCloudError(http.StatusNotFound, coreapi.CloudErrorCodeNotFound, "", "session %q not found", sessionName)
+	} else if err != nil {
+		return fmt.Errorf("failed to get session %q: %w", sessionName, err)
+	}
+	// sessions of other HCPs are reported as missing rather than leaking their existence
+	if !strings.EqualFold(session.Spec.HostedControlPlane.ResourceID, resourceID.String()) {
+		return coreapi.NewCloudError(http.StatusNotFound, coreapi.CloudErrorCodeNotFound, "", "session %q not found", sessionName)
+	}
+	if session.IsRevoked() {
+		return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "session %q has already been revoked by %s", sessionName, session.Status.Revocation.RevokedBy.Name)
+	}
+
+	session.Status.Revocation = &sessiongateapiv1alpha1.Revocation{
+		RevokedBy: sessiongateapiv1alpha1.Principal{Name: revokerName, Type: revokerType},
+		RevokedAt: metav1.NewTime(h.clock.Now()),
+		Reason:    body.Reason,
+	}
+	if _, err := h.sessionClient.UpdateStatus(request.Context(), session, metav1.UpdateOptions{}); err != nil {
+		if apierrors.IsConflict(err) {
+			return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "session %q was modified concurrently, retry the operation", sessionName)
+		}
+		return fmt.Errorf("failed to revoke session %q: %w", sessionName, err)
+	}
+
+	writer.WriteHeader(http.StatusAccepted)
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package breakglass
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/Azure/ARO-HCP/admin/server/middleware"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/apitesting/coreapitesting"
+	"github.com/Azure/ARO-HCP/internal/utils"
+	sessiongateapiv1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+	sessiongatefake "github.com/Azure/ARO-HCP/sessiongate/pkg/generated/clientset/versioned/fake"
+)
+
+func TestHCPBreakglassSessionRevocationHandler(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	newSession := func(resourceID string, revocation *sessiongateapiv1alpha1.Revocation) *sessiongateapiv1alpha1.Session {
+		return &sessiongateapiv1alpha1.Session{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "breakglass-abc",
+				CreationTimestamp: metav1.NewTime(now.Add(-10 * time.Minute)),
+			},
+			Spec: sessiongateapiv1alpha1.SessionSpec{
+				TTL:                metav1.Duration{Duration: time.Hour},
+				HostedControlPlane: sessiongateapiv1alpha1.HostedControlPlane{ResourceID: resourceID},
+				Owner:              sessiongateapiv1alpha1.Principal{Type: sessiongateapiv1alpha1.PrincipalTypeAzureUser, Name: "alice@example.com"},
+			},
+			Status: sessiongateapiv1alpha1.SessionStatus{
+				ExpiresAt:  &metav1.Time{Time: now.Add(50 * time.Minute)},
+				Revocation: revocation,
+			},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		session            *sessiongateapiv1alpha1.Session
+		body               string
+		expectedStatusCode int
+	}{
+		{
+			name:               "session is revoked",
+			session:            newSession(coreapitesting.TestClusterResourceID, nil),
+			body:               `{"reason":"incident resolved"}`,
+			expectedStatusCode: http.StatusAccepted,
+		},
+		{
+			name:               "missing reason",
+			session:            newSession(coreapitesting.TestClusterResourceID, nil),
+			body:               `{"reason":" "}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "session already revoked",
+			session: newSession(coreapitesting.TestClusterResourceID, &sessiongateapiv1alpha1.Revocation{
+				RevokedBy: sessiongateapiv1alpha1.Principal{Type: sessiongateapiv1alpha1.PrincipalTypeAzureUser, Name: "carol@example.com"},
+				RevokedAt: metav1.NewTime(now.Add(-time.Minute)),
+				Reason:    "wrong cluster",
+			}),
+			body:               `{"reason":"incident resolved"}`,
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name:               "session of another cluster",
+			session:            newSession(coreapitesting.TestClusterResourceID+"-other", nil),
+			body:               `{"reason":"incident resolved"}`,
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resourceID, err := azcorearm.ParseResourceID(coreapitesting.TestClusterResourceID)
+			require.NoError(t, err)
+
+			ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+			ctx = utils.ContextWithResourceID(ctx, resourceID)
+			ctx = middleware.ContextWithClientPrincipal(ctx, middleware.ClientPrincipalReference{
+				Name: "bob@example.com",
+				Type: middleware.PrincipalTypeDSTSUser,
+			})
+
+			sessionClient := sessiongatefake.NewSimpleClientset(tt.session).SessiongateV1alpha1().Sessions("")
+			handler := NewHCPBreakglassSessionRevocationHandler(sessionClient)
+			handler.clock = clocktesting.NewFakePassiveClock(now)
+
+			path := "/admin/v1/hcp" + coreapitesting.TestClusterResourceID + "/breakglass/breakglass-abc/revoke"
+			req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(tt.body)).WithContext(ctx)
+			req.SetPathValue("sessionName", "breakglass-abc")
+			recorder := httptest.NewRecorder()
+
+			err = handler.ServeHTTP(recorder, req)
+
+			session, getErr := sessionClient.Get(ctx, "breakglass-abc", metav1.GetOptions{})
+			require.NoError(t, getErr)
+
+			if tt.expectedStatusCode >= 400 {
+				var cloudErr *coreapi.CloudError
+				require.True(t, errors.As(err, &cloudErr), "expected CloudError but got %T: %v", err, err)
+				assert.Equal(t, tt.expectedStatusCode, cloudErr.StatusCode)
+				assert.Equal(t, tt.session.Status.Revocation, session.Status.Revocation, "a rejected revocation must not change the session")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatusCode, recorder.Code)
+			require.NotNil(t, session.Status.Revocation)
+			assert.Equal(t, "bob@example.com", session.Status.Revocation.RevokedBy.Name)
+			assert.Equal(t, sessiongateapiv1alpha1.PrincipalTypeAzureUser, session.Status.Revocation.RevokedBy.Type)
+			assert.Equal(t, "incident resolved", session.Status.Revocation.Reason)
+			assert.True(t, session.Status.Revocation.RevokedAt.Time.Equal(now))
+		})
+	}
+}
@@ -19,14 +19,17 @@ import (
 	"fmt"
 	"net/http"
 
+	"k8s.io/utils/ptr"
+
 	"github.com/Azure/ARO-HCP/internal/api/coreapi"
 	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/corecosmosstorage"
 	"github.com/Azure/ARO-HCP/internal/utils"
 )
 
 // HCPDesiredControlPlaneSizeHandler sets ServiceProviderClusterSpec
-// DesiredHostedClusterControlPlaneSize on the per-cluster ServiceProviderCluster.
-// It intentionally writes only that one field — every other Spec/Status value
+// DesiredHostedClusterControlPlaneSize on the per-cluster ServiceProviderCluster
+// and whether it is pinned against the control plane right-sizing controller.
+// It intentionally writes only those fields — every other Spec/Status value
 // is left as-is — so SRE callers can adjust the sizing tier without touching
 // anything else on the document.
 type HCPDesiredControlPlaneSizeHandler struct {
@@ -40,9 +43,12 @@ func NewHCPDesiredControlPlaneSizeHandler(resourcesDBClient corecosmosstorage.Re
 // desiredControlPlaneSizeRequest is the wire shape for the request body. The
 // Size field is a pointer-string so callers can distinguish "set to value"
 // (non-nil) from "clear the tier" (nil/absent). An explicit empty string is
-// rejected.
+// rejected. Pinned defaults to true when a size is set, so an SRE-selected
+// tier is not replaced by a right-sizing recommendation unless the caller
+// opts in with "pinned": false. Clearing the size always unpins it.
 type desiredControlPlaneSizeRequest struct {
-	Size *string `json:"size"`
+	Size   *string `json:"size"`
+	Pinned *bool   `json:"pinned,omitempty"`
 }
 
 func (h *HCPDesiredControlPlaneSizeHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) error {
@@ -66,6 +72,10 @@ func (h *HCPDesiredControlPlaneSizeHandler) ServeHTTP(writer http.ResponseWriter
 	if body.Size != nil && !coreapi.IsValidHostedClusterControlPlaneSize(*body.Size) {
 		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "size %q must be one of Small, Medium, Large, Xlarge, XXlarge", *body.Size)
 	}
+	if body.Size == nil && ptr.Deref(body.Pinned, false) {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "pinned requires a size")
+	}
+	pinned := body.Size != nil && ptr.Deref(body.Pinned, true)
 
 	existing, err := corecosmosstorage.GetOrCreateServiceProviderCluster(request.Context(), h.resourcesDBClient, resourceID)
 	if err != nil {
@@ -74,12 +84,13 @@ func (h *HCPDesiredControlPlaneSizeHandler) ServeHTTP(writer http.ResponseWriter
 
 	replacement := existing.DeepCopy()
 	replacement.Spec.DesiredHostedClusterControlPlaneSize = body.Size
+	replacement.Spec.DesiredHostedClusterControlPlaneSizeUnpinned = body.Size != nil && !pinned
 
 	_, err = h.resourcesDBClient.ServiceProviderClusters(resourceID.SubscriptionID, resourceID.ResourceGroupName, resourceID.Name).Replace(request.Context(), replacement, nil)
 	if err != nil {
 		return fmt.Errorf("failed to replace ServiceProviderCluster: %w", err)
 	}
 
-	_, err = coreapi.WriteJSONResponse(writer, http.StatusOK, desiredControlPlaneSizeRequest{Size: body.Size})
+	_, err = coreapi.WriteJSONResponse(writer, http.StatusOK, desiredControlPlaneSizeRequest{Size: body.Size, Pinned: &pinned})
 	return utils.TrackError(err)
 }
@@ -48,6 +48,7 @@ func TestDesiredControlPlaneSizeHandler(t *testing.T) {
 		body               io.Reader
 		skipResourceID     bool
 		existingSize       *string
+		existingUnpinned   bool
 		expectedStatusCode int
 		expectedError      string
 		// expectSizeCleared asserts the stored size is nil after the request.
@@ -55,6 +56,10 @@ func TestDesiredControlPlaneSizeHandler(t *testing.T) {
 		// expectedSize asserts the stored size matches (only checked when
 		// expectSizeCleared is false and the request succeeds).
 		expectedSize *string
+		// expectedPinned asserts the pin reported after a successful request.
+		expectedPinned bool
+		// expectedUnpinned asserts the stored unpin flag after a successful request.
+		expectedUnpinned bool
 	}{
 		{
 			name:               "missing resource ID",
@@ -86,12 +91,14 @@ func TestDesiredControlPlaneSizeHandler(t *testing.T) {
 			body:               strings.NewReader(`{"size":"Small"}`),
 			expectedStatusCode: http.StatusOK,
 			expectedSize:       &smallStr,
+			expectedPinned:     true,
 		},
 		{
 			name:               "valid size XXlarge creates SPC",
 			body:               strings.NewReader(`{"size":"XXlarge"}`),
 			expectedStatusCode: http.StatusOK,
 			expectedSize:       &xxlargeStr,
+			expectedPinned:     true,
 		},
 		{
 			name:               "overwrites existing size",
@@ -99,11 +106,26 @@ func TestDesiredControlPlaneSizeHandler(t *testing.T) {
 			existingSize:       &largeStr,
 			expectedStatusCode: http.StatusOK,
 			expectedSize:       &smallStr,
+			expectedPinned:     true,
+		},
+		{
+			name:               "explicit pinned false leaves size to right-sizing",
+			body:               strings.NewReader(`{"size":"Large","pinned":false}`),
+			expectedStatusCode: http.StatusOK,
+			expectedSize:       &largeStr,
+			expectedUnpinned:   true,
+		},
+		{
+			name:               "pinned without size rejected",
+			body:               strings.NewReader(`{"pinned":true}`),
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "pinned requires a size",
 		},
 		{
 			name:               "omitted size clears previously-set tier",
 			body:               strings.NewReader(`{}`),
 			existingSize:       &largeStr,
+			existingUnpinned:   true,
 			expectedStatusCode: http.StatusOK,
 			expectSizeCleared:  true,
 		},
@@ -134,6 +156,7 @@ func TestDesiredControlPlaneSizeHandler(t *testing.T) {
 				existing, err := corecosmosstorage.GetOrCreateServiceProviderCluster(ctx, mockResourcesDBClient, resourceID)
 				require.NoError(t, err)
 				existing.Spec.DesiredHostedClusterControlPlaneSize = tt.existingSize
+				existing.Spec.DesiredHostedClusterControlPlaneSizeUnpinned = tt.existingUnpinned
 				_, err = mockResourcesDBClient.ServiceProviderClusters(resourceID.SubscriptionID, resourceID.ResourceGroupName, resourceID.Name).Replace(ctx, existing, nil)
 				require.NoError(t, err)
 			}
@@ -178,6 +201,13 @@ func TestDesiredControlPlaneSizeHandler(t *testing.T) {
 			var respBody desiredControlPlaneSizeRequest
 			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&respBody))
 
+			if spc.Spec.DesiredHostedClusterControlPlaneSizeUnpinned != tt.expectedUnpinned {
+				t.Errorf("expected DesiredHostedClusterControlPlaneSizeUnpinned %t, got %t", tt.expectedUnpinned, spc.Spec.DesiredHostedClusterControlPlaneSizeUnpinned)
+			}
+			if respBody.Pinned == nil || *respBody.Pinned != tt.expectedPinned {
+				t.Errorf("expected response pinned %t, got %v", tt.expectedPinned, respBody.Pinned)
+			}
+
 			if tt.expectSizeCleared {
 				if spc.Spec.DesiredHostedClusterControlPlaneSize != nil {
 					t.Errorf("expected DesiredHostedClusterControlPlaneSize cleared, got %q", *spc.Spec.DesiredHostedClusterControlPlaneSize)
@@ -0,0 +1,144 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcp
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/corecosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+const (
+	// PathSegmentNodePoolName and PathSegmentExternalAuthName narrow the
+	// history to a single child resource of the cluster.
+	PathSegmentNodePoolName     = "nodePoolName"
+	PathSegmentExternalAuthName = "externalAuthName"
+
+	defaultHistoryPageSize = 50
+	maxHistoryPageSize     = 500
+)
+
+// HCPHistoryHandler pages through the change history the frontend records for
+// every customer update of a cluster, its node pools and its external auths.
+// Records are returned newest first. Without a node pool or external auth in
+// the path, the history of the cluster and all of its child resources is
+// returned.
+type HCPHistoryHandler struct {
+	resourcesDBClient corecosmosstorage.ResourcesDBClient
+}
+
+func NewHCPHistoryHandler(resourcesDBClient corecosmosstorage.ResourcesDBClient) *HCPHistoryHandler {
+	return &HCPHistoryHandler{resourcesDBClient: resourcesDBClient}
+}
+
+// resourceChangeResponse is the wire shape of a single change record.
+type resourceChangeResponse struct {
+	TargetResourceID string                           `json:"targetResourceId"`
+	ChangedAt        time.Time                        `json:"changedAt"`
+	OperationID      string                           `json:"operationId,omitempty"`
+	SystemData       *coreapi.SystemData              `json:"systemData,omitempty"`
+	CorrelationData  *coreapi.CorrelationData         `json:"correlationData,omitempty"`
+	Changes          []coreapi.ResourcePropertyChange `json:"changes"`
+}
+
+func (h *HCPHistoryHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) error {
+	ctx := request.Context()
+
+	resourceID, err := utils.ResourceIDFromContext(ctx)
+	if err != nil {
+		return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "", "invalid resource identifier in request")
+	}
+
+	pageSize := int32(defaultHistoryPageSize)
+	urlQuery := request.URL.Query()
+	if urlQuery.Has("$top") {
+		top, err := strconv.ParseInt(urlQuery.Get("$top"), 10, 32)
+		if err != nil || top <= 0 || top > maxHistoryPageSize {
+			return coreapi.NewCloudError(http.StatusBadRequest, coreapi.CloudErrorCodeInvalidRequestContent, "$top",
+				"$top must be an integer between 1 and %d", maxHistoryPageSize)
+		}
+		pageSize = int32(top)
+	}
+
+	options := &cosmosstorageutils.DBClientListResourceDocsOptions{
+		PageSizeHint: &pageSize,
+		OrderBy: &cosmosstorageutils.ListOrderBy{
+			Path:       []string{"changedAt"},
+			Descending: true,
+		},
+	}
+	if urlQuery.Has("$skipToken") {
+		skipToken := urlQuery.Get("$skipToken")
+		options.ContinuationToken = &skipToken
+	}
+
+	var targetResourceID string
+	switch {
+	case len(request.PathValue(PathSegmentNodePoolName)) > 0:
+		targetResourceID = coreapi.ToNodePoolResourceIDString(resourceID.SubscriptionID, resourceID.ResourceGroupName, resourceID.Name, request.PathValue(PathSegmentNodePoolName))
+	case len(request.PathValue(PathSegmentExternalAuthName)) > 0:
+		targetResourceID = coreapi.ToExternalAuthResourceIDString(resourceID.SubscriptionID, resourceID.ResourceGroupName, resourceID.Name, request.PathValue(PathSegmentExternalAuthName))
+	}
+	if len(targetResourceID) > 0 {
+		options.Filters = []cosmosstorageutils.ListFilter{{
+			Path:     []string{"targetResourceId"},
+			Operator: cosmosstorageutils.ListFilterOperatorEquals,
+			Value:    targetResourceID,
+		}}
+	}
+
+	iterator, err := h.resourcesDBClient.HCPClusters(resourceID.SubscriptionID, resourceID.ResourceGroupName).ResourceChanges(resourceID.Name).List(ctx, options)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	pagedResponse := coreapi.NewPagedResponse()
+	for _, resourceChange := range iterator.Items(ctx) {
+		entry := resourceChangeResponse{
+			ChangedAt:       resourceChange.ChangedAt.Time,
+			OperationID:     resourceChange.OperationID,
+			SystemData:      resourceChange.SystemData,
+			CorrelationData: resourceChange.CorrelationData,
+			Changes:         resourceChange.Changes,
+		}
+		if resourceChange.TargetResourceID != nil {
+			entry.TargetResourceID = resourceChange.TargetResourceID.String()
+		}
+		if entry.Changes == nil {
+			entry.Changes = []coreapi.ResourcePropertyChange{}
+		}
+		jsonBytes, err := coreapi.MarshalJSON(entry)
+		if err != nil {
+			return utils.TrackError(err)
+		}
+		pagedResponse.AddValue(jsonBytes)
+	}
+	if err := iterator.GetError(); err != nil {
+		return utils.TrackError(err)
+	}
+
+	// The next link repeats $top, so every page has the same size.
+	if err := pagedResponse.SetNextLink(request.URL.String(), iterator.GetContinuationToken()); err != nil {
+		return utils.TrackError(err)
+	}
+
+	_, err = coreapi.WriteJSONResponse(writer, http.StatusOK, pagedResponse)
+	return utils.TrackError(err)
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/apitesting/coreapitesting"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/corecosmosstoragetesting"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+func TestHCPHistoryHandler(t *testing.T) {
+	clusterID := metadataapi.Must(azcorearm.ParseResourceID(coreapitesting.TestClusterResourceID))
+	nodePoolID := metadataapi.Must(azcorearm.ParseResourceID(coreapitesting.TestNodePoolResourceID))
+	changedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	newResourceChange := func(name string, target *azcorearm.ResourceID, age time.Duration) *coreapi.ResourceChange {
+		return &coreapi.ResourceChange{
+			CosmosMetadata: coreapi.CosmosMetadata{
+				ResourceID: metadataapi.Must(coreapi.ToResourceChangeResourceID(clusterID.SubscriptionID, clusterID.ResourceGroupName, clusterID.Name, name)),
+			},
+			TargetResourceID: target,
+			ChangedAt:        metav1.NewTime(changedAt.Add(-age)),
+			OperationID:      name,
+			CorrelationData:  &coreapi.CorrelationData{CorrelationRequestID: name},
+			Changes: []coreapi.ResourcePropertyChange{{
+				Path:       "tags.env",
+				ChangeType: coreapi.ResourcePropertyChangeTypeModified,
+				Before:     json.RawMessage(`"dev"`),
+				After:      json.RawMessage(`"prod"`),
+			}},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		pathValues         map[string]string
+		query              string
+		expectedStatusCode int
+		expectedOperations []string
+	}{
+		{
+			name:               "cluster history includes child resources newest first",
+			expectedStatusCode: http.StatusOK,
+			expectedOperations: []string{"newest", "middle", "oldest"},
+		},
+		{
+			name:               "node pool history",
+			pathValues:         map[string]string{PathSegmentNodePoolName: nodePoolID.Name},
+			expectedStatusCode: http.StatusOK,
+			expectedOperations: []string{"middle"},
+		},
+		{
+			name:               "unknown external auth has no history",
+			pathValues:         map[string]string{PathSegmentExternalAuthName: "missing"},
+			expectedStatusCode: http.StatusOK,
+			expectedOperations: []string{},
+		},
+		{
+			name:               "invalid $top",
+			query:              "?$top=0",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+			mockResourcesDBClient, err := corecosmosstoragetesting.NewMockResourcesDBClientWithResources(ctx, []any{
+				newResourceChange("oldest", clusterID, 2*time.Hour),
+				newResourceChange("newest", clusterID, 0),
+				newResourceChange("middle", nodePoolID, time.Hour),
+			})
+			require.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodGet, "/history"+tt.query, nil)
+			for key, value := range tt.pathValues {
+				request.SetPathValue(key, value)
+			}
+			request = request.WithContext(utils.ContextWithResourceID(ctx, clusterID))
+			recorder := httptest.NewRecorder()
+
+			err = NewHCPHistoryHandler(mockResourcesDBClient).ServeHTTP(recorder, request)
+			if tt.expectedStatusCode >= 400 {
+				var cloudErr *coreapi.CloudError
+				require.True(t, errors.As(err, &cloudErr), "expected CloudError, got %v", err)
+				assert.Equal(t, tt.expectedStatusCode, cloudErr.StatusCode)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedStatusCode, recorder.Code)
+
+			var response struct {
+				Value []resourceChangeResponse `json:"value"`
+			}
+			require.NoError(t, json.NewDecoder(recorder.Body).Decode(&response))
+
+			operations := []string{}
+			for _, entry := range response.Value {
+				operations = append(operations, entry.OperationID)
+				require.Len(t, entry.Changes, 1)
+				assert.Equal(t, "tags.env", entry.Changes[0].Path)
+				assert.Equal(t, entry.OperationID, entry.CorrelationData.CorrelationRequestID)
+			}
+			assert.Equal(t, tt.expectedOperations, operations)
+		})
+	}
+}
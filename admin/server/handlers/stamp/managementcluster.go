@@ -30,17 +30,18 @@ import (
 // ManagementClusterStatus mirrors fleetapi.ManagementClusterStatus but
 // serializes *azcorearm.ResourceID and *api.InternalID fields as strings.
 type ManagementClusterStatus struct {
-	Conditions                                           []metav1.Condition `json:"conditions,omitempty"`
-	AKSResourceID                                        string             `json:"aksResourceID"`
-	PublicDNSZoneResourceID                              string             `json:"publicDNSZoneResourceID"`
-	HostedClustersSecretsKeyVaultURL                     string             `json:"hostedClustersSecretsKeyVaultURL,omitempty"`
-	HostedClustersManagedIdentitiesKeyVaultURL           string             `json:"hostedClustersManagedIdentitiesKeyVaultURL,omitempty"`
-	HostedClustersSecretsKeyVaultManagedIdentityClientID string             `json:"hostedClustersSecretsKeyVaultManagedIdentityClientID,omitempty"`
-	MaestroConsumerName                                  string             `json:"maestroConsumerName,omitempty"`
-	MaestroRESTAPIURL                                    string             `json:"maestroRESTAPIURL,omitempty"`
-	MaestroGRPCTarget                                    string             `json:"maestroGRPCTarget,omitempty"`
-	ClusterServiceProvisionShardID                       string             `json:"clusterServiceProvisionShardID"`
-	KubeApplierCosmosContainerName                       string             `json:"kubeApplierCosmosContainerName,omitempty"`
+	Conditions                                           []metav1.Condition                  `json:"conditions,omitempty"`
+	AKSResourceID                                        string                              `json:"aksResourceID"`
+	PublicDNSZoneResourceID                              string                              `json:"publicDNSZoneResourceID"`
+	HostedClustersSecretsKeyVaultURL                     string                              `json:"hostedClustersSecretsKeyVaultURL,omitempty"`
+	HostedClustersManagedIdentitiesKeyVaultURL           string                              `json:"hostedClustersManagedIdentitiesKeyVaultURL,omitempty"`
+	HostedClustersSecretsKeyVaultManagedIdentityClientID string                              `json:"hostedClustersSecretsKeyVaultManagedIdentityClientID,omitempty"`
+	MaestroConsumerName                                  string                              `json:"maestroConsumerName,omitempty"`
+	MaestroRESTAPIURL                                    string                              `json:"maestroRESTAPIURL,omitempty"`
+	MaestroGRPCTarget                                    string                              `json:"maestroGRPCTarget,omitempty"`
+	ClusterServiceProvisionShardID                       string                              `json:"clusterServiceProvisionShardID"`
+	KubeApplierCosmosContainerName                       string                              `json:"kubeApplierCosmosContainerName,omitempty"`
+	Capacity                                             *fleetapi.ManagementClusterCapacity `json:"capacity,omitempty"`
 }
 
 // ManagementCluster is the API response for a management cluster,
@@ -88,6 +89,7 @@ func toManagementClusterStatus(status fleetapi.ManagementClusterStatus) (Managem
 		MaestroGRPCTarget:                                    status.MaestroGRPCTarget,
 		ClusterServiceProvisionShardID:                       status.ClusterServiceProvisionShardID.String(),
 		KubeApplierCosmosContainerName:                       status.KubeApplierCosmosContainerName,
+		Capacity:                                             status.Capacity,
 	}, nil
 }
 
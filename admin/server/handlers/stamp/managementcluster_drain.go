@@ -0,0 +1,209 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stamp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+
+	"github.com/Azure/ARO-HCP/admin/server/middleware"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/fleetcosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+type managementClusterDrainRequest struct {
+	Reason                  string `json:"reason"`
+	MaxConcurrentMigrations *int32 `json:"maxConcurrentMigrations,omitempty"`
+}
+
+// ManagementClusterDrainHandler handles
+// POST /admin/v1/stamps/{stampIdentifier}/managementclusters/{managementClusterName}/drain.
+//
+// Draining cordons the management cluster and records the drain request in
+// its spec. The ManagementClusterDrainController in the fleet controller then
+// migrates the hosted control planes and reports progress as conditions, which
+// are visible through the management cluster GET endpoint. Repeating the
+// request updates the reason and concurrency of a running drain.
+type ManagementClusterDrainHandler struct {
+	fleetDBClient fleetcosmosstorage.FleetDBClient
+	clock         clock.PassiveClock
+}
+
+func NewManagementClusterDrainHandler(fleetDBClient fleetcosmosstorage.FleetDBClient) *ManagementClusterDrainHandler {
+	return &ManagementClusterDrainHandler{
+		fleetDBClient: fleetDBClient,
+		clock:         clock.RealClock{},
+	}
+}
+
+func (h *ManagementClusterDrainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	stampIdentifier := r.PathValue("stampIdentifier")
+	managementClusterName := r.PathValue("managementClusterName")
+
+	var body managementClusterDrainRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return coreapi.NewCloudError(
+			http.StatusBadRequest,
+			coreapi.CloudErrorCodeInvalidRequestContent, "",
+			"The request content was invalid and could not be deserialized: %q", err,
+		)
+	}
+
+	if err := validateDrainRequest(body); err != nil {
+		return err
+	}
+
+	if err := validateStampIdentifier(stampIdentifier); err != nil {
+		return err
+	}
+
+	managementClusterCRUD := h.fleetDBClient.Stamps().ManagementClusters(stampIdentifier)
+	existing, err := managementClusterCRUD.Get(ctx, managementClusterName)
+	if err != nil {
+		if cosmosstorageutils.IsNotFoundError(err) {
+			return coreapi.NewCloudError(http.StatusNotFound, coreapi.CloudErrorCodeNotFound, "",
+				"Management cluster %q not found for stamp %q", managementClusterName, stampIdentifier)
+		}
+		return utils.TrackError(fmt.Errorf("failed to get management cluster: %w", err))
+	}
+
+	updated := existing.DeepCopy()
+	if updated.Spec.Drain == nil {
+		updated.Spec.Drain = &fleetapi.ManagementClusterDrain{
+			RequestedTime:            metav1.NewTime(h.clock.Now()),
+			PreviousSchedulingPolicy: existing.Spec.SchedulingPolicy,
+		}
+		if clientPrincipal, err := middleware.ClientPrincipalFromContext(ctx); err == nil {
+			updated.Spec.Drain.RequestedBy = clientPrincipal.Name
+		}
+	}
+	updated.Spec.Drain.Reason = body.Reason
+	updated.Spec.Drain.MaxConcurrentMigrations = fleetapi.DefaultManagementClusterDrainConcurrentMigrations
+	if body.MaxConcurrentMigrations != nil {
+		updated.Spec.Drain.MaxConcurrentMigrations = *body.MaxConcurrentMigrations
+	}
+	updated.Spec.SchedulingPolicy = fleetapi.ManagementClusterSchedulingPolicyUnschedulable
+
+	if !equality.Semantic.DeepEqual(existing.Spec, updated.Spec) {
+		if _, err := managementClusterCRUD.Replace(ctx, updated, existing, nil); err != nil {
+			if cosmosstorageutils.IsPreconditionFailedError(err) {
+				return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "ETag conflict, retry the operation")
+			}
+			return utils.TrackError(err)
+		}
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	return nil
+}
+
+// ManagementClusterUndrainHandler handles
+// POST /admin/v1/stamps/{stampIdentifier}/managementclusters/{managementClusterName}/undrain.
+//
+// Undraining withdraws the drain request and restores the scheduling policy
+// the management cluster had before the drain, unless the capacity controller
+// holds the management cluster cordoned. Migrations already requested from Cluster Service are not rolled
+// back; the ManagementClusterDrainController stops starting new ones and
+// records the withdrawal in the Drained condition.
+type ManagementClusterUndrainHandler struct {
+	fleetDBClient fleetcosmosstorage.FleetDBClient
+}
+
+func NewManagementClusterUndrainHandler(fleetDBClient fleetcosmosstorage.FleetDBClient) *ManagementClusterUndrainHandler {
+	return &ManagementClusterUndrainHandler{
+		fleetDBClient: fleetDBClient,
+	}
+}
+
+func (h *ManagementClusterUndrainHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) error {
+	ctx := r.Context()
+	stampIdentifier := r.PathValue("stampIdentifier")
+	managementClusterName := r.PathValue("managementClusterName")
+
+	if err := validateStampIdentifier(stampIdentifier); err != nil {
+		return err
+	}
+
+	managementClusterCRUD := h.fleetDBClient.Stamps().ManagementClusters(stampIdentifier)
+	existing, err := managementClusterCRUD.Get(ctx, managementClusterName)
+	if err != nil {
+		if cosmosstorageutils.IsNotFoundError(err) {
+			return coreapi.NewCloudError(http.StatusNotFound, coreapi.CloudErrorCodeNotFound, "",
+				"Management cluster %q not found for stamp %q", managementClusterName, stampIdentifier)
+		}
+		return utils.TrackError(fmt.Errorf("failed to get management cluster: %w", err))
+	}
+
+	if existing.Spec.Drain == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+
+	updated := existing.DeepCopy()
+	updated.Spec.Drain = nil
+	// Drains recorded before the previous policy was kept were requested on
+	// schedulable management clusters.
+	previousSchedulingPolicy := existing.Spec.Drain.PreviousSchedulingPolicy
+	if len(previousSchedulingPolicy) == 0 {
+		previousSchedulingPolicy = fleetapi.ManagementClusterSchedulingPolicySchedulable
+	}
+	if existing.Status.Capacity == nil || !existing.Status.Capacity.Cordoned {
+		updated.Spec.SchedulingPolicy = previousSchedulingPolicy
+	}
+
+	if _, err := managementClusterCRUD.Replace(ctx, updated, existing, nil); err != nil {
+		if cosmosstorageutils.IsPreconditionFailedError(err) {
+			return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "ETag conflict, retry the operation")
+		}
+		return utils.TrackError(err)
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+	return nil
+}
+
+func validateDrainRequest(body managementClusterDrainRequest) error {
+	var details []coreapi.CloudErrorBody
+
+	if len(body.Reason) == 0 {
+		details = append(details, coreapi.CloudErrorBody{
+			Code:    coreapi.CloudErrorCodeInvalidRequestContent,
+			Target:  "reason",
+			Message: "reason is required",
+		})
+	}
+	if body.MaxConcurrentMigrations != nil &&
+		(*body.MaxConcurrentMigrations < 1 || *body.MaxConcurrentMigrations > fleetapi.MaxManagementClusterDrainConcurrentMigrations) {
+		details = append(details, coreapi.CloudErrorBody{
+			Code:    coreapi.CloudErrorCodeInvalidRequestContent,
+			Target:  "maxConcurrentMigrations",
+			Message: fmt.Sprintf("maxConcurrentMigrations must be between 1 and %d", fleetapi.MaxManagementClusterDrainConcurrentMigrations),
+		})
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+	return coreapi.NewContentValidationError(details)
+}
@@ -0,0 +1,298 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stamp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/Azure/ARO-HCP/admin/server/middleware"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/fleetcosmosstoragetesting"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+func TestManagementClusterDrainHandler(t *testing.T) {
+	t.Parallel()
+	requestedTime := metav1.NewTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	drainingManagementCluster := func() *fleetapi.ManagementCluster {
+		mc := newManagementCluster(t, "a1")
+		mc.Spec.SchedulingPolicy = fleetapi.ManagementClusterSchedulingPolicyUnschedulable
+		mc.Spec.Drain = &fleetapi.ManagementClusterDrain{
+			Reason:                  "incident",
+			RequestedBy:             "alice@example.com",
+			RequestedTime:           requestedTime,
+			MaxConcurrentMigrations: 2,
+		}
+		return mc
+	}
+
+	tests := []struct {
+		name               string
+		stampIdentifier    string
+		body               string
+		setupResources     []any
+		expectedStatusCode int
+		expectedError      string
+		verifyState        func(*testing.T, *fleetapi.ManagementCluster)
+	}{
+		{
+			name:               "start drain cordons and records the request",
+			stampIdentifier:    "a1",
+			body:               `{"reason":"decommission"}`,
+			setupResources:     []any{newStamp("a1"), newManagementCluster(t, "a1")},
+			expectedStatusCode: http.StatusAccepted,
+			verifyState: func(t *testing.T, mc *fleetapi.ManagementCluster) {
+				require.Equal(t, fleetapi.ManagementClusterSchedulingPolicyUnschedulable, mc.Spec.SchedulingPolicy)
+				require.NotNil(t, mc.Spec.Drain)
+				require.Equal(t, "decommission", mc.Spec.Drain.Reason)
+				require.Equal(t, "bob@example.com", mc.Spec.Drain.RequestedBy)
+				require.Equal(t, fleetapi.DefaultManagementClusterDrainConcurrentMigrations, mc.Spec.Drain.MaxConcurrentMigrations)
+				require.Equal(t, fleetapi.ManagementClusterSchedulingPolicySchedulable, mc.Spec.Drain.PreviousSchedulingPolicy)
+				require.True(t, now.Equal(mc.Spec.Drain.RequestedTime.Time))
+			},
+		},
+		{
+			name:            "start drain records a cordon placed before the drain",
+			stampIdentifier: "a1",
+			body:            `{"reason":"decommission"}`,
+			setupResources: []any{newStamp("a1"), func() *fleetapi.ManagementCluster {
+				mc := newManagementCluster(t, "a1")
+				mc.Spec.SchedulingPolicy = fleetapi.ManagementClusterSchedulingPolicyUnschedulable
+				return mc
+			}()},
+			expectedStatusCode: http.StatusAccepted,
+			verifyState: func(t *testing.T, mc *fleetapi.ManagementCluster) {
+				require.Equal(t, fleetapi.ManagementClusterSchedulingPolicyUnschedulable, mc.Spec.SchedulingPolicy)
+				require.NotNil(t, mc.Spec.Drain)
+				require.Equal(t, fleetapi.ManagementClusterSchedulingPolicyUnschedulable, mc.Spec.Drain.PreviousSchedulingPolicy)
+			},
+		},
+		{
+			name:               "repeated drain updates concurrency and keeps the original request",
+			stampIdentifier:    "a1",
+			body:               `{"reason":"incident","maxConcurrentMigrations":10}`,
+			setupResources:     []any{newStamp("a1"), drainingManagementCluster()},
+			expectedStatusCode: http.StatusAccepted,
+			verifyState: func(t *testing.T, mc *fleetapi.ManagementCluster) {
+				require.NotNil(t, mc.Spec.Drain)
+				require.Equal(t, int32(10), mc.Spec.Drain.MaxConcurrentMigrations)
+				require.Equal(t, "alice@example.com", mc.Spec.Drain.RequestedBy)
+				require.True(t, requestedTime.Equal(&mc.Spec.Drain.RequestedTime))
+			},
+		},
+		{
+			name:               "management cluster not found returns 404",
+			stampIdentifier:    "a1",
+			body:               `{"reason":"decommission"}`,
+			setupResources:     []any{newStamp("a1")},
+			expectedStatusCode: http.StatusNotFound,
+			expectedError:      "not found",
+		},
+		{
+			name:               "missing reason returns 400",
+			stampIdentifier:    "a1",
+			body:               `{"reason":""}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "reason is required",
+		},
+		{
+			name:               "concurrency out of range returns 400",
+			stampIdentifier:    "a1",
+			body:               `{"reason":"decommission","maxConcurrentMigrations":0}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "maxConcurrentMigrations must be between",
+		},
+		{
+			name:               "invalid JSON body",
+			stampIdentifier:    "a1",
+			body:               `{invalid`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedError:      "could not be deserialized",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+			ctx = middleware.ContextWithClientPrincipal(ctx, middleware.ClientPrincipalReference{Name: "bob@example.com"})
+
+			var mockFleetDB *fleetcosmosstoragetesting.MockFleetDBClient
+			var err error
+			if len(tt.setupResources) > 0 {
+				mockFleetDB, err = fleetcosmosstoragetesting.NewMockFleetDBClientWithResources(ctx, tt.setupResources)
+				require.NoError(t, err)
+			} else {
+				mockFleetDB = fleetcosmosstoragetesting.NewMockFleetDBClient()
+			}
+
+			handler := NewManagementClusterDrainHandler(mockFleetDB)
+			handler.clock = clocktesting.NewFakePassiveClock(now)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/v1/stamps/"+tt.stampIdentifier+"/managementclusters/"+fleetapi.ManagementClusterResourceName+"/drain", strings.NewReader(tt.body))
+			req.SetPathValue("stampIdentifier", tt.stampIdentifier)
+			req.SetPathValue("managementClusterName", fleetapi.ManagementClusterResourceName)
+			req = req.WithContext(ctx)
+			recorder := httptest.NewRecorder()
+
+			handlerErr := handler.ServeHTTP(recorder, req)
+
+			if len(tt.expectedError) > 0 {
+				require.Error(t, handlerErr)
+				var cloudErr *coreapi.CloudError
+				require.True(t, errors.As(handlerErr, &cloudErr), "expected CloudError but got %T: %v", handlerErr, handlerErr)
+				require.Equal(t, tt.expectedStatusCode, cloudErr.StatusCode)
+				require.Contains(t, cloudErr.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, handlerErr)
+			require.Equal(t, tt.expectedStatusCode, recorder.Code)
+
+			if tt.verifyState != nil {
+				mc, err := mockFleetDB.Stamps().ManagementClusters(tt.stampIdentifier).Get(ctx, fleetapi.ManagementClusterResourceName)
+				require.NoError(t, err)
+				tt.verifyState(t, mc)
+			}
+		})
+	}
+}
+
+func TestManagementClusterUndrainHandler(t *testing.T) {
+	t.Parallel()
+	drainingManagementCluster := func(previousSchedulingPolicy fleetapi.ManagementClusterSchedulingPolicy, capacityCordoned bool) *fleetapi.ManagementCluster {
+		mc := newManagementCluster(t, "a1")
+		mc.Spec.SchedulingPolicy = fleetapi.ManagementClusterSchedulingPolicyUnschedulable
+		mc.Spec.Drain = &fleetapi.ManagementClusterDrain{
+			Reason:                   "incident",
+			RequestedTime:            metav1.NewTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)),
+			MaxConcurrentMigrations:  2,
+			PreviousSchedulingPolicy: previousSchedulingPolicy,
+		}
+		if capacityCordoned {
+			mc.Status.Capacity = &fleetapi.ManagementClusterCapacity{Score: 95, Cordoned: true}
+		}
+		return mc
+	}
+
+	tests := []struct {
+		name               string
+		setupResources     []any
+		expectedStatusCode int
+		expectedError      string
+		verifyState        func(*testing.T, *fleetapi.ManagementCluster)
+	}{
+		{
+			name:               "undrain withdraws the drain and lifts the cordon",
+			setupResources:     []any{newStamp("a1"), drainingManagementCluster(fleetapi.ManagementClusterSchedulingPolicySchedulable, false)},
+			expectedStatusCode: http.StatusAccepted,
+			verifyState: func(t *testing.T, mc *fleetapi.ManagementCluster) {
+				require.Nil(t, mc.Spec.Drain)
+				require.Equal(t, fleetapi.ManagementClusterSchedulingPolicySchedulable, mc.Spec.SchedulingPolicy)
+			},
+		},
+		{
+			name:               "undrain keeps a capacity cordon",
+			setupResources:     []any{newStamp("a1"), drainingManagementCluster(fleetapi.ManagementClusterSchedulingPolicySchedulable, true)},
+			expectedStatusCode: http.StatusAccepted,
+			verifyState: func(t *testing.T, mc *fleetapi.ManagementCluster) {
+				require.Nil(t, mc.Spec.Drain)
+				require.Equal(t, fleetapi.ManagementClusterSchedulingPolicyUnschedulable, mc.Spec.SchedulingPolicy)
+			},
+		},
+		{
+			name:               "undrain keeps a cordon placed before the drain",
+			setupResources:     []any{newStamp("a1"), drainingManagementCluster(fleetapi.ManagementClusterSchedulingPolicyUnschedulable, false)},
+			expectedStatusCode: http.StatusAccepted,
+			verifyState: func(t *testing.T, mc *fleetapi.ManagementCluster) {
+				require.Nil(t, mc.Spec.Drain)
+				require.Equal(t, fleetapi.ManagementClusterSchedulingPolicyUnschedulable, mc.Spec.SchedulingPolicy)
+			},
+		},
+		{
+			name:               "undrain of a drain without a recorded policy makes the cluster schedulable",
+			setupResources:     []any{newStamp("a1"), drainingManagementCluster("", false)},
+			expectedStatusCode: http.StatusAccepted,
+			verifyState: func(t *testing.T, mc *fleetapi.ManagementCluster) {
+				require.Nil(t, mc.Spec.Drain)
+				require.Equal(t, fleetapi.ManagementClusterSchedulingPolicySchedulable, mc.Spec.SchedulingPolicy)
+			},
+		},
+		{
+			name:               "undrain without a drain is a no-op",
+			setupResources:     []any{newStamp("a1"), newManagementCluster(t, "a1")},
+			expectedStatusCode: http.StatusNoContent,
+			verifyState: func(t *testing.T, mc *fleetapi.ManagementCluster) {
+				require.Nil(t, mc.Spec.Drain)
+			},
+		},
+		{
+			name:               "management cluster not found returns 404",
+			setupResources:     []any{newStamp("a1")},
+			expectedStatusCode: http.StatusNotFound,
+			expectedError:      "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+
+			mockFleetDB, err := fleetcosmosstoragetesting.NewMockFleetDBClientWithResources(ctx, tt.setupResources)
+			require.NoError(t, err)
+
+			handler := NewManagementClusterUndrainHandler(mockFleetDB)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/v1/stamps/a1/managementclusters/"+fleetapi.ManagementClusterResourceName+"/undrain", nil)
+			req.SetPathValue("stampIdentifier", "a1")
+			req.SetPathValue("managementClusterName", fleetapi.ManagementClusterResourceName)
+			req = req.WithContext(ctx)
+			recorder := httptest.NewRecorder()
+
+			handlerErr := handler.ServeHTTP(recorder, req)
+
+			if len(tt.expectedError) > 0 {
+				require.Error(t, handlerErr)
+				var cloudErr *coreapi.CloudError
+				require.True(t, errors.As(handlerErr, &cloudErr), "expected CloudError but got %T: %v", handlerErr, handlerErr)
+				require.Equal(t, tt.expectedStatusCode, cloudErr.StatusCode)
+				require.Contains(t, cloudErr.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, handlerErr)
+			require.Equal(t, tt.expectedStatusCode, recorder.Code)
+
+			if tt.verifyState != nil {
+				mc, err := mockFleetDB.Stamps().ManagementClusters("a1").Get(ctx, fleetapi.ManagementClusterResourceName)
+				require.NoError(t, err)
+				tt.verifyState(t, mc)
+			}
+		})
+	}
+}
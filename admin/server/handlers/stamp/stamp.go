@@ -17,6 +17,7 @@ package stamp
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/Azure/ARO-HCP/internal/api/coreapi"
 	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
@@ -26,10 +27,13 @@ import (
 )
 
 // Stamp is the API response for a stamp, without CosmosMetadata.
+// ManagementClusterCapacity is the capacity of the management cluster of
+// the stamp, once it has been scored.
 type Stamp struct {
-	ResourceID string               `json:"resourceId"`
-	Spec       fleetapi.StampSpec   `json:"spec"`
-	Status     fleetapi.StampStatus `json:"status"`
+	ResourceID                string                              `json:"resourceId"`
+	Spec                      fleetapi.StampSpec                  `json:"spec"`
+	Status                    fleetapi.StampStatus                `json:"status"`
+	ManagementClusterCapacity *fleetapi.ManagementClusterCapacity `json:"managementClusterCapacity,omitempty"`
 }
 
 func validateStampIdentifier(stampIdentifier string) error {
@@ -43,15 +47,19 @@ func validateStampIdentifier(stampIdentifier string) error {
 	return nil
 }
 
-func toStamp(s *fleetapi.Stamp) (Stamp, error) {
+func toStamp(s *fleetapi.Stamp, managementCluster *fleetapi.ManagementCluster) (Stamp, error) {
 	if s.ResourceID == nil {
 		return Stamp{}, fmt.Errorf("stamp has nil resourceId")
 	}
-	return Stamp{
+	resp := Stamp{
 		ResourceID: s.ResourceID.String(),
 		Spec:       s.Spec,
 		Status:     s.Status,
-	}, nil
+	}
+	if managementCluster != nil {
+		resp.ManagementClusterCapacity = managementCluster.Status.Capacity
+	}
+	return resp, nil
 }
 
 // StampListHandler handles GET /admin/v1/stamps.
@@ -68,6 +76,18 @@ func NewStampListHandler(fleetDBClient fleetcosmosstorage.FleetDBClient) *StampL
 func (h *StampListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) error {
 	ctx := r.Context()
 
+	managementClusterIter, err := h.fleetDBClient.GlobalListers().ManagementClusters().List(ctx, nil)
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to list management clusters: %w", err))
+	}
+	managementClusters := map[string]*fleetapi.ManagementCluster{}
+	for _, managementCluster := range managementClusterIter.Items(ctx) {
+		managementClusters[strings.ToLower(managementCluster.GetStampIdentifier())] = managementCluster
+	}
+	if err := managementClusterIter.GetError(); err != nil {
+		return utils.TrackError(fmt.Errorf("failed to iterate management clusters: %w", err))
+	}
+
 	iter, err := h.fleetDBClient.GlobalListers().Stamps().List(ctx, nil)
 	if err != nil {
 		return utils.TrackError(fmt.Errorf("failed to list stamps: %w", err))
@@ -75,7 +95,7 @@ func (h *StampListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) err
 
 	var stamps []Stamp
 	for _, s := range iter.Items(ctx) {
-		resp, err := toStamp(s)
+		resp, err := toStamp(s, managementClusters[strings.ToLower(s.GetStampIdentifier())])
 		if err != nil {
 			return utils.TrackError(fmt.Errorf("failed to convert stamp: %w", err))
 		}
@@ -120,7 +140,12 @@ func (h *StampGetHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) erro
 		return utils.TrackError(fmt.Errorf("failed to get stamp: %w", err))
 	}
 
-	resp, err := toStamp(stamp)
+	managementCluster, err := h.fleetDBClient.Stamps().ManagementClusters(stampIdentifier).Get(ctx, fleetapi.ManagementClusterResourceName)
+	if err != nil && !cosmosstorageutils.IsNotFoundError(err) {
+		return utils.TrackError(fmt.Errorf("failed to get management cluster: %w", err))
+	}
+
+	resp, err := toStamp(stamp, managementCluster)
 	if err != nil {
 		return utils.TrackError(fmt.Errorf("failed to convert stamp: %w", err))
 	}
@@ -18,11 +18,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"time"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 
+	"github.com/Azure/ARO-HCP/admin/server/middleware"
 	"github.com/Azure/ARO-HCP/internal/api/coreapi"
 	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
 	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
@@ -38,11 +41,13 @@ type stampApprovalRequest struct {
 
 type StampApprovalHandler struct {
 	fleetDBClient fleetcosmosstorage.FleetDBClient
+	clock         clock.PassiveClock
 }
 
 func NewStampApprovalHandler(fleetDBClient fleetcosmosstorage.FleetDBClient) *StampApprovalHandler {
 	return &StampApprovalHandler{
 		fleetDBClient: fleetDBClient,
+		clock:         clock.RealClock{},
 	}
 }
 
@@ -76,30 +81,62 @@ func (h *StampApprovalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return utils.TrackError(fmt.Errorf("failed to get stamp: %w", err))
 	}
 
-	conditionStatus := metav1.ConditionFalse
+	updated := existing.DeepCopy()
+	policy := existing.Spec.ApprovalPolicy
+
 	if body.Approved {
-		conditionStatus = metav1.ConditionTrue
+		approver := ""
+		if clientPrincipal, err := middleware.ClientPrincipalFromContext(ctx); err == nil {
+			approver = clientPrincipal.Name
+		}
+		if policy != nil {
+			if len(approver) == 0 {
+				return coreapi.NewCloudError(http.StatusUnauthorized, "Unauthorized", "", "missing client principal AAD reference")
+			}
+			if strings.EqualFold(approver, policy.RequestedBy) {
+				return coreapi.NewCloudError(http.StatusForbidden, "Forbidden", "", "%q requested stamp %q and cannot approve it", approver, stampIdentifier)
+			}
+		}
+		if len(approver) > 0 {
+			recordApproval(updated, fleetapi.StampApproval{
+				Approver:     approver,
+				Reason:       body.Reason,
+				Message:      body.Message,
+				ApprovedTime: metav1.NewTime(h.clock.Now()),
+			})
+		}
+	} else {
+		updated.Status.Approvals = nil
+	}
+
+	// Stamps with an approval policy are approved by the fleet once enough
+	// distinct approvers signed off; only a revocation takes effect directly.
+	if policy == nil || !body.Approved {
+		conditionStatus := metav1.ConditionFalse
+		if body.Approved {
+			conditionStatus = metav1.ConditionTrue
+		}
+		existingCondition := apimeta.FindStatusCondition(existing.Status.Conditions, string(fleetapi.StampConditionApproved))
+		if existingCondition == nil ||
+			existingCondition.Status != conditionStatus ||
+			existingCondition.Reason != body.Reason ||
+			existingCondition.Message != body.Message {
+			apimeta.SetStatusCondition(&updated.Status.Conditions, metav1.Condition{
+				Type:               string(fleetapi.StampConditionApproved),
+				Status:             conditionStatus,
+				Reason:             body.Reason,
+				Message:            body.Message,
+				LastTransitionTime: metav1.NewTime(h.clock.Now()),
+			})
+		}
 	}
 
 	// Check if this is a no-op (idempotent)
-	existingCondition := apimeta.FindStatusCondition(existing.Status.Conditions, string(fleetapi.StampConditionApproved))
-	if existingCondition != nil &&
-		existingCondition.Status == conditionStatus &&
-		existingCondition.Reason == body.Reason &&
-		existingCondition.Message == body.Message {
+	if equality.Semantic.DeepEqual(existing.Status, updated.Status) {
 		w.WriteHeader(http.StatusNoContent)
 		return nil
 	}
 
-	updated := existing.DeepCopy()
-	apimeta.SetStatusCondition(&updated.Status.Conditions, metav1.Condition{
-		Type:               string(fleetapi.StampConditionApproved),
-		Status:             conditionStatus,
-		Reason:             body.Reason,
-		Message:            body.Message,
-		LastTransitionTime: metav1.NewTime(time.Now()),
-	})
-
 	if _, err := stampsCRUD.Replace(ctx, updated, existing, nil); err != nil {
 		if cosmosstorageutils.IsPreconditionFailedError(err) {
 			return coreapi.NewCloudError(http.StatusConflict, coreapi.CloudErrorCodeConflict, "", "ETag conflict, retry the operation")
@@ -111,6 +148,21 @@ func (h *StampApprovalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	return nil
 }
 
+// recordApproval adds an approval to the stamp, replacing an earlier approval
+// by the same approver unless it carries the same justification.
+func recordApproval(stamp *fleetapi.Stamp, approval fleetapi.StampApproval) {
+	for i, existing := range stamp.Status.Approvals {
+		if !strings.EqualFold(existing.Approver, approval.Approver) {
+			continue
+		}
+		if existing.Reason != approval.Reason || existing.Message != approval.Message {
+			stamp.Status.Approvals[i] = approval
+		}
+		return
+	}
+	stamp.Status.Approvals = append(stamp.Status.Approvals, approval)
+}
+
 func validateApprovalRequest(body stampApprovalRequest) error {
 	var details []coreapi.CloudErrorBody
 
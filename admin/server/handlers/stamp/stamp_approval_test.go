@@ -21,13 +21,16 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
 	"github.com/stretchr/testify/require"
 
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
 
+	"github.com/Azure/ARO-HCP/admin/server/middleware"
 	"github.com/Azure/ARO-HCP/internal/api/coreapi"
 	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
 	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/fleetcosmosstoragetesting"
@@ -59,12 +62,26 @@ func newStampWithConditions(stampIdentifier string, conditions ...metav1.Conditi
 	}
 }
 
+func newStampWithApprovalPolicy(stampIdentifier string, approvers ...string) *fleetapi.Stamp {
+	stamp := newStamp(stampIdentifier)
+	stamp.Spec.ApprovalPolicy = &fleetapi.StampApprovalPolicy{
+		RequiredApprovals: 2,
+		RequestedBy:       "requester@example.com",
+	}
+	for _, approver := range approvers {
+		stamp.Status.Approvals = append(stamp.Status.Approvals, fleetapi.StampApproval{Approver: approver, Reason: "Reviewed", Message: "ok"})
+	}
+	return stamp
+}
+
 func TestStampApprovalHandler(t *testing.T) {
 	t.Parallel()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
 	tests := []struct {
 		name               string
 		stampIdentifier    string
 		body               string
+		clientPrincipal    string
 		setupResources     []any
 		expectedStatusCode int
 		expectedError      string
@@ -85,6 +102,7 @@ func TestStampApprovalHandler(t *testing.T) {
 				require.Equal(t, metav1.ConditionTrue, cond.Status)
 				require.Equal(t, "ManuallyApproved", cond.Reason)
 				require.Equal(t, "Approved by SRE", cond.Message)
+				require.True(t, now.Equal(cond.LastTransitionTime.Time))
 			},
 		},
 		{
@@ -135,6 +153,90 @@ func TestStampApprovalHandler(t *testing.T) {
 			},
 			expectedStatusCode: http.StatusNoContent,
 		},
+		{
+			name:               "approval without policy records the approver",
+			stampIdentifier:    "a1",
+			body:               `{"approved":true,"reason":"ManuallyApproved","message":"Approved by SRE"}`,
+			clientPrincipal:    "alice@example.com",
+			setupResources:     []any{newStamp("a1")},
+			expectedStatusCode: http.StatusNoContent,
+			verifyState: func(t *testing.T, mock *fleetcosmosstoragetesting.MockFleetDBClient) {
+				ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+				stamp, err := mock.Stamps().Get(ctx, "a1")
+				require.NoError(t, err)
+				require.True(t, apimeta.IsStatusConditionTrue(stamp.Status.Conditions, string(fleetapi.StampConditionApproved)))
+				require.Len(t, stamp.Status.Approvals, 1)
+				require.Equal(t, "alice@example.com", stamp.Status.Approvals[0].Approver)
+			},
+		},
+		{
+			name:               "approval under policy records the approver without approving",
+			stampIdentifier:    "a1",
+			body:               `{"approved":true,"reason":"Reviewed","message":"Capacity plan checked"}`,
+			clientPrincipal:    "bob@example.com",
+			setupResources:     []any{newStampWithApprovalPolicy("a1", "alice@example.com")},
+			expectedStatusCode: http.StatusNoContent,
+			verifyState: func(t *testing.T, mock *fleetcosmosstoragetesting.MockFleetDBClient) {
+				ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+				stamp, err := mock.Stamps().Get(ctx, "a1")
+				require.NoError(t, err)
+				require.Nil(t, apimeta.FindStatusCondition(stamp.Status.Conditions, string(fleetapi.StampConditionApproved)))
+				require.Len(t, stamp.Status.Approvals, 2)
+				require.Equal(t, "bob@example.com", stamp.Status.Approvals[1].Approver)
+				require.Equal(t, "Capacity plan checked", stamp.Status.Approvals[1].Message)
+				require.True(t, now.Equal(stamp.Status.Approvals[1].ApprovedTime.Time))
+			},
+		},
+		{
+			name:               "repeated approval replaces the earlier one",
+			stampIdentifier:    "a1",
+			body:               `{"approved":true,"reason":"Reviewed","message":"Rechecked"}`,
+			clientPrincipal:    "Alice@example.com",
+			setupResources:     []any{newStampWithApprovalPolicy("a1", "alice@example.com")},
+			expectedStatusCode: http.StatusNoContent,
+			verifyState: func(t *testing.T, mock *fleetcosmosstoragetesting.MockFleetDBClient) {
+				ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+				stamp, err := mock.Stamps().Get(ctx, "a1")
+				require.NoError(t, err)
+				require.Len(t, stamp.Status.Approvals, 1)
+				require.Equal(t, "Rechecked", stamp.Status.Approvals[0].Message)
+			},
+		},
+		{
+			name:               "self-approval returns 403",
+			stampIdentifier:    "a1",
+			body:               `{"approved":true,"reason":"Reviewed","message":"ok"}`,
+			clientPrincipal:    "requester@example.com",
+			setupResources:     []any{newStampWithApprovalPolicy("a1")},
+			expectedStatusCode: http.StatusForbidden,
+			expectedError:      "cannot approve it",
+		},
+		{
+			name:               "approval under policy without client principal returns 401",
+			stampIdentifier:    "a1",
+			body:               `{"approved":true,"reason":"Reviewed","message":"ok"}`,
+			setupResources:     []any{newStampWithApprovalPolicy("a1")},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedError:      "missing client principal",
+		},
+		{
+			name:               "revocation under policy clears approvals",
+			stampIdentifier:    "a1",
+			body:               `{"approved":false,"reason":"ApprovalRevoked","message":"Rollout halted"}`,
+			clientPrincipal:    "requester@example.com",
+			setupResources:     []any{newStampWithApprovalPolicy("a1", "alice@example.com", "bob@example.com")},
+			expectedStatusCode: http.StatusNoContent,
+			verifyState: func(t *testing.T, mock *fleetcosmosstoragetesting.MockFleetDBClient) {
+				ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+				stamp, err := mock.Stamps().Get(ctx, "a1")
+				require.NoError(t, err)
+				require.Empty(t, stamp.Status.Approvals)
+				cond := apimeta.FindStatusCondition(stamp.Status.Conditions, string(fleetapi.StampConditionApproved))
+				require.NotNil(t, cond)
+				require.Equal(t, metav1.ConditionFalse, cond.Status)
+				require.Equal(t, "ApprovalRevoked", cond.Reason)
+			},
+		},
 		{
 			name:               "stamp not found returns 404",
 			stampIdentifier:    "a1",
@@ -180,6 +282,9 @@ func TestStampApprovalHandler(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+			if len(tt.clientPrincipal) > 0 {
+				ctx = middleware.ContextWithClientPrincipal(ctx, middleware.ClientPrincipalReference{Name: tt.clientPrincipal})
+			}
 
 			var mockFleetDB *fleetcosmosstoragetesting.MockFleetDBClient
 			var err error
@@ -191,6 +296,7 @@ func TestStampApprovalHandler(t *testing.T) {
 			}
 
 			handler := NewStampApprovalHandler(mockFleetDB)
+			handler.clock = clocktesting.NewFakePassiveClock(now)
 
 			req := httptest.NewRequest(http.MethodPost, "/admin/v1/stamps/"+tt.stampIdentifier+"/approval", strings.NewReader(tt.body))
 			req.SetPathValue("stampIdentifier", tt.stampIdentifier)
@@ -25,7 +25,10 @@ import (
 	"github.com/go-logr/logr/testr"
 	"github.com/stretchr/testify/require"
 
+	"k8s.io/utils/ptr"
+
 	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
 	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/fleetcosmosstoragetesting"
 	"github.com/Azure/ARO-HCP/internal/utils"
 )
@@ -38,6 +41,7 @@ func TestStampGetHandler(t *testing.T) {
 		setupResources     []any
 		expectedStatusCode int
 		expectedError      string
+		expectedScore      *int32
 	}{
 		{
 			name:               "get existing stamp",
@@ -45,6 +49,17 @@ func TestStampGetHandler(t *testing.T) {
 			setupResources:     []any{newStamp("a1")},
 			expectedStatusCode: http.StatusOK,
 		},
+		{
+			name:            "get stamp includes management cluster capacity",
+			stampIdentifier: "a1",
+			setupResources: []any{newStamp("a1"), func() *fleetapi.ManagementCluster {
+				mc := newManagementCluster(t, "a1")
+				mc.Status.Capacity = &fleetapi.ManagementClusterCapacity{Score: 42, HostedControlPlanes: 12}
+				return mc
+			}()},
+			expectedStatusCode: http.StatusOK,
+			expectedScore:      ptr.To[int32](42),
+		},
 		{
 			name:               "stamp not found returns 404",
 			stampIdentifier:    "a1",
@@ -95,6 +110,12 @@ func TestStampGetHandler(t *testing.T) {
 				var resp Stamp
 				require.NoError(t, json.NewDecoder(recorder.Body).Decode(&resp))
 				require.NotEmpty(t, resp.ResourceID)
+				if tt.expectedScore == nil {
+					require.Nil(t, resp.ManagementClusterCapacity)
+				} else {
+					require.NotNil(t, resp.ManagementClusterCapacity)
+					require.Equal(t, *tt.expectedScore, resp.ManagementClusterCapacity.Score)
+				}
 			}
 		})
 	}
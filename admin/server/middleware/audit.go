@@ -46,11 +46,19 @@ func (m *middlewareAudit) HandleRequest(w http.ResponseWriter, r *http.Request,
 
 	auditWriter := audit.NewResponseWriter(w)
 
+	// The authorization middleware runs after the mux and records why it
+	// denied a request here.
+	authorizationDenial := new(string)
+	r = r.WithContext(ContextWithAuthorizationDenial(ctx, authorizationDenial))
+
 	next(auditWriter, r)
 
 	if auditWriter.StatusCode() >= http.StatusBadRequest {
 		msg.Record.OperationResult = msgs.Failure
 		msg.Record.OperationResultDescription = fmt.Sprintf("Status code: %d", auditWriter.StatusCode())
+		if len(*authorizationDenial) > 0 {
+			msg.Record.OperationResultDescription += fmt.Sprintf(", authorization denied: %s", *authorizationDenial)
+		}
 	}
 
 	if err := m.auditClient.Send(ctx, msg); err != nil {
@@ -44,6 +44,7 @@ func TestWithAudit(t *testing.T) {
 		name                   string
 		headers                http.Header
 		statusCode             int
+		authorizationDenial    string
 		expectedResult         msgs.OperationResult
 		expectedCallerIdentity string
 	}{
@@ -65,6 +66,16 @@ func TestWithAudit(t *testing.T) {
 			expectedResult:         msgs.Failure,
 			expectedCallerIdentity: "test-user@example.com",
 		},
+		{
+			name: "authorization denial is recorded",
+			headers: http.Header{
+				ClientPrincipalNameHeader: []string{"test-user@example.com"},
+			},
+			statusCode:             http.StatusForbidden,
+			authorizationDenial:    "not allowed",
+			expectedResult:         msgs.Failure,
+			expectedCallerIdentity: "test-user@example.com",
+		},
 		{
 			name:           "redirect is success",
 			statusCode:     http.StatusTemporaryRedirect,
@@ -96,6 +107,9 @@ func TestWithAudit(t *testing.T) {
 			request = request.WithContext(ctx)
 
 			next := func(w http.ResponseWriter, r *http.Request) {
+				if len(tc.authorizationDenial) > 0 {
+					*AuthorizationDenialFromContext(r.Context()) = tc.authorizationDenial
+				}
 				w.WriteHeader(tc.statusCode)
 			}
 
@@ -112,7 +126,11 @@ func TestWithAudit(t *testing.T) {
 			assert.Equal(t, operationAccessLevel, record.OperationAccessLevel)
 
 			if tc.expectedResult == msgs.Failure {
-				assert.Equal(t, fmt.Sprintf("Status code: %d", tc.statusCode), record.OperationResultDescription)
+				expectedDescription := fmt.Sprintf("Status code: %d", tc.statusCode)
+				if len(tc.authorizationDenial) > 0 {
+					expectedDescription += ", authorization denied: " + tc.authorizationDenial
+				}
+				assert.Equal(t, expectedDescription, record.OperationResultDescription)
 			}
 
 			if tc.expectedCallerIdentity != "" {
@@ -0,0 +1,287 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/utils/set"
+	"sigs.k8s.io/yaml"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+const (
+	// HCPResourceRoutePrefix abbreviates the HCP resource ID segments of a
+	// route in an authorization policy, e.g. "/admin/v1/hcp{resourceId}/cosmosdump".
+	HCPResourceRoutePrefix = "/admin/v1/hcp{resourceId}"
+
+	// AuthorizationWildcard matches any method or route in an authorization rule.
+	AuthorizationWildcard = "*"
+
+	cloudErrorCodeAuthorizationFailed = "AuthorizationFailed"
+)
+
+// AuthorizationPolicy declares which principals may call which admin routes.
+// Roles are lists of rules granting HTTP methods on routes. Bindings grant a
+// role to principals, either directly by client principal name or through a
+// named group of principals.
+//
+//	groups:
+//	  sre-oncall: [alice@example.com, bob@example.com]
+//	roles:
+//	  reader:
+//	  - methods: [GET]
+//	    routes: ["/admin/v1/hcp{resourceId}/cosmosdump", "/admin/v1/stamps", "/admin/v1/stamps/*"]
+//	bindings:
+//	- role: reader
+//	  groups: [sre-oncall]
+//
+// Routes are the registered mux patterns without the method. The HCP resource
+// ID segments are abbreviated as {resourceId} and wildcards follow path.Match.
+// A principal or group member of "*" matches every named principal.
+type AuthorizationPolicy struct {
+	Groups   map[string][]string            `json:"groups,omitempty"`
+	Roles    map[string][]AuthorizationRule `json:"roles"`
+	Bindings []AuthorizationBinding         `json:"bindings"`
+}
+
+type AuthorizationRule struct {
+	Methods []string `json:"methods"`
+	Routes  []string `json:"routes"`
+}
+
+type AuthorizationBinding struct {
+	Role       string   `json:"role"`
+	Principals []string `json:"principals,omitempty"`
+	Groups     []string `json:"groups,omitempty"`
+}
+
+// LoadAuthorizationPolicy reads and validates the policy file at policyPath.
+func LoadAuthorizationPolicy(policyPath string) (*AuthorizationPolicy, error) {
+	policyBytes, err := os.ReadFile(filepath.Clean(policyPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read authorization policy: %w", err)
+	}
+	policy := &AuthorizationPolicy{}
+	if err := yaml.UnmarshalStrict(policyBytes, policy); err != nil {
+		return nil, fmt.Errorf("failed to parse authorization policy %s: %w", policyPath, err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid authorization policy %s: %w", policyPath, err)
+	}
+	return policy, nil
+}
+
+// Validate ensures every binding references a declared role and group and
+// every rule is well-formed.
+func (p *AuthorizationPolicy) Validate() error {
+	var errs []error
+	for roleName, rules := range p.Roles {
+		for i, rule := range rules {
+			if len(rule.Methods) == 0 {
+				errs = append(errs, fmt.Errorf("roles[%s][%d]: methods cannot be empty", roleName, i))
+			}
+			if len(rule.Routes) == 0 {
+				errs = append(errs, fmt.Errorf("roles[%s][%d]: routes cannot be empty", roleName, i))
+			}
+			for _, route := range rule.Routes {
+				if _, err := path.Match(strings.ToLower(route), ""); err != nil {
+					errs = append(errs, fmt.Errorf("roles[%s][%d]: invalid route %q: %w", roleName, i, route, err))
+				}
+			}
+		}
+	}
+	for i, binding := range p.Bindings {
+		if _, ok := p.Roles[binding.Role]; !ok {
+			errs = append(errs, fmt.Errorf("bindings[%d]: unknown role %q", i, binding.Role))
+		}
+		if len(binding.Principals) == 0 && len(binding.Groups) == 0 {
+			errs = append(errs, fmt.Errorf("bindings[%d]: principals or groups are required", i))
+		}
+		for _, group := range binding.Groups {
+			if _, ok := p.Groups[group]; !ok {
+				errs = append(errs, fmt.Errorf("bindings[%d]: unknown group %q", i, group))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// rolesFor returns the roles bound to the principal, directly or through one
+// of its groups. Principal names are compared case-insensitively.
+func (p *AuthorizationPolicy) rolesFor(principalName string) set.Set[string] {
+	principalName = strings.ToLower(principalName)
+
+	groups := set.New[string]()
+	for groupName, members := range p.Groups {
+		for _, member := range members {
+			if matchesPrincipal(member, principalName) {
+				groups.Insert(groupName)
+			}
+		}
+	}
+
+	roles := set.New[string]()
+	for _, binding := range p.Bindings {
+		for _, principal := range binding.Principals {
+			if matchesPrincipal(principal, principalName) {
+				roles.Insert(binding.Role)
+			}
+		}
+		if groups.HasAny(binding.Groups...) {
+			roles.Insert(binding.Role)
+		}
+	}
+	return roles
+}
+
+// Authorize reports whether the principal may call route with method, and
+// the role granting access.
+func (p *AuthorizationPolicy) Authorize(principalName, method, route string) (string, bool) {
+	route = strings.ToLower(route)
+	for _, roleName := range p.rolesFor(principalName).SortedList() {
+		for _, rule := range p.Roles[roleName] {
+			if matchesMethod(rule.Methods, method) && matchesRoute(rule.Routes, route) {
+				return roleName, true
+			}
+		}
+	}
+	return "", false
+}
+
+// matchesPrincipal reports whether candidate names the lowercased principal.
+// The wildcard never matches a request without a principal.
+func matchesPrincipal(candidate, principalName string) bool {
+	if candidate == AuthorizationWildcard {
+		return len(principalName) > 0
+	}
+	return strings.ToLower(candidate) == principalName
+}
+
+func matchesMethod(methods []string, method string) bool {
+	for _, candidate := range methods {
+		if candidate == AuthorizationWildcard || strings.EqualFold(candidate, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesRoute(routes []string, route string) bool {
+	for _, candidate := range routes {
+		if candidate == AuthorizationWildcard {
+			return true
+		}
+		if matched, _ := path.Match(strings.ToLower(candidate), route); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// routeFromPattern turns a registered mux pattern into the route form used by
+// authorization policies.
+func routeFromPattern(pattern string) string {
+	if _, route, found := strings.Cut(pattern, " "); found {
+		pattern = route
+	}
+	return strings.Replace(strings.ToLower(pattern), "/admin/v1/hcp"+patternPrefix, strings.ToLower(HCPResourceRoutePrefix), 1)
+}
+
+// Authorizer enforces an AuthorizationPolicy loaded from a file. The policy
+// is reloaded when the file changes; an invalid update is logged and the
+// previous policy stays in effect.
+type Authorizer struct {
+	policyPath string
+	policy     atomic.Pointer[AuthorizationPolicy]
+}
+
+// NewAuthorizer loads the policy at policyPath. A policy is required: the
+// admin API never serves requests without one.
+func NewAuthorizer(policyPath string) (*Authorizer, error) {
+	if len(policyPath) == 0 {
+		return nil, errors.New("an authorization policy file is required")
+	}
+	authorizer := &Authorizer{policyPath: policyPath}
+	policy, err := LoadAuthorizationPolicy(policyPath)
+	if err != nil {
+		return nil, err
+	}
+	authorizer.policy.Store(policy)
+	return authorizer, nil
+}
+
+// Run watches the policy file for changes until ctx is cancelled.
+func (a *Authorizer) Run(ctx context.Context, checkInterval time.Duration) error {
+	watcher, err := utils.NewFSWatcher(a.policyPath, checkInterval, a.reload)
+	if err != nil {
+		return fmt.Errorf("failed to create authorization policy watcher: %w", err)
+	}
+	return watcher.Start(ctx)
+}
+
+func (a *Authorizer) reload(ctx context.Context) error {
+	policy, err := LoadAuthorizationPolicy(a.policyPath)
+	if err != nil {
+		return fmt.Errorf("keeping previous authorization policy: %w", err)
+	}
+	a.policy.Store(policy)
+	utils.LoggerFromContext(ctx).Info("reloaded authorization policy", "path", a.policyPath)
+	return nil
+}
+
+// HandleRequest is a post-mux middleware: it relies on the matched pattern of
+// the request and on MiddlewareClientPrincipal having run before.
+func (a *Authorizer) HandleRequest(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	policy := a.policy.Load()
+
+	ctx := r.Context()
+	logger := utils.LoggerFromContext(ctx)
+	route := routeFromPattern(r.Pattern)
+
+	principal, err := ClientPrincipalFromContext(ctx)
+	if err != nil {
+		a.deny(w, r, fmt.Sprintf("no client principal for %s %s", r.Method, route))
+		return
+	}
+
+	role, allowed := policy.Authorize(principal.Name, r.Method, route)
+	if !allowed {
+		a.deny(w, r, fmt.Sprintf("principal %q is not allowed to %s %s", principal.Name, r.Method, route))
+		return
+	}
+
+	logger.V(1).Info("request authorized", "role", role, "route", route)
+	next(w, r)
+}
+
+func (a *Authorizer) deny(w http.ResponseWriter, r *http.Request, reason string) {
+	utils.LoggerFromContext(r.Context()).Info("request denied by authorization policy", "reason", reason)
+	if denial := AuthorizationDenialFromContext(r.Context()); denial != nil {
+		*denial = reason
+	}
+	coreapi.WriteError(w, http.StatusForbidden, cloudErrorCodeAuthorizationFailed, "", "The client principal is not authorized to perform this operation")
+}
@@ -0,0 +1,280 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+const testAuthorizationPolicy = `
+groups:
+  sre:
+  - Alice@example.com
+roles:
+  reader:
+  - methods: [GET]
+    routes:
+    - /admin/v1/hcp{resourceId}/cosmosdump
+    - /admin/v1/stamps
+    - /admin/v1/stamps/*
+  sizer:
+  - methods: [POST]
+    routes: ["/admin/v1/hcp{resourceId}/desiredcontrolplanesize"]
+  admin:
+  - methods: ["*"]
+    routes: ["*"]
+bindings:
+- role: reader
+  groups: [sre]
+- role: sizer
+  principals: [bob@example.com]
+- role: admin
+  principals: [root@example.com]
+`
+
+func writeAuthorizationPolicy(t *testing.T, dir, content string) string {
+	t.Helper()
+	policyPath := filepath.Join(dir, "policy.yaml")
+	require.NoError(t, os.WriteFile(policyPath, []byte(content), 0600))
+	return policyPath
+}
+
+func TestAuthorizer(t *testing.T) {
+	authorizer, err := NewAuthorizer(writeAuthorizationPolicy(t, t.TempDir(), testAuthorizationPolicy))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		principal      string
+		pattern        string
+		expectedStatus int
+	}{
+		{
+			name:           "group member can read dumps",
+			principal:      "alice@example.com",
+			pattern:        V1HCPResourcePattern("GET", "/cosmosdump"),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "wildcard route matches stamp identifier",
+			principal:      "alice@example.com",
+			pattern:        "GET /admin/v1/stamps/{stampIdentifier}",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "reader cannot size control planes",
+			principal:      "alice@example.com",
+			pattern:        V1HCPResourcePattern("POST", "/desiredcontrolplanesize"),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "sizer can size control planes",
+			principal:      "bob@example.com",
+			pattern:        V1HCPResourcePattern("POST", "/desiredcontrolplanesize"),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "sizer cannot read dumps",
+			principal:      "bob@example.com",
+			pattern:        V1HCPResourcePattern("GET", "/cosmosdump"),
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "wildcard role allows everything",
+			principal:      "root@example.com",
+			pattern:        V1HCPResourcePattern("POST", "/breakglass"),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown principal is denied",
+			principal:      "mallory@example.com",
+			pattern:        "GET /admin/helloworld",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "missing principal is denied",
+			pattern:        "GET /admin/helloworld",
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			method, _, _ := strings.Cut(tc.pattern, " ")
+			ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+			denial := new(string)
+			ctx = ContextWithAuthorizationDenial(ctx, denial)
+			if len(tc.principal) > 0 {
+				ctx = ContextWithClientPrincipal(ctx, ClientPrincipalReference{Name: tc.principal})
+			}
+			request := httptest.NewRequest(method, "/", nil).WithContext(ctx)
+			request.Pattern = tc.pattern
+			writer := httptest.NewRecorder()
+
+			authorizer.HandleRequest(writer, request, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			assert.Equal(t, tc.expectedStatus, writer.Code)
+			if tc.expectedStatus == http.StatusForbidden {
+				assert.NotEmpty(t, *denial)
+				assert.Contains(t, writer.Body.String(), cloudErrorCodeAuthorizationFailed)
+			} else {
+				assert.Empty(t, *denial)
+			}
+		})
+	}
+}
+
+func TestAuthorizerRequiresPolicy(t *testing.T) {
+	_, err := NewAuthorizer("")
+	require.Error(t, err)
+}
+
+func TestAuthorizerReload(t *testing.T) {
+	ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+	policyPath := writeAuthorizationPolicy(t, t.TempDir(), testAuthorizationPolicy)
+	authorizer, err := NewAuthorizer(policyPath)
+	require.NoError(t, err)
+
+	// An invalid update keeps the previous policy.
+	require.NoError(t, os.WriteFile(policyPath, []byte("bindings:\n- role: missing\n  principals: [bob@example.com]\n"), 0600))
+	require.Error(t, authorizer.reload(ctx))
+	_, allowed := authorizer.policy.Load().Authorize("bob@example.com", http.MethodPost, HCPResourceRoutePrefix+"/desiredcontrolplanesize")
+	assert.True(t, allowed)
+
+	// A valid update replaces it.
+	require.NoError(t, os.WriteFile(policyPath, []byte("roles:\n  reader:\n  - methods: [GET]\n    routes: [/admin/helloworld]\nbindings:\n- role: reader\n  principals: [bob@example.com]\n"), 0600))
+	require.NoError(t, authorizer.reload(ctx))
+	_, allowed = authorizer.policy.Load().Authorize("bob@example.com", http.MethodPost, HCPResourceRoutePrefix+"/desiredcontrolplanesize")
+	assert.False(t, allowed)
+	_, allowed = authorizer.policy.Load().Authorize("bob@example.com", http.MethodGet, "/admin/helloworld")
+	assert.True(t, allowed)
+}
+
+func TestAuthorizationPolicyWildcardPrincipal(t *testing.T) {
+	policy, err := LoadAuthorizationPolicy(writeAuthorizationPolicy(t, t.TempDir(), `
+groups:
+  everyone: ["*"]
+roles:
+  reader:
+  - methods: [GET]
+    routes: [/admin/helloworld]
+bindings:
+- role: reader
+  groups: [everyone]
+`))
+	require.NoError(t, err)
+
+	_, allowed := policy.Authorize("mallory@example.com", http.MethodGet, "/admin/helloworld")
+	assert.True(t, allowed)
+	_, allowed = policy.Authorize("", http.MethodGet, "/admin/helloworld")
+	assert.False(t, allowed)
+}
+
+// TestChartAuthorizationPolicy checks the policy the Helm chart renders from
+// per-environment principals against every registered admin route.
+func TestChartAuthorizationPolicy(t *testing.T) {
+	fixture, err := os.ReadFile(filepath.Join("..", "..", "testdata", "zz_fixture_TestHelmTemplate_admin_api_authorization_policy.yaml"))
+	require.NoError(t, err)
+
+	var policyContent string
+	for _, document := range strings.Split(string(fixture), "\n---\n") {
+		var configMap struct {
+			Kind     string `json:"kind"`
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+			Data map[string]string `json:"data"`
+		}
+		require.NoError(t, yaml.Unmarshal([]byte(document), &configMap))
+		if configMap.Kind == "ConfigMap" && configMap.Metadata.Name == "admin-api-authorization-policy" {
+			policyContent = configMap.Data["policy.yaml"]
+		}
+	}
+	require.NotEmpty(t, policyContent, "authorization policy ConfigMap not rendered")
+
+	policy, err := LoadAuthorizationPolicy(writeAuthorizationPolicy(t, t.TempDir(), policyContent))
+	require.NoError(t, err)
+
+	// Principals come from admin/testdata/helmtest_authorization_policy.yaml.
+	testCases := []struct {
+		pattern      string
+		principal    string
+		expectedRole string
+	}{
+		{pattern: "GET /admin/helloworld", principal: "alice@example.com", expectedRole: "reader"},
+		{pattern: V1HCPResourcePattern("GET", "/history"), principal: "bob@example.com", expectedRole: "reader"},
+		{pattern: V1HCPResourcePattern("GET", "/nodepools/{nodePoolName}/history"), principal: "alice@example.com", expectedRole: "reader"},
+		{pattern: V1HCPResourcePattern("GET", "/serialconsole"), principal: "alice@example.com", expectedRole: "reader"},
+		{pattern: "GET /admin/v1/stamps/{stampIdentifier}/managementclusters/{managementClusterName}", principal: "bob@example.com", expectedRole: "reader"},
+		{pattern: V1HCPResourcePattern("GET", "/cosmosdump"), principal: "alice@example.com", expectedRole: "dumps"},
+		{pattern: V1HCPResourcePattern("GET", "/billingdump"), principal: "alice@example.com", expectedRole: "dumps"},
+		{pattern: V1HCPResourcePattern("GET", "/cosmosdump"), principal: "bob@example.com"},
+		{pattern: V1HCPResourcePattern("POST", "/breakglass"), principal: "bob@example.com", expectedRole: "breakglass"},
+		{pattern: V1HCPResourcePattern("POST", "/breakglass/{sessionName}/extend"), principal: "bob@example.com", expectedRole: "breakglass"},
+		{pattern: V1HCPResourcePattern("POST", "/breakglass/{sessionName}/revoke"), principal: "bob@example.com", expectedRole: "breakglass"},
+		{pattern: V1HCPResourcePattern("GET", "/breakglass/{sessionName}/kubeconfig"), principal: "bob@example.com", expectedRole: "breakglass"},
+		{pattern: V1HCPResourcePattern("POST", "/breakglass"), principal: "alice@example.com"},
+		{pattern: V1HCPResourcePattern("POST", "/breakglass/{sessionName}/approve"), principal: "bob@example.com"},
+		{pattern: V1HCPResourcePattern("POST", "/breakglass/{sessionName}/approve"), principal: "carol@example.com", expectedRole: "breakglass-approver"},
+		{pattern: "POST /admin/v1/stamps/{stampIdentifier}/approval", principal: "dave@example.com", expectedRole: "stamp-approver"},
+		{pattern: "POST /admin/v1/stamps/{stampIdentifier}/approval", principal: "bob@example.com"},
+		{pattern: V1HCPResourcePattern("POST", "/desiredcontrolplanesize"), principal: "dave@example.com", expectedRole: "control-plane-sizing"},
+		{pattern: V1HCPResourcePattern("POST", "/desiredcontrolplanesize"), principal: "carol@example.com"},
+		{pattern: "POST /admin/v1/stamps/{stampIdentifier}/managementclusters/{managementClusterName}/drain", principal: "alice@example.com"},
+		{pattern: "POST /admin/v1/stamps/{stampIdentifier}/managementclusters/{managementClusterName}/undrain", principal: "dave@example.com"},
+		{pattern: "GET /admin/v1/stamps", principal: "mallory@example.com"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.principal+" "+tc.pattern, func(t *testing.T) {
+			method, _, _ := strings.Cut(tc.pattern, " ")
+			role, allowed := policy.Authorize(tc.principal, method, routeFromPattern(tc.pattern))
+			assert.Equal(t, len(tc.expectedRole) > 0, allowed)
+			assert.Equal(t, tc.expectedRole, role)
+		})
+	}
+}
+
+func TestAuthorizationPolicyValidate(t *testing.T) {
+	_, err := LoadAuthorizationPolicy(writeAuthorizationPolicy(t, t.TempDir(), `
+roles:
+  reader:
+  - methods: []
+    routes: ["/admin/v1/stamps/["]
+bindings:
+- role: writer
+  groups: [unknown]
+`))
+	require.Error(t, err)
+	for _, expected := range []string{"methods cannot be empty", "invalid route", `unknown role "writer"`, `unknown group "unknown"`} {
+		assert.Contains(t, err.Error(), expected)
+	}
+}
@@ -44,6 +44,7 @@ const (
 	contextKeyHCPResourceID        = contextKey("hcp_resource_id")
 	contextKeyClientPrincipalRef   = contextKey("client_principal_reference")
 	contextKeyPattern              = contextKey("pattern")
+	contextKeyAuthorizationDenial  = contextKey("authorization_denial")
 )
 
 func ContextWithOriginalUrlPathValue(ctx context.Context, originalUrlPathValue string) context.Context {
@@ -111,3 +112,15 @@ func PatternFromContext(ctx context.Context) *string {
 	pattern, _ := ctx.Value(contextKeyPattern).(*string)
 	return pattern
 }
+
+// ContextWithAuthorizationDenial stores a holder for the reason an
+// authorization policy denied the request, so that pre-mux middleware can
+// read the reason after the request has been handled.
+func ContextWithAuthorizationDenial(ctx context.Context, denial *string) context.Context {
+	return context.WithValue(ctx, contextKeyAuthorizationDenial, denial)
+}
+
+func AuthorizationDenialFromContext(ctx context.Context) *string {
+	denial, _ := ctx.Value(contextKeyAuthorizationDenial).(*string)
+	return denial
+}
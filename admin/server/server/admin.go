@@ -82,6 +82,7 @@ func NewAdminAPI(
 	minSessionTTL time.Duration,
 	maxSessionTTL time.Duration,
 	allowedBreakglassGroups set.Set[string],
+	authorizer *middleware.Authorizer,
 	gatherer prometheus.Gatherer,
 ) *AdminAPI {
 	// Pre-mux middleware (runs on all admin routes before pattern matching)
@@ -92,8 +93,15 @@ func NewAdminAPI(
 		middleware.MiddlewareClientPrincipal,
 	)
 
+	// Post-mux middleware for routes that are not HCP scoped. Authorization
+	// needs the matched pattern and so runs after the mux.
+	authzMiddleware := middleware.NewMiddleware(
+		authorizer.HandleRequest,
+	)
+
 	// HCP resource routes
 	hcpMiddleware := middleware.NewMiddleware(
+		authorizer.HandleRequest,
 		middleware.MiddlewareHCPResourceID,
 	)
 	middlewareMux.Handle(
@@ -108,6 +116,18 @@ func NewAdminAPI(
 		middleware.V1HCPResourcePattern("POST", "/breakglass"),
 		hcpMiddleware.HandlerFunc(errorutils.ReportError(breakglasshandlers.NewHCPBreakglassSessionCreationHandler(resourcesDBClient, clustersServiceClient, sessionClient, allowedBreakglassGroups, minSessionTTL, maxSessionTTL).ServeHTTP)),
 	)
+	middlewareMux.Handle(
+		middleware.V1HCPResourcePattern("POST", "/breakglass/{sessionName}/approve"),
+		hcpMiddleware.HandlerFunc(errorutils.ReportError(breakglasshandlers.NewHCPBreakglassSessionApprovalHandler(sessionClient).ServeHTTP)),
+	)
+	middlewareMux.Handle(
+		middleware.V1HCPResourcePattern("POST", "/breakglass/{sessionName}/extend"),
+		hcpMiddleware.HandlerFunc(errorutils.ReportError(breakglasshandlers.NewHCPBreakglassSessionExtensionHandler(sessionClient, maxSessionTTL).ServeHTTP)),
+	)
+	middlewareMux.Handle(
+		middleware.V1HCPResourcePattern("POST", "/breakglass/{sessionName}/revoke"),
+		hcpMiddleware.HandlerFunc(errorutils.ReportError(breakglasshandlers.NewHCPBreakglassSessionRevocationHandler(sessionClient).ServeHTTP)),
+	)
 	middlewareMux.Handle(
 		middleware.V1HCPResourcePattern("GET", "/breakglass/{sessionName}/kubeconfig"),
 		hcpMiddleware.HandlerFunc(errorutils.ReportError(breakglasshandlers.NewHCPBreakglassSessionKubeconfigHandler(sessionLister, sessionClient).ServeHTTP)),
@@ -128,19 +148,27 @@ func NewAdminAPI(
 		middleware.V1HCPResourcePattern("POST", "/desiredcontrolplanesize"),
 		hcpMiddleware.HandlerFunc(errorutils.ReportError(hcp.NewHCPDesiredControlPlaneSizeHandler(resourcesDBClient).ServeHTTP)),
 	)
+	historyHandler := hcpMiddleware.HandlerFunc(errorutils.ReportError(hcp.NewHCPHistoryHandler(resourcesDBClient).ServeHTTP))
+	middlewareMux.Handle(middleware.V1HCPResourcePattern("GET", "/history"), historyHandler)
+	middlewareMux.Handle(middleware.V1HCPResourcePattern("GET", "/nodepools/{"+hcp.PathSegmentNodePoolName+"}/history"), historyHandler)
+	middlewareMux.Handle(middleware.V1HCPResourcePattern("GET", "/externalauths/{"+hcp.PathSegmentExternalAuthName+"}/history"), historyHandler)
 
 	// Non-HCP admin routes
-	middlewareMux.Handle("GET /admin/helloworld", handlers.HelloWorldHandler())
+	middlewareMux.Handle("GET /admin/helloworld", authzMiddleware.Handler(handlers.HelloWorldHandler()))
 
 	// Stamp management routes
 	middlewareMux.Handle("GET /admin/v1/stamps",
-		errorutils.ReportError(stamphandlers.NewStampListHandler(fleetDBClient).ServeHTTP))
+		authzMiddleware.HandlerFunc(errorutils.ReportError(stamphandlers.NewStampListHandler(fleetDBClient).ServeHTTP)))
 	middlewareMux.Handle("GET /admin/v1/stamps/{stampIdentifier}",
-		errorutils.ReportError(stamphandlers.NewStampGetHandler(fleetDBClient).ServeHTTP))
+		authzMiddleware.HandlerFunc(errorutils.ReportError(stamphandlers.NewStampGetHandler(fleetDBClient).ServeHTTP)))
 	middlewareMux.Handle("GET /admin/v1/stamps/{stampIdentifier}/managementclusters/{managementClusterName}",
-		errorutils.ReportError(stamphandlers.NewManagementClusterGetHandler(fleetDBClient).ServeHTTP))
+		authzMiddleware.HandlerFunc(errorutils.ReportError(stamphandlers.NewManagementClusterGetHandler(fleetDBClient).ServeHTTP)))
+	middlewareMux.Handle("POST /admin/v1/stamps/{stampIdentifier}/managementclusters/{managementClusterName}/drain",
+		authzMiddleware.HandlerFunc(errorutils.ReportError(stamphandlers.NewManagementClusterDrainHandler(fleetDBClient).ServeHTTP)))
+	middlewareMux.Handle("POST /admin/v1/stamps/{stampIdentifier}/managementclusters/{managementClusterName}/undrain",
+		authzMiddleware.HandlerFunc(errorutils.ReportError(stamphandlers.NewManagementClusterUndrainHandler(fleetDBClient).ServeHTTP)))
 	middlewareMux.Handle("POST /admin/v1/stamps/{stampIdentifier}/approval",
-		errorutils.ReportError(stamphandlers.NewStampApprovalHandler(fleetDBClient).ServeHTTP))
+		authzMiddleware.HandlerFunc(errorutils.ReportError(stamphandlers.NewStampApprovalHandler(fleetDBClient).ServeHTTP)))
 
 	// Top-level mux (healthz bypasses all middleware)
 	apiMux := http.NewServeMux()
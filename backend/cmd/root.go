@@ -64,6 +64,7 @@ type BackendRootCmdFlags struct {
 	InsecureIgnoreUserAzureManagedIdentitiesThatNeedManagedIdentitiesDataplaneAvailableAndUseMock bool
 	ExitOnPanic                                                                                   bool
 	AzureClusterScopedIdentitiesRoleSetName                                                       string
+	ControlPlaneRightSizingAutoApply                                                              bool
 }
 
 func (f *BackendRootCmdFlags) AddFlags(cmd *cobra.Command) {
@@ -162,6 +163,11 @@ func (f *BackendRootCmdFlags) AddFlags(cmd *cobra.Command) {
 		"If set, backend will exit the process if a panic occurs. As of now it only controls the setting of k8s.io/apimachinery/pkg/util/runtime.ReallyCrash",
 	)
 
+	cmd.Flags().BoolVar(&f.ControlPlaneRightSizingAutoApply, "control-plane-right-sizing-auto-apply", f.ControlPlaneRightSizingAutoApply,
+		"If set, the control plane right-sizing controller applies its control plane size recommendations to clusters whose size is not pinned. "+
+			"Otherwise recommendations are only recorded on the ServiceProviderCluster status.",
+	)
+
 	cmd.Flags().StringVar(
 		&f.InsecureAzureARMPermissionsManagerIdentityCertificateBundlePath,
 		"insecure-azure-arm-permissions-manager-identity-certificate-bundle-path",
@@ -444,6 +450,7 @@ func (f *BackendRootCmdFlags) ToBackendOptions(ctx context.Context, cmd *cobra.C
 		SMIClientBuilder:                   smiClientBuilder,
 		CheckAccessV2ClientBuilder:         checkAccessV2ClientBuilder,
 		ClusterScopedIdentitiesConfig:      clusterScopedIdentitiesConfig,
+		ControlPlaneRightSizingAutoApply:   f.ControlPlaneRightSizingAutoApply,
 		MetricsRegisterer:                  legacyregistry.Registerer(),
 		MetricsGatherer:                    legacyregistry.DefaultGatherer,
 	}
@@ -62,11 +62,14 @@ import (
 	nodepooldeletion "github.com/Azure/ARO-HCP/backend/pkg/controllers/nodepool/deletion"
 	nodepooloperations "github.com/Azure/ARO-HCP/backend/pkg/controllers/nodepool/operations"
 	nodepoolreaddesires "github.com/Azure/ARO-HCP/backend/pkg/controllers/nodepool/readdesires"
+	nodepoolreplace "github.com/Azure/ARO-HCP/backend/pkg/controllers/nodepool/replace"
 	nodepoolstatus "github.com/Azure/ARO-HCP/backend/pkg/controllers/nodepool/status"
 	nodepoolupdate "github.com/Azure/ARO-HCP/backend/pkg/controllers/nodepool/update"
 	nodepoolvalidation "github.com/Azure/ARO-HCP/backend/pkg/controllers/nodepool/validation"
 	nodepoolversion "github.com/Azure/ARO-HCP/backend/pkg/controllers/nodepool/version"
+	"github.com/Azure/ARO-HCP/backend/pkg/controllers/operationnotification"
 	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/operationutils"
 	"github.com/Azure/ARO-HCP/backend/pkg/utils/validationutils"
 	internalazure "github.com/Azure/ARO-HCP/internal/azure"
 	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/billingcosmosstorage"
@@ -111,6 +114,9 @@ type BackendOptions struct {
 	SMIClientBuilder                   azureclient.ServiceManagedIdentityClientBuilder
 	CheckAccessV2ClientBuilder         azureclient.CheckAccessV2ClientBuilder
 	ClusterScopedIdentitiesConfig      *internalazure.ClusterScopedIdentitiesConfig
+	// ControlPlaneRightSizingAutoApply lets the control plane right-sizing
+	// controller apply its recommendations instead of only recording them.
+	ControlPlaneRightSizingAutoApply bool
 }
 
 const backendShutdownTimeout = 31 * time.Second
@@ -489,6 +495,22 @@ func (b *Backend) runBackendControllersUnderLeaderElection(ctx context.Context,
 		http.DefaultClient,
 		activeOperationInformer,
 	)
+	operationClusterStopController := clusteroperations.NewOperationClusterStopController(
+		b.clock,
+		b.options.ResourcesDBClient,
+		b.options.ClustersServiceClient,
+		http.DefaultClient,
+		activeOperationInformer,
+		backendInformers,
+	)
+	operationClusterStartController := clusteroperations.NewOperationClusterStartController(
+		b.clock,
+		b.options.ResourcesDBClient,
+		b.options.ClustersServiceClient,
+		http.DefaultClient,
+		activeOperationInformer,
+		backendInformers,
+	)
 	operationNodePoolCreateController := nodepooloperations.NewOperationNodePoolCreateController(
 		b.clock,
 		b.options.ResourcesDBClient,
@@ -513,6 +535,13 @@ func (b *Backend) runBackendControllersUnderLeaderElection(ctx context.Context,
 		http.DefaultClient,
 		activeOperationInformer,
 	)
+	operationNodePoolReplaceController := nodepooloperations.NewOperationNodePoolReplaceController(
+		b.clock,
+		b.options.ResourcesDBClient,
+		http.DefaultClient,
+		activeOperationInformer,
+		backendInformers,
+	)
 	operationExternalAuthCreateController := externalauthoperations.NewOperationExternalAuthCreateController(
 		b.clock,
 		b.options.ResourcesDBClient,
@@ -563,10 +592,21 @@ func (b *Backend) runBackendControllersUnderLeaderElection(ctx context.Context,
 	backfillClusterUIDController := controllerutils.NewClusterWatchingController(
 		"BackfillClusterUID", b.options.ResourcesDBClient, backendInformers, unionKubeApplierInformers, 60*time.Minute,
 		mismatch.NewBackfillClusterUIDController(b.clock, b.options.ResourcesDBClient, b.options.BillingDBClient, clusterLister))
-	orphanedBillingCleanupController := billing.NewOrphanedBillingCleanupController(b.clock, b.options.BillingDBClient, clusterLister, billingLister)
+	_, operationNotificationLister := backendInformers.OperationNotifications()
+	operationNotificationDeliveryController := operationnotification.NewOperationNotificationDeliveryController(
+		b.clock,
+		b.options.ResourcesDBClient,
+		operationNotificationLister,
+		operationutils.PostAsyncNotificationFn(http.DefaultClient),
+		b.options.MetricsRegisterer,
+	)
+	orphanedBillingCleanupController := billing.NewOrphanedBillingCleanupController(b.clock, b.options.BillingDBClient, clusterLister, nodePoolLister, billingLister)
 	createBillingDocController := controllerutils.NewClusterWatchingController(
 		"CreateBillingDoc", b.options.ResourcesDBClient, backendInformers, unionKubeApplierInformers, 60*time.Second,
 		billing.NewCreateBillingDocController(b.clock, b.options.AzureLocation, b.options.ResourcesDBClient, b.options.BillingDBClient, clusterLister, billingLister))
+	recordNodePoolScaleEventsController := controllerutils.NewNodePoolWatchingController(
+		"RecordNodePoolScaleEvents", b.options.ResourcesDBClient, backendInformers, nil, 5*time.Minute,
+		billing.NewRecordNodePoolScaleEventsController(b.clock, b.options.ClustersServiceClient, b.options.BillingDBClient, clusterLister, nodePoolLister))
 	controlPlaneActiveVersionController := clusterversion.NewControlPlaneActiveVersionController(
 		b.options.ResourcesDBClient,
 		serviceProviderClusterLister,
@@ -619,6 +659,14 @@ func (b *Backend) runBackendControllersUnderLeaderElection(ctx context.Context,
 		backendInformers,
 		unionKubeApplierInformers,
 	)
+	controlPlaneRightSizingController := clusterproperties.NewControlPlaneRightSizingController(
+		b.clock,
+		b.options.ResourcesDBClient,
+		backendInformers,
+		unionKubeApplierInformers,
+		unionReadDesireLister,
+		b.options.ControlPlaneRightSizingAutoApply,
+	)
 	serviceProviderClusterPropertiesSyncController := clusterproperties.NewServiceProviderClusterPropertiesSyncController(
 		b.options.ResourcesDBClient,
 		backendInformers,
@@ -708,6 +756,15 @@ func (b *Backend) runBackendControllersUnderLeaderElection(ctx context.Context,
 		b.options.FPAClientBuilder,
 		b.options.AzureLocation,
 	)
+	nodePoolUsageAggregationController := billing.NewNodePoolUsageAggregationController(
+		b.clock,
+		b.options.AzureLocation,
+		virtualMachineResourceSKUsCachedReaderController,
+		b.options.BillingDBClient,
+		clusterLister,
+		nodePoolLister,
+		billingLister,
+	)
 
 	azureRPRegistrationValidationController := clustervalidation.NewClusterValidationController(
 		validationutils.NewAzureResourceProvidersRegistrationValidation(b.options.FPAClientBuilder),
@@ -757,6 +814,7 @@ func (b *Backend) runBackendControllersUnderLeaderElection(ctx context.Context,
 		unionReadDesireLister,
 	)
 	triggerNodePoolUpgradeController := nodepoolversion.NewTriggerNodePoolUpgradeController(
+		b.clock,
 		b.options.ResourcesDBClient,
 		b.options.ClustersServiceClient,
 		serviceProviderNodePoolLister,
@@ -881,6 +939,11 @@ func (b *Backend) runBackendControllersUnderLeaderElection(ctx context.Context,
 		b.options.ClustersServiceClient,
 		backendInformers,
 	)
+	nodePoolReplaceController := nodepoolreplace.NewNodePoolReplaceController(
+		b.options.ResourcesDBClient,
+		b.options.ClustersServiceClient,
+		backendInformers,
+	)
 	externalAuthClusterServiceUpdateDispatchController := externalauthupdate.NewExternalAuthClusterServiceUpdateDispatchController(
 		b.options.ResourcesDBClient,
 		b.options.ClustersServiceClient,
@@ -920,14 +983,18 @@ func (b *Backend) runBackendControllersUnderLeaderElection(ctx context.Context,
 				go operationClusterCreateController.Run(ctx, 20)
 				go operationClusterUpdateController.Run(ctx, 20)
 				go operationClusterDeleteController.Run(ctx, 20)
+				go operationClusterStopController.Run(ctx, 20)
+				go operationClusterStartController.Run(ctx, 20)
 				go operationNodePoolCreateController.Run(ctx, 20)
 				go operationNodePoolUpdateController.Run(ctx, 20)
 				go operationNodePoolDeleteController.Run(ctx, 20)
+				go operationNodePoolReplaceController.Run(ctx, 20)
 				go operationExternalAuthCreateController.Run(ctx, 20)
 				go operationExternalAuthUpdateController.Run(ctx, 20)
 				go operationExternalAuthDeleteController.Run(ctx, 20)
 				go operationRequestCredentialController.Run(ctx, 20)
 				go operationRevokeCredentialsController.Run(ctx, 20)
+				go operationNotificationDeliveryController.Run(ctx, 20)
 				go clusterServiceMatchingClusterController.Run(ctx, 20)
 				go alwaysSuccessClusterValidationController.Run(ctx, 20)
 				go deleteOrphanedCosmosResourcesController.Run(ctx, 20)
@@ -935,6 +1002,8 @@ func (b *Backend) runBackendControllersUnderLeaderElection(ctx context.Context,
 				go backfillClusterUIDController.Run(ctx, 20)
 				go orphanedBillingCleanupController.Run(ctx, 20)
 				go createBillingDocController.Run(ctx, 20)
+				go recordNodePoolScaleEventsController.Run(ctx, 20)
+				go nodePoolUsageAggregationController.Run(ctx, 20)
 				go controlPlaneActiveVersionController.Run(ctx, 20)
 				go controlPlaneDesiredVersionController.Run(ctx, 20)
 				go triggerControlPlaneUpgradeController.Run(ctx, 20)
@@ -947,6 +1016,7 @@ func (b *Backend) runBackendControllersUnderLeaderElection(ctx context.Context,
 				go nodePoolRequirementsValidAggregatorController.Run(ctx, 20)
 				go externalAuthDegradedAggregatorController.Run(ctx, 20)
 				go desiredControlPlaneSizeController.Run(ctx, 20)
+				go controlPlaneRightSizingController.Run(ctx, 20)
 				go serviceProviderClusterPropertiesSyncController.Run(ctx, 20)
 				go azureRPRegistrationValidationController.Run(ctx, 20)
 				go azureClusterResourceGroupExistenceValidationController.Run(ctx, 20)
@@ -975,6 +1045,7 @@ func (b *Backend) runBackendControllersUnderLeaderElection(ctx context.Context,
 				go clusterDeletionController.Run(ctx, 20)
 				go clusterClusterServiceUpdateDispatchController.Run(ctx, 20)
 				go nodePoolClusterServiceUpdateDispatchController.Run(ctx, 20)
+				go nodePoolReplaceController.Run(ctx, 20)
 				go externalAuthClusterServiceUpdateDispatchController.Run(ctx, 20)
 				go operationPhaseMetricsController.Run(ctx, 1)
 				go clusterMetricsController.Run(ctx, 1)
@@ -0,0 +1,125 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package billing
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/billingcosmosstorage"
+)
+
+// latestScaleEvents returns the most recent scale event of every node pool,
+// keyed by the lowercased node pool resource ID.
+func latestScaleEvents(events []*billingcosmosstorage.NodePoolScaleEvent) map[string]*billingcosmosstorage.NodePoolScaleEvent {
+	latestEvents := map[string]*billingcosmosstorage.NodePoolScaleEvent{}
+	for _, event := range events {
+		if event.NodePoolResourceID == nil {
+			continue
+		}
+		key := strings.ToLower(event.NodePoolResourceID.String())
+		if latest, exists := latestEvents[key]; !exists || latest.ObservedTime.Before(event.ObservedTime) {
+			latestEvents[key] = event
+		}
+	}
+	return latestEvents
+}
+
+// aggregateHourlyUsage integrates the node counts of the scale events over
+// [start, end) and returns one usage record per node pool, clock hour, and VM
+// size with non-zero usage. Each event applies from its ObservedTime until
+// the next event of the same node pool, or until end for the latest event.
+// vcpusPerNode holds the vCPU count of every metered VM size, keyed by the
+// lowercased VM size. start and end are expected to be on hour boundaries.
+func aggregateHourlyUsage(events []*billingcosmosstorage.NodePoolScaleEvent, vcpusPerNode map[string]int32, start, end time.Time) []*billingcosmosstorage.NodePoolUsageRecord {
+	start = start.UTC()
+	end = end.UTC()
+
+	eventsByNodePool := map[string][]*billingcosmosstorage.NodePoolScaleEvent{}
+	var nodePoolKeys []string
+	for _, event := range events {
+		if event.NodePoolResourceID == nil {
+			continue
+		}
+		key := strings.ToLower(event.NodePoolResourceID.String())
+		if _, exists := eventsByNodePool[key]; !exists {
+			nodePoolKeys = append(nodePoolKeys, key)
+		}
+		eventsByNodePool[key] = append(eventsByNodePool[key], event)
+	}
+	sort.Strings(nodePoolKeys)
+
+	recordsByID := map[string]*billingcosmosstorage.NodePoolUsageRecord{}
+	var records []*billingcosmosstorage.NodePoolUsageRecord
+	for _, key := range nodePoolKeys {
+		nodePoolEvents := eventsByNodePool[key]
+		sort.SliceStable(nodePoolEvents, func(i, j int) bool {
+			return nodePoolEvents[i].ObservedTime.Before(nodePoolEvents[j].ObservedTime)
+		})
+
+		for i, event := range nodePoolEvents {
+			if event.CurrentReplicas <= 0 {
+				continue
+			}
+
+			segmentStart := event.ObservedTime.UTC()
+			segmentEnd := end
+			if i+1 < len(nodePoolEvents) {
+				segmentEnd = nodePoolEvents[i+1].ObservedTime.UTC()
+			}
+			if segmentStart.Before(start) {
+				segmentStart = start
+			}
+			if segmentEnd.After(end) {
+				segmentEnd = end
+			}
+			if !segmentStart.Before(segmentEnd) {
+				continue
+			}
+
+			for hourStart := segmentStart.Truncate(time.Hour); hourStart.Before(segmentEnd); hourStart = hourStart.Add(time.Hour) {
+				from := segmentStart
+				if from.Before(hourStart) {
+					from = hourStart
+				}
+				to := segmentEnd
+				if hourEnd := hourStart.Add(time.Hour); to.After(hourEnd) {
+					to = hourEnd
+				}
+
+				id := billingcosmosstorage.NodePoolUsageRecordID(event.NodePoolResourceID, hourStart, event.VMSize)
+				record, exists := recordsByID[id]
+				if !exists {
+					record = billingcosmosstorage.NewNodePoolUsageRecord(event.NodePoolResourceID, hourStart, event.VMSize)
+					record.ClusterUID = event.ClusterUID
+					if event.ClusterResourceID != nil {
+						record.ClusterResourceID = event.ClusterResourceID
+					}
+					record.VCPUsPerNode = vcpusPerNode[strings.ToLower(event.VMSize)]
+					recordsByID[id] = record
+					records = append(records, record)
+				}
+				record.NodeHours += to.Sub(from).Hours() * float64(event.CurrentReplicas)
+			}
+		}
+	}
+
+	for _, record := range records {
+		record.VCPUHours = record.NodeHours * float64(record.VCPUsPerNode)
+	}
+
+	return records
+}
@@ -0,0 +1,309 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+	utilsclock "k8s.io/utils/clock"
+
+	"github.com/Azure/ARO-HCP/backend/pkg/azure/cachedreader"
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/validationutils"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/billingcosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/listers/corelisters"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+// usageAggregationLookback is how many completed hours are recomputed on every
+// run. Recomputing is idempotent, so a lookback of several hours lets a run
+// pick up scale events recorded late or runs missed during an outage.
+const usageAggregationLookback = 6 * time.Hour
+
+type nodePoolUsageAggregation struct {
+	name string
+
+	clock                    utilsclock.PassiveClock
+	azureLocation            string
+	resourceSKUsCachedReader cachedreader.VirtualMachineResourceSKUsCachedReader
+	clusterLister            corelisters.ClusterLister
+	nodePoolLister           corelisters.NodePoolLister
+	billingLister            corelisters.BillingLister
+	billingDBClient          billingcosmosstorage.BillingDBClient
+
+	// queue is where incoming work is placed to de-dup and to allow "easy"
+	// rate limited requeues on errors
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewNodePoolUsageAggregationController creates a controller that aggregates
+// node pool scale events into hourly usage records and logs a reconciliation
+// report comparing the metered usage with the clusters in Cosmos DB. The vCPU
+// count of a VM size is read from the VM Resource SKUs of the node pool's
+// subscription in azureLocation.
+func NewNodePoolUsageAggregationController(clock utilsclock.PassiveClock, azureLocation string, resourceSKUsCachedReader cachedreader.VirtualMachineResourceSKUsCachedReader, billingDBClient billingcosmosstorage.BillingDBClient, clusterLister corelisters.ClusterLister, nodePoolLister corelisters.NodePoolLister, billingLister corelisters.BillingLister) controllerutils.Controller {
+	c := &nodePoolUsageAggregation{
+		name:                     "NodePoolUsageAggregation",
+		clock:                    clock,
+		azureLocation:            azureLocation,
+		resourceSKUsCachedReader: resourceSKUsCachedReader,
+		clusterLister:            clusterLister,
+		nodePoolLister:           nodePoolLister,
+		billingLister:            billingLister,
+		billingDBClient:          billingDBClient,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "NodePoolUsageAggregation",
+			},
+		),
+	}
+
+	return c
+}
+
+// vcpusPerNode returns the vCPU count of every VM size that has nodes in
+// events, keyed by the lowercased VM size.
+func (c *nodePoolUsageAggregation) vcpusPerNode(ctx context.Context, tenantID, subscriptionID string, events []*billingcosmosstorage.NodePoolScaleEvent) (map[string]int32, error) {
+	vcpusPerNode := map[string]int32{}
+	for _, event := range events {
+		key := strings.ToLower(event.VMSize)
+		if _, exists := vcpusPerNode[key]; exists || event.CurrentReplicas <= 0 {
+			continue
+		}
+
+		sku, err := c.resourceSKUsCachedReader.GetVirtualMachineSKU(ctx, tenantID, subscriptionID, c.azureLocation, event.VMSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get resource SKU for VM size %q: %w", event.VMSize, err)
+		}
+		vcpus, ok := validationutils.LookupCapabilityVCPUs(sku)
+		if !ok || vcpus <= 0 {
+			return nil, fmt.Errorf("resource SKU for VM size %q has no valid vCPUs capability", event.VMSize)
+		}
+		vcpusPerNode[key] = int32(vcpus)
+	}
+	return vcpusPerNode, nil
+}
+
+// aggregateSubscriptionUsage recomputes the usage records of a subscription
+// for [start, end). Records written by an earlier run that the scale events no
+// longer produce, e.g. after a late event lowered a node count to zero, are
+// zeroed so that no stale usage remains. It returns the scale events and the
+// records with usage.
+func (c *nodePoolUsageAggregation) aggregateSubscriptionUsage(ctx context.Context, tenantID, subscriptionID string, start, end time.Time) ([]*billingcosmosstorage.NodePoolScaleEvent, []*billingcosmosstorage.NodePoolUsageRecord, error) {
+	logger := utils.LoggerFromContext(ctx)
+	now := c.clock.Now().UTC()
+	usageCRUD := c.billingDBClient.NodePoolUsage(subscriptionID)
+
+	events, err := usageCRUD.ListScaleEvents(ctx, start)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list node pool scale events: %w", err)
+	}
+	vcpusPerNode, err := c.vcpusPerNode(ctx, tenantID, subscriptionID, events)
+	if err != nil {
+		return nil, nil, err
+	}
+	existingRecords, err := usageCRUD.ListUsageRecords(ctx, start, end)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list node pool usage records: %w", err)
+	}
+
+	records := aggregateHourlyUsage(events, vcpusPerNode, start, end)
+	recordIDs := sets.New[string]()
+	for _, record := range records {
+		recordIDs.Insert(record.ID)
+		record.AggregatedTime = now
+		err = usageCRUD.UpsertUsageRecord(ctx, record)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	zeroedRecords := 0
+	for _, existing := range existingRecords {
+		if recordIDs.Has(existing.ID) || (existing.NodeHours == 0 && existing.VCPUHours == 0) {
+			continue
+		}
+		existing.NodeHours = 0
+		existing.VCPUHours = 0
+		existing.AggregatedTime = now
+		err = usageCRUD.UpsertUsageRecord(ctx, existing)
+		if err != nil {
+			return nil, nil, err
+		}
+		zeroedRecords++
+	}
+
+	if len(records) > 0 || zeroedRecords > 0 {
+		logger.Info("aggregated node pool usage",
+			"subscriptionID", subscriptionID,
+			"windowStart", start,
+			"windowEnd", end,
+			"records", len(records),
+			"zeroedRecords", zeroedRecords,
+		)
+	}
+
+	return events, records, nil
+}
+
+func (c *nodePoolUsageAggregation) aggregateUsage(ctx context.Context) (*usageReconciliationReport, error) {
+	now := c.clock.Now().UTC()
+	end := now.Truncate(time.Hour)
+	start := end.Add(-usageAggregationLookback)
+
+	billingDocs, err := c.billingLister.List(ctx)
+	if err != nil {
+		return nil, utils.TrackError(err)
+	}
+
+	// Only subscriptions with a cluster billed during the window can have usage in it.
+	tenantIDs := map[string]string{}
+	for _, doc := range billingDocs {
+		if doc.DeletionTime == nil || doc.DeletionTime.After(start) {
+			tenantIDs[doc.SubscriptionID] = doc.TenantID
+		}
+	}
+
+	// A subscription that fails to aggregate keeps its earlier records and
+	// does not stop the others from being aggregated.
+	var errs []error
+	var allEvents []*billingcosmosstorage.NodePoolScaleEvent
+	var allRecords []*billingcosmosstorage.NodePoolUsageRecord
+	for _, subscriptionID := range sets.List(sets.KeySet(tenantIDs)) {
+		events, records, err := c.aggregateSubscriptionUsage(ctx, tenantIDs[subscriptionID], subscriptionID, start, end)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to aggregate node pool usage of subscription %q: %w", subscriptionID, err))
+			continue
+		}
+		allEvents = append(allEvents, events...)
+		allRecords = append(allRecords, records...)
+	}
+	if len(errs) > 0 {
+		return nil, utils.TrackError(errors.Join(errs...))
+	}
+
+	clusters, err := c.clusterLister.List(ctx)
+	if err != nil {
+		return nil, utils.TrackError(err)
+	}
+	nodePools, err := c.nodePoolLister.List(ctx)
+	if err != nil {
+		return nil, utils.TrackError(err)
+	}
+
+	return buildUsageReconciliationReport(start, end, clusters, nodePools, billingDocs, allEvents, allRecords), nil
+}
+
+func (c *nodePoolUsageAggregation) QueueForInformers(resyncDuration time.Duration, notifiers ...controllerutils.Notifier) error {
+	// panic so that the developer error is noticed immediately
+	panic("not implemented")
+}
+
+func (c *nodePoolUsageAggregation) SyncOnce(ctx context.Context, _ any) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	report, syncErr := c.aggregateUsage(ctx)
+	if syncErr != nil {
+		logger.Error(syncErr, "unable to aggregate node pool usage")
+		return utils.TrackError(syncErr)
+	}
+
+	logger.Info("node pool usage reconciliation report",
+		"windowStart", report.WindowStart,
+		"windowEnd", report.WindowEnd,
+		"meteredNodePools", report.MeteredNodePools,
+		"nodeHours", report.NodeHours,
+		"vcpuHours", report.VCPUHours,
+		"discrepancies", report.Discrepancies(),
+	)
+	if report.Discrepancies() > 0 {
+		logger.Info("node pool usage does not reconcile with Cosmos",
+			"unmeteredNodePools", report.UnmeteredNodePools,
+			"unclosedNodePools", report.UnclosedNodePools,
+			"usageWithoutBillingDocument", report.UsageWithoutBillingDocument,
+			"usageWithoutCluster", report.UsageWithoutCluster,
+			"usageAfterBillingDeletion", report.UsageAfterBillingDeletion,
+		)
+	}
+
+	return nil
+}
+
+func (c *nodePoolUsageAggregation) Run(ctx context.Context, threadiness int) {
+	// don't let panics crash the process
+	defer utilruntime.HandleCrash()
+	// make sure the work queue is shutdown which will trigger workers to end
+	defer c.queue.ShutDown()
+
+	logger := utils.LoggerFromContext(ctx)
+	logger = logger.WithValues(utils.LogValues{}.AddControllerName(c.name)...)
+	ctx = utils.ContextWithLogger(ctx, logger)
+	logger.Info("Starting")
+
+	// start up your worker threads based on threadiness.  Some controllers
+	// have multiple kinds of workers
+	for i := 0; i < threadiness; i++ {
+		// runWorker will loop until "something bad" happens.  The .Until will
+		// then rekick the worker after 10 minutes
+		go wait.UntilWithContext(ctx, c.runWorker, 10*time.Minute)
+	}
+
+	go wait.JitterUntilWithContext(ctx, func(ctx context.Context) { c.queue.Add("default") }, 60*time.Minute, 0.1, true)
+
+	logger.Info("Started workers")
+
+	// wait until we're told to stop
+	<-ctx.Done()
+	logger.Info("Shutting down")
+}
+
+func (c *nodePoolUsageAggregation) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+// processNextWorkItem deals with one item off the queue.  It returns false
+// when it's time to quit.
+func (c *nodePoolUsageAggregation) processNextWorkItem(ctx context.Context) bool {
+	ref, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(ref)
+
+	logger := utils.LoggerFromContext(ctx)
+	ctx = utils.ContextWithLogger(ctx, logger)
+
+	controllerutils.ReconcileTotal.WithLabelValues(c.name).Inc()
+	err := c.SyncOnce(ctx, ref)
+	if err == nil {
+		c.queue.Forget(ref)
+		return true
+	}
+
+	utilruntime.HandleErrorWithContext(ctx, err, "Error syncing; requeuing for later retry", "objectReference", ref)
+	c.queue.AddRateLimited(ref)
+
+	return true
+}
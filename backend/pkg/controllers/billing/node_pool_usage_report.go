@@ -0,0 +1,153 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package billing
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/billingcosmosstorage"
+)
+
+// usageReconciliationReport compares the node pool usage metered for a window
+// with the clusters and node pools in Cosmos and with the billing documents.
+// Node pools are identified by their resource ID.
+type usageReconciliationReport struct {
+	WindowStart time.Time
+	WindowEnd   time.Time
+
+	// MeteredNodePools is the number of node pools with usage in the window
+	MeteredNodePools int
+	// NodeHours is the total node-hours metered in the window
+	NodeHours float64
+	// VCPUHours is the total vCPU-hours metered in the window
+	VCPUHours float64
+
+	// UnmeteredNodePools are node pools of billed clusters that exist in
+	// Cluster Service but have no scale event
+	UnmeteredNodePools []string
+	// UnclosedNodePools are node pools missing from Cosmos whose latest scale
+	// event still reports nodes
+	UnclosedNodePools []string
+	// UsageWithoutBillingDocument are node pools with usage attributed to a
+	// ClusterUID that has no billing document
+	UsageWithoutBillingDocument []string
+	// UsageWithoutCluster are node pools with usage whose cluster is missing
+	// from Cosmos while its billing document is still active
+	UsageWithoutCluster []string
+	// UsageAfterBillingDeletion are node pools with usage in hours starting
+	// after the deletion time of their billing document
+	UsageAfterBillingDeletion []string
+}
+
+// Discrepancies returns the number of findings in the report.
+func (r *usageReconciliationReport) Discrepancies() int {
+	return len(r.UnmeteredNodePools) +
+		len(r.UnclosedNodePools) +
+		len(r.UsageWithoutBillingDocument) +
+		len(r.UsageWithoutCluster) +
+		len(r.UsageAfterBillingDeletion)
+}
+
+// buildUsageReconciliationReport builds the reconciliation report of the
+// usage records of [start, end). events are all scale events of the metered
+// subscriptions, clusters and nodePools are the resources in Cosmos.
+func buildUsageReconciliationReport(
+	start, end time.Time,
+	clusters []*coreapi.HCPOpenShiftCluster,
+	nodePools []*coreapi.HCPOpenShiftClusterNodePool,
+	billingDocs []*billingcosmosstorage.BillingDocument,
+	events []*billingcosmosstorage.NodePoolScaleEvent,
+	records []*billingcosmosstorage.NodePoolUsageRecord,
+) *usageReconciliationReport {
+	report := &usageReconciliationReport{
+		WindowStart: start,
+		WindowEnd:   end,
+	}
+
+	clustersByID := map[string]*coreapi.HCPOpenShiftCluster{}
+	for _, cluster := range clusters {
+		clustersByID[strings.ToLower(cluster.ID.String())] = cluster
+	}
+	billingDocsByID := map[string]*billingcosmosstorage.BillingDocument{}
+	for _, doc := range billingDocs {
+		billingDocsByID[doc.ID] = doc
+	}
+	latestEvents := latestScaleEvents(events)
+	nodePoolIDs := sets.New[string]()
+	for _, nodePool := range nodePools {
+		nodePoolIDs.Insert(strings.ToLower(nodePool.ID.String()))
+	}
+
+	for _, nodePool := range nodePools {
+		key := strings.ToLower(nodePool.ID.String())
+		if _, metered := latestEvents[key]; metered {
+			continue
+		}
+		csID := nodePool.ServiceProviderProperties.ClusterServiceID
+		if csID == nil || len(csID.String()) == 0 || nodePool.ServiceProviderProperties.DeletionTimestamp != nil {
+			continue
+		}
+		cluster, exists := clustersByID[strings.ToLower(nodePool.ID.Parent.String())]
+		if !exists || len(cluster.ServiceProviderProperties.BillingDocumentCosmosID) == 0 {
+			continue
+		}
+		report.UnmeteredNodePools = append(report.UnmeteredNodePools, nodePool.ID.String())
+	}
+
+	for key, latest := range latestEvents {
+		if latest.CurrentReplicas > 0 && !nodePoolIDs.Has(key) {
+			report.UnclosedNodePools = append(report.UnclosedNodePools, latest.NodePoolResourceID.String())
+		}
+	}
+
+	meteredNodePools := sets.New[string]()
+	withoutBillingDocument := sets.New[string]()
+	withoutCluster := sets.New[string]()
+	afterBillingDeletion := sets.New[string]()
+	for _, record := range records {
+		nodePoolID := record.NodePoolResourceID.String()
+		meteredNodePools.Insert(strings.ToLower(nodePoolID))
+		report.NodeHours += record.NodeHours
+		report.VCPUHours += record.VCPUHours
+
+		doc, exists := billingDocsByID[record.ClusterUID]
+		switch {
+		case !exists:
+			withoutBillingDocument.Insert(nodePoolID)
+		case doc.DeletionTime != nil:
+			if record.HourStart.After(*doc.DeletionTime) {
+				afterBillingDeletion.Insert(nodePoolID)
+			}
+		case record.ClusterResourceID != nil:
+			if _, exists := clustersByID[strings.ToLower(record.ClusterResourceID.String())]; !exists {
+				withoutCluster.Insert(nodePoolID)
+			}
+		}
+	}
+
+	report.MeteredNodePools = meteredNodePools.Len()
+	report.UsageWithoutBillingDocument = sets.List(withoutBillingDocument)
+	report.UsageWithoutCluster = sets.List(withoutCluster)
+	report.UsageAfterBillingDeletion = sets.List(afterBillingDeletion)
+	sort.Strings(report.UnmeteredNodePools)
+	sort.Strings(report.UnclosedNodePools)
+
+	return report
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package billing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/billingcosmosstorage"
+)
+
+func TestBuildUsageReconciliationReport(t *testing.T) {
+	start := mustParseTime("2025-01-20T10:00:00Z")
+	end := mustParseTime("2025-01-20T12:00:00Z")
+
+	newNodePool := func(name string) *coreapi.HCPOpenShiftClusterNodePool {
+		resourceID := newTestNodePoolResourceID(name)
+		return newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+			np.ID = resourceID
+			np.ResourceID = resourceID
+			np.Name = name
+		})
+	}
+	newRecord := func(nodePoolName, clusterUID, hourStart, vmSize string, nodeHours float64) *billingcosmosstorage.NodePoolUsageRecord {
+		record := billingcosmosstorage.NewNodePoolUsageRecord(newTestNodePoolResourceID(nodePoolName), mustParseTime(hourStart), vmSize)
+		record.ClusterUID = clusterUID
+		record.VCPUsPerNode = testVCPUsPerNode[strings.ToLower(vmSize)]
+		record.NodeHours = nodeHours
+		record.VCPUHours = nodeHours * float64(record.VCPUsPerNode)
+		return record
+	}
+	nodePoolID := func(name string) string {
+		return newTestNodePoolResourceID(name).String()
+	}
+
+	t.Run("reconciled usage has no discrepancies", func(t *testing.T) {
+		report := buildUsageReconciliationReport(start, end,
+			[]*coreapi.HCPOpenShiftCluster{newTestBilledCluster(t)},
+			[]*coreapi.HCPOpenShiftClusterNodePool{newNodePool("np1")},
+			[]*billingcosmosstorage.BillingDocument{
+				newTestBillingDocument(testClusterUID, testSubscriptionID, testResourceGroupName, testClusterName, nil),
+			},
+			[]*billingcosmosstorage.NodePoolScaleEvent{
+				newTestScaleEvent("e1", "np1", "2025-01-20T09:00:00Z", "Standard_D8s_v3", 2),
+			},
+			[]*billingcosmosstorage.NodePoolUsageRecord{
+				newRecord("np1", testClusterUID, "2025-01-20T10:00:00Z", "Standard_D8s_v3", 2),
+				newRecord("np1", testClusterUID, "2025-01-20T11:00:00Z", "Standard_D8s_v3", 2),
+			},
+		)
+
+		assert.Equal(t, start, report.WindowStart)
+		assert.Equal(t, end, report.WindowEnd)
+		assert.Equal(t, 1, report.MeteredNodePools)
+		assert.Equal(t, 4.0, report.NodeHours)
+		assert.Equal(t, 32.0, report.VCPUHours)
+		assert.Zero(t, report.Discrepancies())
+	})
+
+	t.Run("reports unmetered and unclosed node pools", func(t *testing.T) {
+		notInClusterService := newNodePool("np3")
+		notInClusterService.ServiceProviderProperties.ClusterServiceID = nil
+
+		report := buildUsageReconciliationReport(start, end,
+			[]*coreapi.HCPOpenShiftCluster{newTestBilledCluster(t)},
+			[]*coreapi.HCPOpenShiftClusterNodePool{newNodePool("np1"), notInClusterService},
+			[]*billingcosmosstorage.BillingDocument{
+				newTestBillingDocument(testClusterUID, testSubscriptionID, testResourceGroupName, testClusterName, nil),
+			},
+			[]*billingcosmosstorage.NodePoolScaleEvent{
+				newTestScaleEvent("e1", "np2", "2025-01-20T09:00:00Z", "Standard_D8s_v3", 2),
+				newTestScaleEvent("e2", "np4", "2025-01-20T09:00:00Z", "Standard_D8s_v3", 2),
+				newTestScaleEvent("e3", "np4", "2025-01-20T09:30:00Z", "Standard_D8s_v3", 0),
+			},
+			nil,
+		)
+
+		assert.Equal(t, []string{nodePoolID("np1")}, report.UnmeteredNodePools)
+		assert.Equal(t, []string{nodePoolID("np2")}, report.UnclosedNodePools)
+		assert.Equal(t, 2, report.Discrepancies())
+	})
+
+	t.Run("reports usage that is not backed by a billed cluster", func(t *testing.T) {
+		deletedAt := mustParseTime("2025-01-20T10:15:00Z")
+
+		report := buildUsageReconciliationReport(start, end,
+			nil,
+			nil,
+			[]*billingcosmosstorage.BillingDocument{
+				newTestBillingDocument(testClusterUID, testSubscriptionID, testResourceGroupName, testClusterName, nil),
+				newTestBillingDocument("deleted-cluster-uid", testSubscriptionID, testResourceGroupName, "deleted-cluster", ptr.To(deletedAt)),
+			},
+			nil,
+			[]*billingcosmosstorage.NodePoolUsageRecord{
+				newRecord("np1", testClusterUID, "2025-01-20T10:00:00Z", "Standard_D8s_v3", 1),
+				newRecord("np2", "deleted-cluster-uid", "2025-01-20T10:00:00Z", "Standard_D8s_v3", 1),
+				newRecord("np2", "deleted-cluster-uid", "2025-01-20T11:00:00Z", "Standard_D8s_v3", 1),
+				newRecord("np3", "unknown-cluster-uid", "2025-01-20T11:00:00Z", "Standard_D8s_v3", 1),
+			},
+		)
+
+		assert.Equal(t, 3, report.MeteredNodePools)
+		assert.Equal(t, 4.0, report.NodeHours)
+		assert.Equal(t, 32.0, report.VCPUHours)
+		assert.Equal(t, []string{nodePoolID("np1")}, report.UsageWithoutCluster)
+		assert.Equal(t, []string{nodePoolID("np2")}, report.UsageAfterBillingDeletion)
+		assert.Equal(t, []string{nodePoolID("np3")}, report.UsageWithoutBillingDocument)
+		assert.Equal(t, 3, report.Discrepancies())
+	})
+}
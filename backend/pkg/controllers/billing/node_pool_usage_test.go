@@ -0,0 +1,299 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package billing
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v6"
+
+	"github.com/Azure/ARO-HCP/backend/pkg/azure/cachedreader"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/billingcosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/billingcosmosstoragetesting"
+	"github.com/Azure/ARO-HCP/internal/database/listertesting/corelistertesting"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+func newTestNodePoolResourceID(nodePoolName string) *azcorearm.ResourceID {
+	return metadataapi.Must(coreapi.ToNodePoolResourceID(testSubscriptionID, testResourceGroupName, testClusterName, nodePoolName))
+}
+
+func newTestScaleEvent(id, nodePoolName, observedTime, vmSize string, currentReplicas int32) *billingcosmosstorage.NodePoolScaleEvent {
+	event := billingcosmosstorage.NewNodePoolScaleEvent(id, newTestNodePoolResourceID(nodePoolName))
+	event.ClusterUID = testClusterUID
+	event.ObservedTime = mustParseTime(observedTime)
+	event.VMSize = vmSize
+	event.Replicas = currentReplicas
+	event.CurrentReplicas = currentReplicas
+	return event
+}
+
+// testVCPUsPerNode is the vCPU count of the VM sizes known to
+// newTestResourceSKUsCachedReader, keyed by the lowercased VM size.
+var testVCPUsPerNode = map[string]int32{
+	"standard_d4s_v3": 4,
+	"standard_d8s_v3": 8,
+}
+
+// newTestResourceSKUsCachedReader returns a VM Resource SKU reader serving the
+// VM sizes of testVCPUsPerNode and failing for any other VM size.
+func newTestResourceSKUsCachedReader(t *testing.T) cachedreader.VirtualMachineResourceSKUsCachedReader {
+	skuReader := cachedreader.NewMockVirtualMachineResourceSKUsCachedReader(gomock.NewController(t))
+	skuReader.EXPECT().
+		GetVirtualMachineSKU(gomock.Any(), testTenantID, testSubscriptionID, testAzureLocation, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _, vmSize string) (*armcompute.ResourceSKU, error) {
+			vcpus, exists := testVCPUsPerNode[strings.ToLower(vmSize)]
+			if !exists {
+				return nil, fmt.Errorf("VM size %q not found", vmSize)
+			}
+			return &armcompute.ResourceSKU{
+				Name: ptr.To(vmSize),
+				Capabilities: []*armcompute.ResourceSKUCapabilities{
+					{Name: ptr.To("vCPUs"), Value: ptr.To(strconv.Itoa(int(vcpus)))},
+				},
+			}, nil
+		}).
+		AnyTimes()
+	return skuReader
+}
+
+func newTestNodePoolUsageAggregation(t *testing.T, billingDBClient billingcosmosstorage.BillingDBClient, now time.Time) *nodePoolUsageAggregation {
+	return &nodePoolUsageAggregation{
+		name:                     "NodePoolUsageAggregation",
+		clock:                    clocktesting.NewFakePassiveClock(now),
+		azureLocation:            testAzureLocation,
+		resourceSKUsCachedReader: newTestResourceSKUsCachedReader(t),
+		clusterLister: &corelistertesting.SliceClusterLister{
+			Clusters: []*coreapi.HCPOpenShiftCluster{newTestBilledCluster(t)},
+		},
+		nodePoolLister: &corelistertesting.SliceNodePoolLister{
+			NodePools: []*coreapi.HCPOpenShiftClusterNodePool{newTestNodePool(nil)},
+		},
+		billingLister: &corelistertesting.SliceBillingLister{
+			BillingDocuments: []*billingcosmosstorage.BillingDocument{
+				newTestBillingDocument(testClusterUID, testSubscriptionID, testResourceGroupName, testClusterName, nil),
+			},
+		},
+		billingDBClient: billingDBClient,
+	}
+}
+
+func TestAggregateHourlyUsage(t *testing.T) {
+	start := mustParseTime("2025-01-20T10:00:00Z")
+	end := mustParseTime("2025-01-20T13:00:00Z")
+
+	type usage struct {
+		nodePoolName string
+		hourStart    string
+		vmSize       string
+		nodeHours    float64
+		vcpuHours    float64
+	}
+
+	tests := []struct {
+		name   string
+		events []*billingcosmosstorage.NodePoolScaleEvent
+		want   []usage
+	}{
+		{
+			name: "event before the window applies from the window start until the window end",
+			events: []*billingcosmosstorage.NodePoolScaleEvent{
+				newTestScaleEvent("e1", "np1", "2025-01-20T08:00:00Z", "Standard_D8s_v3", 2),
+			},
+			want: []usage{
+				{"np1", "2025-01-20T10:00:00Z", "Standard_D8s_v3", 2, 16},
+				{"np1", "2025-01-20T11:00:00Z", "Standard_D8s_v3", 2, 16},
+				{"np1", "2025-01-20T12:00:00Z", "Standard_D8s_v3", 2, 16},
+			},
+		},
+		{
+			name: "node count changes are integrated within the hour",
+			events: []*billingcosmosstorage.NodePoolScaleEvent{
+				newTestScaleEvent("e1", "np1", "2025-01-20T10:30:00Z", "Standard_D4s_v3", 2),
+				newTestScaleEvent("e2", "np1", "2025-01-20T11:15:00Z", "Standard_D4s_v3", 4),
+				newTestScaleEvent("e3", "np1", "2025-01-20T11:45:00Z", "Standard_D4s_v3", 0),
+			},
+			want: []usage{
+				{"np1", "2025-01-20T10:00:00Z", "Standard_D4s_v3", 1, 4},
+				// 15 minutes at 2 nodes and 30 minutes at 4 nodes
+				{"np1", "2025-01-20T11:00:00Z", "Standard_D4s_v3", 2.5, 10},
+			},
+		},
+		{
+			name: "VM size change splits the hour into one record per VM size",
+			events: []*billingcosmosstorage.NodePoolScaleEvent{
+				newTestScaleEvent("e1", "np1", "2025-01-20T09:00:00Z", "Standard_D4s_v3", 2),
+				newTestScaleEvent("e2", "np1", "2025-01-20T12:30:00Z", "Standard_D8s_v3", 2),
+			},
+			want: []usage{
+				{"np1", "2025-01-20T10:00:00Z", "Standard_D4s_v3", 2, 8},
+				{"np1", "2025-01-20T11:00:00Z", "Standard_D4s_v3", 2, 8},
+				{"np1", "2025-01-20T12:00:00Z", "Standard_D4s_v3", 1, 4},
+				{"np1", "2025-01-20T12:00:00Z", "Standard_D8s_v3", 1, 8},
+			},
+		},
+		{
+			name: "node pools are metered independently",
+			events: []*billingcosmosstorage.NodePoolScaleEvent{
+				newTestScaleEvent("e2", "np2", "2025-01-20T12:00:00Z", "Standard_D4s_v3", 1),
+				newTestScaleEvent("e1", "np1", "2025-01-20T12:00:00Z", "Standard_D4s_v3", 3),
+			},
+			want: []usage{
+				{"np1", "2025-01-20T12:00:00Z", "Standard_D4s_v3", 3, 12},
+				{"np2", "2025-01-20T12:00:00Z", "Standard_D4s_v3", 1, 4},
+			},
+		},
+		{
+			name: "events after the window and deleted node pools are not metered",
+			events: []*billingcosmosstorage.NodePoolScaleEvent{
+				newTestScaleEvent("e1", "np1", "2025-01-20T05:00:00Z", "Standard_D4s_v3", 3),
+				newTestScaleEvent("e2", "np1", "2025-01-20T06:00:00Z", "Standard_D4s_v3", 0),
+				newTestScaleEvent("e3", "np2", "2025-01-20T13:30:00Z", "Standard_D4s_v3", 3),
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records := aggregateHourlyUsage(tt.events, testVCPUsPerNode, start, end)
+
+			var got []usage
+			for _, record := range records {
+				assert.Equal(t, billingcosmosstorage.NodePoolUsageRecordID(record.NodePoolResourceID, record.HourStart, record.VMSize), record.ID)
+				assert.Equal(t, testSubscriptionID, record.SubscriptionID)
+				assert.Equal(t, testClusterUID, record.ClusterUID)
+				got = append(got, usage{
+					nodePoolName: record.NodePoolResourceID.Name,
+					hourStart:    record.HourStart.Format(time.RFC3339),
+					vmSize:       record.VMSize,
+					nodeHours:    record.NodeHours,
+					vcpuHours:    record.VCPUHours,
+				})
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAggregateHourlyUsage_Idempotent(t *testing.T) {
+	events := []*billingcosmosstorage.NodePoolScaleEvent{
+		newTestScaleEvent("e1", "np1", "2025-01-20T10:30:00Z", "Standard_D4s_v3", 2),
+	}
+	start := mustParseTime("2025-01-20T10:00:00Z")
+	end := mustParseTime("2025-01-20T12:00:00Z")
+
+	first := aggregateHourlyUsage(events, testVCPUsPerNode, start, end)
+	second := aggregateHourlyUsage(events, testVCPUsPerNode, start, end)
+	require.Equal(t, first, second)
+}
+
+func TestNodePoolUsageAggregation_SyncOnce(t *testing.T) {
+	ctx := context.Background()
+	ctx = utils.ContextWithLogger(ctx, testr.New(t))
+	fixedTime := mustParseTime("2025-01-20T12:10:00Z")
+
+	mockBillingDBClient := billingcosmosstoragetesting.NewMockBillingDBClient()
+	usageCRUD := mockBillingDBClient.NodePoolUsage(testSubscriptionID)
+	require.NoError(t, usageCRUD.CreateScaleEvent(ctx, newTestScaleEvent("e1", testNodePoolName, "2025-01-20T10:30:00Z", "Standard_D4s_v3", 2)))
+	// Scale events after the last completed hour are aggregated by a later run.
+	require.NoError(t, usageCRUD.CreateScaleEvent(ctx, newTestScaleEvent("e2", testNodePoolName, "2025-01-20T12:05:00Z", "Standard_D4s_v3", 4)))
+
+	controller := newTestNodePoolUsageAggregation(t, mockBillingDBClient, fixedTime)
+
+	// Running twice must not duplicate usage records.
+	for i := 0; i < 2; i++ {
+		require.NoError(t, controller.SyncOnce(ctx, "default"))
+	}
+
+	records := mockBillingDBClient.GetNodePoolUsageRecords()
+	require.Len(t, records, 2)
+
+	nodePoolID := newTestNodePoolResourceID(testNodePoolName)
+	first := records[billingcosmosstorage.NodePoolUsageRecordID(nodePoolID, mustParseTime("2025-01-20T10:00:00Z"), "Standard_D4s_v3")]
+	require.NotNil(t, first)
+	assert.Equal(t, 1.0, first.NodeHours)
+	assert.Equal(t, 4.0, first.VCPUHours)
+	assert.Equal(t, fixedTime.UTC(), first.AggregatedTime)
+
+	second := records[billingcosmosstorage.NodePoolUsageRecordID(nodePoolID, mustParseTime("2025-01-20T11:00:00Z"), "Standard_D4s_v3")]
+	require.NotNil(t, second)
+	assert.Equal(t, 2.0, second.NodeHours)
+	assert.Equal(t, 8.0, second.VCPUHours)
+}
+
+func TestNodePoolUsageAggregation_ZeroesStaleRecords(t *testing.T) {
+	ctx := context.Background()
+	ctx = utils.ContextWithLogger(ctx, testr.New(t))
+	fixedTime := mustParseTime("2025-01-20T12:10:00Z")
+
+	mockBillingDBClient := billingcosmosstoragetesting.NewMockBillingDBClient()
+	usageCRUD := mockBillingDBClient.NodePoolUsage(testSubscriptionID)
+	require.NoError(t, usageCRUD.CreateScaleEvent(ctx, newTestScaleEvent("e1", testNodePoolName, "2025-01-20T10:30:00Z", "Standard_D4s_v3", 2)))
+
+	controller := newTestNodePoolUsageAggregation(t, mockBillingDBClient, fixedTime)
+	require.NoError(t, controller.SyncOnce(ctx, "default"))
+
+	// A late scale event shows that the node pool was scaled to zero before the
+	// hour that was already metered.
+	require.NoError(t, usageCRUD.CreateScaleEvent(ctx, newTestScaleEvent("e2", testNodePoolName, "2025-01-20T10:45:00Z", "Standard_D4s_v3", 0)))
+	require.NoError(t, controller.SyncOnce(ctx, "default"))
+
+	records := mockBillingDBClient.GetNodePoolUsageRecords()
+	require.Len(t, records, 2)
+
+	nodePoolID := newTestNodePoolResourceID(testNodePoolName)
+	first := records[billingcosmosstorage.NodePoolUsageRecordID(nodePoolID, mustParseTime("2025-01-20T10:00:00Z"), "Standard_D4s_v3")]
+	require.NotNil(t, first)
+	assert.Equal(t, 0.5, first.NodeHours)
+	assert.Equal(t, 2.0, first.VCPUHours)
+
+	stale := records[billingcosmosstorage.NodePoolUsageRecordID(nodePoolID, mustParseTime("2025-01-20T11:00:00Z"), "Standard_D4s_v3")]
+	require.NotNil(t, stale)
+	assert.Zero(t, stale.NodeHours)
+	assert.Zero(t, stale.VCPUHours)
+	assert.Equal(t, fixedTime.UTC(), stale.AggregatedTime)
+}
+
+func TestNodePoolUsageAggregation_UnknownVMSize(t *testing.T) {
+	ctx := context.Background()
+	ctx = utils.ContextWithLogger(ctx, testr.New(t))
+	fixedTime := mustParseTime("2025-01-20T12:10:00Z")
+
+	mockBillingDBClient := billingcosmosstoragetesting.NewMockBillingDBClient()
+	usageCRUD := mockBillingDBClient.NodePoolUsage(testSubscriptionID)
+	require.NoError(t, usageCRUD.CreateScaleEvent(ctx, newTestScaleEvent("e1", testNodePoolName, "2025-01-20T10:30:00Z", "Custom_Size", 2)))
+
+	controller := newTestNodePoolUsageAggregation(t, mockBillingDBClient, fixedTime)
+	err := controller.SyncOnce(ctx, "default")
+	require.ErrorContains(t, err, `VM size "Custom_Size" not found`)
+	assert.Empty(t, mockBillingDBClient.GetNodePoolUsageRecords())
+}
@@ -19,7 +19,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/workqueue"
 	utilsclock "k8s.io/utils/clock"
@@ -36,6 +39,7 @@ type orphanedBillingCleanup struct {
 
 	clock           utilsclock.PassiveClock
 	clusterLister   corelisters.ClusterLister
+	nodePoolLister  corelisters.NodePoolLister
 	billingLister   corelisters.BillingLister
 	billingDBClient billingcosmosstorage.BillingDBClient
 
@@ -45,12 +49,14 @@ type orphanedBillingCleanup struct {
 }
 
 // NewOrphanedBillingCleanupController creates a controller that marks billing documents
-// as deleted when their corresponding cluster no longer exists in Cosmos DB.
-func NewOrphanedBillingCleanupController(clock utilsclock.PassiveClock, billingDBClient billingcosmosstorage.BillingDBClient, clusterLister corelisters.ClusterLister, billingLister corelisters.BillingLister) controllerutils.Controller {
+// as deleted when their corresponding cluster no longer exists in Cosmos DB, and closes
+// the usage metering of node pools that no longer exist in Cosmos DB.
+func NewOrphanedBillingCleanupController(clock utilsclock.PassiveClock, billingDBClient billingcosmosstorage.BillingDBClient, clusterLister corelisters.ClusterLister, nodePoolLister corelisters.NodePoolLister, billingLister corelisters.BillingLister) controllerutils.Controller {
 	c := &orphanedBillingCleanup{
 		name:            "OrphanedBillingCleanup",
 		clock:           clock,
 		clusterLister:   clusterLister,
+		nodePoolLister:  nodePoolLister,
 		billingLister:   billingLister,
 		billingDBClient: billingDBClient,
 		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
@@ -117,6 +123,85 @@ func (c *orphanedBillingCleanup) synchronizeAllBillingDocs(ctx context.Context)
 	return nil
 }
 
+// closeOrphanedNodePoolUsage records a Deleted scale event for every node pool
+// whose latest scale event still reports nodes but which no longer exists in
+// Cosmos DB, so that its usage stops accruing. The event is dated at the
+// deletion time of the cluster's billing document when there is one, but no
+// earlier than the usage aggregation lookback: hours before it are no longer
+// recomputed, so an older event would not correct them and would only make the
+// metered usage disagree with the scale events.
+func (c *orphanedBillingCleanup) closeOrphanedNodePoolUsage(ctx context.Context) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	now := c.clock.Now()
+	earliestObservedTime := now.Truncate(time.Hour).Add(-usageAggregationLookback)
+
+	allBillingDocs, err := c.billingLister.List(ctx)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	billingDocsByID := map[string]*billingcosmosstorage.BillingDocument{}
+	subscriptionIDs := sets.New[string]()
+	for _, doc := range allBillingDocs {
+		billingDocsByID[doc.ID] = doc
+		subscriptionIDs.Insert(doc.SubscriptionID)
+	}
+
+	for _, subscriptionID := range sets.List(subscriptionIDs) {
+		usageCRUD := c.billingDBClient.NodePoolUsage(subscriptionID)
+		events, err := usageCRUD.ListScaleEvents(ctx, earliestObservedTime)
+		if err != nil {
+			return utils.TrackError(fmt.Errorf("failed to list node pool scale events: %w", err))
+		}
+
+		for _, latest := range latestScaleEvents(events) {
+			if latest.CurrentReplicas == 0 {
+				continue
+			}
+
+			nodePoolID := latest.NodePoolResourceID
+			_, err := c.nodePoolLister.Get(ctx, nodePoolID.SubscriptionID, nodePoolID.ResourceGroupName, nodePoolID.Parent.Name, nodePoolID.Name)
+			if err == nil {
+				continue
+			}
+			if !cosmosstorageutils.IsNotFoundError(err) {
+				return utils.TrackError(fmt.Errorf("failed to get node pool from cache: %w", err))
+			}
+
+			observedTime := now
+			if doc, exists := billingDocsByID[latest.ClusterUID]; exists && doc.DeletionTime != nil && doc.DeletionTime.Before(observedTime) {
+				observedTime = *doc.DeletionTime
+			}
+			if observedTime.Before(earliestObservedTime) {
+				observedTime = earliestObservedTime
+			}
+			if observedTime.Before(latest.ObservedTime) {
+				observedTime = latest.ObservedTime
+			}
+
+			event := billingcosmosstorage.NewNodePoolScaleEvent(uuid.New().String(), nodePoolID)
+			event.ClusterUID = latest.ClusterUID
+			event.ClusterResourceID = latest.ClusterResourceID
+			event.ObservedTime = observedTime
+			event.Reason = billingcosmosstorage.NodePoolScaleEventReasonDeleted
+			event.VMSize = latest.VMSize
+			err = usageCRUD.CreateScaleEvent(ctx, event)
+			if err != nil {
+				return utils.TrackError(err)
+			}
+
+			logger.Info("closed usage metering of orphaned node pool",
+				"nodePoolResourceID", nodePoolID.String(),
+				"clusterUID", latest.ClusterUID,
+				"observedTime", observedTime,
+			)
+		}
+	}
+
+	return nil
+}
+
 func (c *orphanedBillingCleanup) QueueForInformers(resyncDuration time.Duration, notifiers ...controllerutils.Notifier) error {
 	// panic so that the developer error is noticed immediately
 	panic("not implemented")
@@ -128,6 +213,12 @@ func (c *orphanedBillingCleanup) SyncOnce(ctx context.Context, _ any) error {
 	syncErr := c.synchronizeAllBillingDocs(ctx)
 	if syncErr != nil {
 		logger.Error(syncErr, "unable to synchronize billing documents")
+		return utils.TrackError(syncErr)
+	}
+
+	syncErr = c.closeOrphanedNodePoolUsage(ctx)
+	if syncErr != nil {
+		logger.Error(syncErr, "unable to close usage metering of orphaned node pools")
 	}
 
 	return utils.TrackError(syncErr)
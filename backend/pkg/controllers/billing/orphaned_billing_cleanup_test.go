@@ -66,6 +66,8 @@ func TestOrphanedBillingCleanup_SyncOnce(t *testing.T) {
 		name             string
 		billingDocuments []*billingcosmosstorage.BillingDocument
 		clusters         []*coreapi.HCPOpenShiftCluster
+		nodePools        []*coreapi.HCPOpenShiftClusterNodePool
+		scaleEvents      []*billingcosmosstorage.NodePoolScaleEvent
 		expectError      bool
 		verify           func(t *testing.T, billingDBClient *billingcosmosstoragetesting.MockBillingDBClient)
 	}{
@@ -119,6 +121,77 @@ func TestOrphanedBillingCleanup_SyncOnce(t *testing.T) {
 			},
 		},
 
+		{
+			name: "closes usage metering of node pools deleted with their cluster at the billing deletion time",
+			billingDocuments: []*billingcosmosstorage.BillingDocument{
+				newTestBillingDocument(testClusterUID, testSubscriptionID, testResourceGroupName, testClusterName, ptr.To(mustParseTime("2025-01-20T07:30:00Z"))),
+			},
+			clusters: []*coreapi.HCPOpenShiftCluster{}, // No clusters
+			scaleEvents: []*billingcosmosstorage.NodePoolScaleEvent{
+				newTestScaleEvent("event-1", "np1", "2025-01-20T06:00:00Z", "Standard_D8s_v3", 2),
+			},
+			expectError: false,
+			verify: func(t *testing.T, billingDBClient *billingcosmosstoragetesting.MockBillingDBClient) {
+				events := billingDBClient.GetNodePoolScaleEvents()
+				require.Len(t, events, 2)
+				closing := events[1]
+				assert.Equal(t, billingcosmosstorage.NodePoolScaleEventReasonDeleted, closing.Reason)
+				assert.Equal(t, mustParseTime("2025-01-20T07:30:00Z"), closing.ObservedTime)
+				assert.Equal(t, testClusterUID, closing.ClusterUID)
+				assert.Equal(t, "Standard_D8s_v3", closing.VMSize)
+				assert.Zero(t, closing.CurrentReplicas)
+			},
+		},
+		{
+			name: "closes usage metering no earlier than the usage aggregation lookback",
+			billingDocuments: []*billingcosmosstorage.BillingDocument{
+				newTestBillingDocument(testClusterUID, testSubscriptionID, testResourceGroupName, testClusterName, ptr.To(mustParseTime("2025-01-19T10:30:00Z"))),
+			},
+			clusters: []*coreapi.HCPOpenShiftCluster{}, // No clusters
+			scaleEvents: []*billingcosmosstorage.NodePoolScaleEvent{
+				newTestScaleEvent("event-1", "np1", "2025-01-19T08:00:00Z", "Standard_D8s_v3", 2),
+			},
+			expectError: false,
+			verify: func(t *testing.T, billingDBClient *billingcosmosstoragetesting.MockBillingDBClient) {
+				events := billingDBClient.GetNodePoolScaleEvents()
+				require.Len(t, events, 2)
+				closing := events[1]
+				assert.Equal(t, billingcosmosstorage.NodePoolScaleEventReasonDeleted, closing.Reason)
+				assert.Equal(t, mustParseTime("2025-01-20T04:00:00Z"), closing.ObservedTime)
+			},
+		},
+		{
+			name: "closes usage metering of deleted node pools of an existing cluster",
+			billingDocuments: []*billingcosmosstorage.BillingDocument{
+				newTestBillingDocument(testClusterUID, testSubscriptionID, testResourceGroupName, testClusterName, nil),
+			},
+			clusters: []*coreapi.HCPOpenShiftCluster{
+				newTestCluster(t, testClusterUID, coreapi.ProvisioningStateSucceeded, &createdAt),
+			},
+			nodePools: []*coreapi.HCPOpenShiftClusterNodePool{
+				newTestNodePool(nil),
+			},
+			scaleEvents: []*billingcosmosstorage.NodePoolScaleEvent{
+				newTestScaleEvent("event-1", testNodePoolName, "2025-01-20T08:00:00Z", "Standard_D8s_v3", 3),
+				newTestScaleEvent("event-2", "np1", "2025-01-20T09:00:00Z", "Standard_D4s_v3", 2),
+				newTestScaleEvent("event-3", "np2", "2025-01-20T08:00:00Z", "Standard_D4s_v3", 2),
+				newTestScaleEvent("event-4", "np2", "2025-01-20T09:00:00Z", "Standard_D4s_v3", 0),
+			},
+			expectError: false,
+			verify: func(t *testing.T, billingDBClient *billingcosmosstoragetesting.MockBillingDBClient) {
+				events := billingDBClient.GetNodePoolScaleEvents()
+				require.Len(t, events, 5)
+				closing := events[4]
+				assert.Equal(t, "np1", closing.NodePoolResourceID.Name)
+				assert.Equal(t, billingcosmosstorage.NodePoolScaleEventReasonDeleted, closing.Reason)
+				assert.Equal(t, fixedTime, closing.ObservedTime)
+				assert.Zero(t, closing.CurrentReplicas)
+
+				billingDocs := billingDBClient.GetBillingDocuments()
+				assert.Nil(t, billingDocs[testClusterUID].DeletionTime)
+			},
+		},
+
 		{
 			name: "handles multiple billing documents",
 			billingDocuments: []*billingcosmosstorage.BillingDocument{
@@ -188,6 +261,10 @@ func TestOrphanedBillingCleanup_SyncOnce(t *testing.T) {
 				err := billingCRUD.Create(ctx, doc)
 				require.NoError(t, err)
 			}
+			for _, event := range tt.scaleEvents {
+				err := mockBillingDBClient.NodePoolUsage(event.SubscriptionID).CreateScaleEvent(ctx, event)
+				require.NoError(t, err)
+			}
 
 			// Add clusters
 			for _, cluster := range tt.clusters {
@@ -204,6 +281,9 @@ func TestOrphanedBillingCleanup_SyncOnce(t *testing.T) {
 				clusterLister: &corelistertesting.SliceClusterLister{
 					Clusters: tt.clusters,
 				},
+				nodePoolLister: &corelistertesting.SliceNodePoolLister{
+					NodePools: tt.nodePools,
+				},
 				billingLister: &corelistertesting.SliceBillingLister{
 					BillingDocuments: tt.billingDocuments,
 				},
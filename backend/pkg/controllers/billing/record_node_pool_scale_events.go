@@ -0,0 +1,187 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package billing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	utilsclock "k8s.io/utils/clock"
+
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/billingcosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/listers/corelisters"
+	"github.com/Azure/ARO-HCP/internal/ocm"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+type recordNodePoolScaleEvents struct {
+	clock                utilsclock.PassiveClock
+	clusterServiceClient ocm.ClusterServiceClientSpec
+	billingDBClient      billingcosmosstorage.BillingDBClient
+	clusterLister        corelisters.ClusterLister
+	nodePoolLister       corelisters.NodePoolLister
+}
+
+var _ controllerutils.NodePoolSyncer = (*recordNodePoolScaleEvents)(nil)
+
+// NewRecordNodePoolScaleEventsController creates a controller that records a
+// NodePoolScaleEvent whenever the VM size, the requested scaling, or the node
+// count Cluster Service reports for a node pool changes. Only node pools of
+// clusters with a billing document are metered.
+func NewRecordNodePoolScaleEventsController(clock utilsclock.PassiveClock, clusterServiceClient ocm.ClusterServiceClientSpec, billingDBClient billingcosmosstorage.BillingDBClient, clusterLister corelisters.ClusterLister, nodePoolLister corelisters.NodePoolLister) controllerutils.NodePoolSyncer {
+	return &recordNodePoolScaleEvents{
+		clock:                clock,
+		clusterServiceClient: clusterServiceClient,
+		billingDBClient:      billingDBClient,
+		clusterLister:        clusterLister,
+		nodePoolLister:       nodePoolLister,
+	}
+}
+
+func (c *recordNodePoolScaleEvents) SyncOnce(ctx context.Context, keyObj controllerutils.HCPNodePoolKey) error {
+	logger := keyObj.AddLoggerValues(utils.LoggerFromContext(ctx))
+
+	// Node pools removed from Cosmos are closed out by the OrphanedBillingCleanup controller.
+	nodePool, err := c.nodePoolLister.Get(ctx, keyObj.SubscriptionID, keyObj.ResourceGroupName, keyObj.HCPClusterName, keyObj.HCPNodePoolName)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to get node pool from cache: %w", err))
+	}
+
+	cluster, err := c.clusterLister.Get(ctx, keyObj.SubscriptionID, keyObj.ResourceGroupName, keyObj.HCPClusterName)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to get cluster from cache: %w", err))
+	}
+
+	// Usage is attributed to the cluster's billing document, so wait for the
+	// CreateBillingDoc controller to create it.
+	if len(cluster.ServiceProviderProperties.ClusterUID) == 0 || len(cluster.ServiceProviderProperties.BillingDocumentCosmosID) == 0 {
+		return nil
+	}
+
+	usageCRUD := c.billingDBClient.NodePoolUsage(keyObj.SubscriptionID)
+	latest, err := usageCRUD.GetLatestScaleEvent(ctx, nodePool.ID)
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to get latest node pool scale event: %w", err))
+	}
+
+	event, err := c.observeNodePool(ctx, cluster, nodePool)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	if event == nil {
+		return nil
+	}
+	if latest == nil && event.Reason == billingcosmosstorage.NodePoolScaleEventReasonDeleted {
+		// Nothing was ever metered, so there is nothing to close.
+		return nil
+	}
+	if latest != nil && latest.SameScale(event) {
+		if len(event.Reason) > 0 || event.ObservedTime.Sub(latest.ObservedTime) < billingcosmosstorage.NodePoolScaleEventRefreshInterval {
+			return nil
+		}
+		event.Reason = billingcosmosstorage.NodePoolScaleEventReasonRefreshed
+	}
+	if len(event.Reason) == 0 {
+		event.Reason = scaleEventReason(latest, event)
+	}
+
+	err = usageCRUD.CreateScaleEvent(ctx, event)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	logger.Info("recorded node pool scale event",
+		"clusterUID", event.ClusterUID,
+		"reason", event.Reason,
+		"vmSize", event.VMSize,
+		"currentReplicas", event.CurrentReplicas,
+	)
+
+	return nil
+}
+
+// observeNodePool returns the current scale of the node pool as an unsaved
+// NodePoolScaleEvent, or nil if the node pool has not reached Cluster
+// Service yet. A node pool Cluster Service no longer knows about is observed
+// with zero nodes and the Deleted reason.
+func (c *recordNodePoolScaleEvents) observeNodePool(ctx context.Context, cluster *coreapi.HCPOpenShiftCluster, nodePool *coreapi.HCPOpenShiftClusterNodePool) (*billingcosmosstorage.NodePoolScaleEvent, error) {
+	event := billingcosmosstorage.NewNodePoolScaleEvent(uuid.New().String(), nodePool.ID)
+	event.ClusterUID = cluster.ServiceProviderProperties.ClusterUID
+	event.ClusterResourceID = cluster.ID
+	event.ObservedTime = c.clock.Now()
+	event.VMSize = nodePool.Properties.Platform.VMSize
+	event.Replicas = nodePool.Properties.Replicas
+	if nodePool.Properties.AutoScaling != nil {
+		event.AutoScaling = &billingcosmosstorage.NodePoolScaleEventAutoScaling{
+			Min: nodePool.Properties.AutoScaling.Min,
+			Max: nodePool.Properties.AutoScaling.Max,
+		}
+	}
+
+	csID := nodePool.ServiceProviderProperties.ClusterServiceID
+	if csID == nil || len(csID.String()) == 0 {
+		// The Cluster Service ID is only cleared once the Cluster Service node pool is deleted.
+		if nodePool.ServiceProviderProperties.DeletionTimestamp == nil {
+			return nil, nil
+		}
+		event.Reason = billingcosmosstorage.NodePoolScaleEventReasonDeleted
+		return event, nil
+	}
+
+	status, err := c.clusterServiceClient.GetNodePoolStatus(ctx, *csID)
+	var ocmError *ocmerrors.Error
+	if errors.As(err, &ocmError) && ocmError.Status() == http.StatusNotFound {
+		event.Reason = billingcosmosstorage.NodePoolScaleEventReasonDeleted
+		return event, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node pool status from Cluster Service: %w", err)
+	}
+	event.CurrentReplicas = int32(status.CurrentReplicas())
+
+	return event, nil
+}
+
+// scaleEventReason describes what changed between the latest recorded event
+// and a newly observed one.
+func scaleEventReason(latest, observed *billingcosmosstorage.NodePoolScaleEvent) billingcosmosstorage.NodePoolScaleEventReason {
+	switch {
+	case latest == nil, latest.Reason == billingcosmosstorage.NodePoolScaleEventReasonDeleted:
+		return billingcosmosstorage.NodePoolScaleEventReasonCreated
+	case latest.VMSize != observed.VMSize:
+		return billingcosmosstorage.NodePoolScaleEventReasonVMSizeChanged
+	case latest.Replicas != observed.Replicas,
+		(latest.AutoScaling == nil) != (observed.AutoScaling == nil),
+		latest.AutoScaling != nil && *latest.AutoScaling != *observed.AutoScaling:
+		return billingcosmosstorage.NodePoolScaleEventReasonScalingChanged
+	default:
+		return billingcosmosstorage.NodePoolScaleEventReasonNodeCountChanged
+	}
+}
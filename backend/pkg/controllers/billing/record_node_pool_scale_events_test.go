@@ -0,0 +1,326 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package billing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	arohcpv1alpha1 "github.com/openshift-online/ocm-sdk-go/arohcp/v1alpha1"
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/billingcosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/billingcosmosstoragetesting"
+	"github.com/Azure/ARO-HCP/internal/database/listertesting/corelistertesting"
+	"github.com/Azure/ARO-HCP/internal/ocm"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+const (
+	testNodePoolName    = "test-nodepool"
+	testNodePoolCSIDStr = testClusterServiceIDStr + "/node_pools/" + testNodePoolName
+)
+
+func newTestNodePool(mutate func(*coreapi.HCPOpenShiftClusterNodePool)) *coreapi.HCPOpenShiftClusterNodePool {
+	resourceID := newTestNodePoolResourceID(testNodePoolName)
+	nodePool := &coreapi.HCPOpenShiftClusterNodePool{
+		CosmosMetadata: coreapi.CosmosMetadata{
+			ResourceID:   resourceID,
+			PartitionKey: strings.ToLower(resourceID.SubscriptionID),
+		},
+		TrackedResource: coreapi.TrackedResource{
+			Resource: coreapi.Resource{
+				ID:   resourceID,
+				Name: testNodePoolName,
+				Type: resourceID.ResourceType.String(),
+			},
+		},
+		Properties: coreapi.HCPOpenShiftClusterNodePoolProperties{
+			Platform: coreapi.NodePoolPlatformProfile{
+				VMSize: "Standard_D8s_v3",
+			},
+			Replicas: 3,
+		},
+		ServiceProviderProperties: coreapi.HCPOpenShiftClusterNodePoolServiceProviderProperties{
+			ClusterServiceID: metadataapi.Ptr(metadataapi.Must(metadataapi.NewInternalID(testNodePoolCSIDStr))),
+		},
+	}
+	if mutate != nil {
+		mutate(nodePool)
+	}
+	return nodePool
+}
+
+func newTestBilledCluster(t *testing.T) *coreapi.HCPOpenShiftCluster {
+	t.Helper()
+	cluster := newTestCluster(t, testClusterUID, coreapi.ProvisioningStateSucceeded, nil)
+	cluster.ServiceProviderProperties.BillingDocumentCosmosID = testClusterUID
+	return cluster
+}
+
+func TestRecordNodePoolScaleEvents_SyncOnce(t *testing.T) {
+	fixedTime := mustParseTime("2025-01-20T10:30:00Z")
+	csID := metadataapi.Must(metadataapi.NewInternalID(testNodePoolCSIDStr))
+
+	csNodePoolStatus := func(currentReplicas int) *arohcpv1alpha1.NodePoolStatus {
+		return metadataapi.Must(arohcpv1alpha1.NewNodePoolStatus().CurrentReplicas(currentReplicas).Build())
+	}
+	newFakeOCMNotFoundError := func() error {
+		e, _ := ocmerrors.NewError().Status(http.StatusNotFound).Build()
+		return e
+	}
+	// previousEvent is the latest recorded event of the test node pool, matching newTestNodePool with 3 nodes.
+	previousEvent := func() *billingcosmosstorage.NodePoolScaleEvent {
+		return newTestScaleEvent("previous", testNodePoolName, "2025-01-20T09:00:00Z", "Standard_D8s_v3", 3)
+	}
+
+	tests := []struct {
+		name              string
+		cluster           *coreapi.HCPOpenShiftCluster
+		nodePool          *coreapi.HCPOpenShiftClusterNodePool
+		existingEvents    []*billingcosmosstorage.NodePoolScaleEvent
+		setupMockCSClient func(mock *ocm.MockClusterServiceClientSpec)
+		wantErr           bool
+		// wantEvent is the expected new event, nil when no event should be recorded
+		wantEvent *billingcosmosstorage.NodePoolScaleEvent
+	}{
+		{
+			name: "records the first observation of a node pool",
+			nodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.Properties.AutoScaling = &coreapi.NodePoolAutoScaling{Min: 1, Max: 5}
+			}),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePoolStatus(gomock.Any(), csID).Return(csNodePoolStatus(2), nil)
+			},
+			wantEvent: &billingcosmosstorage.NodePoolScaleEvent{
+				Reason:          billingcosmosstorage.NodePoolScaleEventReasonCreated,
+				VMSize:          "Standard_D8s_v3",
+				Replicas:        3,
+				AutoScaling:     &billingcosmosstorage.NodePoolScaleEventAutoScaling{Min: 1, Max: 5},
+				CurrentReplicas: 2,
+			},
+		},
+		{
+			name:           "does not record an unchanged node pool",
+			nodePool:       newTestNodePool(nil),
+			existingEvents: []*billingcosmosstorage.NodePoolScaleEvent{previousEvent()},
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePoolStatus(gomock.Any(), csID).Return(csNodePoolStatus(3), nil)
+			},
+		},
+		{
+			name:     "records an unchanged node pool again before its latest event expires",
+			nodePool: newTestNodePool(nil),
+			existingEvents: []*billingcosmosstorage.NodePoolScaleEvent{
+				newTestScaleEvent("previous", testNodePoolName, "2024-12-20T10:30:00Z", "Standard_D8s_v3", 3),
+			},
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePoolStatus(gomock.Any(), csID).Return(csNodePoolStatus(3), nil)
+			},
+			wantEvent: &billingcosmosstorage.NodePoolScaleEvent{
+				Reason:          billingcosmosstorage.NodePoolScaleEventReasonRefreshed,
+				VMSize:          "Standard_D8s_v3",
+				Replicas:        3,
+				CurrentReplicas: 3,
+			},
+		},
+		{
+			name:           "records a node count change",
+			nodePool:       newTestNodePool(nil),
+			existingEvents: []*billingcosmosstorage.NodePoolScaleEvent{previousEvent()},
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePoolStatus(gomock.Any(), csID).Return(csNodePoolStatus(5), nil)
+			},
+			wantEvent: &billingcosmosstorage.NodePoolScaleEvent{
+				Reason:          billingcosmosstorage.NodePoolScaleEventReasonNodeCountChanged,
+				VMSize:          "Standard_D8s_v3",
+				Replicas:        3,
+				CurrentReplicas: 5,
+			},
+		},
+		{
+			name: "records a VM size change",
+			nodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.Properties.Platform.VMSize = "Standard_D16s_v3"
+			}),
+			existingEvents: []*billingcosmosstorage.NodePoolScaleEvent{previousEvent()},
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePoolStatus(gomock.Any(), csID).Return(csNodePoolStatus(3), nil)
+			},
+			wantEvent: &billingcosmosstorage.NodePoolScaleEvent{
+				Reason:          billingcosmosstorage.NodePoolScaleEventReasonVMSizeChanged,
+				VMSize:          "Standard_D16s_v3",
+				Replicas:        3,
+				CurrentReplicas: 3,
+			},
+		},
+		{
+			name: "records a scaling change",
+			nodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.Properties.Replicas = 0
+				np.Properties.AutoScaling = &coreapi.NodePoolAutoScaling{Min: 3, Max: 10}
+			}),
+			existingEvents: []*billingcosmosstorage.NodePoolScaleEvent{previousEvent()},
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePoolStatus(gomock.Any(), csID).Return(csNodePoolStatus(3), nil)
+			},
+			wantEvent: &billingcosmosstorage.NodePoolScaleEvent{
+				Reason:          billingcosmosstorage.NodePoolScaleEventReasonScalingChanged,
+				VMSize:          "Standard_D8s_v3",
+				AutoScaling:     &billingcosmosstorage.NodePoolScaleEventAutoScaling{Min: 3, Max: 10},
+				CurrentReplicas: 3,
+			},
+		},
+		{
+			name:           "records a deletion when Cluster Service no longer has the node pool",
+			nodePool:       newTestNodePool(nil),
+			existingEvents: []*billingcosmosstorage.NodePoolScaleEvent{previousEvent()},
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePoolStatus(gomock.Any(), csID).Return(nil, newFakeOCMNotFoundError())
+			},
+			wantEvent: &billingcosmosstorage.NodePoolScaleEvent{
+				Reason:   billingcosmosstorage.NodePoolScaleEventReasonDeleted,
+				VMSize:   "Standard_D8s_v3",
+				Replicas: 3,
+			},
+		},
+		{
+			name: "records a deletion when the Cluster Service ID of a deleting node pool was cleared",
+			nodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.ServiceProviderProperties.ClusterServiceID = nil
+				np.ServiceProviderProperties.DeletionTimestamp = &metav1.Time{Time: fixedTime.Add(-time.Hour)}
+			}),
+			existingEvents: []*billingcosmosstorage.NodePoolScaleEvent{previousEvent()},
+			wantEvent: &billingcosmosstorage.NodePoolScaleEvent{
+				Reason:   billingcosmosstorage.NodePoolScaleEventReasonDeleted,
+				VMSize:   "Standard_D8s_v3",
+				Replicas: 3,
+			},
+		},
+		{
+			name:     "does not record a deletion of a node pool that was never metered",
+			nodePool: newTestNodePool(nil),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePoolStatus(gomock.Any(), csID).Return(nil, newFakeOCMNotFoundError())
+			},
+		},
+		{
+			name: "skips node pool not yet created in Cluster Service",
+			nodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.ServiceProviderProperties.ClusterServiceID = nil
+			}),
+		},
+		{
+			name:     "skips cluster without a billing document",
+			cluster:  newTestCluster(t, testClusterUID, coreapi.ProvisioningStateSucceeded, nil),
+			nodePool: newTestNodePool(nil),
+		},
+		{
+			name: "skips node pool missing from the cache",
+		},
+		{
+			name:     "returns error when Cluster Service fails",
+			nodePool: newTestNodePool(nil),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePoolStatus(gomock.Any(), csID).Return(nil, errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctx = utils.ContextWithLogger(ctx, testr.New(t))
+
+			ctrl := gomock.NewController(t)
+			mockCSClient := ocm.NewMockClusterServiceClientSpec(ctrl)
+			if tt.setupMockCSClient != nil {
+				tt.setupMockCSClient(mockCSClient)
+			}
+
+			mockBillingDBClient := billingcosmosstoragetesting.NewMockBillingDBClient()
+			for _, event := range tt.existingEvents {
+				require.NoError(t, mockBillingDBClient.NodePoolUsage(event.SubscriptionID).CreateScaleEvent(ctx, event))
+			}
+
+			cluster := tt.cluster
+			if cluster == nil {
+				cluster = newTestBilledCluster(t)
+			}
+			var nodePools []*coreapi.HCPOpenShiftClusterNodePool
+			if tt.nodePool != nil {
+				nodePools = append(nodePools, tt.nodePool)
+			}
+
+			syncer := NewRecordNodePoolScaleEventsController(
+				clocktesting.NewFakePassiveClock(fixedTime),
+				mockCSClient,
+				mockBillingDBClient,
+				&corelistertesting.SliceClusterLister{Clusters: []*coreapi.HCPOpenShiftCluster{cluster}},
+				&corelistertesting.SliceNodePoolLister{NodePools: nodePools},
+			)
+
+			err := syncer.SyncOnce(ctx, controllerutils.HCPNodePoolKey{
+				SubscriptionID:    testSubscriptionID,
+				ResourceGroupName: testResourceGroupName,
+				HCPClusterName:    testClusterName,
+				HCPNodePoolName:   testNodePoolName,
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			events := mockBillingDBClient.GetNodePoolScaleEvents()
+			if tt.wantEvent == nil {
+				assert.Len(t, events, len(tt.existingEvents))
+				return
+			}
+
+			require.Len(t, events, len(tt.existingEvents)+1)
+			got := events[len(events)-1]
+			assert.NotEmpty(t, got.ID)
+			assert.Equal(t, 7776000, got.TimeToLive, "scale events expire after 90 days")
+			assert.Equal(t, billingcosmosstorage.UsageDocumentTypeNodePoolScaleEvent, got.DocumentType)
+			assert.Equal(t, testSubscriptionID, got.SubscriptionID)
+			assert.Equal(t, testClusterUID, got.ClusterUID)
+			assert.Equal(t, newTestClusterResourceID(t).String(), got.ClusterResourceID.String())
+			assert.Equal(t, newTestNodePoolResourceID(testNodePoolName).String(), got.NodePoolResourceID.String())
+			assert.Equal(t, fixedTime, got.ObservedTime)
+			assert.Equal(t, tt.wantEvent.Reason, got.Reason)
+			assert.Equal(t, tt.wantEvent.VMSize, got.VMSize)
+			assert.Equal(t, tt.wantEvent.Replicas, got.Replicas)
+			assert.Equal(t, tt.wantEvent.AutoScaling, got.AutoScaling)
+			assert.Equal(t, tt.wantEvent.CurrentReplicas, got.CurrentReplicas)
+		})
+	}
+}
@@ -49,6 +49,15 @@ import (
 	"github.com/Azure/ARO-HCP/internal/utils"
 )
 
+// Customer-visible phases of a cluster creation.
+const (
+	clusterCreatePhaseClusterServiceAccepted   = "ClusterServiceAccepted"
+	clusterCreatePhaseControlPlaneAvailable    = "ControlPlaneAvailable"
+	clusterCreatePhaseAPIServerPublished       = "APIServerPublished"
+	clusterCreatePhaseServingCABundlePublished = "ServingCABundlePublished"
+	clusterCreatePhaseClusterServiceReady      = "ClusterServiceReady"
+)
+
 type operationClusterCreate struct {
 	clock                                 utilsclock.PassiveClock
 	activeOperationLister                 corelisters.ActiveOperationLister
@@ -156,7 +165,7 @@ func (c *operationClusterCreate) SynchronizeOperation(ctx context.Context, key c
 	if !c.shouldReconcileOperationAndResourceStatus(cluster) {
 		return nil
 	}
-	operationalState, err := c.determineOperationState(ctx, operation, cluster)
+	operationalState, phases, err := c.determineOperationState(ctx, operation, cluster)
 	if err != nil {
 		return utils.TrackError(err)
 	}
@@ -191,7 +200,7 @@ func (c *operationClusterCreate) SynchronizeOperation(ctx context.Context, key c
 	}
 
 	logger.Info("updating status")
-	err = operationbase.UpdateOperationStatus(ctx, c.clock, c.resourcesDBClient, operation, operationalState.ProvisioningState, persistErr, operationbase.PostAsyncNotificationFn(c.notificationClient))
+	err = operationbase.UpdateOperationStatusWithDetails(ctx, c.clock, c.resourcesDBClient, operation, operationalState.ProvisioningState, persistErr, operationbase.NewOperationStatusDetailsFromPhases(phases), operationbase.PostAsyncNotificationFn(c.notificationClient))
 	if cosmosstorageutils.IsPreconditionFailedError(err) {
 		return nil
 	}
@@ -202,52 +211,68 @@ func (c *operationClusterCreate) SynchronizeOperation(ctx context.Context, key c
 	return nil
 }
 
-func (c *operationClusterCreate) determineOperationState(ctx context.Context, operation *coreapi.Operation, cluster *coreapi.HCPOpenShiftCluster) (*operationbase.OperationState, error) {
+// determineOperationState returns the overall state of the creation along with
+// the customer-visible phases, in the order they usually complete.
+func (c *operationClusterCreate) determineOperationState(ctx context.Context, operation *coreapi.Operation, cluster *coreapi.HCPOpenShiftCluster) (*operationbase.OperationState, []coreapi.OperationPhase, error) {
 	logger := utils.LoggerFromContext(ctx)
 
 	errs := []error{}
 	operationStates := []*operationbase.OperationState{}
 
-	if currState, err := c.hostedClusterOperationStatus(ctx, operation); err != nil {
+	hostedClusterState, err := c.hostedClusterOperationStatus(ctx, operation)
+	if err != nil {
 		errs = append(errs, utils.TrackError(err))
 	} else {
-		operationStates = append(operationStates, currState.WithSource("hypershiftHostedCluster"))
+		operationStates = append(operationStates, hostedClusterState.WithSource("hypershiftHostedCluster"))
 	}
-	if currState, err := c.clusterOperationStatus(ctx, operation); err != nil {
+	cosmosClusterState, err := c.clusterOperationStatus(ctx, operation)
+	if err != nil {
 		errs = append(errs, utils.TrackError(err))
 	} else {
-		operationStates = append(operationStates, currState.WithSource("cosmosCluster"))
+		operationStates = append(operationStates, cosmosClusterState.WithSource("cosmosCluster"))
 	}
-	if currState, err := c.clusterServiceCreateOperationState(ctx, operation, cluster); err != nil {
+	clusterServiceState, err := c.clusterServiceCreateOperationState(ctx, operation, cluster)
+	if err != nil {
 		errs = append(errs, utils.TrackError(err))
 	} else {
-		operationStates = append(operationStates, currState.WithSource("clusterServiceClusterStatus"))
+		operationStates = append(operationStates, clusterServiceState.WithSource("clusterServiceClusterStatus"))
 	}
-	if currState, err := c.servingCABundleOperationStatus(ctx, operation); err != nil {
+	servingCABundleState, err := c.servingCABundleOperationStatus(ctx, operation)
+	if err != nil {
 		errs = append(errs, utils.TrackError(err))
 	} else {
-		operationStates = append(operationStates, currState.WithSource("servingCABundle"))
+		operationStates = append(operationStates, servingCABundleState.WithSource("servingCABundle"))
 	}
 
 	if err := errors.Join(errs...); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	// cheap and easy backup check for potential accidents in future code.
 	if len(operationStates) == 0 {
-		return nil, errors.New("no operation states")
+		return nil, nil, errors.New("no operation states")
+	}
+
+	// The controller only runs once Cluster Service has accepted the cluster.
+	phases := []coreapi.OperationPhase{
+		operationbase.NewOperationPhase(clusterCreatePhaseClusterServiceAccepted, true),
+		operationbase.OperationPhaseFromState(clusterCreatePhaseControlPlaneAvailable, hostedClusterState),
+		operationbase.OperationPhaseFromState(clusterCreatePhaseAPIServerPublished, cosmosClusterState),
+		operationbase.OperationPhaseFromState(clusterCreatePhaseServingCABundlePublished, servingCABundleState),
+		operationbase.OperationPhaseFromState(clusterCreatePhaseClusterServiceReady, clusterServiceState),
 	}
+
 	slices.SortStableFunc(operationStates, operationbase.CompareOperationState)
 	if operationStates[0] == nil {
-		return nil, errors.New("nil operation state")
+		return nil, nil, errors.New("nil operation state")
 	}
 	logger.Info("determined cluster create operation status", "operationStates", operationStates)
 
 	picked, err := operationbase.PickWorstOperationState(operationStates)
 	if err != nil {
-		return nil, utils.TrackError(err)
+		return nil, nil, utils.TrackError(err)
 	}
-	logger.Info("picked cluster create operation status", "provisioningState", picked.ProvisioningState, "message", picked.Message)
-	return picked, nil
+	logger.Info("picked cluster create operation status", "provisioningState", picked.ProvisioningState, "message", picked.Message, "phases", phases)
+	return picked, phases, nil
 }
 
 func (c *operationClusterCreate) clusterServiceCreateOperationState(ctx context.Context, operation *coreapi.Operation, cluster *coreapi.HCPOpenShiftCluster) (*operationbase.OperationState, error) {
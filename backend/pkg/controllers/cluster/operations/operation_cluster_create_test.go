@@ -35,6 +35,7 @@ import (
 	configv1 "github.com/openshift/api/config/v1"
 	"github.com/openshift/hypershift/api/hypershift/v1beta1"
 
+	operationbase "github.com/Azure/ARO-HCP/backend/pkg/utils/operationutils"
 	operationtesting "github.com/Azure/ARO-HCP/backend/pkg/utils/operationutils/operationtesting"
 	"github.com/Azure/ARO-HCP/internal/api/coreapi"
 	"github.com/Azure/ARO-HCP/internal/api/kubeapplierapi"
@@ -122,6 +123,14 @@ func TestOperationClusterCreate_SynchronizeOperation(t *testing.T) {
 				op, err := db.Operations(operationtesting.TestSubscriptionID).Get(ctx, operationtesting.TestOperationName)
 				require.NoError(t, err)
 				assert.Equal(t, coreapi.ProvisioningStateProvisioning, op.Status)
+				assert.Equal(t, float64(80), op.PercentComplete)
+				assert.Equal(t, []coreapi.OperationPhase{
+					{Name: clusterCreatePhaseClusterServiceAccepted, Status: coreapi.OperationPhaseStatusCompleted},
+					{Name: clusterCreatePhaseControlPlaneAvailable, Status: coreapi.OperationPhaseStatusCompleted},
+					{Name: clusterCreatePhaseAPIServerPublished, Status: coreapi.OperationPhaseStatusCompleted},
+					{Name: clusterCreatePhaseServingCABundlePublished, Status: coreapi.OperationPhaseStatusCompleted},
+					{Name: clusterCreatePhaseClusterServiceReady, Status: coreapi.OperationPhaseStatusPending},
+				}, op.Phases)
 			},
 		},
 		{
@@ -753,7 +762,7 @@ func TestDetermineOperationState(t *testing.T) {
 				},
 			}
 
-			result, err := controller.determineOperationState(ctx, operation, cluster)
+			result, phases, err := controller.determineOperationState(ctx, operation, cluster)
 
 			if tt.expectError {
 				require.Error(t, err)
@@ -766,6 +775,11 @@ func TestDetermineOperationState(t *testing.T) {
 			require.NoError(t, err)
 			require.NotNil(t, result)
 			assert.Equal(t, tt.expectedState, result.ProvisioningState)
+			if tt.expectedState == coreapi.ProvisioningStateSucceeded {
+				assert.Equal(t, float64(100), operationbase.PercentComplete(phases))
+			} else {
+				assert.Less(t, operationbase.PercentComplete(phases), float64(100))
+			}
 			if tt.wantMessageSubstr != "" {
 				assert.Contains(t, result.Message, tt.wantMessageSubstr)
 			}
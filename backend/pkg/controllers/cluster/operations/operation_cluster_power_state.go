@@ -0,0 +1,74 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/corecosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+// Customer-visible phases of the cluster stop and start actions.
+const (
+	clusterStopPhaseNodePoolsScaledDown  = "NodePoolsScaledDown"
+	clusterStopPhaseControlPlaneStopped  = "ControlPlaneStopped"
+	clusterStartPhaseControlPlaneStarted = "ControlPlaneStarted"
+	clusterStartPhaseNodePoolsRestored   = "NodePoolsRestored"
+)
+
+// replaceNodePoolScaling reads the node pool from Cosmos DB, applies mutate to
+// a copy and writes it back if mutate reports a change. The node pool cluster
+// service update dispatch controller then carries the new scaling over to
+// Cluster Service. The informer cache may lag behind our own writes, so mutate
+// must decide from the node pool it is given rather than the cached one.
+func replaceNodePoolScaling(ctx context.Context, resourcesDBClient corecosmosstorage.ResourcesDBClient, cachedNodePool *coreapi.HCPOpenShiftClusterNodePool, mutate func(*coreapi.HCPOpenShiftClusterNodePool) bool) error {
+	nodePoolID := cachedNodePool.ID
+	nodePoolCRUD := resourcesDBClient.HCPClusters(nodePoolID.SubscriptionID, nodePoolID.ResourceGroupName).NodePools(nodePoolID.Parent.Name)
+
+	nodePool, err := nodePoolCRUD.Get(ctx, nodePoolID.Name)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to get node pool: %w", err))
+	}
+
+	replacement := nodePool.DeepCopy()
+	if !mutate(replacement) {
+		return nil
+	}
+
+	_, err = nodePoolCRUD.Replace(ctx, replacement, nil)
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to replace node pool: %w", err))
+	}
+	return nil
+}
+
+// activeNodePools drops node pools that are being deleted, since their
+// scaling no longer matters.
+func activeNodePools(nodePools []*coreapi.HCPOpenShiftClusterNodePool) []*coreapi.HCPOpenShiftClusterNodePool {
+	active := make([]*coreapi.HCPOpenShiftClusterNodePool, 0, len(nodePools))
+	for _, nodePool := range nodePools {
+		if nodePool.ServiceProviderProperties.DeletionTimestamp == nil {
+			active = append(active, nodePool)
+		}
+	}
+	return active
+}
@@ -0,0 +1,266 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	utilsclock "k8s.io/utils/clock"
+
+	arohcpv1alpha1 "github.com/openshift-online/ocm-sdk-go/arohcp/v1alpha1"
+
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
+	operationbase "github.com/Azure/ARO-HCP/backend/pkg/utils/operationutils"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/corecosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/informers/coreinformers"
+	"github.com/Azure/ARO-HCP/internal/database/listers/corelisters"
+	"github.com/Azure/ARO-HCP/internal/ocm"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+type operationClusterStart struct {
+	clock                  utilsclock.PassiveClock
+	resourcesDBClient      corecosmosstorage.ResourcesDBClient
+	clusterServiceClient   ocm.ClusterServiceClientSpec
+	clusterLister          corelisters.ClusterLister
+	nodePoolLister         corelisters.NodePoolLister
+	activeOperationsLister corelisters.ActiveOperationLister
+	notificationClient     *http.Client
+}
+
+// NewOperationClusterStartController returns a new Controller instance that
+// resumes a stopped cluster and follows the start operation to completion.
+//
+// The hosted control plane is resumed through Cluster Service first. Once it
+// is ready, every node pool gets back the replicas and autoscaling bounds it
+// had before the cluster was stopped, and the operation completes when each
+// node pool is back to that size.
+//
+// Operation documents relevant to this controller will have the following values:
+//
+//	ResourceType: Microsoft.RedHatOpenShift/hcpOpenShiftClusters
+//	     Request: Start
+//	      Status: any non-terminal value
+func NewOperationClusterStartController(
+	clock utilsclock.PassiveClock,
+	resourcesDBClient corecosmosstorage.ResourcesDBClient,
+	clusterServiceClient ocm.ClusterServiceClientSpec,
+	notificationClient *http.Client,
+	activeOperationInformer cache.SharedIndexInformer,
+	backendInformers coreinformers.BackendInformers,
+) controllerutils.Controller {
+	_, clusterLister := backendInformers.Clusters()
+	_, nodePoolLister := backendInformers.NodePools()
+	_, activeOperationsLister := backendInformers.ActiveOperations()
+
+	syncer := &operationClusterStart{
+		clock:                  clock,
+		resourcesDBClient:      resourcesDBClient,
+		clusterServiceClient:   clusterServiceClient,
+		clusterLister:          clusterLister,
+		nodePoolLister:         nodePoolLister,
+		activeOperationsLister: activeOperationsLister,
+		notificationClient:     notificationClient,
+	}
+
+	controller := controllerutils.NewGenericOperationController(
+		"OperationClusterStart",
+		syncer,
+		10*time.Second,
+		activeOperationInformer,
+		resourcesDBClient,
+	)
+
+	return controller
+}
+
+func (c *operationClusterStart) ShouldProcess(ctx context.Context, operation *coreapi.Operation) bool {
+	if operation.Status.IsTerminal() {
+		return false
+	}
+	if operation.Request != cosmosstorageutils.OperationRequestClusterStart {
+		return false
+	}
+	if operation.ExternalID == nil || !strings.EqualFold(operation.ExternalID.ResourceType.String(), coreapi.ClusterResourceType.String()) {
+		return false
+	}
+	return true
+}
+
+func (c *operationClusterStart) SynchronizeOperation(ctx context.Context, key controllerutils.OperationKey) error {
+	logger := utils.LoggerFromContext(ctx)
+	logger.Info("checking operation")
+
+	operation, err := c.activeOperationsLister.Get(ctx, key.SubscriptionID, key.OperationName)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return nil // no work to do
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get active operation: %w", err)
+	}
+	if !c.ShouldProcess(ctx, operation) {
+		return nil // no work to do
+	}
+
+	cluster, err := c.clusterLister.Get(ctx, operation.ExternalID.SubscriptionID, operation.ExternalID.ResourceGroupName, operation.ExternalID.Name)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		logger.Info("cluster not found in cache, waiting")
+		return nil // no work to do
+	}
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to get cluster: %w", err))
+	}
+	if operation.ResourceID.Name != cluster.ServiceProviderProperties.ActiveOperationID {
+		logger.Info("cluster active operation id mismatch, returning early", "synchronizedActiveOperationID", operation.ResourceID.Name, "clusterActiveOperationID", cluster.ServiceProviderProperties.ActiveOperationID)
+		return nil
+	}
+	if cluster.ServiceProviderProperties.ClusterServiceID == nil {
+		return nil // no work to do
+	}
+
+	operationalState, controlPlaneStarted, err := c.startControlPlane(ctx, *cluster.ServiceProviderProperties.ClusterServiceID)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	nodePoolsRestored := false
+	if controlPlaneStarted {
+		nodePoolsRestored, err = c.restoreNodePools(ctx, cluster)
+		if err != nil {
+			return utils.TrackError(err)
+		}
+		if nodePoolsRestored {
+			operationalState = operationbase.NewOperationState(coreapi.ProvisioningStateSucceeded, "")
+		} else {
+			operationalState = operationbase.NewOperationState(coreapi.ProvisioningStateUpdating, "waiting for node pools to scale up")
+		}
+	}
+
+	var persistErr *coreapi.CloudErrorBody
+	if operationalState.ProvisioningState == coreapi.ProvisioningStateFailed {
+		persistErr = &coreapi.CloudErrorBody{
+			Code:    coreapi.CloudErrorCodeInternalServerError,
+			Message: operationalState.Message,
+		}
+	}
+
+	details := operationbase.NewOperationStatusDetailsFromPhases([]coreapi.OperationPhase{
+		operationbase.NewOperationPhase(clusterStartPhaseControlPlaneStarted, controlPlaneStarted),
+		operationbase.NewOperationPhase(clusterStartPhaseNodePoolsRestored, nodePoolsRestored),
+	})
+	details.StatusMessage = operationalState.Message
+
+	logger.Info("updating status")
+	err = operationbase.UpdateOperationStatusWithDetails(ctx, c.clock, c.resourcesDBClient, operation, operationalState.ProvisioningState, persistErr, details, operationbase.PostAsyncNotificationFn(c.notificationClient))
+	if cosmosstorageutils.IsPreconditionFailedError(err) {
+		// if we have a conflict error, then we're guaranteed that our informer will eventually see an update and trigger us again.
+		return nil
+	}
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	return nil
+}
+
+// startControlPlane resumes the hosted control plane through Cluster Service
+// and reports whether it is ready again.
+func (c *operationClusterStart) startControlPlane(ctx context.Context, clusterCSID ocm.InternalID) (*operationbase.OperationState, bool, error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	clusterStatus, err := c.clusterServiceClient.GetClusterStatus(ctx, clusterCSID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get cluster status from Cluster Service: %w", err)
+	}
+
+	switch state := clusterStatus.State(); state {
+	case arohcpv1alpha1.ClusterStateReady:
+		return operationbase.NewOperationState(coreapi.ProvisioningStateUpdating, ""), true, nil
+	case arohcpv1alpha1.ClusterStateError:
+		message := "The control plane could not be started."
+		if description := clusterStatus.Description(); len(description) > 0 {
+			message += " " + description
+		}
+		return operationbase.NewOperationState(coreapi.ProvisioningStateFailed, message), false, nil
+	case arohcpv1alpha1.ClusterStateHibernating:
+		logger.Info("resuming control plane", "clusterServiceID", clusterCSID.String())
+		if err := c.clusterServiceClient.ResumeCluster(ctx, clusterCSID); err != nil {
+			return nil, false, fmt.Errorf("failed to resume cluster in Cluster Service: %w", err)
+		}
+	default:
+		logger.Info("waiting for control plane to start", "state", state)
+	}
+
+	return operationbase.NewOperationState(coreapi.ProvisioningStateUpdating, "waiting for the control plane to start"), false, nil
+}
+
+// restoreNodePools gives every node pool back the scaling it had before the
+// cluster was stopped and returns true once each one has reached it in
+// Cluster Service.
+func (c *operationClusterStart) restoreNodePools(ctx context.Context, cluster *coreapi.HCPOpenShiftCluster) (bool, error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	nodePools, err := c.nodePoolLister.ListForCluster(ctx, cluster.ID.SubscriptionID, cluster.ID.ResourceGroupName, cluster.ID.Name)
+	if err != nil {
+		return false, fmt.Errorf("failed to list node pools: %w", err)
+	}
+
+	restored := true
+	for _, nodePool := range activeNodePools(nodePools) {
+		if stoppedScaling := nodePool.ServiceProviderProperties.StoppedScaling; stoppedScaling != nil {
+			logger.Info("restoring node pool scaling", "nodePool", nodePool.ID.String(), "replicas", stoppedScaling.Replicas)
+			err := replaceNodePoolScaling(ctx, c.resourcesDBClient, nodePool, func(nodePool *coreapi.HCPOpenShiftClusterNodePool) bool {
+				stoppedScaling := nodePool.ServiceProviderProperties.StoppedScaling
+				if stoppedScaling == nil {
+					return false
+				}
+				nodePool.Properties.Replicas = stoppedScaling.Replicas
+				nodePool.Properties.AutoScaling = stoppedScaling.AutoScaling
+				nodePool.ServiceProviderProperties.StoppedScaling = nil
+				return true
+			})
+			if err != nil {
+				return false, err
+			}
+			restored = false
+			continue
+		}
+
+		nodePoolCSID := nodePool.ServiceProviderProperties.ClusterServiceID
+		if nodePoolCSID == nil {
+			continue
+		}
+		desiredReplicas := nodePool.Properties.Replicas
+		if nodePool.Properties.AutoScaling != nil {
+			desiredReplicas = nodePool.Properties.AutoScaling.Min
+		}
+		status, err := c.clusterServiceClient.GetNodePoolStatus(ctx, *nodePoolCSID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get node pool status from Cluster Service: %w", err)
+		}
+		if status.CurrentReplicas() < int(desiredReplicas) {
+			logger.Info("waiting for node pool to scale up", "nodePool", nodePool.ID.String(), "currentReplicas", status.CurrentReplicas(), "desiredReplicas", desiredReplicas)
+			restored = false
+		}
+	}
+
+	return restored, nil
+}
@@ -0,0 +1,259 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	utilsclock "k8s.io/utils/clock"
+
+	arohcpv1alpha1 "github.com/openshift-online/ocm-sdk-go/arohcp/v1alpha1"
+
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
+	operationbase "github.com/Azure/ARO-HCP/backend/pkg/utils/operationutils"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/corecosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/informers/coreinformers"
+	"github.com/Azure/ARO-HCP/internal/database/listers/corelisters"
+	"github.com/Azure/ARO-HCP/internal/ocm"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+type operationClusterStop struct {
+	clock                  utilsclock.PassiveClock
+	resourcesDBClient      corecosmosstorage.ResourcesDBClient
+	clusterServiceClient   ocm.ClusterServiceClientSpec
+	clusterLister          corelisters.ClusterLister
+	nodePoolLister         corelisters.NodePoolLister
+	activeOperationsLister corelisters.ActiveOperationLister
+	notificationClient     *http.Client
+}
+
+// NewOperationClusterStopController returns a new Controller instance that
+// hibernates a cluster and follows the stop operation to completion.
+//
+// Every node pool is first scaled to zero, with its previous replicas and
+// autoscaling bounds recorded on the node pool so a later start can restore
+// them. Once no node pool has nodes left, the hosted control plane is
+// hibernated through Cluster Service.
+//
+// Operation documents relevant to this controller will have the following values:
+//
+//	ResourceType: Microsoft.RedHatOpenShift/hcpOpenShiftClusters
+//	     Request: Stop
+//	      Status: any non-terminal value
+func NewOperationClusterStopController(
+	clock utilsclock.PassiveClock,
+	resourcesDBClient corecosmosstorage.ResourcesDBClient,
+	clusterServiceClient ocm.ClusterServiceClientSpec,
+	notificationClient *http.Client,
+	activeOperationInformer cache.SharedIndexInformer,
+	backendInformers coreinformers.BackendInformers,
+) controllerutils.Controller {
+	_, clusterLister := backendInformers.Clusters()
+	_, nodePoolLister := backendInformers.NodePools()
+	_, activeOperationsLister := backendInformers.ActiveOperations()
+
+	syncer := &operationClusterStop{
+		clock:                  clock,
+		resourcesDBClient:      resourcesDBClient,
+		clusterServiceClient:   clusterServiceClient,
+		clusterLister:          clusterLister,
+		nodePoolLister:         nodePoolLister,
+		activeOperationsLister: activeOperationsLister,
+		notificationClient:     notificationClient,
+	}
+
+	controller := controllerutils.NewGenericOperationController(
+		"OperationClusterStop",
+		syncer,
+		10*time.Second,
+		activeOperationInformer,
+		resourcesDBClient,
+	)
+
+	return controller
+}
+
+func (c *operationClusterStop) ShouldProcess(ctx context.Context, operation *coreapi.Operation) bool {
+	if operation.Status.IsTerminal() {
+		return false
+	}
+	if operation.Request != cosmosstorageutils.OperationRequestClusterStop {
+		return false
+	}
+	if operation.ExternalID == nil || !strings.EqualFold(operation.ExternalID.ResourceType.String(), coreapi.ClusterResourceType.String()) {
+		return false
+	}
+	return true
+}
+
+func (c *operationClusterStop) SynchronizeOperation(ctx context.Context, key controllerutils.OperationKey) error {
+	logger := utils.LoggerFromContext(ctx)
+	logger.Info("checking operation")
+
+	operation, err := c.activeOperationsLister.Get(ctx, key.SubscriptionID, key.OperationName)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return nil // no work to do
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get active operation: %w", err)
+	}
+	if !c.ShouldProcess(ctx, operation) {
+		return nil // no work to do
+	}
+
+	cluster, err := c.clusterLister.Get(ctx, operation.ExternalID.SubscriptionID, operation.ExternalID.ResourceGroupName, operation.ExternalID.Name)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		logger.Info("cluster not found in cache, waiting")
+		return nil // no work to do
+	}
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to get cluster: %w", err))
+	}
+	if operation.ResourceID.Name != cluster.ServiceProviderProperties.ActiveOperationID {
+		logger.Info("cluster active operation id mismatch, returning early", "synchronizedActiveOperationID", operation.ResourceID.Name, "clusterActiveOperationID", cluster.ServiceProviderProperties.ActiveOperationID)
+		return nil
+	}
+	if cluster.ServiceProviderProperties.ClusterServiceID == nil {
+		return nil // no work to do
+	}
+
+	nodePoolsScaledDown, err := c.scaleDownNodePools(ctx, cluster)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	operationalState := operationbase.NewOperationState(coreapi.ProvisioningStateUpdating, "waiting for node pools to scale down")
+	controlPlaneStopped := false
+	if nodePoolsScaledDown {
+		operationalState, controlPlaneStopped, err = c.stopControlPlane(ctx, *cluster.ServiceProviderProperties.ClusterServiceID)
+		if err != nil {
+			return utils.TrackError(err)
+		}
+	}
+
+	var persistErr *coreapi.CloudErrorBody
+	if operationalState.ProvisioningState == coreapi.ProvisioningStateFailed {
+		persistErr = &coreapi.CloudErrorBody{
+			Code:    coreapi.CloudErrorCodeInternalServerError,
+			Message: operationalState.Message,
+		}
+	}
+
+	details := operationbase.NewOperationStatusDetailsFromPhases([]coreapi.OperationPhase{
+		operationbase.NewOperationPhase(clusterStopPhaseNodePoolsScaledDown, nodePoolsScaledDown),
+		operationbase.NewOperationPhase(clusterStopPhaseControlPlaneStopped, controlPlaneStopped),
+	})
+	details.StatusMessage = operationalState.Message
+
+	logger.Info("updating status")
+	err = operationbase.UpdateOperationStatusWithDetails(ctx, c.clock, c.resourcesDBClient, operation, operationalState.ProvisioningState, persistErr, details, operationbase.PostAsyncNotificationFn(c.notificationClient))
+	if cosmosstorageutils.IsPreconditionFailedError(err) {
+		// if we have a conflict error, then we're guaranteed that our informer will eventually see an update and trigger us again.
+		return nil
+	}
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	return nil
+}
+
+// scaleDownNodePools scales every node pool of the cluster to zero and
+// returns true once none of them has any nodes left in Cluster Service.
+func (c *operationClusterStop) scaleDownNodePools(ctx context.Context, cluster *coreapi.HCPOpenShiftCluster) (bool, error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	nodePools, err := c.nodePoolLister.ListForCluster(ctx, cluster.ID.SubscriptionID, cluster.ID.ResourceGroupName, cluster.ID.Name)
+	if err != nil {
+		return false, fmt.Errorf("failed to list node pools: %w", err)
+	}
+
+	scaledDown := true
+	for _, nodePool := range activeNodePools(nodePools) {
+		if nodePool.ServiceProviderProperties.StoppedScaling == nil {
+			logger.Info("scaling node pool to zero", "nodePool", nodePool.ID.String(), "replicas", nodePool.Properties.Replicas)
+			err := replaceNodePoolScaling(ctx, c.resourcesDBClient, nodePool, func(nodePool *coreapi.HCPOpenShiftClusterNodePool) bool {
+				if nodePool.ServiceProviderProperties.StoppedScaling != nil {
+					return false
+				}
+				nodePool.ServiceProviderProperties.StoppedScaling = &coreapi.NodePoolStoppedScaling{
+					Replicas:    nodePool.Properties.Replicas,
+					AutoScaling: nodePool.Properties.AutoScaling,
+				}
+				nodePool.Properties.Replicas = 0
+				nodePool.Properties.AutoScaling = nil
+				return true
+			})
+			if err != nil {
+				return false, err
+			}
+			scaledDown = false
+			continue
+		}
+
+		nodePoolCSID := nodePool.ServiceProviderProperties.ClusterServiceID
+		if nodePoolCSID == nil {
+			continue
+		}
+		status, err := c.clusterServiceClient.GetNodePoolStatus(ctx, *nodePoolCSID)
+		if err != nil {
+			return false, fmt.Errorf("failed to get node pool status from Cluster Service: %w", err)
+		}
+		if status.CurrentReplicas() > 0 {
+			logger.Info("waiting for node pool to scale down", "nodePool", nodePool.ID.String(), "currentReplicas", status.CurrentReplicas())
+			scaledDown = false
+		}
+	}
+
+	return scaledDown, nil
+}
+
+// stopControlPlane hibernates the hosted control plane through Cluster
+// Service and reports whether it has finished powering down.
+func (c *operationClusterStop) stopControlPlane(ctx context.Context, clusterCSID ocm.InternalID) (*operationbase.OperationState, bool, error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	clusterStatus, err := c.clusterServiceClient.GetClusterStatus(ctx, clusterCSID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get cluster status from Cluster Service: %w", err)
+	}
+
+	switch state := clusterStatus.State(); state {
+	case arohcpv1alpha1.ClusterStateHibernating:
+		return operationbase.NewOperationState(coreapi.ProvisioningStateSucceeded, ""), true, nil
+	case arohcpv1alpha1.ClusterStateError:
+		message := "The control plane could not be stopped."
+		if description := clusterStatus.Description(); len(description) > 0 {
+			message += " " + description
+		}
+		return operationbase.NewOperationState(coreapi.ProvisioningStateFailed, message), false, nil
+	case arohcpv1alpha1.ClusterStateReady:
+		logger.Info("hibernating control plane", "clusterServiceID", clusterCSID.String())
+		if err := c.clusterServiceClient.HibernateCluster(ctx, clusterCSID); err != nil {
+			return nil, false, fmt.Errorf("failed to hibernate cluster in Cluster Service: %w", err)
+		}
+	default:
+		logger.Info("waiting for control plane to stop", "state", state)
+	}
+
+	return operationbase.NewOperationState(coreapi.ProvisioningStateUpdating, "waiting for the control plane to stop"), false, nil
+}
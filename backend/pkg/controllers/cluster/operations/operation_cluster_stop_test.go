@@ -0,0 +1,241 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	utilsclock "k8s.io/utils/clock"
+
+	arohcpv1alpha1 "github.com/openshift-online/ocm-sdk-go/arohcp/v1alpha1"
+
+	operationtesting "github.com/Azure/ARO-HCP/backend/pkg/utils/operationutils/operationtesting"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/corecosmosstoragetesting"
+	"github.com/Azure/ARO-HCP/internal/database/listertesting/corelistertesting"
+	"github.com/Azure/ARO-HCP/internal/ocm"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+func newPowerStateTestNodePool(mutate func(*coreapi.HCPOpenShiftClusterNodePool)) *coreapi.HCPOpenShiftClusterNodePool {
+	nodePool := operationtesting.NewNodePoolTestFixture().NewNodePool()
+	nodePool.Properties.ProvisioningState = coreapi.ProvisioningStateSucceeded
+	nodePool.Properties.Replicas = 3
+	nodePool.ServiceProviderProperties.ActiveOperationID = ""
+	if mutate != nil {
+		mutate(nodePool)
+	}
+	return nodePool
+}
+
+func getPowerStateTestNodePool(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) *coreapi.HCPOpenShiftClusterNodePool {
+	nodePool, err := db.HCPClusters(operationtesting.TestSubscriptionID, operationtesting.TestResourceGroupName).NodePools(operationtesting.TestClusterName).Get(ctx, operationtesting.TestNodePoolName)
+	require.NoError(t, err)
+	return nodePool
+}
+
+func TestOperationClusterStop_SynchronizeOperation(t *testing.T) {
+	fixture := operationtesting.NewClusterTestFixture()
+	nodePoolInternalID := operationtesting.NewNodePoolTestFixture().NodePoolInternalID
+
+	stoppedNodePool := func(np *coreapi.HCPOpenShiftClusterNodePool) {
+		np.ServiceProviderProperties.StoppedScaling = &coreapi.NodePoolStoppedScaling{Replicas: 3}
+		np.Properties.Replicas = 0
+	}
+	clusterStatus := func(state arohcpv1alpha1.ClusterState, description string) *arohcpv1alpha1.ClusterStatus {
+		status, _ := arohcpv1alpha1.NewClusterStatus().State(state).Description(description).Build()
+		return status
+	}
+	nodePoolStatus := func(currentReplicas int) *arohcpv1alpha1.NodePoolStatus {
+		status, _ := arohcpv1alpha1.NewNodePoolStatus().CurrentReplicas(currentReplicas).Build()
+		return status
+	}
+	getOperation := func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) *coreapi.Operation {
+		op, err := db.Operations(operationtesting.TestSubscriptionID).Get(ctx, operationtesting.TestOperationName)
+		require.NoError(t, err)
+		return op
+	}
+	getCluster := func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) *coreapi.HCPOpenShiftCluster {
+		cluster, err := db.HCPClusters(operationtesting.TestSubscriptionID, operationtesting.TestResourceGroupName).Get(ctx, operationtesting.TestClusterName)
+		require.NoError(t, err)
+		return cluster
+	}
+
+	testCases := []struct {
+		name        string
+		cluster     func() *coreapi.HCPOpenShiftCluster
+		nodePool    *coreapi.HCPOpenShiftClusterNodePool
+		setupCSMock func(mockCSClient *ocm.MockClusterServiceClientSpec)
+		verifyDB    func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient)
+	}{
+		{
+			name:     "node pool scaling is recorded and set to zero",
+			nodePool: newPowerStateTestNodePool(nil),
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				nodePool := getPowerStateTestNodePool(t, ctx, db)
+				assert.Equal(t, int32(0), nodePool.Properties.Replicas)
+				assert.Equal(t, &coreapi.NodePoolStoppedScaling{Replicas: 3}, nodePool.ServiceProviderProperties.StoppedScaling)
+
+				op := getOperation(t, ctx, db)
+				assert.Equal(t, coreapi.ProvisioningStateUpdating, op.Status)
+				assert.Equal(t, "waiting for node pools to scale down", op.StatusMessage)
+			},
+		},
+		{
+			name: "node pool autoscaling is recorded and removed",
+			nodePool: newPowerStateTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.Properties.Replicas = 0
+				np.Properties.AutoScaling = &coreapi.NodePoolAutoScaling{Min: 2, Max: 5}
+			}),
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				nodePool := getPowerStateTestNodePool(t, ctx, db)
+				assert.Nil(t, nodePool.Properties.AutoScaling)
+				assert.Equal(t, &coreapi.NodePoolStoppedScaling{AutoScaling: &coreapi.NodePoolAutoScaling{Min: 2, Max: 5}}, nodePool.ServiceProviderProperties.StoppedScaling)
+			},
+		},
+		{
+			name:     "waits for node pools to drain in Cluster Service",
+			nodePool: newPowerStateTestNodePool(stoppedNodePool),
+			setupCSMock: func(mockCSClient *ocm.MockClusterServiceClientSpec) {
+				mockCSClient.EXPECT().GetNodePoolStatus(gomock.Any(), nodePoolInternalID).Return(nodePoolStatus(2), nil)
+			},
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				op := getOperation(t, ctx, db)
+				assert.Equal(t, coreapi.ProvisioningStateUpdating, op.Status)
+				assert.Equal(t, "waiting for node pools to scale down", op.StatusMessage)
+			},
+		},
+		{
+			name:     "hibernates a ready control plane once node pools are empty",
+			nodePool: newPowerStateTestNodePool(stoppedNodePool),
+			setupCSMock: func(mockCSClient *ocm.MockClusterServiceClientSpec) {
+				mockCSClient.EXPECT().GetNodePoolStatus(gomock.Any(), nodePoolInternalID).Return(nodePoolStatus(0), nil)
+				mockCSClient.EXPECT().GetClusterStatus(gomock.Any(), fixture.ClusterInternalID).Return(clusterStatus(arohcpv1alpha1.ClusterStateReady, ""), nil)
+				mockCSClient.EXPECT().HibernateCluster(gomock.Any(), fixture.ClusterInternalID).Return(nil)
+			},
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				op := getOperation(t, ctx, db)
+				assert.Equal(t, coreapi.ProvisioningStateUpdating, op.Status)
+				assert.Equal(t, "waiting for the control plane to stop", op.StatusMessage)
+				assert.Equal(t, []coreapi.OperationPhase{
+					{Name: clusterStopPhaseNodePoolsScaledDown, Status: coreapi.OperationPhaseStatusCompleted},
+					{Name: clusterStopPhaseControlPlaneStopped, Status: coreapi.OperationPhaseStatusPending},
+				}, op.Phases)
+			},
+		},
+		{
+			name:     "hibernated control plane completes the operation",
+			nodePool: newPowerStateTestNodePool(stoppedNodePool),
+			setupCSMock: func(mockCSClient *ocm.MockClusterServiceClientSpec) {
+				mockCSClient.EXPECT().GetNodePoolStatus(gomock.Any(), nodePoolInternalID).Return(nodePoolStatus(0), nil)
+				mockCSClient.EXPECT().GetClusterStatus(gomock.Any(), fixture.ClusterInternalID).Return(clusterStatus(arohcpv1alpha1.ClusterStateHibernating, ""), nil)
+			},
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				op := getOperation(t, ctx, db)
+				assert.Equal(t, coreapi.ProvisioningStateSucceeded, op.Status)
+				assert.Nil(t, op.Error)
+
+				cluster := getCluster(t, ctx, db)
+				assert.Equal(t, coreapi.PowerStateStopped, cluster.ServiceProviderProperties.PowerState)
+				assert.Empty(t, cluster.ServiceProviderProperties.ActiveOperationID)
+			},
+		},
+		{
+			name:     "control plane error fails the operation",
+			nodePool: newPowerStateTestNodePool(stoppedNodePool),
+			setupCSMock: func(mockCSClient *ocm.MockClusterServiceClientSpec) {
+				mockCSClient.EXPECT().GetNodePoolStatus(gomock.Any(), nodePoolInternalID).Return(nodePoolStatus(0), nil)
+				mockCSClient.EXPECT().GetClusterStatus(gomock.Any(), fixture.ClusterInternalID).Return(clusterStatus(arohcpv1alpha1.ClusterStateError, "etcd is unavailable"), nil)
+			},
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				op := getOperation(t, ctx, db)
+				assert.Equal(t, coreapi.ProvisioningStateFailed, op.Status)
+				require.NotNil(t, op.Error)
+				assert.Equal(t, "The control plane could not be stopped. etcd is unavailable", op.Error.Message)
+
+				cluster := getCluster(t, ctx, db)
+				assert.Equal(t, coreapi.PowerStateStopping, cluster.ServiceProviderProperties.PowerState)
+			},
+		},
+		{
+			name: "active operation mismatch is ignored",
+			cluster: func() *coreapi.HCPOpenShiftCluster {
+				cluster := fixture.NewCluster(nil)
+				cluster.ServiceProviderProperties.ActiveOperationID = "other-operation"
+				return cluster
+			},
+			nodePool: newPowerStateTestNodePool(nil),
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				assert.Equal(t, coreapi.ProvisioningStateAccepted, getOperation(t, ctx, db).Status)
+				assert.Nil(t, getPowerStateTestNodePool(t, ctx, db).ServiceProviderProperties.StoppedScaling)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+			ctrl := gomock.NewController(t)
+
+			cluster := fixture.NewCluster(nil)
+			if tc.cluster != nil {
+				cluster = tc.cluster()
+			}
+			cluster.ServiceProviderProperties.PowerState = coreapi.PowerStateStopping
+
+			resources := []any{cluster, tc.nodePool, fixture.NewOperation(cosmosstorageutils.OperationRequestClusterStop)}
+			mockResourcesDBClient, err := corecosmosstoragetesting.NewMockResourcesDBClientWithResources(ctx, resources)
+			require.NoError(t, err)
+
+			mockCSClient := ocm.NewMockClusterServiceClientSpec(ctrl)
+			if tc.setupCSMock != nil {
+				tc.setupCSMock(mockCSClient)
+			}
+
+			controller := &operationClusterStop{
+				clock:                  utilsclock.RealClock{},
+				resourcesDBClient:      mockResourcesDBClient,
+				clusterServiceClient:   mockCSClient,
+				clusterLister:          &corelistertesting.DBClusterLister{ResourcesDBClient: mockResourcesDBClient},
+				nodePoolLister:         &corelistertesting.DBNodePoolLister{ResourcesDBClient: mockResourcesDBClient},
+				activeOperationsLister: &corelistertesting.DBActiveOperationLister{ResourcesDBClient: mockResourcesDBClient},
+			}
+
+			err = controller.SynchronizeOperation(ctx, fixture.OperationKey())
+			require.NoError(t, err)
+
+			tc.verifyDB(t, ctx, mockResourcesDBClient)
+		})
+	}
+}
+
+func TestOperationClusterStop_ShouldProcess(t *testing.T) {
+	fixture := operationtesting.NewClusterTestFixture()
+	controller := &operationClusterStop{}
+
+	assert.True(t, controller.ShouldProcess(context.Background(), fixture.NewOperation(cosmosstorageutils.OperationRequestClusterStop)))
+	assert.False(t, controller.ShouldProcess(context.Background(), fixture.NewOperation(cosmosstorageutils.OperationRequestClusterStart)))
+
+	succeeded := fixture.NewOperation(cosmosstorageutils.OperationRequestClusterStop)
+	succeeded.Status = coreapi.ProvisioningStateSucceeded
+	assert.False(t, controller.ShouldProcess(context.Background(), succeeded))
+}
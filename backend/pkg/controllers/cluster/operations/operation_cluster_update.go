@@ -159,7 +159,7 @@ func (c *operationClusterUpdate) SynchronizeOperation(ctx context.Context, key c
 		return nil // no work to do
 	}
 
-	operationalState, err := c.determineOperationState(ctx, operation, existingCluster)
+	operationalState, statusMessage, err := c.determineOperationState(ctx, operation, existingCluster)
 	if err != nil {
 		return utils.TrackError(err)
 	}
@@ -173,7 +173,7 @@ func (c *operationClusterUpdate) SynchronizeOperation(ctx context.Context, key c
 	}
 
 	logger.Info("updating status")
-	err = operationbase.UpdateOperationStatus(ctx, c.clock, c.resourcesDBClient, operation, operationalState.ProvisioningState, persistErr, operationbase.PostAsyncNotificationFn(c.notificationClient))
+	err = operationbase.UpdateOperationStatusWithDetails(ctx, c.clock, c.resourcesDBClient, operation, operationalState.ProvisioningState, persistErr, operationbase.OperationStatusDetails{StatusMessage: statusMessage}, operationbase.PostAsyncNotificationFn(c.notificationClient))
 	if cosmosstorageutils.IsPreconditionFailedError(err) {
 		// if we have a conflict error, then we're guaranteed that our informer will eventually see an update and trigger us again.
 		return nil
@@ -184,18 +184,20 @@ func (c *operationClusterUpdate) SynchronizeOperation(ctx context.Context, key c
 	return nil
 }
 
-func (c *operationClusterUpdate) determineOperationState(ctx context.Context, operation *coreapi.Operation, existingCluster *coreapi.HCPOpenShiftCluster) (*operationbase.OperationState, error) {
+// determineOperationState returns the worst operation state across all sources, along with
+// a customer facing status message describing what the operation is waiting on, if anything.
+func (c *operationClusterUpdate) determineOperationState(ctx context.Context, operation *coreapi.Operation, existingCluster *coreapi.HCPOpenShiftCluster) (*operationbase.OperationState, string, error) {
 	logger := utils.LoggerFromContext(ctx)
 
 	clusterCSID := existingCluster.ServiceProviderProperties.ClusterServiceID
 	existingCSCluster, err := c.clusterServiceClient.GetCluster(ctx, *clusterCSID)
 	if err != nil {
-		return nil, utils.TrackError(fmt.Errorf("failed to get cluster from cluster service: %w", err))
+		return nil, "", utils.TrackError(fmt.Errorf("failed to get cluster from cluster service: %w", err))
 	}
 
 	existingServiceProviderCluster, err := c.serviceProviderClusterLister.Get(ctx, operation.ExternalID.SubscriptionID, operation.ExternalID.ResourceGroupName, operation.ExternalID.Name)
 	if err != nil {
-		return nil, utils.TrackError(fmt.Errorf("failed to get service provider cluster from cache: %w", err))
+		return nil, "", utils.TrackError(fmt.Errorf("failed to get service provider cluster from cache: %w", err))
 	}
 
 	errs := []error{}
@@ -227,24 +229,72 @@ func (c *operationClusterUpdate) determineOperationState(ctx context.Context, op
 	} else {
 		operationStates = append(operationStates, operationState.WithSource("hypershiftControlPlaneClusterAutoscaler"))
 	}
+	statusMessage, maintenanceWindowErr := c.maintenanceWindowStatusMessage(ctx, existingCluster, existingServiceProviderCluster)
+	if maintenanceWindowErr != nil {
+		errs = append(errs, utils.TrackError(maintenanceWindowErr))
+	} else {
+		operationStates = append(operationStates, operationbase.MaintenanceWindowOperationState(statusMessage).WithSource("maintenanceWindow"))
+	}
 
 	if err := errors.Join(errs...); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if len(operationStates) == 0 {
-		return nil, errors.New("no operation states")
+		return nil, "", errors.New("no operation states")
 	}
 	slices.SortStableFunc(operationStates, operationbase.CompareOperationState)
 	if operationStates[0] == nil {
-		return nil, errors.New("nil operation state")
+		return nil, "", errors.New("nil operation state")
 	}
 	logger.Info("determined cluster update operation status", "operationStates", operationStates)
 	picked, err := operationbase.PickWorstOperationState(operationStates)
 	if err != nil {
-		return nil, utils.TrackError(err)
+		return nil, "", utils.TrackError(err)
 	}
 	logger.Info("picked cluster update operation status", "picked", picked)
-	return picked, nil
+	return picked, statusMessage, nil
+}
+
+// maintenanceWindowStatusMessage returns a status message while a pending control plane
+// upgrade is held back by the cluster's maintenance window, or an empty string otherwise.
+// The TriggerControlPlaneUpgrade controller starts the upgrade once the window opens; an
+// upgrade it already started keeps running after the window closes and is not waiting.
+func (c *operationClusterUpdate) maintenanceWindowStatusMessage(ctx context.Context, existingCluster *coreapi.HCPOpenShiftCluster, spc *coreapi.ServiceProviderCluster) (string, error) {
+	desiredVersion := spc.Spec.ControlPlaneVersion.DesiredVersion
+	activeVersions := spc.Status.ControlPlaneVersion.ActiveVersions
+	if desiredVersion == nil || len(activeVersions) == 0 || activeVersions[0].Version == nil || desiredVersion.EQ(*activeVersions[0].Version) {
+		return "", nil
+	}
+	message, err := operationbase.MaintenanceWindowStatusMessage(existingCluster.CustomerProperties.MaintenanceWindow, c.clock.Now())
+	if err != nil || len(message) == 0 {
+		return message, err
+	}
+
+	triggered, err := c.controlPlaneUpgradeTriggered(ctx, existingCluster, desiredVersion)
+	if err != nil {
+		return "", err
+	}
+	if triggered {
+		return "", nil
+	}
+	return message, nil
+}
+
+// controlPlaneUpgradeTriggered reports whether the latest control plane upgrade policy in
+// Cluster Service is for desiredVersion, which is how TriggerControlPlaneUpgrade starts an upgrade.
+func (c *operationClusterUpdate) controlPlaneUpgradeTriggered(ctx context.Context, existingCluster *coreapi.HCPOpenShiftCluster, desiredVersion *semver.Version) (bool, error) {
+	iterator := c.clusterServiceClient.ListControlPlaneUpgradePolicies(*existingCluster.ServiceProviderProperties.ClusterServiceID, "creation_timestamp desc")
+
+	triggered := false
+	for policy := range iterator.Items(ctx) {
+		latestPolicyVersion, ok := policy.GetVersion()
+		triggered = ok && latestPolicyVersion == desiredVersion.String()
+		break // Only need to check the first policy
+	}
+	if err := iterator.GetError(); err != nil {
+		return false, utils.TrackError(fmt.Errorf("failed to list control plane upgrade policies: %w", err))
+	}
+	return triggered, nil
 }
 
 func (c *operationClusterUpdate) desiredVersionResolutionOperationState(ctx context.Context, operation *coreapi.Operation, existingCluster *coreapi.HCPOpenShiftCluster, spc *coreapi.ServiceProviderCluster) (*operationbase.OperationState, error) {
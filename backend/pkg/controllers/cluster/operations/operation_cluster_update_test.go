@@ -183,6 +183,70 @@ func TestOperationClusterUpdate_SynchronizeOperation(t *testing.T) {
 				assert.Empty(t, cluster.ServiceProviderProperties.ActiveOperationID)
 			},
 		},
+		{
+			name: "closed maintenance window keeps operation updating with status message",
+			existingCluster: newClusterWithCustomerVersion("4.19", func(cluster *coreapi.HCPOpenShiftCluster) {
+				cluster.CustomerProperties.MaintenanceWindow = &coreapi.MaintenanceWindowProfile{
+					DaysOfWeek:    []metadataapi.DayOfWeek{metadataapi.DayOfWeekSunday},
+					StartTime:     "02:00",
+					DurationHours: 4,
+				}
+			}),
+			existingOperation: newOperationAccepted(),
+			existingServiceProviderCluster: func() *coreapi.ServiceProviderCluster {
+				spc := newServiceProviderClusterWithSpecControlPlaneVersion("4.19.2")
+				spc.Spec.ControlPlaneVersion.DesiredVersion = ptr.To(semver.MustParse("4.19.5"))
+				return spc
+			}(),
+			cachedHostedClusterReadDesire: newPassingCachedHostedClusterReadDesire(),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().
+					GetCluster(gomock.Any(), fixture.ClusterInternalID).
+					Return(newCSClusterWithState(arohcpv1alpha1.ClusterStateReady), nil)
+				previousPolicy := metadataapi.Must(arohcpv1alpha1.NewControlPlaneUpgradePolicy().Version("4.19.2").Build())
+				mock.EXPECT().
+					ListControlPlaneUpgradePolicies(fixture.ClusterInternalID, "creation_timestamp desc").
+					Return(ocm.NewSimpleControlPlaneUpgradePolicyListIterator([]*arohcpv1alpha1.ControlPlaneUpgradePolicy{previousPolicy}, nil))
+			},
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				op, err := db.Operations(operationtesting.TestSubscriptionID).Get(ctx, operationtesting.TestOperationName)
+				require.NoError(t, err)
+				assert.Equal(t, coreapi.ProvisioningStateUpdating, op.Status)
+				// 2024-06-01 is a Saturday, so the window next opens on Sunday.
+				assert.Equal(t, "waiting for maintenance window; next opening at 2024-06-02T02:00:00Z", op.StatusMessage)
+			},
+		},
+		{
+			name: "upgrade triggered before the maintenance window closed is not reported as waiting",
+			existingCluster: newClusterWithCustomerVersion("4.19", func(cluster *coreapi.HCPOpenShiftCluster) {
+				cluster.CustomerProperties.MaintenanceWindow = &coreapi.MaintenanceWindowProfile{
+					DaysOfWeek:    []metadataapi.DayOfWeek{metadataapi.DayOfWeekSunday},
+					StartTime:     "02:00",
+					DurationHours: 4,
+				}
+			}),
+			existingOperation: newOperationAccepted(),
+			existingServiceProviderCluster: func() *coreapi.ServiceProviderCluster {
+				spc := newServiceProviderClusterWithSpecControlPlaneVersion("4.19.2")
+				spc.Spec.ControlPlaneVersion.DesiredVersion = ptr.To(semver.MustParse("4.19.5"))
+				return spc
+			}(),
+			cachedHostedClusterReadDesire: newPassingCachedHostedClusterReadDesire(),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().
+					GetCluster(gomock.Any(), fixture.ClusterInternalID).
+					Return(newCSClusterWithState(arohcpv1alpha1.ClusterStateReady), nil)
+				triggeredPolicy := metadataapi.Must(arohcpv1alpha1.NewControlPlaneUpgradePolicy().Version("4.19.5").Build())
+				mock.EXPECT().
+					ListControlPlaneUpgradePolicies(fixture.ClusterInternalID, "creation_timestamp desc").
+					Return(ocm.NewSimpleControlPlaneUpgradePolicyListIterator([]*arohcpv1alpha1.ControlPlaneUpgradePolicy{triggeredPolicy}, nil))
+			},
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				op, err := db.Operations(operationtesting.TestSubscriptionID).Get(ctx, operationtesting.TestOperationName)
+				require.NoError(t, err)
+				assert.Empty(t, op.StatusMessage)
+			},
+		},
 		{
 			name:                           "cs cluster updating transitions operation to updating",
 			existingCluster:                newClusterWithCustomerVersion("4.19"),
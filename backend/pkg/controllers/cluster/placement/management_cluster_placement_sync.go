@@ -17,6 +17,7 @@ package placement
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
@@ -77,8 +78,20 @@ func NewManagementClusterPlacementSyncController(
 }
 
 // needsWork checks if the ServiceProviderCluster still needs its ManagementClusterResourceID resolved.
-func (c *managementClusterPlacementSyncer) needsWork(spc *coreapi.ServiceProviderCluster) bool {
-	return spc.Status.ManagementClusterResourceID == nil
+// HCPs placed on a draining management cluster are re-resolved so that the
+// placement follows them once Cluster Service has migrated them elsewhere.
+func (c *managementClusterPlacementSyncer) needsWork(ctx context.Context, spc *coreapi.ServiceProviderCluster) bool {
+	if spc.Status.ManagementClusterResourceID == nil {
+		return true
+	}
+	if spc.Status.ManagementClusterResourceID.Parent == nil {
+		return false
+	}
+	managementCluster, err := c.managementClusterLister.Get(ctx, spc.Status.ManagementClusterResourceID.Parent.Name)
+	if err != nil {
+		return false
+	}
+	return managementCluster.Spec.Drain != nil
 }
 
 // SyncOnce resolves the management cluster placement for a single HCP cluster.
@@ -96,7 +109,7 @@ func (c *managementClusterPlacementSyncer) SyncOnce(ctx context.Context, key con
 	if err != nil {
 		return utils.TrackError(fmt.Errorf("failed to get ServiceProviderCluster from cache: %w", err))
 	}
-	if !c.needsWork(cachedSPC) {
+	if !c.needsWork(ctx, cachedSPC) {
 		logger.V(1).Info("ServiceProviderCluster already has ManagementClusterResourceID, skipping")
 		return nil
 	}
@@ -126,7 +139,7 @@ func (c *managementClusterPlacementSyncer) SyncOnce(ctx context.Context, key con
 		return utils.TrackError(fmt.Errorf("failed to get ServiceProviderCluster: %w", err))
 	}
 	// check if we need to do work again. Sometimes the live data is more fresh than the cache
-	if !c.needsWork(existingSPC) {
+	if !c.needsWork(ctx, existingSPC) {
 		logger.V(1).Info("ServiceProviderCluster already has ManagementClusterResourceID (live read), skipping")
 		return nil
 	}
@@ -152,6 +165,13 @@ func (c *managementClusterPlacementSyncer) SyncOnce(ctx context.Context, key con
 		return utils.TrackError(fmt.Errorf("failed to resolve provision shard %q to management cluster: %w", provisionShardID.Path(), err))
 	}
 
+	// A draining management cluster keeps its HCPs until they are migrated.
+	if existingSPC.Status.ManagementClusterResourceID != nil &&
+		strings.EqualFold(existingSPC.Status.ManagementClusterResourceID.String(), managementCluster.ResourceID.String()) {
+		logger.V(1).Info("management cluster placement unchanged")
+		return nil
+	}
+
 	// Set the ManagementClusterResourceID on the ServiceProviderCluster
 	replacement := existingSPC.DeepCopy()
 	replacement.Status.ManagementClusterResourceID = managementCluster.ResourceID
@@ -47,6 +47,8 @@ const (
 	testClusterServiceIDStr   = "/api/clusters_mgmt/v1/clusters/abc123"
 	testProvisionShardIDStr   = "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"
 	testMgmtClusterName       = "mc1"
+
+	testMigrationTargetShardIDStr = "ffffffff-bbbb-cccc-dddd-eeeeeeeeeeee"
 )
 
 func testClusterResourceID() *azcorearm.ResourceID {
@@ -115,6 +117,27 @@ func newTestManagementCluster() *fleetapi.ManagementCluster {
 	}
 }
 
+func newDrainingTestManagementCluster() *fleetapi.ManagementCluster {
+	mc := newTestManagementCluster()
+	mc.Spec.SchedulingPolicy = fleetapi.ManagementClusterSchedulingPolicyUnschedulable
+	mc.Spec.Drain = &fleetapi.ManagementClusterDrain{Reason: "decommission", MaxConcurrentMigrations: 1}
+	return mc
+}
+
+func newMigrationTargetTestManagementCluster() *fleetapi.ManagementCluster {
+	resourceID := metadataapi.Must(fleetapi.ToManagementClusterResourceID("mc2"))
+	return &fleetapi.ManagementCluster{
+		CosmosMetadata: coreapi.CosmosMetadata{
+			ResourceID:   resourceID,
+			PartitionKey: "mc2",
+		},
+		ResourceID: resourceID,
+		Status: fleetapi.ManagementClusterStatus{
+			ClusterServiceProvisionShardID: ptr.To(metadataapi.Must(metadataapi.NewInternalID(testProvisionShardHREF(testMigrationTargetShardIDStr)))),
+		},
+	}
+}
+
 func testProvisionShardHREF(shardID string) string {
 	return "/api/aro_hcp/v1alpha1/provision_shards/" + shardID
 }
@@ -192,6 +215,34 @@ func TestManagementClusterPlacementSyncer_SyncOnce(t *testing.T) {
 			expectError:                         false,
 			expectedManagementClusterResourceID: testMgmtClusterResourceID().String(),
 		},
+		{
+			name: "draining management cluster - placement follows migration",
+			existingSPC: newTestSPC(func(spc *coreapi.ServiceProviderCluster) {
+				spc.Status.ManagementClusterResourceID = testMgmtClusterResourceID()
+			}),
+			cachedCluster: newTestHCPCluster(),
+			csShard: metadataapi.Must(arohcpv1alpha1.NewProvisionShard().
+				HREF(testProvisionShardHREF(testMigrationTargetShardIDStr)).
+				Build()),
+			managementClusters:                  []*fleetapi.ManagementCluster{newDrainingTestManagementCluster(), newMigrationTargetTestManagementCluster()},
+			expectCSCall:                        true,
+			expectError:                         false,
+			expectedManagementClusterResourceID: newMigrationTargetTestManagementCluster().ResourceID.String(),
+		},
+		{
+			name: "draining management cluster - not migrated yet",
+			existingSPC: newTestSPC(func(spc *coreapi.ServiceProviderCluster) {
+				spc.Status.ManagementClusterResourceID = testMgmtClusterResourceID()
+			}),
+			cachedCluster: newTestHCPCluster(),
+			csShard: metadataapi.Must(arohcpv1alpha1.NewProvisionShard().
+				HREF(testProvisionShardHREF(testProvisionShardIDStr)).
+				Build()),
+			managementClusters:                  []*fleetapi.ManagementCluster{newDrainingTestManagementCluster(), newMigrationTargetTestManagementCluster()},
+			expectCSCall:                        true,
+			expectError:                         false,
+			expectedManagementClusterResourceID: testMgmtClusterResourceID().String(),
+		},
 		{
 			name:                                "error - CS call fails",
 			cachedSPC:                           newTestSPC(),
@@ -0,0 +1,373 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package properties
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilsclock "k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	hsv1beta1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+
+	"github.com/Azure/ARO-HCP/backend/pkg/kubeapplierhelpers"
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/corecosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/informers/coreinformers"
+	"github.com/Azure/ARO-HCP/internal/database/listers/corelisters"
+	"github.com/Azure/ARO-HCP/internal/database/listers/kubeapplierlisters"
+	unionkubeapplierinformers "github.com/Azure/ARO-HCP/internal/database/unioninformers/kubeapplier"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+const (
+	ControlPlaneRightSizingControllerName = "ControlPlaneRightSizing"
+
+	// controlPlaneSizeStabilizationPeriod is how long a recommendation must
+	// hold before it is applied, so a short burst does not resize the control
+	// plane.
+	controlPlaneSizeStabilizationPeriod = 30 * time.Minute
+
+	// controlPlaneSizeApplyCooldown is the minimum time between two sizes
+	// applied by the controller.
+	controlPlaneSizeApplyCooldown = 6 * time.Hour
+
+	// controlPlaneSizeDownsizeHeadroomPercent is the headroom a cluster must
+	// have in a smaller tier before the recommendation steps down to it.
+	controlPlaneSizeDownsizeHeadroomPercent = 25
+)
+
+// controlPlaneSizeTiers lists the control plane sizes from smallest to
+// largest together with the largest number of worker nodes and the highest
+// kube-apiserver request rate each is sized for. The node ranges follow the
+// ClusterSizingConfiguration deployed by the HyperShift operator.
+var controlPlaneSizeTiers = []struct {
+	size                 coreapi.HostedClusterControlPlaneSize
+	maxNodes             int32
+	maxRequestsPerSecond int64
+}{
+	{size: coreapi.HostedClusterControlPlaneSizeSmall, maxNodes: 60, maxRequestsPerSecond: 400},
+	{size: coreapi.HostedClusterControlPlaneSizeMedium, maxNodes: 120, maxRequestsPerSecond: 800},
+	{size: coreapi.HostedClusterControlPlaneSizeLarge, maxNodes: 252, maxRequestsPerSecond: 1600},
+	{size: coreapi.HostedClusterControlPlaneSizeXlarge, maxNodes: 360, maxRequestsPerSecond: 2400},
+	{size: coreapi.HostedClusterControlPlaneSizeXXlarge, maxNodes: math.MaxInt32, maxRequestsPerSecond: math.MaxInt64},
+}
+
+// kubeAPIServerRequestRateMetricPrefix selects the metrics of the
+// kube-apiserver HorizontalPodAutoscaler that measure the API request rate.
+const kubeAPIServerRequestRateMetricPrefix = "apiserver_request"
+
+// controlPlaneSizeIndex returns the position of size in controlPlaneSizeTiers,
+// or -1 if it is not a known tier. Sizes are compared case-insensitively.
+func controlPlaneSizeIndex(size string) int {
+	for i, tier := range controlPlaneSizeTiers {
+		if strings.EqualFold(string(tier.size), size) {
+			return i
+		}
+	}
+	return -1
+}
+
+// controlPlaneSizeIndexForNodes returns the smallest tier sized for nodes.
+func controlPlaneSizeIndexForNodes(nodes int32) int {
+	for i, tier := range controlPlaneSizeTiers {
+		if nodes <= tier.maxNodes {
+			return i
+		}
+	}
+	return len(controlPlaneSizeTiers) - 1
+}
+
+// controlPlaneSizeIndexForRequestRate returns the smallest tier sized for
+// requestsPerSecond.
+func controlPlaneSizeIndexForRequestRate(requestsPerSecond int64) int {
+	for i, tier := range controlPlaneSizeTiers {
+		if requestsPerSecond <= tier.maxRequestsPerSecond {
+			return i
+		}
+	}
+	return len(controlPlaneSizeTiers) - 1
+}
+
+// controlPlaneSizeSignals are the observations a recommendation is computed from.
+type controlPlaneSizeSignals struct {
+	nodeCount            int32
+	maxNodeCount         int32
+	autoscalerSize       string
+	apiRequestsPerSecond int64
+}
+
+// recommendControlPlaneSize returns the tier the signals call for and the
+// reason for it. current is the size the cluster runs at, or was last
+// recommended, and is used to apply hysteresis: the recommendation grows as
+// soon as the signals require it but only shrinks once the cluster fits the
+// smaller tier with controlPlaneSizeDownsizeHeadroomPercent to spare.
+func recommendControlPlaneSize(signals controlPlaneSizeSignals, current string) (string, string) {
+	// Size for half of the autoscaling ceiling when that exceeds the current
+	// node count, so clusters that can burst are sized before they do.
+	sizingNodes := max(signals.nodeCount, (signals.maxNodeCount+1)/2)
+	index := controlPlaneSizeIndexForNodes(sizingNodes)
+	reason := fmt.Sprintf("sized for %d worker nodes (%d observed, %d maximum)", sizingNodes, signals.nodeCount, signals.maxNodeCount)
+
+	if requestRateIndex := controlPlaneSizeIndexForRequestRate(signals.apiRequestsPerSecond); requestRateIndex > index {
+		index = requestRateIndex
+		reason = fmt.Sprintf("sized for %d API requests per second", signals.apiRequestsPerSecond)
+	}
+
+	if autoscalerIndex := controlPlaneSizeIndex(signals.autoscalerSize); autoscalerIndex > index {
+		index = autoscalerIndex
+		reason = fmt.Sprintf("control plane autoscaler recommends %s", signals.autoscalerSize)
+	}
+
+	if currentIndex := controlPlaneSizeIndex(current); currentIndex > index {
+		headroomIndex := max(
+			controlPlaneSizeIndexForNodes(sizingNodes+sizingNodes*controlPlaneSizeDownsizeHeadroomPercent/100),
+			controlPlaneSizeIndexForRequestRate(signals.apiRequestsPerSecond+signals.apiRequestsPerSecond*controlPlaneSizeDownsizeHeadroomPercent/100),
+		)
+		if headroomIndex >= currentIndex {
+			return string(controlPlaneSizeTiers[currentIndex].size), fmt.Sprintf("keeping %s until %d worker nodes and %d API requests per second fit a smaller size with %d%% headroom", current, sizingNodes, signals.apiRequestsPerSecond, controlPlaneSizeDownsizeHeadroomPercent)
+		}
+		index = max(index, headroomIndex)
+	}
+
+	return string(controlPlaneSizeTiers[index].size), reason
+}
+
+// controlPlaneRightSizingSyncer records a control plane size recommendation in
+// ServiceProviderCluster.Status.ControlPlaneSizeRecommendation from the node
+// pools of the cluster, the API request rate reported by the mirrored
+// kube-apiserver HorizontalPodAutoscaler and the control plane autoscaler
+// recommendation on the mirrored HostedCluster.
+//
+// When autoApply is set, a recommendation that held for
+// controlPlaneSizeStabilizationPeriod is copied into
+// Spec.DesiredHostedClusterControlPlaneSize, at most once per
+// controlPlaneSizeApplyCooldown, unless the size is pinned. Any size the
+// controller did not apply itself is pinned unless SRE unpinned it through the
+// admin API. The cluster update dispatch controller then applies it to
+// cluster-service like any other size override.
+type controlPlaneRightSizingSyncer struct {
+	clock                        utilsclock.PassiveClock
+	serviceProviderClusterLister corelisters.ServiceProviderClusterLister
+	nodePoolLister               corelisters.NodePoolLister
+	readDesireLister             kubeapplierlisters.ReadDesireLister
+	resourcesDBClient            corecosmosstorage.ResourcesDBClient
+	autoApply                    bool
+}
+
+var _ controllerutils.ClusterSyncer = (*controlPlaneRightSizingSyncer)(nil)
+
+// NewControlPlaneRightSizingController creates a controller that recommends a
+// control plane size for every cluster and, when autoApply is set, applies it.
+func NewControlPlaneRightSizingController(
+	clock utilsclock.PassiveClock,
+	resourcesDBClient corecosmosstorage.ResourcesDBClient,
+	informers coreinformers.BackendInformers,
+	kubeApplierInformers *unionkubeapplierinformers.UnionKubeApplierInformers,
+	readDesireLister kubeapplierlisters.ReadDesireLister,
+	autoApply bool,
+) controllerutils.Controller {
+	_, serviceProviderClusterLister := informers.ServiceProviderClusters()
+	_, nodePoolLister := informers.NodePools()
+
+	syncer := &controlPlaneRightSizingSyncer{
+		clock:                        clock,
+		serviceProviderClusterLister: serviceProviderClusterLister,
+		nodePoolLister:               nodePoolLister,
+		readDesireLister:             readDesireLister,
+		resourcesDBClient:            resourcesDBClient,
+		autoApply:                    autoApply,
+	}
+
+	return controllerutils.NewClusterWatchingController(
+		ControlPlaneRightSizingControllerName,
+		resourcesDBClient,
+		informers,
+		kubeApplierInformers,
+		5*time.Minute,
+		syncer,
+	)
+}
+
+func (c *controlPlaneRightSizingSyncer) SyncOnce(ctx context.Context, key controllerutils.HCPClusterKey) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	existing, err := c.serviceProviderClusterLister.Get(ctx, key.SubscriptionID, key.ResourceGroupName, key.HCPClusterName)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to get ServiceProviderCluster from cache: %w", err))
+	}
+
+	signals, err := c.observeSignals(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	previous := existing.Status.ControlPlaneSizeRecommendation
+	current := ptr.Deref(existing.Spec.DesiredHostedClusterControlPlaneSize, "")
+	if len(current) == 0 && previous != nil {
+		current = previous.Size
+	}
+	size, reason := recommendControlPlaneSize(signals, current)
+
+	now := c.clock.Now()
+	recommendation := &coreapi.HostedClusterControlPlaneSizeRecommendation{
+		Size:                       size,
+		Reason:                     reason,
+		NodeCount:                  signals.nodeCount,
+		MaxNodeCount:               signals.maxNodeCount,
+		ControlPlaneAutoscalerSize: signals.autoscalerSize,
+		APIRequestsPerSecond:       signals.apiRequestsPerSecond,
+		RecommendedTime:            metav1.NewTime(now),
+	}
+	if previous != nil {
+		recommendation.AppliedTime = previous.AppliedTime
+		if previous.Size == size {
+			recommendation.RecommendedTime = previous.RecommendedTime
+		}
+	}
+
+	replacement := existing.DeepCopy()
+	replacement.Status.ControlPlaneSizeRecommendation = recommendation
+	applied := c.autoApply && applyControlPlaneSizeRecommendation(replacement, now)
+
+	if equality.Semantic.DeepEqual(existing, replacement) {
+		return nil
+	}
+	_, err = c.resourcesDBClient.ServiceProviderClusters(key.SubscriptionID, key.ResourceGroupName, key.HCPClusterName).Replace(ctx, replacement, nil)
+	if cosmosstorageutils.IsPreconditionFailedError(err) {
+		return nil
+	}
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to replace ServiceProviderCluster: %w", err))
+	}
+
+	if applied {
+		logger.Info("applied control plane size recommendation", "size", size, "previousSize", current, "reason", reason)
+	} else {
+		logger.V(1).Info("recorded control plane size recommendation", "size", size, "reason", reason)
+	}
+	return nil
+}
+
+// applyControlPlaneSizeRecommendation copies the recommendation of
+// serviceProviderCluster into its Spec when the size is unset or unpinned, the
+// recommendation is stable and the cooldown since the last applied size has
+// passed. It reports whether Spec changed.
+func applyControlPlaneSizeRecommendation(serviceProviderCluster *coreapi.ServiceProviderCluster, now time.Time) bool {
+	recommendation := serviceProviderCluster.Status.ControlPlaneSizeRecommendation
+	switch {
+	case serviceProviderCluster.Spec.DesiredHostedClusterControlPlaneSize != nil && !serviceProviderCluster.Spec.DesiredHostedClusterControlPlaneSizeUnpinned:
+		return false
+	case ptr.Deref(serviceProviderCluster.Spec.DesiredHostedClusterControlPlaneSize, "") == recommendation.Size:
+		return false
+	case now.Sub(recommendation.RecommendedTime.Time) < controlPlaneSizeStabilizationPeriod:
+		return false
+	case recommendation.AppliedTime != nil && now.Sub(recommendation.AppliedTime.Time) < controlPlaneSizeApplyCooldown:
+		return false
+	}
+
+	serviceProviderCluster.Spec.DesiredHostedClusterControlPlaneSize = ptr.To(recommendation.Size)
+	serviceProviderCluster.Spec.DesiredHostedClusterControlPlaneSizeUnpinned = true
+	recommendation.AppliedTime = ptr.To(metav1.NewTime(now))
+	return true
+}
+
+// observeSignals collects the worker node counts of the cluster's node pools,
+// preferring the replicas reported by the mirrored HyperShift NodePool over
+// the requested ones, the API request rate from the mirrored kube-apiserver
+// HorizontalPodAutoscaler and the control plane autoscaler recommendation from
+// the mirrored HostedCluster.
+func (c *controlPlaneRightSizingSyncer) observeSignals(ctx context.Context, key controllerutils.HCPClusterKey) (controlPlaneSizeSignals, error) {
+	signals := controlPlaneSizeSignals{}
+
+	nodePools, err := c.nodePoolLister.ListForCluster(ctx, key.SubscriptionID, key.ResourceGroupName, key.HCPClusterName)
+	if err != nil {
+		return signals, utils.TrackError(fmt.Errorf("failed to list node pools: %w", err))
+	}
+	for _, nodePool := range nodePools {
+		replicas := nodePool.Properties.Replicas
+		maxReplicas := replicas
+		if autoScaling := nodePool.Properties.AutoScaling; autoScaling != nil {
+			replicas = autoScaling.Min
+			maxReplicas = autoScaling.Max
+		}
+
+		hostedNodePool, err := kubeapplierhelpers.GetCachedNodePoolForNodePool(ctx, c.readDesireLister, key.SubscriptionID, key.ResourceGroupName, key.HCPClusterName, nodePool.ID.Name)
+		if err != nil {
+			return signals, utils.TrackError(err)
+		}
+		if hostedNodePool != nil {
+			replicas = hostedNodePool.Status.Replicas
+		}
+
+		signals.nodeCount += replicas
+		signals.maxNodeCount += max(maxReplicas, replicas)
+	}
+
+	hostedCluster, err := kubeapplierhelpers.GetCachedHostedClusterForCluster(ctx, c.readDesireLister, key.SubscriptionID, key.ResourceGroupName, key.HCPClusterName)
+	if err != nil {
+		return signals, utils.TrackError(err)
+	}
+	if hostedCluster != nil {
+		signals.autoscalerSize = hostedCluster.Annotations[hsv1beta1.RecommendedClusterSizeAnnotation]
+	}
+
+	horizontalPodAutoscaler, err := kubeapplierhelpers.GetCachedKubeAPIServerHorizontalPodAutoscalerForCluster(ctx, c.readDesireLister, key.SubscriptionID, key.ResourceGroupName, key.HCPClusterName)
+	if err != nil {
+		return signals, utils.TrackError(err)
+	}
+	if horizontalPodAutoscaler != nil {
+		signals.apiRequestsPerSecond = kubeAPIServerRequestRate(horizontalPodAutoscaler)
+	}
+
+	return signals, nil
+}
+
+// kubeAPIServerRequestRate returns the total API request rate reported by the
+// request rate metrics of the kube-apiserver HorizontalPodAutoscaler. Per-pod
+// averages are multiplied by the current replicas; object and external
+// metrics already cover every replica.
+func kubeAPIServerRequestRate(horizontalPodAutoscaler *autoscalingv2.HorizontalPodAutoscaler) int64 {
+	var requestsPerSecond int64
+	for _, metric := range horizontalPodAutoscaler.Status.CurrentMetrics {
+		switch {
+		case metric.Pods != nil && strings.HasPrefix(metric.Pods.Metric.Name, kubeAPIServerRequestRateMetricPrefix):
+			if averageValue := metric.Pods.Current.AverageValue; averageValue != nil {
+				requestsPerSecond = max(requestsPerSecond, averageValue.Value()*int64(horizontalPodAutoscaler.Status.CurrentReplicas))
+			}
+		case metric.Object != nil && strings.HasPrefix(metric.Object.Metric.Name, kubeAPIServerRequestRateMetricPrefix):
+			if value := metric.Object.Current.Value; value != nil {
+				requestsPerSecond = max(requestsPerSecond, value.Value())
+			}
+		case metric.External != nil && strings.HasPrefix(metric.External.Metric.Name, kubeAPIServerRequestRateMetricPrefix):
+			if value := metric.External.Current.Value; value != nil {
+				requestsPerSecond = max(requestsPerSecond, value.Value())
+			}
+		}
+	}
+	return requestsPerSecond
+}
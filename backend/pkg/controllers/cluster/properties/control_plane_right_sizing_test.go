@@ -0,0 +1,446 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package properties
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/json"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	hsv1beta1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
+
+	"github.com/Azure/ARO-HCP/backend/pkg/kubeapplierhelpers"
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/kubeapplierapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/corecosmosstoragetesting"
+	"github.com/Azure/ARO-HCP/internal/database/listertesting/corelistertesting"
+)
+
+func TestRecommendControlPlaneSize(t *testing.T) {
+	tests := []struct {
+		name         string
+		signals      controlPlaneSizeSignals
+		current      string
+		expectedSize coreapi.HostedClusterControlPlaneSize
+	}{
+		{
+			name:         "no nodes",
+			expectedSize: coreapi.HostedClusterControlPlaneSizeSmall,
+		},
+		{
+			name:         "observed nodes",
+			signals:      controlPlaneSizeSignals{nodeCount: 100, maxNodeCount: 100},
+			expectedSize: coreapi.HostedClusterControlPlaneSizeMedium,
+		},
+		{
+			name:         "autoscaling ceiling sizes ahead of a burst",
+			signals:      controlPlaneSizeSignals{nodeCount: 3, maxNodeCount: 400},
+			expectedSize: coreapi.HostedClusterControlPlaneSizeLarge,
+		},
+		{
+			name:         "control plane autoscaler raises the size",
+			signals:      controlPlaneSizeSignals{nodeCount: 3, maxNodeCount: 3, autoscalerSize: "xlarge"},
+			expectedSize: coreapi.HostedClusterControlPlaneSizeXlarge,
+		},
+		{
+			name:         "unknown control plane autoscaler size is ignored",
+			signals:      controlPlaneSizeSignals{nodeCount: 3, maxNodeCount: 3, autoscalerSize: "huge"},
+			expectedSize: coreapi.HostedClusterControlPlaneSizeSmall,
+		},
+		{
+			name:         "API request rate raises the size",
+			signals:      controlPlaneSizeSignals{nodeCount: 3, maxNodeCount: 3, apiRequestsPerSecond: 1000},
+			expectedSize: coreapi.HostedClusterControlPlaneSizeLarge,
+		},
+		{
+			name:         "keeps the current size while the API request rate lacks headroom",
+			signals:      controlPlaneSizeSignals{nodeCount: 3, maxNodeCount: 3, apiRequestsPerSecond: 700},
+			current:      string(coreapi.HostedClusterControlPlaneSizeLarge),
+			expectedSize: coreapi.HostedClusterControlPlaneSizeLarge,
+		},
+		{
+			name:         "grows past the current size immediately",
+			signals:      controlPlaneSizeSignals{nodeCount: 61, maxNodeCount: 61},
+			current:      string(coreapi.HostedClusterControlPlaneSizeSmall),
+			expectedSize: coreapi.HostedClusterControlPlaneSizeMedium,
+		},
+		{
+			name:         "keeps the current size without enough headroom",
+			signals:      controlPlaneSizeSignals{nodeCount: 110, maxNodeCount: 110},
+			current:      string(coreapi.HostedClusterControlPlaneSizeLarge),
+			expectedSize: coreapi.HostedClusterControlPlaneSizeLarge,
+		},
+		{
+			name:         "shrinks once the smaller size has headroom",
+			signals:      controlPlaneSizeSignals{nodeCount: 80, maxNodeCount: 80},
+			current:      string(coreapi.HostedClusterControlPlaneSizeLarge),
+			expectedSize: coreapi.HostedClusterControlPlaneSizeMedium,
+		},
+		{
+			name:         "shrinks only as far as headroom allows",
+			signals:      controlPlaneSizeSignals{nodeCount: 55, maxNodeCount: 55},
+			current:      string(coreapi.HostedClusterControlPlaneSizeXXlarge),
+			expectedSize: coreapi.HostedClusterControlPlaneSizeMedium,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			size, reason := recommendControlPlaneSize(tc.signals, tc.current)
+			assert.Equal(t, string(tc.expectedSize), size)
+			assert.NotEmpty(t, reason)
+		})
+	}
+}
+
+func TestControlPlaneRightSizingSyncer_SyncOnce(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	smallStr := string(coreapi.HostedClusterControlPlaneSizeSmall)
+	mediumStr := string(coreapi.HostedClusterControlPlaneSizeMedium)
+	largeStr := string(coreapi.HostedClusterControlPlaneSizeLarge)
+
+	tests := []struct {
+		name                   string
+		autoApply              bool
+		specSize               *string
+		unpinned               bool
+		previous               *coreapi.HostedClusterControlPlaneSizeRecommendation
+		nodePools              []*coreapi.HCPOpenShiftClusterNodePool
+		hostedNodePoolReplicas *int32
+		autoscalerSize         string
+		apiRequestRateMetrics  []autoscalingv2.MetricStatus
+		expectedRecommendation coreapi.HostedClusterControlPlaneSizeRecommendation
+		expectedSpecSize       *string
+		expectedUnpinned       bool
+	}{
+		{
+			name:      "records a recommendation without auto-apply",
+			nodePools: []*coreapi.HCPOpenShiftClusterNodePool{newTestRightSizingNodePool("np-1", 100, nil)},
+			expectedRecommendation: coreapi.HostedClusterControlPlaneSizeRecommendation{
+				Size:            mediumStr,
+				NodeCount:       100,
+				MaxNodeCount:    100,
+				RecommendedTime: metav1.NewTime(now),
+			},
+		},
+		{
+			name: "prefers replicas observed on the HyperShift NodePool",
+			nodePools: []*coreapi.HCPOpenShiftClusterNodePool{
+				newTestRightSizingNodePool("np-1", 0, &coreapi.NodePoolAutoScaling{Min: 2, Max: 10}),
+			},
+			hostedNodePoolReplicas: ptr.To(int32(8)),
+			autoscalerSize:         "large",
+			expectedRecommendation: coreapi.HostedClusterControlPlaneSizeRecommendation{
+				Size:                       largeStr,
+				NodeCount:                  8,
+				MaxNodeCount:               10,
+				ControlPlaneAutoscalerSize: "large",
+				RecommendedTime:            metav1.NewTime(now),
+			},
+		},
+		{
+			name:      "sizes for the API request rate reported by the kube-apiserver HorizontalPodAutoscaler",
+			nodePools: []*coreapi.HCPOpenShiftClusterNodePool{newTestRightSizingNodePool("np-1", 10, nil)},
+			apiRequestRateMetrics: []autoscalingv2.MetricStatus{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricStatus{
+						Name:    "cpu",
+						Current: autoscalingv2.MetricValueStatus{AverageUtilization: ptr.To(int32(90))},
+					},
+				},
+				{
+					Type: autoscalingv2.PodsMetricSourceType,
+					Pods: &autoscalingv2.PodsMetricStatus{
+						Metric:  autoscalingv2.MetricIdentifier{Name: "apiserver_request_rate"},
+						Current: autoscalingv2.MetricValueStatus{AverageValue: ptr.To(resource.MustParse("300"))},
+					},
+				},
+			},
+			expectedRecommendation: coreapi.HostedClusterControlPlaneSizeRecommendation{
+				Size:                 largeStr,
+				NodeCount:            10,
+				MaxNodeCount:         10,
+				APIRequestsPerSecond: 900,
+				RecommendedTime:      metav1.NewTime(now),
+			},
+		},
+		{
+			name:      "does not apply a recommendation that has not stabilized",
+			autoApply: true,
+			nodePools: []*coreapi.HCPOpenShiftClusterNodePool{newTestRightSizingNodePool("np-1", 100, nil)},
+			previous: &coreapi.HostedClusterControlPlaneSizeRecommendation{
+				Size:            smallStr,
+				RecommendedTime: metav1.NewTime(now.Add(-time.Hour)),
+			},
+			expectedRecommendation: coreapi.HostedClusterControlPlaneSizeRecommendation{
+				Size:            mediumStr,
+				NodeCount:       100,
+				MaxNodeCount:    100,
+				RecommendedTime: metav1.NewTime(now),
+			},
+		},
+		{
+			name:      "applies a stable recommendation",
+			autoApply: true,
+			nodePools: []*coreapi.HCPOpenShiftClusterNodePool{newTestRightSizingNodePool("np-1", 100, nil)},
+			previous: &coreapi.HostedClusterControlPlaneSizeRecommendation{
+				Size:            mediumStr,
+				RecommendedTime: metav1.NewTime(now.Add(-time.Hour)),
+			},
+			expectedRecommendation: coreapi.HostedClusterControlPlaneSizeRecommendation{
+				Size:            mediumStr,
+				NodeCount:       100,
+				MaxNodeCount:    100,
+				RecommendedTime: metav1.NewTime(now.Add(-time.Hour)),
+				AppliedTime:     ptr.To(metav1.NewTime(now)),
+			},
+			expectedSpecSize: &mediumStr,
+			expectedUnpinned: true,
+		},
+		{
+			name:      "does not apply during the cooldown",
+			autoApply: true,
+			specSize:  &smallStr,
+			unpinned:  true,
+			nodePools: []*coreapi.HCPOpenShiftClusterNodePool{newTestRightSizingNodePool("np-1", 100, nil)},
+			previous: &coreapi.HostedClusterControlPlaneSizeRecommendation{
+				Size:            mediumStr,
+				RecommendedTime: metav1.NewTime(now.Add(-time.Hour)),
+				AppliedTime:     ptr.To(metav1.NewTime(now.Add(-2 * time.Hour))),
+			},
+			expectedRecommendation: coreapi.HostedClusterControlPlaneSizeRecommendation{
+				Size:            mediumStr,
+				NodeCount:       100,
+				MaxNodeCount:    100,
+				RecommendedTime: metav1.NewTime(now.Add(-time.Hour)),
+				AppliedTime:     ptr.To(metav1.NewTime(now.Add(-2 * time.Hour))),
+			},
+			expectedSpecSize: &smallStr,
+			expectedUnpinned: true,
+		},
+		{
+			name:      "replaces an unpinned size",
+			autoApply: true,
+			specSize:  &smallStr,
+			unpinned:  true,
+			nodePools: []*coreapi.HCPOpenShiftClusterNodePool{newTestRightSizingNodePool("np-1", 100, nil)},
+			previous: &coreapi.HostedClusterControlPlaneSizeRecommendation{
+				Size:            mediumStr,
+				RecommendedTime: metav1.NewTime(now.Add(-time.Hour)),
+				AppliedTime:     ptr.To(metav1.NewTime(now.Add(-7 * time.Hour))),
+			},
+			expectedRecommendation: coreapi.HostedClusterControlPlaneSizeRecommendation{
+				Size:            mediumStr,
+				NodeCount:       100,
+				MaxNodeCount:    100,
+				RecommendedTime: metav1.NewTime(now.Add(-time.Hour)),
+				AppliedTime:     ptr.To(metav1.NewTime(now)),
+			},
+			expectedSpecSize: &mediumStr,
+			expectedUnpinned: true,
+		},
+		{
+			// Sizes written before right-sizing existed carry no pin flag and
+			// must be treated as pinned.
+			name:      "does not change a size it did not apply",
+			autoApply: true,
+			specSize:  &smallStr,
+			nodePools: []*coreapi.HCPOpenShiftClusterNodePool{newTestRightSizingNodePool("np-1", 100, nil)},
+			previous: &coreapi.HostedClusterControlPlaneSizeRecommendation{
+				Size:            mediumStr,
+				RecommendedTime: metav1.NewTime(now.Add(-time.Hour)),
+			},
+			expectedRecommendation: coreapi.HostedClusterControlPlaneSizeRecommendation{
+				Size:            mediumStr,
+				NodeCount:       100,
+				MaxNodeCount:    100,
+				RecommendedTime: metav1.NewTime(now.Add(-time.Hour)),
+			},
+			expectedSpecSize: &smallStr,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			mockResourcesDBClient := corecosmosstoragetesting.NewMockResourcesDBClient()
+			serviceProviderCluster := newTestServiceProviderCluster(testClusterName, tc.specSize, nil)
+			serviceProviderCluster.Spec.DesiredHostedClusterControlPlaneSizeUnpinned = tc.unpinned
+			serviceProviderCluster.Status.ControlPlaneSizeRecommendation = tc.previous
+			created, err := mockResourcesDBClient.ServiceProviderClusters(testSubscriptionID, testResourceGroupName, testClusterName).Create(ctx, serviceProviderCluster, nil)
+			require.NoError(t, err)
+
+			readDesires := []*kubeapplierapi.ReadDesire{
+				newTestHostedClusterReadDesire(t, func(hostedCluster *hsv1beta1.HostedCluster) {
+					if len(tc.autoscalerSize) > 0 {
+						hostedCluster.Annotations = map[string]string{hsv1beta1.RecommendedClusterSizeAnnotation: tc.autoscalerSize}
+					}
+				}),
+			}
+			if tc.apiRequestRateMetrics != nil {
+				readDesires = append(readDesires, newTestKubeAPIServerHorizontalPodAutoscalerReadDesire(t, 3, tc.apiRequestRateMetrics))
+			}
+			if tc.hostedNodePoolReplicas != nil {
+				readDesires = append(readDesires, newTestHostedNodePoolReadDesire(t, tc.nodePools[0].ID.Name, *tc.hostedNodePoolReplicas))
+			}
+			readDesireLister, err := newSeededReadDesireLister(ctx, readDesires...)
+			require.NoError(t, err)
+
+			syncer := &controlPlaneRightSizingSyncer{
+				clock: clocktesting.NewFakePassiveClock(now),
+				serviceProviderClusterLister: &corelistertesting.SliceServiceProviderClusterLister{
+					ServiceProviderClusters: []*coreapi.ServiceProviderCluster{created},
+				},
+				nodePoolLister:    &corelistertesting.SliceNodePoolLister{NodePools: tc.nodePools},
+				readDesireLister:  readDesireLister,
+				resourcesDBClient: mockResourcesDBClient,
+				autoApply:         tc.autoApply,
+			}
+
+			require.NoError(t, syncer.SyncOnce(ctx, controllerutils.HCPClusterKey{
+				SubscriptionID:    testSubscriptionID,
+				ResourceGroupName: testResourceGroupName,
+				HCPClusterName:    testClusterName,
+			}))
+
+			live, err := mockResourcesDBClient.ServiceProviderClusters(testSubscriptionID, testResourceGroupName, testClusterName).Get(ctx, coreapi.ServiceProviderClusterResourceName)
+			require.NoError(t, err)
+			require.NotNil(t, live.Status.ControlPlaneSizeRecommendation)
+			recommendation := *live.Status.ControlPlaneSizeRecommendation
+			assert.NotEmpty(t, recommendation.Reason)
+			recommendation.Reason = ""
+			// Cosmos round-trips drop the location of the timestamps.
+			recommendation.RecommendedTime = metav1.NewTime(recommendation.RecommendedTime.UTC())
+			if recommendation.AppliedTime != nil {
+				recommendation.AppliedTime = ptr.To(metav1.NewTime(recommendation.AppliedTime.UTC()))
+			}
+			assert.Equal(t, tc.expectedRecommendation, recommendation)
+			assert.Equal(t, tc.expectedSpecSize, live.Spec.DesiredHostedClusterControlPlaneSize)
+			assert.Equal(t, tc.expectedUnpinned, live.Spec.DesiredHostedClusterControlPlaneSizeUnpinned)
+		})
+	}
+}
+
+func newTestRightSizingNodePool(nodePoolName string, replicas int32, autoScaling *coreapi.NodePoolAutoScaling) *coreapi.HCPOpenShiftClusterNodePool {
+	resourceID := metadataapi.Must(azcorearm.ParseResourceID(
+		"/subscriptions/" + testSubscriptionID +
+			"/resourceGroups/" + testResourceGroupName +
+			"/providers/Microsoft.RedHatOpenShift/hcpOpenShiftClusters/" + testClusterName +
+			"/nodePools/" + nodePoolName,
+	))
+	return &coreapi.HCPOpenShiftClusterNodePool{
+		CosmosMetadata: coreapi.CosmosMetadata{
+			ResourceID:   resourceID,
+			PartitionKey: strings.ToLower(resourceID.SubscriptionID),
+		},
+		TrackedResource: coreapi.TrackedResource{
+			Resource: coreapi.Resource{
+				ID:   resourceID,
+				Name: nodePoolName,
+				Type: resourceID.ResourceType.String(),
+			},
+		},
+		Properties: coreapi.HCPOpenShiftClusterNodePoolProperties{
+			Replicas:    replicas,
+			AutoScaling: autoScaling,
+		},
+	}
+}
+
+func newTestHostedNodePoolReadDesire(t *testing.T, nodePoolName string, replicas int32) *kubeapplierapi.ReadDesire {
+	t.Helper()
+
+	raw, err := json.Marshal(&hsv1beta1.NodePool{
+		ObjectMeta: metav1.ObjectMeta{Name: nodePoolName},
+		Status:     hsv1beta1.NodePoolStatus{Replicas: replicas},
+	})
+	require.NoError(t, err)
+
+	resourceID := metadataapi.Must(azcorearm.ParseResourceID(kubeapplierapi.ToNodePoolScopedReadDesireResourceIDString(
+		testSubscriptionID,
+		testResourceGroupName,
+		testClusterName,
+		nodePoolName,
+		kubeapplierhelpers.ReadDesireNameReadonlyNodePool,
+	)))
+	managementClusterResourceID := metadataapi.Must(azcorearm.ParseResourceID(
+		"/providers/microsoft.redhatopenshift/stamps/1/managementclusters/mgmt-a"))
+
+	return &kubeapplierapi.ReadDesire{
+		CosmosMetadata: coreapi.CosmosMetadata{
+			ResourceID:   resourceID,
+			PartitionKey: strings.ToLower(managementClusterResourceID.String()),
+		},
+		Spec: kubeapplierapi.ReadDesireSpec{
+			ManagementCluster: managementClusterResourceID,
+		},
+		Status: kubeapplierapi.ReadDesireStatus{
+			KubeContent: &runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func newTestKubeAPIServerHorizontalPodAutoscalerReadDesire(t *testing.T, replicas int32, metrics []autoscalingv2.MetricStatus) *kubeapplierapi.ReadDesire {
+	t.Helper()
+
+	raw, err := json.Marshal(&autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver"},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: replicas,
+			CurrentMetrics:  metrics,
+		},
+	})
+	require.NoError(t, err)
+
+	resourceID := metadataapi.Must(azcorearm.ParseResourceID(kubeapplierapi.ToClusterScopedReadDesireResourceIDString(
+		testSubscriptionID,
+		testResourceGroupName,
+		testClusterName,
+		kubeapplierhelpers.ReadDesireNameKubeAPIServerHorizontalPodAutoscaler,
+	)))
+	managementClusterResourceID := metadataapi.Must(azcorearm.ParseResourceID(
+		"/providers/microsoft.redhatopenshift/stamps/1/managementclusters/mgmt-a"))
+
+	return &kubeapplierapi.ReadDesire{
+		CosmosMetadata: coreapi.CosmosMetadata{
+			ResourceID:   resourceID,
+			PartitionKey: strings.ToLower(managementClusterResourceID.String()),
+		},
+		Spec: kubeapplierapi.ReadDesireSpec{
+			ManagementCluster: managementClusterResourceID,
+		},
+		Status: kubeapplierapi.ReadDesireStatus{
+			KubeContent: &runtime.RawExtension{Raw: raw},
+		},
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"strings"
 	"time"
 
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+
 	hsv1beta1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
 
 	"github.com/Azure/ARO-HCP/backend/pkg/kubeapplierhelpers"
@@ -168,6 +170,11 @@ func (c *createClusterScopedReadDesiresSyncer) SyncOnce(ctx context.Context, key
 			mcResourceID,
 			clusterAutoscalerTarget(c.hostedClusterNamespaceEnvIdentifier, csClusterID, csClusterDomainPrefix),
 		),
+		controllerutils.BuildReadDesire(
+			kubeapplierapi.ToClusterScopedReadDesireResourceIDString(key.SubscriptionID, key.ResourceGroupName, key.HCPClusterName, kubeapplierhelpers.ReadDesireNameKubeAPIServerHorizontalPodAutoscaler),
+			mcResourceID,
+			kubeAPIServerHorizontalPodAutoscalerTarget(c.hostedClusterNamespaceEnvIdentifier, csClusterID, csClusterDomainPrefix),
+		),
 	}
 
 	controlPlaneNamespace := serviceProviderCluster.Status.ControlPlaneNamespace
@@ -222,6 +229,20 @@ func clusterAutoscalerTarget(envIdentifier, csClusterID, csClusterDomainPrefix s
 	}
 }
 
+// kubeAPIServerHorizontalPodAutoscalerTarget builds the ResourceReference for
+// the kube-apiserver HorizontalPodAutoscaler in the HCP control plane
+// namespace, whose metrics carry the API request rate used for control plane
+// right-sizing.
+func kubeAPIServerHorizontalPodAutoscalerTarget(envIdentifier, csClusterID, csClusterDomainPrefix string) kubeapplierapi.ResourceReference {
+	return kubeapplierapi.ResourceReference{
+		Group:     autoscalingv2.SchemeGroupVersion.Group,
+		Version:   autoscalingv2.SchemeGroupVersion.Version,
+		Resource:  "horizontalpodautoscalers",
+		Namespace: controllerutils.HostedControlPlaneNamespace(envIdentifier, csClusterID, csClusterDomainPrefix),
+		Name:      "kube-apiserver",
+	}
+}
+
 const servingCATLSSecretName = "kube-apiserver-tls-cert"
 
 func servingCATarget(controlPlaneNamespace string) kubeapplierapi.ResourceReference {
@@ -130,7 +130,7 @@ func TestCreateClusterScopedReadDesires_SyncOnce(t *testing.T) {
 		verifyDB                     func(t *testing.T, ctx context.Context, kaClient *kubeappliercosmosstoragetesting.MockKubeApplierDBClient)
 	}{
 		{
-			name: "creates HostedCluster and cluster-autoscaler ReadDesires",
+			name: "creates HostedCluster, cluster-autoscaler and kube-apiserver HorizontalPodAutoscaler ReadDesires",
 			resources: []any{
 				newTestCluster(),
 			},
@@ -152,6 +152,13 @@ func TestCreateClusterScopedReadDesires_SyncOnce(t *testing.T) {
 				assert.Equal(t, controllerutils.HostedControlPlaneNamespace(readDesireTestEnvIdentifier, "abc123", readDesireTestDomainPrefix), autoscalerRD.Spec.TargetItem.Namespace)
 				assert.Equal(t, hsv1beta1.SchemeGroupVersion.Group, autoscalerRD.Spec.TargetItem.Group)
 				assert.Equal(t, hsv1beta1.SchemeGroupVersion.Version, autoscalerRD.Spec.TargetItem.Version)
+
+				kubeAPIServerHPARD, err := crud.Get(ctx, kubeapplierhelpers.ReadDesireNameKubeAPIServerHorizontalPodAutoscaler)
+				require.NoError(t, err)
+				assert.Equal(t, kubeAPIServerHorizontalPodAutoscalerTarget(readDesireTestEnvIdentifier, "abc123", readDesireTestDomainPrefix), kubeAPIServerHPARD.Spec.TargetItem)
+				assert.Equal(t, "horizontalpodautoscalers", kubeAPIServerHPARD.Spec.TargetItem.Resource)
+				assert.Equal(t, "kube-apiserver", kubeAPIServerHPARD.Spec.TargetItem.Name)
+				assert.Equal(t, controllerutils.HostedControlPlaneNamespace(readDesireTestEnvIdentifier, "abc123", readDesireTestDomainPrefix), kubeAPIServerHPARD.Spec.TargetItem.Namespace)
 			},
 		},
 		{
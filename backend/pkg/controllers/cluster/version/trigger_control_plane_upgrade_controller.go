@@ -89,7 +89,7 @@ func NewTriggerControlPlaneUpgradeController(
 // High-level flow:
 //  1. Fetch the customer's desired cluster configuration and service provider state
 //  2. Check if desiredVersion differs from latest actual version
-//  3. If different, call version service API to trigger upgrade
+//  3. If different and the cluster's maintenance window (if any) is open, call version service API to trigger upgrade
 //  4. The version service API is idempotent and handles the actual upgrade orchestration
 func (c *triggerControlPlaneUpgradeSyncer) SyncOnce(ctx context.Context, key controllerutils.HCPClusterKey) error {
 	logger := utils.LoggerFromContext(ctx)
@@ -146,6 +146,17 @@ func (c *triggerControlPlaneUpgradeSyncer) SyncOnce(ctx context.Context, key con
 		return nil
 	}
 
+	// Upgrades may only start inside the customer's maintenance window. We are
+	// re-synced every minute, so the upgrade is triggered shortly after it opens.
+	upgradeAllowed, err := existingCluster.CustomerProperties.MaintenanceWindow.UpgradeAllowed(c.clock.Now())
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to evaluate maintenance window: %w", err))
+	}
+	if !upgradeAllowed {
+		logger.Info("Waiting for maintenance window to trigger control plane upgrade", "cluster", existingCluster.Name, "desiredVersion", desiredVersion)
+		return nil
+	}
+
 	return c.createUpgradePolicyIfNeeded(ctx, desiredVersion, *existingCluster.ServiceProviderProperties.ClusterServiceID)
 }
 
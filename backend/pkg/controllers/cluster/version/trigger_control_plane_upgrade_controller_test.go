@@ -32,6 +32,7 @@ import (
 
 	arohcpv1alpha1 "github.com/openshift-online/ocm-sdk-go/arohcp/v1alpha1"
 
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
 	"github.com/Azure/ARO-HCP/internal/api/coreapi"
 	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
 	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/corecosmosstoragetesting"
@@ -291,3 +292,75 @@ func TestTriggerControlPlaneUpgradeSyncer_ShouldTriggerUpgrade(t *testing.T) {
 		})
 	}
 }
+
+func TestTriggerControlPlaneUpgradeSyncer_SyncOnceHonorsMaintenanceWindow(t *testing.T) {
+	clusterKey := controllerutils.HCPClusterKey{
+		SubscriptionID:    testSubscriptionID,
+		ResourceGroupName: testResourceGroupName,
+		HCPClusterName:    testClusterName,
+	}
+	testClusterServiceID := metadataapi.Must(metadataapi.NewInternalID(testCSClusterIDStr))
+	// Saturdays from 22:00 to 02:00 UTC.
+	maintenanceWindow := &coreapi.MaintenanceWindowProfile{
+		DaysOfWeek:    []metadataapi.DayOfWeek{metadataapi.DayOfWeekSaturday},
+		StartTime:     "22:00",
+		DurationHours: 4,
+	}
+
+	tests := []struct {
+		name                 string
+		now                  time.Time
+		expectPolicyCreation bool
+	}{
+		{
+			name:                 "maintenance window closed - upgrade is not triggered",
+			now:                  time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC),
+			expectPolicyCreation: false,
+		},
+		{
+			name:                 "maintenance window open - upgrade is triggered",
+			now:                  time.Date(2026, 10, 17, 23, 0, 0, 0, time.UTC),
+			expectPolicyCreation: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := utils.ContextWithLogger(context.Background(), logr.Discard())
+			mockDB := corecosmosstoragetesting.NewMockResourcesDBClient()
+
+			createTestHCPClusterWithCustomerVersion(t, ctx, mockDB, "4.19", "stable")
+			clusterCRUD := mockDB.HCPClusters(testSubscriptionID, testResourceGroupName)
+			existing, err := clusterCRUD.Get(ctx, testClusterName)
+			require.NoError(t, err)
+			updated := existing.DeepCopy()
+			updated.CustomerProperties.MaintenanceWindow = maintenanceWindow.DeepCopy()
+			_, err = clusterCRUD.Replace(ctx, updated, nil)
+			require.NoError(t, err)
+
+			createServiceProviderClusterWithActiveAndDesiredVersion(t, ctx, mockDB, semver.MustParse("4.19.18"), ptr.To(semver.MustParse("4.19.20")))
+
+			ctrl := gomock.NewController(t)
+			mockClusterServiceClient := ocm.NewMockClusterServiceClientSpec(ctrl)
+			if tt.expectPolicyCreation {
+				expectedBuilder := arohcpv1alpha1.NewControlPlaneUpgradePolicy().Version("4.19.20")
+				mockClusterServiceClient.EXPECT().
+					ListControlPlaneUpgradePolicies(testClusterServiceID, "creation_timestamp desc").
+					Return(ocm.NewSimpleControlPlaneUpgradePolicyListIterator([]*arohcpv1alpha1.ControlPlaneUpgradePolicy{}, nil))
+				mockClusterServiceClient.EXPECT().
+					PostControlPlaneUpgradePolicy(gomock.Any(), testClusterServiceID, expectedBuilder).
+					Return(metadataapi.Must(expectedBuilder.Build()), nil)
+			}
+
+			syncer := &triggerControlPlaneUpgradeSyncer{
+				clock:                        clocktesting.NewFakePassiveClock(tt.now),
+				resourcesDBClient:            mockDB,
+				clusterServiceClient:         mockClusterServiceClient,
+				activeOperationLister:        &corelistertesting.DBActiveOperationLister{ResourcesDBClient: mockDB},
+				serviceProviderClusterLister: &corelistertesting.DBServiceProviderClusterLister{ResourcesDBClient: mockDB},
+			}
+
+			require.NoError(t, syncer.SyncOnce(ctx, clusterKey))
+		})
+	}
+}
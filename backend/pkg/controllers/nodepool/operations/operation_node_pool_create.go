@@ -37,6 +37,12 @@ import (
 	"github.com/Azure/ARO-HCP/internal/utils"
 )
 
+// Customer-visible phases of a node pool creation.
+const (
+	nodePoolCreatePhaseClusterServiceAccepted = "ClusterServiceAccepted"
+	nodePoolCreatePhaseNodePoolReady          = "NodePoolReady"
+)
+
 type operationNodePoolCreate struct {
 	clock                  utilsclock.PassiveClock
 	resourcesDBClient      corecosmosstorage.ResourcesDBClient
@@ -139,7 +145,7 @@ func (c *operationNodePoolCreate) SynchronizeOperation(ctx context.Context, key
 		return nil
 	}
 
-	operationalState, err := c.determineOperationState(ctx, operation, nodePool)
+	operationalState, phases, err := c.determineOperationState(ctx, operation, nodePool)
 	if err != nil {
 		return utils.TrackError(err)
 	}
@@ -173,7 +179,7 @@ func (c *operationNodePoolCreate) SynchronizeOperation(ctx context.Context, key
 	}
 
 	logger.Info("updating status")
-	err = operationbase.UpdateOperationStatus(ctx, c.clock, c.resourcesDBClient, operation, operationalState.ProvisioningState, persistErr, operationbase.PostAsyncNotificationFn(c.notificationClient))
+	err = operationbase.UpdateOperationStatusWithDetails(ctx, c.clock, c.resourcesDBClient, operation, operationalState.ProvisioningState, persistErr, operationbase.NewOperationStatusDetailsFromPhases(phases), operationbase.PostAsyncNotificationFn(c.notificationClient))
 	if cosmosstorageutils.IsPreconditionFailedError(err) {
 		// if we have a conflict error, then we're guaranteed that our informer will eventually see an update and trigger us again.
 		return nil
@@ -189,35 +195,45 @@ func (c *operationNodePoolCreate) shouldReconcileOperationAndResourceStatus(node
 	return nodePool.ServiceProviderProperties.DeletionTimestamp == nil && nodePool.ServiceProviderProperties.ClusterServiceID != nil
 }
 
-func (c *operationNodePoolCreate) determineOperationState(ctx context.Context, operation *coreapi.Operation, nodePool *coreapi.HCPOpenShiftClusterNodePool) (*operationbase.OperationState, error) {
+// determineOperationState returns the overall state of the creation along with
+// the customer-visible phases, in the order they usually complete.
+func (c *operationNodePoolCreate) determineOperationState(ctx context.Context, operation *coreapi.Operation, nodePool *coreapi.HCPOpenShiftClusterNodePool) (*operationbase.OperationState, []coreapi.OperationPhase, error) {
 	logger := utils.LoggerFromContext(ctx)
 
 	var errs []error
 	var operationStates []*operationbase.OperationState
 
-	if state, err := c.nodePoolServiceCreateOperationState(ctx, operation, nodePool); err != nil {
+	clusterServiceState, err := c.nodePoolServiceCreateOperationState(ctx, operation, nodePool)
+	if err != nil {
 		errs = append(errs, utils.TrackError(err))
 	} else {
-		operationStates = append(operationStates, state.WithSource("clusterServiceNodePoolStatus"))
+		operationStates = append(operationStates, clusterServiceState.WithSource("clusterServiceNodePoolStatus"))
 	}
 
 	if err := errors.Join(errs...); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if len(operationStates) == 0 {
-		return nil, errors.New("no operation states")
+		return nil, nil, errors.New("no operation states")
 	}
+
+	// The controller only runs once Cluster Service has accepted the node pool.
+	phases := []coreapi.OperationPhase{
+		operationbase.NewOperationPhase(nodePoolCreatePhaseClusterServiceAccepted, true),
+		operationbase.OperationPhaseFromState(nodePoolCreatePhaseNodePoolReady, clusterServiceState),
+	}
+
 	slices.SortStableFunc(operationStates, operationbase.CompareOperationState)
 	if operationStates[0] == nil {
-		return nil, errors.New("nil operation state")
+		return nil, nil, errors.New("nil operation state")
 	}
 	logger.Info("determined node pool create operation status", "operationStates", operationStates)
 	picked, err := operationbase.PickWorstOperationState(operationStates)
 	if err != nil {
-		return nil, utils.TrackError(err)
+		return nil, nil, utils.TrackError(err)
 	}
-	logger.Info("picked node pool create operation status", "provisioningState", picked.ProvisioningState, "message", picked.Message)
-	return picked, nil
+	logger.Info("picked node pool create operation status", "provisioningState", picked.ProvisioningState, "message", picked.Message, "phases", phases)
+	return picked, phases, nil
 }
 
 func (c *operationNodePoolCreate) nodePoolServiceCreateOperationState(ctx context.Context, operation *coreapi.Operation, nodePool *coreapi.HCPOpenShiftClusterNodePool) (*operationbase.OperationState, error) {
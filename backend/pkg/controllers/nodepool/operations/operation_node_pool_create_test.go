@@ -132,6 +132,11 @@ func TestOperationNodePoolCreate_SynchronizeOperation(t *testing.T) {
 				op, err := db.Operations(operationtesting.TestSubscriptionID).Get(ctx, operationtesting.TestOperationName)
 				require.NoError(t, err)
 				assert.Equal(t, coreapi.ProvisioningStateProvisioning, op.Status)
+				assert.Equal(t, float64(50), op.PercentComplete)
+				assert.Equal(t, []coreapi.OperationPhase{
+					{Name: nodePoolCreatePhaseClusterServiceAccepted, Status: coreapi.OperationPhaseStatusCompleted},
+					{Name: nodePoolCreatePhaseNodePoolReady, Status: coreapi.OperationPhaseStatusPending},
+				}, op.Phases)
 
 				nodePool, err := db.HCPClusters(operationtesting.TestSubscriptionID, operationtesting.TestResourceGroupName).NodePools(operationtesting.TestClusterName).Get(ctx, operationtesting.TestNodePoolName)
 				require.NoError(t, err)
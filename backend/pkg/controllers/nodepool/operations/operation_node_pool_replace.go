@@ -0,0 +1,189 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	utilsclock "k8s.io/utils/clock"
+
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
+	operationbase "github.com/Azure/ARO-HCP/backend/pkg/utils/operationutils"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/corecosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/informers/coreinformers"
+	"github.com/Azure/ARO-HCP/internal/database/listers/corelisters"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+// Customer-visible phases of a node pool replacement.
+const (
+	nodePoolReplacePhaseSurgeNodePoolRequested  = "SurgeNodePoolRequested"
+	nodePoolReplacePhaseSurgeNodePoolReady      = "SurgeNodePoolReady"
+	nodePoolReplacePhasePreviousNodePoolRemoved = "PreviousNodePoolRemoved"
+)
+
+type operationNodePoolReplace struct {
+	clock                  utilsclock.PassiveClock
+	resourcesDBClient      corecosmosstorage.ResourcesDBClient
+	activeOperationsLister corelisters.ActiveOperationLister
+	nodePoolLister         corelisters.NodePoolLister
+	notificationClient     *http.Client
+}
+
+// NewOperationNodePoolReplaceController returns a new Controller instance that
+// follows an asynchronous node pool replacement to completion and updates the
+// corresponding operation document in Cosmos DB. The replacement itself is
+// carried out by the NodePoolReplace controller, which records its progress on
+// the node pool.
+//
+// Operation documents relevant to this controller will have the following values:
+//
+//	ResourceType: Microsoft.RedHatOpenShift/hcpOpenShiftClusters/nodePools
+//	     Request: Replace
+//	      Status: any non-terminal value
+func NewOperationNodePoolReplaceController(
+	clock utilsclock.PassiveClock,
+	resourcesDBClient corecosmosstorage.ResourcesDBClient,
+	notificationClient *http.Client,
+	activeOperationInformer cache.SharedIndexInformer,
+	backendInformers coreinformers.BackendInformers,
+) controllerutils.Controller {
+	_, nodePoolLister := backendInformers.NodePools()
+	_, activeOperationsLister := backendInformers.ActiveOperations()
+
+	syncer := &operationNodePoolReplace{
+		clock:                  clock,
+		resourcesDBClient:      resourcesDBClient,
+		nodePoolLister:         nodePoolLister,
+		activeOperationsLister: activeOperationsLister,
+		notificationClient:     notificationClient,
+	}
+
+	controller := controllerutils.NewGenericOperationController(
+		"OperationNodePoolReplace",
+		syncer,
+		10*time.Second,
+		activeOperationInformer,
+		resourcesDBClient,
+	)
+
+	return controller
+}
+
+func (c *operationNodePoolReplace) ShouldProcess(ctx context.Context, operation *coreapi.Operation) bool {
+	if operation.Status.IsTerminal() {
+		return false
+	}
+	if operation.Request != cosmosstorageutils.OperationRequestNodePoolReplace {
+		return false
+	}
+	if operation.ExternalID == nil || !strings.EqualFold(operation.ExternalID.ResourceType.String(), coreapi.NodePoolResourceType.String()) {
+		return false
+	}
+
+	return true
+}
+
+func (c *operationNodePoolReplace) SynchronizeOperation(ctx context.Context, key controllerutils.OperationKey) error {
+	logger := utils.LoggerFromContext(ctx)
+	logger.Info("checking operation")
+
+	operation, err := c.activeOperationsLister.Get(ctx, key.SubscriptionID, key.OperationName)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return nil // no work to do
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get active operation: %w", err)
+	}
+
+	if !c.ShouldProcess(ctx, operation) {
+		return nil // no work to do
+	}
+
+	nodePool, err := c.nodePoolLister.Get(ctx, operation.ExternalID.SubscriptionID, operation.ExternalID.ResourceGroupName, operation.ExternalID.Parent.Name, operation.ExternalID.Name)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		logger.Info("node pool not found in cache, waiting")
+		return nil // no work to do
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get node pool: %w", err)
+	}
+
+	if operation.ResourceID.Name != nodePool.ServiceProviderProperties.ActiveOperationID {
+		logger.Info("node pool active operation id mismatch, returning early", "synchronizedActiveOperationID", operation.ResourceID.Name, "nodePoolActiveOperationID", nodePool.ServiceProviderProperties.ActiveOperationID)
+		return nil
+	}
+
+	replacement := nodePool.ServiceProviderProperties.Replacement
+	if replacement == nil || replacement.OperationID != operation.ResourceID.Name {
+		logger.Info("node pool replacement does not belong to this operation, returning early")
+		return nil
+	}
+
+	operationalState, phases := determineNodePoolReplaceOperationState(replacement)
+
+	var persistErr *coreapi.CloudErrorBody
+	if operationalState.ProvisioningState == coreapi.ProvisioningStateFailed {
+		persistErr = &coreapi.CloudErrorBody{
+			Code:    coreapi.CloudErrorCodeInternalServerError,
+			Message: operationalState.Message,
+		}
+	}
+
+	details := operationbase.NewOperationStatusDetailsFromPhases(phases)
+	details.StatusMessage = operationalState.Message
+
+	logger.Info("updating status", "replacementPhase", replacement.Phase)
+	err = operationbase.UpdateOperationStatusWithDetails(ctx, c.clock, c.resourcesDBClient, operation, operationalState.ProvisioningState, persistErr, details, operationbase.PostAsyncNotificationFn(c.notificationClient))
+	if cosmosstorageutils.IsPreconditionFailedError(err) {
+		// if we have a conflict error, then we're guaranteed that our informer will eventually see an update and trigger us again.
+		return nil
+	}
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	return nil
+}
+
+// determineNodePoolReplaceOperationState maps the replacement progress recorded
+// on the node pool to an operation state and the customer-visible phases.
+func determineNodePoolReplaceOperationState(replacement *coreapi.NodePoolReplacement) (*operationbase.OperationState, []coreapi.OperationPhase) {
+	surgeReady := replacement.Phase == coreapi.NodePoolReplacementPhaseRemovingPreviousNodePool ||
+		replacement.Phase == coreapi.NodePoolReplacementPhaseSucceeded
+	phases := []coreapi.OperationPhase{
+		operationbase.NewOperationPhase(nodePoolReplacePhaseSurgeNodePoolRequested, replacement.SurgeClusterServiceID != nil),
+		operationbase.NewOperationPhase(nodePoolReplacePhaseSurgeNodePoolReady, surgeReady),
+		operationbase.NewOperationPhase(nodePoolReplacePhasePreviousNodePoolRemoved, replacement.Phase == coreapi.NodePoolReplacementPhaseSucceeded),
+	}
+
+	switch replacement.Phase {
+	case coreapi.NodePoolReplacementPhaseSucceeded:
+		return operationbase.NewOperationState(coreapi.ProvisioningStateSucceeded, ""), phases
+	case coreapi.NodePoolReplacementPhaseFailed:
+		return operationbase.NewOperationState(coreapi.ProvisioningStateFailed, replacement.Message), phases
+	case coreapi.NodePoolReplacementPhaseRemovingPreviousNodePool:
+		return operationbase.NewOperationState(coreapi.ProvisioningStateUpdating, "draining and deleting the previous node pool"), phases
+	default:
+		return operationbase.NewOperationState(coreapi.ProvisioningStateUpdating, "waiting for the replacement node pool to become ready"), phases
+	}
+}
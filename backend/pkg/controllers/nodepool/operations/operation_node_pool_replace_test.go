@@ -0,0 +1,187 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	utilsclock "k8s.io/utils/clock"
+
+	operationtesting "github.com/Azure/ARO-HCP/backend/pkg/utils/operationutils/operationtesting"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/corecosmosstoragetesting"
+	"github.com/Azure/ARO-HCP/internal/database/listertesting/corelistertesting"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+func TestOperationNodePoolReplace_SynchronizeOperation(t *testing.T) {
+	fixture := operationtesting.NewNodePoolTestFixture()
+
+	nodePoolWithReplacement := func(phase coreapi.NodePoolReplacementPhase, mutate ...func(*coreapi.NodePoolReplacement)) func() *coreapi.HCPOpenShiftClusterNodePool {
+		return func() *coreapi.HCPOpenShiftClusterNodePool {
+			np := fixture.NewNodePool()
+			np.ServiceProviderProperties.Replacement = &coreapi.NodePoolReplacement{
+				OperationID: operationtesting.TestOperationName,
+				Phase:       phase,
+			}
+			for _, m := range mutate {
+				m(np.ServiceProviderProperties.Replacement)
+			}
+			return np
+		}
+	}
+	withSurge := func(r *coreapi.NodePoolReplacement) {
+		r.SurgeClusterServiceID = fixture.NodePoolInternalID.DeepCopy()
+	}
+
+	getOperation := func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) *coreapi.Operation {
+		op, err := db.Operations(operationtesting.TestSubscriptionID).Get(ctx, operationtesting.TestOperationName)
+		require.NoError(t, err)
+		return op
+	}
+	getNodePool := func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) *coreapi.HCPOpenShiftClusterNodePool {
+		nodePool, err := db.HCPClusters(operationtesting.TestSubscriptionID, operationtesting.TestResourceGroupName).NodePools(operationtesting.TestClusterName).Get(ctx, operationtesting.TestNodePoolName)
+		require.NoError(t, err)
+		return nodePool
+	}
+
+	tests := []struct {
+		name     string
+		nodePool func() *coreapi.HCPOpenShiftClusterNodePool
+		verifyDB func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient)
+	}{
+		{
+			name:     "surge node pool requested",
+			nodePool: nodePoolWithReplacement(coreapi.NodePoolReplacementPhaseCreatingSurgeNodePool, withSurge),
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				op := getOperation(t, ctx, db)
+				assert.Equal(t, coreapi.ProvisioningStateUpdating, op.Status)
+				assert.Equal(t, "waiting for the replacement node pool to become ready", op.StatusMessage)
+				assert.Equal(t, []coreapi.OperationPhase{
+					{Name: nodePoolReplacePhaseSurgeNodePoolRequested, Status: coreapi.OperationPhaseStatusCompleted},
+					{Name: nodePoolReplacePhaseSurgeNodePoolReady, Status: coreapi.OperationPhaseStatusPending},
+					{Name: nodePoolReplacePhasePreviousNodePoolRemoved, Status: coreapi.OperationPhaseStatusPending},
+				}, op.Phases)
+			},
+		},
+		{
+			name:     "removing previous node pool",
+			nodePool: nodePoolWithReplacement(coreapi.NodePoolReplacementPhaseRemovingPreviousNodePool, withSurge),
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				op := getOperation(t, ctx, db)
+				assert.Equal(t, coreapi.ProvisioningStateUpdating, op.Status)
+				assert.Equal(t, "draining and deleting the previous node pool", op.StatusMessage)
+				assert.Equal(t, coreapi.OperationPhaseStatusCompleted, op.Phases[1].Status)
+				assert.Equal(t, coreapi.OperationPhaseStatusPending, op.Phases[2].Status)
+			},
+		},
+		{
+			name:     "replacement succeeded",
+			nodePool: nodePoolWithReplacement(coreapi.NodePoolReplacementPhaseSucceeded, withSurge),
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				op := getOperation(t, ctx, db)
+				assert.Equal(t, coreapi.ProvisioningStateSucceeded, op.Status)
+				assert.Nil(t, op.Error)
+
+				nodePool := getNodePool(t, ctx, db)
+				assert.Equal(t, coreapi.ProvisioningStateSucceeded, nodePool.Properties.ProvisioningState)
+				assert.Empty(t, nodePool.ServiceProviderProperties.ActiveOperationID)
+			},
+		},
+		{
+			name: "replacement failed",
+			nodePool: nodePoolWithReplacement(coreapi.NodePoolReplacementPhaseFailed, func(r *coreapi.NodePoolReplacement) {
+				r.Message = "The replacement node pool failed to provision."
+			}),
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				op := getOperation(t, ctx, db)
+				assert.Equal(t, coreapi.ProvisioningStateFailed, op.Status)
+				require.NotNil(t, op.Error)
+				assert.Equal(t, "The replacement node pool failed to provision.", op.Error.Message)
+
+				nodePool := getNodePool(t, ctx, db)
+				assert.Equal(t, coreapi.ProvisioningStateFailed, nodePool.Properties.ProvisioningState)
+			},
+		},
+		{
+			name: "replacement of another operation is ignored",
+			nodePool: nodePoolWithReplacement(coreapi.NodePoolReplacementPhaseSucceeded, func(r *coreapi.NodePoolReplacement) {
+				r.OperationID = "other-operation"
+			}),
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				assert.Equal(t, coreapi.ProvisioningStateAccepted, getOperation(t, ctx, db).Status)
+			},
+		},
+		{
+			name: "active operation mismatch is ignored",
+			nodePool: func() *coreapi.HCPOpenShiftClusterNodePool {
+				np := nodePoolWithReplacement(coreapi.NodePoolReplacementPhaseSucceeded)()
+				np.ServiceProviderProperties.ActiveOperationID = "other-operation"
+				return np
+			},
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				assert.Equal(t, coreapi.ProvisioningStateAccepted, getOperation(t, ctx, db).Status)
+			},
+		},
+		{
+			name:     "missing replacement is ignored",
+			nodePool: fixture.NewNodePool,
+			verifyDB: func(t *testing.T, ctx context.Context, db *corecosmosstoragetesting.MockResourcesDBClient) {
+				assert.Equal(t, coreapi.ProvisioningStateAccepted, getOperation(t, ctx, db).Status)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctx = utils.ContextWithLogger(ctx, testr.New(t))
+
+			resources := []any{fixture.NewCluster(), tt.nodePool(), fixture.NewOperation(cosmosstorageutils.OperationRequestNodePoolReplace)}
+			mockResourcesDBClient, err := corecosmosstoragetesting.NewMockResourcesDBClientWithResources(ctx, resources)
+			require.NoError(t, err)
+
+			controller := &operationNodePoolReplace{
+				clock:                  utilsclock.RealClock{},
+				resourcesDBClient:      mockResourcesDBClient,
+				activeOperationsLister: &corelistertesting.DBActiveOperationLister{ResourcesDBClient: mockResourcesDBClient},
+				nodePoolLister:         &corelistertesting.DBNodePoolLister{ResourcesDBClient: mockResourcesDBClient},
+			}
+
+			err = controller.SynchronizeOperation(ctx, fixture.OperationKey())
+			require.NoError(t, err)
+
+			tt.verifyDB(t, ctx, mockResourcesDBClient)
+		})
+	}
+}
+
+func TestOperationNodePoolReplace_ShouldProcess(t *testing.T) {
+	fixture := operationtesting.NewNodePoolTestFixture()
+	controller := &operationNodePoolReplace{}
+
+	assert.True(t, controller.ShouldProcess(context.Background(), fixture.NewOperation(cosmosstorageutils.OperationRequestNodePoolReplace)))
+	assert.False(t, controller.ShouldProcess(context.Background(), fixture.NewOperation(cosmosstorageutils.OperationRequestUpdate)))
+
+	terminal := fixture.NewOperation(cosmosstorageutils.OperationRequestNodePoolReplace)
+	terminal.Status = coreapi.ProvisioningStateSucceeded
+	assert.False(t, controller.ShouldProcess(context.Background(), terminal))
+}
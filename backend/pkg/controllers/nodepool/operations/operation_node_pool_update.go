@@ -50,6 +50,7 @@ type operationNodePoolUpdate struct {
 	clock                           utilsclock.PassiveClock
 	resourcesDBClient               corecosmosstorage.ResourcesDBClient
 	clusterServiceClient            ocm.ClusterServiceClientSpec
+	clusterLister                   corelisters.ClusterLister
 	nodePoolLister                  corelisters.NodePoolLister
 	serviceProviderNodePoolLister   corelisters.ServiceProviderNodePoolLister
 	readDesireLister                kubeapplierlisters.ReadDesireLister
@@ -81,6 +82,7 @@ func NewOperationNodePoolUpdateController(
 	activeOperationInformer cache.SharedIndexInformer,
 	backendInformers coreinformers.BackendInformers,
 ) controllerutils.Controller {
+	_, clusterLister := backendInformers.Clusters()
 	_, nodePoolLister := backendInformers.NodePools()
 	_, serviceProviderNodePoolLister := backendInformers.ServiceProviderNodePools()
 	_, activeOperationsLister := backendInformers.ActiveOperations()
@@ -89,6 +91,7 @@ func NewOperationNodePoolUpdateController(
 		clock:                           clock,
 		resourcesDBClient:               resourcesDBClient,
 		clusterServiceClient:            clusterServiceClient,
+		clusterLister:                   clusterLister,
 		nodePoolLister:                  nodePoolLister,
 		serviceProviderNodePoolLister:   serviceProviderNodePoolLister,
 		readDesireLister:                readDesireLister,
@@ -154,7 +157,7 @@ func (c *operationNodePoolUpdate) SynchronizeOperation(ctx context.Context, key
 		return nil // no work to do
 	}
 
-	operationalState, err := c.determineOperationState(ctx, operation, existingNodePool)
+	operationalState, statusMessage, err := c.determineOperationState(ctx, operation, existingNodePool)
 	if err != nil {
 		return utils.TrackError(err)
 	}
@@ -168,7 +171,7 @@ func (c *operationNodePoolUpdate) SynchronizeOperation(ctx context.Context, key
 	}
 
 	logger.Info("updating status")
-	err = operationbase.UpdateOperationStatus(ctx, c.clock, c.resourcesDBClient, operation, operationalState.ProvisioningState, persistErr, operationbase.PostAsyncNotificationFn(c.notificationClient))
+	err = operationbase.UpdateOperationStatusWithDetails(ctx, c.clock, c.resourcesDBClient, operation, operationalState.ProvisioningState, persistErr, operationbase.OperationStatusDetails{StatusMessage: statusMessage}, operationbase.PostAsyncNotificationFn(c.notificationClient))
 	if cosmosstorageutils.IsPreconditionFailedError(err) {
 		// if we have a conflict error, then we're guaranteed that our informer will eventually see an update and trigger us again.
 		return nil
@@ -185,18 +188,20 @@ func (c *operationNodePoolUpdate) shouldReconcileOperationAndResourceStatus(node
 		nodePool.ServiceProviderProperties.ClusterServiceID != nil
 }
 
-func (c *operationNodePoolUpdate) determineOperationState(ctx context.Context, operation *coreapi.Operation, existingNodePool *coreapi.HCPOpenShiftClusterNodePool) (*operationbase.OperationState, error) {
+// determineOperationState returns the worst operation state across all sources, along with
+// a customer facing status message describing what the operation is waiting on, if anything.
+func (c *operationNodePoolUpdate) determineOperationState(ctx context.Context, operation *coreapi.Operation, existingNodePool *coreapi.HCPOpenShiftClusterNodePool) (*operationbase.OperationState, string, error) {
 	logger := utils.LoggerFromContext(ctx)
 
 	nodePoolCSID := existingNodePool.ServiceProviderProperties.ClusterServiceID
 	existingCSNodePool, err := c.clusterServiceClient.GetNodePool(ctx, *nodePoolCSID)
 	if err != nil {
-		return nil, utils.TrackError(fmt.Errorf("failed to get node pool from cluster service: %w", err))
+		return nil, "", utils.TrackError(fmt.Errorf("failed to get node pool from cluster service: %w", err))
 	}
 
 	existingServiceProviderNodePool, err := c.serviceProviderNodePoolLister.Get(ctx, operation.ExternalID.SubscriptionID, operation.ExternalID.ResourceGroupName, operation.ExternalID.Parent.Name, operation.ExternalID.Name)
 	if err != nil {
-		return nil, utils.TrackError(fmt.Errorf("failed to get service provider node pool from cache: %w", err))
+		return nil, "", utils.TrackError(fmt.Errorf("failed to get service provider node pool from cache: %w", err))
 	}
 
 	errs := []error{}
@@ -223,24 +228,80 @@ func (c *operationNodePoolUpdate) determineOperationState(ctx context.Context, o
 	} else {
 		operationStates = append(operationStates, operationState.WithSource("hypershiftNodePool"))
 	}
+	statusMessage, maintenanceWindowErr := c.maintenanceWindowStatusMessage(ctx, operation, existingNodePool, existingServiceProviderNodePool)
+	if maintenanceWindowErr != nil {
+		errs = append(errs, utils.TrackError(maintenanceWindowErr))
+	} else {
+		operationStates = append(operationStates, operationbase.MaintenanceWindowOperationState(statusMessage).WithSource("maintenanceWindow"))
+	}
 
 	if err := errors.Join(errs...); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	if len(operationStates) == 0 {
-		return nil, errors.New("no operation states")
+		return nil, "", errors.New("no operation states")
 	}
 	slices.SortStableFunc(operationStates, operationbase.CompareOperationState)
 	if operationStates[0] == nil {
-		return nil, errors.New("nil operation state")
+		return nil, "", errors.New("nil operation state")
 	}
 	logger.Info("determined node pool update operation status", "operationStates", operationStates)
 	picked, err := operationbase.PickWorstOperationState(operationStates)
 	if err != nil {
-		return nil, utils.TrackError(err)
+		return nil, "", utils.TrackError(err)
 	}
 	logger.Info("picked node pool update operation status", "provisioningState", picked.ProvisioningState, "message", picked.Message)
-	return picked, nil
+	return picked, statusMessage, nil
+}
+
+// maintenanceWindowStatusMessage returns a status message while a pending node pool upgrade
+// is held back by the parent cluster's maintenance window, or an empty string otherwise.
+// The TriggerNodePoolUpgrade controller starts the upgrade once the window opens; an
+// upgrade it already started keeps running after the window closes and is not waiting.
+func (c *operationNodePoolUpdate) maintenanceWindowStatusMessage(ctx context.Context, operation *coreapi.Operation, existingNodePool *coreapi.HCPOpenShiftClusterNodePool, existingServiceProviderNodePool *coreapi.ServiceProviderNodePool) (string, error) {
+	desiredVersion := existingServiceProviderNodePool.Spec.NodePoolVersion.DesiredVersion
+	activeVersions := existingServiceProviderNodePool.Status.NodePoolVersion.ActiveVersions
+	if desiredVersion == nil || len(activeVersions) == 0 || activeVersions[0].Version == nil || desiredVersion.EQ(*activeVersions[0].Version) {
+		return "", nil
+	}
+
+	existingCluster, err := c.clusterLister.Get(ctx, operation.ExternalID.SubscriptionID, operation.ExternalID.ResourceGroupName, operation.ExternalID.Parent.Name)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", utils.TrackError(fmt.Errorf("failed to get cluster from cache: %w", err))
+	}
+	message, err := operationbase.MaintenanceWindowStatusMessage(existingCluster.CustomerProperties.MaintenanceWindow, c.clock.Now())
+	if err != nil || len(message) == 0 {
+		return message, err
+	}
+
+	triggered, err := c.nodePoolUpgradeTriggered(ctx, existingNodePool, desiredVersion)
+	if err != nil {
+		return "", err
+	}
+	if triggered {
+		return "", nil
+	}
+	return message, nil
+}
+
+// nodePoolUpgradeTriggered reports whether the latest node pool upgrade policy in Cluster
+// Service is for desiredVersion, which is how TriggerNodePoolUpgrade starts an upgrade.
+func (c *operationNodePoolUpdate) nodePoolUpgradeTriggered(ctx context.Context, existingNodePool *coreapi.HCPOpenShiftClusterNodePool, desiredVersion *semver.Version) (bool, error) {
+	iterator := c.clusterServiceClient.ListNodePoolUpgradePolicies(*existingNodePool.ServiceProviderProperties.ClusterServiceID, "creation_timestamp desc")
+
+	triggered := false
+	for policy := range iterator.Items(ctx) {
+		latestPolicyVersion, ok := policy.GetVersion()
+		triggered = ok && latestPolicyVersion == desiredVersion.String()
+		break // Only need to check the first policy
+	}
+	if err := iterator.GetError(); err != nil {
+		return false, utils.TrackError(fmt.Errorf("failed to list node pool upgrade policies: %w", err))
+	}
+	return triggered, nil
 }
 
 func (c *operationNodePoolUpdate) desiredVersionResolutionOperationState(ctx context.Context, operation *coreapi.Operation, existingNodePool *coreapi.HCPOpenShiftClusterNodePool, existingServiceProviderNodePool *coreapi.ServiceProviderNodePool) (*operationbase.OperationState, error) {
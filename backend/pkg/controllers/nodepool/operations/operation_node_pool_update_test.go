@@ -464,6 +464,7 @@ func TestOperationNodePoolUpdate_SynchronizeOperation(t *testing.T) {
 				}
 			}
 
+			clusterLister := &corelistertesting.DBClusterLister{ResourcesDBClient: mockResourcesDBClient}
 			nodePoolLister := tc.nodePoolLister
 			if nodePoolLister == nil {
 				nodePoolLister = &corelistertesting.DBNodePoolLister{ResourcesDBClient: mockResourcesDBClient}
@@ -486,6 +487,7 @@ func TestOperationNodePoolUpdate_SynchronizeOperation(t *testing.T) {
 			controller := &operationNodePoolUpdate{
 				resourcesDBClient:               mockResourcesDBClient,
 				clusterServiceClient:            mockCSClient,
+				clusterLister:                   clusterLister,
 				nodePoolLister:                  nodePoolLister,
 				serviceProviderNodePoolLister:   serviceProviderNodePoolLister,
 				readDesireLister:                readDesireLister,
@@ -0,0 +1,312 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	arohcpv1alpha1 "github.com/openshift-online/ocm-sdk-go/arohcp/v1alpha1"
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
+	operationbase "github.com/Azure/ARO-HCP/backend/pkg/utils/operationutils"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/corecosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/informers/coreinformers"
+	"github.com/Azure/ARO-HCP/internal/database/listers/corelisters"
+	"github.com/Azure/ARO-HCP/internal/ocm"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+const NodePoolReplaceControllerName = "NodePoolReplace"
+
+// nodePoolReplaceSyncer carries out a node pool replace action recorded in
+// ServiceProviderProperties.Replacement by the frontend. It:
+//
+//  1. creates a surge Cluster Service node pool with the new platform next to
+//     the current one and waits for it to be ready,
+//  2. points the node pool at the surge node pool and adopts the new platform,
+//  3. deletes the previous Cluster Service node pool, which cordons and drains
+//     its nodes within the node pool's NodeDrainTimeoutMinutes, and waits for
+//     it to be gone.
+//
+// Progress is recorded in Replacement.Phase. The OperationNodePoolReplace
+// controller reports that progress on the replace operation.
+type nodePoolReplaceSyncer struct {
+	nodePoolLister       corelisters.NodePoolLister
+	resourcesDBClient    corecosmosstorage.ResourcesDBClient
+	clusterServiceClient ocm.ClusterServiceClientSpec
+}
+
+var _ controllerutils.NodePoolSyncer = (*nodePoolReplaceSyncer)(nil)
+
+func NewNodePoolReplaceController(
+	resourcesDBClient corecosmosstorage.ResourcesDBClient,
+	clusterServiceClient ocm.ClusterServiceClientSpec,
+	informers coreinformers.BackendInformers,
+) controllerutils.Controller {
+	_, nodePoolLister := informers.NodePools()
+	syncer := &nodePoolReplaceSyncer{
+		nodePoolLister:       nodePoolLister,
+		resourcesDBClient:    resourcesDBClient,
+		clusterServiceClient: clusterServiceClient,
+	}
+
+	return controllerutils.NewNodePoolWatchingController(
+		NodePoolReplaceControllerName,
+		resourcesDBClient,
+		informers,
+		nil,
+		time.Minute,
+		syncer,
+	)
+}
+
+func needsWork(nodePool *coreapi.HCPOpenShiftClusterNodePool) bool {
+	replacement := nodePool.ServiceProviderProperties.Replacement
+	if replacement == nil || replacement.Phase.IsTerminal() {
+		return false
+	}
+
+	csID := nodePool.ServiceProviderProperties.ClusterServiceID
+	return csID != nil && len(csID.String()) > 0
+}
+
+func (c *nodePoolReplaceSyncer) SyncOnce(ctx context.Context, key controllerutils.HCPNodePoolKey) error {
+	cachedNodePool, err := c.nodePoolLister.Get(ctx, key.SubscriptionID, key.ResourceGroupName, key.HCPClusterName, key.HCPNodePoolName)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to get node pool from cache: %w", err))
+	}
+	if !needsWork(cachedNodePool) {
+		return nil
+	}
+
+	// We are about to use the node pool to interact with Cluster Service, so
+	// confirm against the live document.
+	nodePoolCRUD := c.resourcesDBClient.HCPClusters(key.SubscriptionID, key.ResourceGroupName).NodePools(key.HCPClusterName)
+	nodePool, err := nodePoolCRUD.Get(ctx, key.HCPNodePoolName)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return nil
+	}
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to get node pool: %w", err))
+	}
+	if !needsWork(nodePool) {
+		return nil
+	}
+
+	var replacement *coreapi.HCPOpenShiftClusterNodePool
+	switch {
+	case nodePool.ServiceProviderProperties.DeletionTimestamp != nil:
+		replacement, err = c.abandonReplacement(ctx, nodePool)
+	case nodePool.ServiceProviderProperties.Replacement.Phase == coreapi.NodePoolReplacementPhaseCreatingSurgeNodePool:
+		replacement, err = c.syncSurgeNodePool(ctx, nodePool)
+	case nodePool.ServiceProviderProperties.Replacement.Phase == coreapi.NodePoolReplacementPhaseRemovingPreviousNodePool:
+		replacement, err = c.syncPreviousNodePool(ctx, nodePool)
+	default:
+		return utils.TrackError(fmt.Errorf("unknown node pool replacement phase %q", nodePool.ServiceProviderProperties.Replacement.Phase))
+	}
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	if replacement == nil {
+		return nil
+	}
+
+	_, err = nodePoolCRUD.Replace(ctx, replacement, nil)
+	if cosmosstorageutils.IsPreconditionFailedError(err) {
+		// if we have a conflict error, then we're guaranteed that our informer will eventually see an update and trigger us again.
+		return nil
+	}
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	return nil
+}
+
+// abandonReplacement stops a replacement of a node pool that is being deleted.
+// The deletion controllers only know about the node pool's ClusterServiceID,
+// so the other Cluster Service node pool of the replacement is deleted here:
+// the surge node pool if it has not taken over yet, otherwise the previous
+// node pool if it is still draining.
+func (c *nodePoolReplaceSyncer) abandonReplacement(ctx context.Context, nodePool *coreapi.HCPOpenShiftClusterNodePool) (*coreapi.HCPOpenShiftClusterNodePool, error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	switch replacement := nodePool.ServiceProviderProperties.Replacement; replacement.Phase {
+	case coreapi.NodePoolReplacementPhaseCreatingSurgeNodePool:
+		if surgeID := replacement.SurgeClusterServiceID; surgeID != nil {
+			logger.Info("deleting surge node pool of deleted node pool", "surgeClusterServiceID", surgeID.String())
+			if err := c.deleteCSNodePool(ctx, *surgeID); err != nil {
+				return nil, err
+			}
+		}
+	case coreapi.NodePoolReplacementPhaseRemovingPreviousNodePool:
+		if previousID := replacement.PreviousClusterServiceID; previousID != nil {
+			logger.Info("deleting previous node pool of deleted node pool", "previousClusterServiceID", previousID.String())
+			if err := c.deleteCSNodePool(ctx, *previousID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	replacement := nodePool.DeepCopy()
+	replacement.ServiceProviderProperties.Replacement.Phase = coreapi.NodePoolReplacementPhaseFailed
+	replacement.ServiceProviderProperties.Replacement.Message = "The node pool was deleted before the replacement completed."
+	return replacement, nil
+}
+
+// syncSurgeNodePool creates the surge node pool and, once it is ready, hands
+// the node pool over to it.
+func (c *nodePoolReplaceSyncer) syncSurgeNodePool(ctx context.Context, nodePool *coreapi.HCPOpenShiftClusterNodePool) (*coreapi.HCPOpenShiftClusterNodePool, error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	currentID := nodePool.ServiceProviderProperties.ClusterServiceID
+	surgeID := nodePool.ServiceProviderProperties.Replacement.SurgeClusterServiceID
+	if surgeID == nil {
+		surgeName := ocm.ReplacementNodePoolName(nodePool.Name, currentID)
+		surgeInternalID, err := metadataapi.NewInternalID(ocm.GenerateAROHCPNodePoolHREF(currentID.ClusterID(), surgeName))
+		if err != nil {
+			return nil, fmt.Errorf("failed to build surge node pool internal ID: %w", err)
+		}
+		clusterInternalID, err := metadataapi.NewInternalID(ocm.GenerateAROHCPClusterHREF(currentID.ClusterID()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to build cluster internal ID: %w", err)
+		}
+
+		// A previous sync may have created the surge node pool and then
+		// failed to record it.
+		existing, err := c.getCSNodePool(ctx, surgeInternalID)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil {
+			builder, err := ocm.BuildCSReplacementNodePool(ctx, nodePool, &nodePool.ServiceProviderProperties.Replacement.Platform, surgeName)
+			if err != nil {
+				return nil, err
+			}
+			logger.Info("performing POST surge node pool to Cluster Service", "surgeClusterServiceID", surgeInternalID.String())
+			if _, err := c.clusterServiceClient.PostNodePool(ctx, clusterInternalID, builder); err != nil {
+				return nil, err
+			}
+		}
+
+		replacement := nodePool.DeepCopy()
+		replacement.ServiceProviderProperties.Replacement.SurgeClusterServiceID = surgeInternalID.DeepCopy()
+		return replacement, nil
+	}
+
+	status, err := c.clusterServiceClient.GetNodePoolStatus(ctx, *surgeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get surge node pool status: %w", err)
+	}
+
+	switch state := operationbase.NodePoolStateValue(status.State().NodePoolStateValue()); state {
+	case operationbase.NodePoolStateReady:
+		logger.Info("surge node pool is ready, removing previous node pool",
+			"surgeClusterServiceID", surgeID.String(), "previousClusterServiceID", currentID.String())
+		replacement := nodePool.DeepCopy()
+		replacement.ServiceProviderProperties.Replacement.PreviousClusterServiceID = currentID.DeepCopy()
+		replacement.ServiceProviderProperties.ClusterServiceID = surgeID.DeepCopy()
+		nodePool.ServiceProviderProperties.Replacement.Platform.DeepCopyInto(&replacement.Properties.Platform)
+		replacement.ServiceProviderProperties.Replacement.Phase = coreapi.NodePoolReplacementPhaseRemovingPreviousNodePool
+		return replacement, nil
+
+	case operationbase.NodePoolStateError:
+		message, _ := status.GetMessage()
+		logger.Info("surge node pool failed, deleting it", "surgeClusterServiceID", surgeID.String(), "message", message)
+		if err := c.deleteCSNodePool(ctx, *surgeID); err != nil {
+			return nil, err
+		}
+		replacement := nodePool.DeepCopy()
+		replacement.ServiceProviderProperties.Replacement.Phase = coreapi.NodePoolReplacementPhaseFailed
+		replacement.ServiceProviderProperties.Replacement.Message = "The replacement node pool failed to provision."
+		if len(message) > 0 {
+			replacement.ServiceProviderProperties.Replacement.Message += " " + message
+		}
+		return replacement, nil
+
+	default:
+		logger.Info("waiting for surge node pool", "surgeClusterServiceID", surgeID.String(), "state", state)
+		return nil, nil
+	}
+}
+
+// syncPreviousNodePool deletes the previous node pool and completes the
+// replacement once Cluster Service no longer knows about it.
+func (c *nodePoolReplaceSyncer) syncPreviousNodePool(ctx context.Context, nodePool *coreapi.HCPOpenShiftClusterNodePool) (*coreapi.HCPOpenShiftClusterNodePool, error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	previousID := nodePool.ServiceProviderProperties.Replacement.PreviousClusterServiceID
+	if previousID == nil {
+		return nil, fmt.Errorf("node pool replacement in phase %q has no previous Cluster Service ID", nodePool.ServiceProviderProperties.Replacement.Phase)
+	}
+
+	previous, err := c.getCSNodePool(ctx, *previousID)
+	if err != nil {
+		return nil, err
+	}
+	if previous == nil {
+		logger.Info("previous node pool is gone, replacement complete", "previousClusterServiceID", previousID.String())
+		replacement := nodePool.DeepCopy()
+		replacement.ServiceProviderProperties.Replacement.Phase = coreapi.NodePoolReplacementPhaseSucceeded
+		return replacement, nil
+	}
+
+	if operationbase.NodePoolStateValue(previous.Status().State().NodePoolStateValue()) != operationbase.NodePoolStateUninstalling {
+		// Cluster Service cordons and drains the nodes of a deleted node pool,
+		// honoring the node pool's node drain timeout.
+		logger.Info("performing DELETE previous node pool to Cluster Service", "previousClusterServiceID", previousID.String())
+		if err := c.deleteCSNodePool(ctx, *previousID); err != nil {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// getCSNodePool returns (nil, nil) when Cluster Service responds with 404.
+func (c *nodePoolReplaceSyncer) getCSNodePool(ctx context.Context, internalID metadataapi.InternalID) (*arohcpv1alpha1.NodePool, error) {
+	nodePool, err := c.clusterServiceClient.GetNodePool(ctx, internalID)
+	if isOCMNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Cluster Service node pool %s: %w", internalID.String(), err)
+	}
+	return nodePool, nil
+}
+
+// deleteCSNodePool treats a node pool that is already gone as deleted.
+func (c *nodePoolReplaceSyncer) deleteCSNodePool(ctx context.Context, internalID metadataapi.InternalID) error {
+	err := c.clusterServiceClient.DeleteNodePool(ctx, internalID)
+	if err != nil && !isOCMNotFoundError(err) {
+		return fmt.Errorf("failed to delete Cluster Service node pool %s: %w", internalID.String(), err)
+	}
+	return nil
+}
+
+func isOCMNotFoundError(err error) bool {
+	var ocmErr *ocmerrors.Error
+	return err != nil && errors.As(err, &ocmErr) && ocmErr.Status() == http.StatusNotFound
+}
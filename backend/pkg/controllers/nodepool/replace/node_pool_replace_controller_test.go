@@ -0,0 +1,356 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replace
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	arohcpv1alpha1 "github.com/openshift-online/ocm-sdk-go/arohcp/v1alpha1"
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
+	operationbase "github.com/Azure/ARO-HCP/backend/pkg/utils/operationutils"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/corecosmosstoragetesting"
+	"github.com/Azure/ARO-HCP/internal/database/listertesting/corelistertesting"
+	"github.com/Azure/ARO-HCP/internal/ocm"
+)
+
+const (
+	testSubscriptionID      = "00000000-0000-0000-0000-000000000000"
+	testResourceGroupName   = "test-rg"
+	testClusterName         = "test-cluster"
+	testNodePoolName        = "test-nodepool"
+	testOperationID         = "11111111-1111-1111-1111-111111111111"
+	testClusterServiceIDStr = "/api/aro_hcp/v1alpha1/clusters/abc123"
+	testNodePoolCSIDStr     = testClusterServiceIDStr + "/node_pools/" + testNodePoolName
+	testSurgeCSIDStr        = testClusterServiceIDStr + "/node_pools/test-nodepoo-rp"
+)
+
+func TestNodePoolReplaceSyncer_SyncOnce(t *testing.T) {
+	clusterCSID := metadataapi.Must(metadataapi.NewInternalID(testClusterServiceIDStr))
+	currentCSID := metadataapi.Must(metadataapi.NewInternalID(testNodePoolCSIDStr))
+	surgeCSID := metadataapi.Must(metadataapi.NewInternalID(testSurgeCSIDStr))
+	now := metav1.Now()
+
+	csNodePoolStatus := func(state operationbase.NodePoolStateValue) *arohcpv1alpha1.NodePoolStatus {
+		return metadataapi.Must(arohcpv1alpha1.NewNodePoolStatus().
+			State(arohcpv1alpha1.NewNodePoolState().NodePoolStateValue(string(state))).
+			Message("surge failed").
+			Build())
+	}
+	csNodePool := func(state operationbase.NodePoolStateValue) *arohcpv1alpha1.NodePool {
+		return metadataapi.Must(arohcpv1alpha1.NewNodePool().
+			Status(arohcpv1alpha1.NewNodePoolStatus().
+				State(arohcpv1alpha1.NewNodePoolState().NodePoolStateValue(string(state)))).
+			Build())
+	}
+
+	testCases := []struct {
+		name              string
+		existingNodePool  *coreapi.HCPOpenShiftClusterNodePool
+		setupMockCSClient func(mock *ocm.MockClusterServiceClientSpec)
+		verify            func(t *testing.T, nodePool *coreapi.HCPOpenShiftClusterNodePool)
+		wantErr           bool
+	}{
+		{
+			name: "no replacement",
+			existingNodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.ServiceProviderProperties.Replacement = nil
+			}),
+		},
+		{
+			name: "terminal replacement",
+			existingNodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.ServiceProviderProperties.Replacement.Phase = coreapi.NodePoolReplacementPhaseSucceeded
+			}),
+		},
+		{
+			name:             "creates surge node pool",
+			existingNodePool: newTestNodePool(),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePool(gomock.Any(), surgeCSID).Return(nil, fakeOCMNotFoundError())
+				mock.EXPECT().PostNodePool(gomock.Any(), clusterCSID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ ocm.InternalID, builder *arohcpv1alpha1.NodePoolBuilder) (*arohcpv1alpha1.NodePool, error) {
+						csNodePool, err := builder.Build()
+						require.NoError(t, err)
+						assert.Equal(t, "test-nodepoo-rp", csNodePool.ID())
+						assert.Equal(t, "Standard_D8s_v3", csNodePool.AzureNodePool().VMSize())
+						return csNodePool, nil
+					})
+			},
+			verify: func(t *testing.T, nodePool *coreapi.HCPOpenShiftClusterNodePool) {
+				replacement := nodePool.ServiceProviderProperties.Replacement
+				assert.Equal(t, coreapi.NodePoolReplacementPhaseCreatingSurgeNodePool, replacement.Phase)
+				assert.Equal(t, &surgeCSID, replacement.SurgeClusterServiceID)
+				assert.Equal(t, &currentCSID, nodePool.ServiceProviderProperties.ClusterServiceID)
+			},
+		},
+		{
+			name:             "records surge node pool created by a previous sync",
+			existingNodePool: newTestNodePool(),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePool(gomock.Any(), surgeCSID).Return(csNodePool(operationbase.NodePoolStateInstalling), nil)
+			},
+			verify: func(t *testing.T, nodePool *coreapi.HCPOpenShiftClusterNodePool) {
+				assert.Equal(t, &surgeCSID, nodePool.ServiceProviderProperties.Replacement.SurgeClusterServiceID)
+			},
+		},
+		{
+			name: "waits for surge node pool",
+			existingNodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.ServiceProviderProperties.Replacement.SurgeClusterServiceID = &surgeCSID
+			}),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePoolStatus(gomock.Any(), surgeCSID).Return(csNodePoolStatus(operationbase.NodePoolStateInstalling), nil)
+			},
+			verify: func(t *testing.T, nodePool *coreapi.HCPOpenShiftClusterNodePool) {
+				assert.Equal(t, coreapi.NodePoolReplacementPhaseCreatingSurgeNodePool, nodePool.ServiceProviderProperties.Replacement.Phase)
+			},
+		},
+		{
+			name: "hands over to ready surge node pool",
+			existingNodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.ServiceProviderProperties.Replacement.SurgeClusterServiceID = &surgeCSID
+			}),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePoolStatus(gomock.Any(), surgeCSID).Return(csNodePoolStatus(operationbase.NodePoolStateReady), nil)
+			},
+			verify: func(t *testing.T, nodePool *coreapi.HCPOpenShiftClusterNodePool) {
+				replacement := nodePool.ServiceProviderProperties.Replacement
+				assert.Equal(t, coreapi.NodePoolReplacementPhaseRemovingPreviousNodePool, replacement.Phase)
+				assert.Equal(t, &currentCSID, replacement.PreviousClusterServiceID)
+				assert.Equal(t, &surgeCSID, nodePool.ServiceProviderProperties.ClusterServiceID)
+				assert.Equal(t, "Standard_D8s_v3", nodePool.Properties.Platform.VMSize)
+			},
+		},
+		{
+			name: "fails when surge node pool fails",
+			existingNodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.ServiceProviderProperties.Replacement.SurgeClusterServiceID = &surgeCSID
+			}),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePoolStatus(gomock.Any(), surgeCSID).Return(csNodePoolStatus(operationbase.NodePoolStateError), nil)
+				mock.EXPECT().DeleteNodePool(gomock.Any(), surgeCSID).Return(nil)
+			},
+			verify: func(t *testing.T, nodePool *coreapi.HCPOpenShiftClusterNodePool) {
+				replacement := nodePool.ServiceProviderProperties.Replacement
+				assert.Equal(t, coreapi.NodePoolReplacementPhaseFailed, replacement.Phase)
+				assert.Contains(t, replacement.Message, "surge failed")
+				assert.Equal(t, &currentCSID, nodePool.ServiceProviderProperties.ClusterServiceID)
+				assert.Equal(t, "Standard_D4s_v3", nodePool.Properties.Platform.VMSize)
+			},
+		},
+		{
+			name: "deletes previous node pool",
+			existingNodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.ServiceProviderProperties.ClusterServiceID = &surgeCSID
+				np.ServiceProviderProperties.Replacement.SurgeClusterServiceID = &surgeCSID
+				np.ServiceProviderProperties.Replacement.PreviousClusterServiceID = &currentCSID
+				np.ServiceProviderProperties.Replacement.Phase = coreapi.NodePoolReplacementPhaseRemovingPreviousNodePool
+			}),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePool(gomock.Any(), currentCSID).Return(csNodePool(operationbase.NodePoolStateReady), nil)
+				mock.EXPECT().DeleteNodePool(gomock.Any(), currentCSID).Return(nil)
+			},
+			verify: func(t *testing.T, nodePool *coreapi.HCPOpenShiftClusterNodePool) {
+				assert.Equal(t, coreapi.NodePoolReplacementPhaseRemovingPreviousNodePool, nodePool.ServiceProviderProperties.Replacement.Phase)
+			},
+		},
+		{
+			name: "waits for previous node pool to drain",
+			existingNodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.ServiceProviderProperties.ClusterServiceID = &surgeCSID
+				np.ServiceProviderProperties.Replacement.PreviousClusterServiceID = &currentCSID
+				np.ServiceProviderProperties.Replacement.Phase = coreapi.NodePoolReplacementPhaseRemovingPreviousNodePool
+			}),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePool(gomock.Any(), currentCSID).Return(csNodePool(operationbase.NodePoolStateUninstalling), nil)
+			},
+		},
+		{
+			name: "completes once previous node pool is gone",
+			existingNodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.ServiceProviderProperties.ClusterServiceID = &surgeCSID
+				np.ServiceProviderProperties.Replacement.PreviousClusterServiceID = &currentCSID
+				np.ServiceProviderProperties.Replacement.Phase = coreapi.NodePoolReplacementPhaseRemovingPreviousNodePool
+			}),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().GetNodePool(gomock.Any(), currentCSID).Return(nil, fakeOCMNotFoundError())
+			},
+			verify: func(t *testing.T, nodePool *coreapi.HCPOpenShiftClusterNodePool) {
+				assert.Equal(t, coreapi.NodePoolReplacementPhaseSucceeded, nodePool.ServiceProviderProperties.Replacement.Phase)
+			},
+		},
+		{
+			name: "abandons replacement of deleted node pool",
+			existingNodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.ServiceProviderProperties.DeletionTimestamp = &now
+				np.ServiceProviderProperties.Replacement.SurgeClusterServiceID = &surgeCSID
+			}),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().DeleteNodePool(gomock.Any(), surgeCSID).Return(fakeOCMNotFoundError())
+			},
+			verify: func(t *testing.T, nodePool *coreapi.HCPOpenShiftClusterNodePool) {
+				assert.Equal(t, coreapi.NodePoolReplacementPhaseFailed, nodePool.ServiceProviderProperties.Replacement.Phase)
+			},
+		},
+		{
+			name: "abandons replacement of deleted node pool while removing previous node pool",
+			existingNodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.ServiceProviderProperties.DeletionTimestamp = &now
+				np.ServiceProviderProperties.ClusterServiceID = &surgeCSID
+				np.ServiceProviderProperties.Replacement.SurgeClusterServiceID = &surgeCSID
+				np.ServiceProviderProperties.Replacement.PreviousClusterServiceID = &currentCSID
+				np.ServiceProviderProperties.Replacement.Phase = coreapi.NodePoolReplacementPhaseRemovingPreviousNodePool
+			}),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				mock.EXPECT().DeleteNodePool(gomock.Any(), currentCSID).Return(nil)
+			},
+			verify: func(t *testing.T, nodePool *coreapi.HCPOpenShiftClusterNodePool) {
+				assert.Equal(t, coreapi.NodePoolReplacementPhaseFailed, nodePool.ServiceProviderProperties.Replacement.Phase)
+			},
+		},
+		{
+			name: "cluster service error",
+			existingNodePool: newTestNodePool(func(np *coreapi.HCPOpenShiftClusterNodePool) {
+				np.ServiceProviderProperties.Replacement.SurgeClusterServiceID = &surgeCSID
+			}),
+			setupMockCSClient: func(mock *ocm.MockClusterServiceClientSpec) {
+				e, _ := ocmerrors.NewError().Status(http.StatusInternalServerError).Reason("boom").Build()
+				mock.EXPECT().GetNodePoolStatus(gomock.Any(), surgeCSID).Return(nil, e)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+
+			mockResourcesDB, err := corecosmosstoragetesting.NewMockResourcesDBClientWithResources(ctx, []any{tc.existingNodePool})
+			require.NoError(t, err)
+
+			mockCSClient := ocm.NewMockClusterServiceClientSpec(ctrl)
+			if tc.setupMockCSClient != nil {
+				tc.setupMockCSClient(mockCSClient)
+			}
+
+			syncer := &nodePoolReplaceSyncer{
+				nodePoolLister:       &corelistertesting.DBNodePoolLister{ResourcesDBClient: mockResourcesDB},
+				resourcesDBClient:    mockResourcesDB,
+				clusterServiceClient: mockCSClient,
+			}
+
+			err = syncer.SyncOnce(ctx, controllerutils.HCPNodePoolKey{
+				SubscriptionID:    testSubscriptionID,
+				ResourceGroupName: testResourceGroupName,
+				HCPClusterName:    testClusterName,
+				HCPNodePoolName:   testNodePoolName,
+			})
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.verify != nil {
+				nodePool, err := mockResourcesDB.HCPClusters(testSubscriptionID, testResourceGroupName).NodePools(testClusterName).Get(ctx, testNodePoolName)
+				require.NoError(t, err)
+				tc.verify(t, nodePool)
+			}
+		})
+	}
+}
+
+func fakeOCMNotFoundError() error {
+	e, _ := ocmerrors.NewError().Status(http.StatusNotFound).Reason("not found").Build()
+	return e
+}
+
+func newTestNodePool(opts ...func(*coreapi.HCPOpenShiftClusterNodePool)) *coreapi.HCPOpenShiftClusterNodePool {
+	resourceID := metadataapi.Must(azcorearm.ParseResourceID(
+		"/subscriptions/" + testSubscriptionID +
+			"/resourceGroups/" + testResourceGroupName +
+			"/providers/Microsoft.RedHatOpenShift/hcpOpenShiftClusters/" + testClusterName +
+			"/nodePools/" + testNodePoolName,
+	))
+
+	csID := metadataapi.Must(metadataapi.NewInternalID(testNodePoolCSIDStr))
+	nodePool := &coreapi.HCPOpenShiftClusterNodePool{
+		CosmosMetadata: coreapi.CosmosMetadata{
+			ResourceID:   resourceID,
+			PartitionKey: strings.ToLower(resourceID.SubscriptionID),
+		},
+		TrackedResource: coreapi.TrackedResource{
+			Resource: coreapi.Resource{
+				ID:   resourceID,
+				Name: testNodePoolName,
+				Type: resourceID.ResourceType.String(),
+			},
+		},
+		ServiceProviderProperties: coreapi.HCPOpenShiftClusterNodePoolServiceProviderProperties{
+			ClusterServiceID:  &csID,
+			ActiveOperationID: testOperationID,
+			Replacement: &coreapi.NodePoolReplacement{
+				OperationID: testOperationID,
+				Phase:       coreapi.NodePoolReplacementPhaseCreatingSurgeNodePool,
+				Platform: coreapi.NodePoolPlatformProfile{
+					VMSize: "Standard_D8s_v3",
+					OSDisk: coreapi.OSDiskProfile{
+						SizeGiB:                ptr.To(int32(256)),
+						DiskType:               metadataapi.OsDiskTypeManaged,
+						DiskStorageAccountType: metadataapi.DiskStorageAccountTypePremium_LRS,
+					},
+				},
+			},
+		},
+		Properties: coreapi.HCPOpenShiftClusterNodePoolProperties{
+			Replicas: 2,
+			Version: coreapi.NodePoolVersionProfile{
+				ID:           "4.20.8",
+				ChannelGroup: "stable",
+			},
+			Platform: coreapi.NodePoolPlatformProfile{
+				VMSize: "Standard_D4s_v3",
+				OSDisk: coreapi.OSDiskProfile{
+					SizeGiB:                ptr.To(int32(128)),
+					DiskType:               metadataapi.OsDiskTypeManaged,
+					DiskStorageAccountType: metadataapi.DiskStorageAccountTypePremium_LRS,
+				},
+			},
+		},
+	}
+
+	for _, opt := range opts {
+		opt(nodePool)
+	}
+
+	return nodePool
+}
@@ -21,6 +21,8 @@ import (
 
 	"github.com/blang/semver/v4"
 
+	utilsclock "k8s.io/utils/clock"
+
 	arohcpv1alpha1 "github.com/openshift-online/ocm-sdk-go/arohcp/v1alpha1"
 
 	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
@@ -36,6 +38,7 @@ import (
 
 // triggerNodePoolUpgradeSyncer is a NodePool syncer that triggers node pool upgrades
 type triggerNodePoolUpgradeSyncer struct {
+	clock                         utilsclock.PassiveClock
 	resourcesDBClient             corecosmosstorage.ResourcesDBClient
 	clusterServiceClient          ocm.ClusterServiceClientSpec
 	serviceProviderNodePoolLister corelisters.ServiceProviderNodePoolLister
@@ -47,6 +50,7 @@ var _ controllerutils.NodePoolSyncer = (*triggerNodePoolUpgradeSyncer)(nil)
 // It monitors node pools where the desired version differs from the actual version and creates
 // a NodePoolUpgradePolicy in Cluster Service to initiate the upgrade.
 func NewTriggerNodePoolUpgradeController(
+	clock utilsclock.PassiveClock,
 	resourcesDBClient corecosmosstorage.ResourcesDBClient,
 	clusterServiceClient ocm.ClusterServiceClientSpec,
 	serviceProviderNodePoolLister corelisters.ServiceProviderNodePoolLister,
@@ -54,6 +58,7 @@ func NewTriggerNodePoolUpgradeController(
 	kubeApplierInformers *unionkubeapplierinformers.UnionKubeApplierInformers,
 ) controllerutils.Controller {
 	syncer := &triggerNodePoolUpgradeSyncer{
+		clock:                         clock,
 		resourcesDBClient:             resourcesDBClient,
 		clusterServiceClient:          clusterServiceClient,
 		serviceProviderNodePoolLister: serviceProviderNodePoolLister,
@@ -77,8 +82,10 @@ func NewTriggerNodePoolUpgradeController(
 //  1. Fetch the node pool and service provider node pool state
 //  2. Skips upgrade trigger if no active versions exist yet (during installation)
 //  3. Check if desiredVersion differs from latest actual version
-//  4. If different, create a NodePoolUpgradePolicy to trigger upgrade
+//  4. If different and the parent cluster's maintenance window (if any) is open, create a NodePoolUpgradePolicy to trigger upgrade
 func (c *triggerNodePoolUpgradeSyncer) SyncOnce(ctx context.Context, key controllerutils.HCPNodePoolKey) error {
+	logger := utils.LoggerFromContext(ctx)
+
 	existingNodePool, err := c.resourcesDBClient.HCPClusters(key.SubscriptionID, key.ResourceGroupName).
 		NodePools(key.HCPClusterName).Get(ctx, key.HCPNodePoolName)
 	if cosmosstorageutils.IsNotFoundError(err) {
@@ -122,6 +129,24 @@ func (c *triggerNodePoolUpgradeSyncer) SyncOnce(ctx context.Context, key control
 		return nil
 	}
 
+	// The maintenance window is defined on the parent cluster and applies to
+	// its node pools as well.
+	existingCluster, err := c.resourcesDBClient.HCPClusters(key.SubscriptionID, key.ResourceGroupName).Get(ctx, key.HCPClusterName)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return nil // cluster doesn't exist, no work to do
+	}
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to get Cluster: %w", err))
+	}
+	upgradeAllowed, err := existingCluster.CustomerProperties.MaintenanceWindow.UpgradeAllowed(c.clock.Now())
+	if err != nil {
+		return utils.TrackError(fmt.Errorf("failed to evaluate maintenance window: %w", err))
+	}
+	if !upgradeAllowed {
+		logger.Info("Waiting for maintenance window to trigger node pool upgrade", "nodePool", existingNodePool.Name, "desiredVersion", desiredVersion)
+		return nil
+	}
+
 	return c.createUpgradePolicyIfNeeded(ctx, desiredVersion, *existingNodePool.ServiceProviderProperties.ClusterServiceID)
 }
 
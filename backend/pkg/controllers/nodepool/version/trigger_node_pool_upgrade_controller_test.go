@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/go-logr/logr"
@@ -27,6 +28,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 
 	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -335,3 +337,76 @@ func createServiceProviderNodePoolWithActiveAndDesiredVersion(
 	_, err := mockResourcesDBClient.ServiceProviderNodePools(testSubscriptionID, testResourceGroupName, testClusterName, testNodePoolName).Create(ctx, spNodePool, nil)
 	require.NoError(t, err)
 }
+
+func TestTriggerNodePoolUpgradeSyncer_SyncOnceHonorsMaintenanceWindow(t *testing.T) {
+	testNodePoolServiceID := metadataapi.Must(metadataapi.NewInternalID(testCSNodePoolIDStr))
+	// Saturdays from 22:00 to 02:00 UTC.
+	maintenanceWindow := &coreapi.MaintenanceWindowProfile{
+		DaysOfWeek:    []metadataapi.DayOfWeek{metadataapi.DayOfWeekSaturday},
+		StartTime:     "22:00",
+		DurationHours: 4,
+	}
+
+	tests := []struct {
+		name                 string
+		now                  time.Time
+		expectPolicyCreation bool
+	}{
+		{
+			name:                 "maintenance window closed - upgrade is not triggered",
+			now:                  time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC),
+			expectPolicyCreation: false,
+		},
+		{
+			name:                 "maintenance window open - upgrade is triggered",
+			now:                  time.Date(2026, 10, 18, 1, 0, 0, 0, time.UTC),
+			expectPolicyCreation: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runCtx := utils.ContextWithLogger(context.Background(), logr.Discard())
+			mockDB := corecosmosstoragetesting.NewMockResourcesDBClient()
+
+			createTestNodePoolWithVersion(t, runCtx, mockDB, "4.21.3")
+			createServiceProviderNodePoolWithActiveAndDesiredVersion(
+				t, runCtx, mockDB, ptr.To(semver.MustParse("4.21.3")),
+				"4.21.0",
+			)
+			clusterCRUD := mockDB.HCPClusters(testSubscriptionID, testResourceGroupName)
+			cluster, err := clusterCRUD.Get(runCtx, testClusterName)
+			require.NoError(t, err)
+			cluster.CustomerProperties.MaintenanceWindow = maintenanceWindow.DeepCopy()
+			_, err = clusterCRUD.Replace(runCtx, cluster, nil)
+			require.NoError(t, err)
+
+			ctrl := gomock.NewController(t)
+			mockClusterServiceClient := ocm.NewMockClusterServiceClientSpec(ctrl)
+			if tt.expectPolicyCreation {
+				expectedBuilder := arohcpv1alpha1.NewNodePoolUpgradePolicy().Version("4.21.3")
+				mockClusterServiceClient.EXPECT().
+					ListNodePoolUpgradePolicies(testNodePoolServiceID, "creation_timestamp desc").
+					Return(ocm.NewSimpleNodePoolUpgradePolicyListIterator([]*arohcpv1alpha1.NodePoolUpgradePolicy{}, nil))
+				mockClusterServiceClient.EXPECT().
+					PostNodePoolUpgradePolicy(gomock.Any(), testNodePoolServiceID, expectedBuilder).
+					Return(metadataapi.Must(expectedBuilder.Build()), nil)
+			}
+
+			syncer := &triggerNodePoolUpgradeSyncer{
+				clock:                         clocktesting.NewFakePassiveClock(tt.now),
+				resourcesDBClient:             mockDB,
+				clusterServiceClient:          mockClusterServiceClient,
+				serviceProviderNodePoolLister: &corelistertesting.DBServiceProviderNodePoolLister{ResourcesDBClient: mockDB},
+			}
+
+			err = syncer.SyncOnce(runCtx, controllerutils.HCPNodePoolKey{
+				SubscriptionID:    testSubscriptionID,
+				ResourceGroupName: testResourceGroupName,
+				HCPClusterName:    testClusterName,
+				HCPNodePoolName:   testNodePoolName,
+			})
+			assertSyncResult(t, err, false, "")
+		})
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operationnotification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+	utilsclock "k8s.io/utils/clock"
+
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/controllerutils"
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/operationutils"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/corecosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/listers/corelisters"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+const (
+	notificationStatePending      = "Pending"
+	notificationStateDelivered    = "Delivered"
+	notificationStateDeadLettered = "DeadLettered"
+)
+
+type operationNotificationDelivery struct {
+	name string
+
+	clock                   utilsclock.PassiveClock
+	resourcesDBClient       corecosmosstorage.ResourcesDBClient
+	notificationLister      corelisters.OperationNotificationLister
+	postAsyncNotificationFn operationutils.PostAsyncNotificationFunc
+
+	notifications         *prometheus.GaugeVec
+	oldestPendingAge      prometheus.Gauge
+	deliveryAttemptsTotal *prometheus.CounterVec
+	deadLetteredTotal     prometheus.Counter
+
+	// queue is where incoming work is placed to de-dup and to allow "easy"
+	// rate limited requeues on errors
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewOperationNotificationDeliveryController creates a controller that drains the
+// OperationNotification outbox. Every pending notification whose next attempt is due is
+// POSTed to ARM; failures are retried with exponential backoff and notifications that
+// cannot be delivered before operationutils.OperationNotificationDeliveryDeadline are
+// dead-lettered.
+func NewOperationNotificationDeliveryController(
+	clock utilsclock.PassiveClock,
+	resourcesDBClient corecosmosstorage.ResourcesDBClient,
+	notificationLister corelisters.OperationNotificationLister,
+	postAsyncNotificationFn operationutils.PostAsyncNotificationFunc,
+	registerer prometheus.Registerer,
+) controllerutils.Controller {
+	c := &operationNotificationDelivery{
+		name:                    "OperationNotificationDelivery",
+		clock:                   clock,
+		resourcesDBClient:       resourcesDBClient,
+		notificationLister:      notificationLister,
+		postAsyncNotificationFn: postAsyncNotificationFn,
+		notifications: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "backend_operation_notifications",
+			Help: "Number of ARM async operation notifications in the outbox by delivery state.",
+		}, []string{"state"}),
+		oldestPendingAge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "backend_operation_notification_oldest_pending_age_seconds",
+			Help: "Age of the oldest ARM async operation notification that has not been delivered or dead-lettered.",
+		}),
+		deliveryAttemptsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "backend_operation_notification_delivery_attempts_total",
+			Help: "Total number of ARM async operation notification delivery attempts made by the delivery controller by result.",
+		}, []string{"result"}),
+		deadLetteredTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "backend_operation_notification_dead_lettered_total",
+			Help: "Total number of ARM async operation notifications dead-lettered after the delivery deadline passed.",
+		}),
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "OperationNotificationDelivery",
+			},
+		),
+	}
+	registerer.MustRegister(c.notifications, c.oldestPendingAge, c.deliveryAttemptsTotal, c.deadLetteredTotal)
+
+	return c
+}
+
+func (c *operationNotificationDelivery) synchronizeAllNotifications(ctx context.Context) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	allNotifications, err := c.notificationLister.List(ctx)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	now := c.clock.Now()
+	counts := map[string]int{
+		notificationStatePending:      0,
+		notificationStateDelivered:    0,
+		notificationStateDeadLettered: 0,
+	}
+	var oldestPending time.Duration
+	var syncErrs []error
+	for _, cached := range allNotifications {
+		notification := cached
+		if operationutils.OperationNotificationIsDue(notification, now) {
+			delivered, err := c.deliver(ctx, notification)
+			if err != nil {
+				syncErrs = append(syncErrs, err)
+			} else if delivered != nil {
+				notification = delivered
+			}
+		}
+
+		switch {
+		case notification.Status.DeliveredTime != nil:
+			counts[notificationStateDelivered]++
+		case notification.Status.DeadLetteredTime != nil:
+			counts[notificationStateDeadLettered]++
+		default:
+			counts[notificationStatePending]++
+			oldestPending = max(oldestPending, now.Sub(notification.Spec.CreationTime.Time))
+		}
+	}
+
+	for state, count := range counts {
+		c.notifications.WithLabelValues(state).Set(float64(count))
+	}
+	c.oldestPendingAge.Set(oldestPending.Seconds())
+
+	if len(syncErrs) > 0 {
+		logger.Error(errors.Join(syncErrs...), "failed to record some async notification delivery attempts")
+	}
+
+	return utils.TrackError(errors.Join(syncErrs...))
+}
+
+// deliver re-reads the notification so that a stale cache entry does not cause a
+// duplicate POST, then makes one delivery attempt. It returns nil if the notification
+// no longer needs an attempt.
+func (c *operationNotificationDelivery) deliver(ctx context.Context, cached *coreapi.OperationNotification) (*coreapi.OperationNotification, error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	operationID := cached.Spec.OperationID
+	if operationID == nil {
+		logger.Info("operation notification has no operation ID", "resourceID", cached.GetResourceID())
+		return nil, nil
+	}
+
+	notification, err := c.resourcesDBClient.OperationNotifications(operationID.SubscriptionID).Get(ctx, operationID.Name)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, utils.TrackError(err)
+	}
+	if !operationutils.OperationNotificationIsDue(notification, c.clock.Now()) {
+		return notification, nil
+	}
+
+	updated, err := operationutils.DeliverOperationNotification(ctx, c.clock, c.resourcesDBClient, notification, c.postAsyncNotificationFn)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case updated.Status.DeliveredTime != nil:
+		c.deliveryAttemptsTotal.WithLabelValues("success").Inc()
+	case updated.Status.DeadLetteredTime != nil:
+		c.deadLetteredTotal.Inc()
+	default:
+		c.deliveryAttemptsTotal.WithLabelValues("failure").Inc()
+	}
+
+	return updated, nil
+}
+
+func (c *operationNotificationDelivery) QueueForInformers(resyncDuration time.Duration, notifiers ...controllerutils.Notifier) error {
+	// panic so that the developer error is noticed immediately
+	panic("not implemented")
+}
+
+func (c *operationNotificationDelivery) SyncOnce(ctx context.Context, _ any) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	syncErr := c.synchronizeAllNotifications(ctx)
+	if syncErr != nil {
+		logger.Error(syncErr, "unable to synchronize operation notifications")
+	}
+
+	return utils.TrackError(syncErr)
+}
+
+func (c *operationNotificationDelivery) Run(ctx context.Context, threadiness int) {
+	// don't let panics crash the process
+	defer utilruntime.HandleCrash()
+	// make sure the work queue is shutdown which will trigger workers to end
+	defer c.queue.ShutDown()
+
+	logger := utils.LoggerFromContext(ctx)
+	logger = logger.WithValues(utils.LogValues{}.AddControllerName(c.name)...)
+	ctx = utils.ContextWithLogger(ctx, logger)
+	logger.Info("Starting")
+
+	// there is only ever one key, so more than one worker would sit idle
+	for i := 0; i < min(threadiness, 1); i++ {
+		go wait.UntilWithContext(ctx, c.runWorker, time.Second)
+	}
+
+	// the shortest backoff is operationutils.OperationNotificationInitialBackoff, so checking
+	// at that interval keeps retries close to their scheduled time.
+	go wait.JitterUntilWithContext(ctx, func(ctx context.Context) { c.queue.Add("default") }, operationutils.OperationNotificationInitialBackoff, 0.1, true)
+
+	logger.Info("Started workers")
+
+	// wait until we're told to stop
+	<-ctx.Done()
+	logger.Info("Shutting down")
+}
+
+func (c *operationNotificationDelivery) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+// processNextWorkItem deals with one item off the queue.  It returns false
+// when it's time to quit.
+func (c *operationNotificationDelivery) processNextWorkItem(ctx context.Context) bool {
+	ref, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(ref)
+
+	logger := utils.LoggerFromContext(ctx)
+	ctx = utils.ContextWithLogger(ctx, logger)
+
+	controllerutils.ReconcileTotal.WithLabelValues(c.name).Inc()
+	err := c.SyncOnce(ctx, ref)
+	if err == nil {
+		c.queue.Forget(ref)
+		return true
+	}
+
+	utilruntime.HandleErrorWithContext(ctx, err, "Error syncing; requeuing for later retry", "objectReference", ref)
+	c.queue.AddRateLimited(ref)
+
+	return true
+}
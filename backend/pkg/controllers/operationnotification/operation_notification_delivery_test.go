@@ -0,0 +1,250 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operationnotification
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/Azure/ARO-HCP/backend/pkg/utils/operationutils"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/corecosmosstoragetesting"
+)
+
+const testSubscriptionID = "00000000-0000-0000-0000-000000000000"
+
+// fakeOperationNotificationLister serves a fixed set of notifications, standing in for an
+// informer cache that may lag behind Cosmos.
+type fakeOperationNotificationLister struct {
+	notifications []*coreapi.OperationNotification
+}
+
+func (l *fakeOperationNotificationLister) List(ctx context.Context) ([]*coreapi.OperationNotification, error) {
+	ret := make([]*coreapi.OperationNotification, 0, len(l.notifications))
+	for _, notification := range l.notifications {
+		ret = append(ret, notification.DeepCopy())
+	}
+	return ret, nil
+}
+
+func (l *fakeOperationNotificationLister) Get(ctx context.Context, subscriptionID, operationName string) (*coreapi.OperationNotification, error) {
+	for _, notification := range l.notifications {
+		if strings.EqualFold(notification.Spec.OperationID.SubscriptionID, subscriptionID) && strings.EqualFold(notification.Spec.OperationID.Name, operationName) {
+			return notification.DeepCopy(), nil
+		}
+	}
+	return nil, cosmosstorageutils.NewNotFoundError()
+}
+
+func newTestNotification(operationName string, creationTime time.Time, status coreapi.OperationNotificationStatus) *coreapi.OperationNotification {
+	notification := &coreapi.OperationNotification{
+		CosmosMetadata: coreapi.CosmosMetadata{
+			ResourceID: metadataapi.Must(coreapi.ToOperationNotificationResourceID(testSubscriptionID, operationName)),
+		},
+		Spec: coreapi.OperationNotificationSpec{
+			OperationID:     metadataapi.Must(azcorearm.ParseResourceID(coreapi.ToOperationResourceIDString(testSubscriptionID, operationName))),
+			NotificationURI: "https://arm.example.com/notify/" + operationName,
+			Payload:         []byte(`{"status":"Succeeded"}`),
+			CreationTime:    metav1.NewTime(creationTime),
+		},
+		Status: status,
+	}
+	notification.SetPartitionKey(testSubscriptionID)
+	return notification
+}
+
+func TestOperationNotificationDelivery_SyncOnce(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		// stored is what Cosmos holds.
+		stored []*coreapi.OperationNotification
+		// cached is what the lister returns; defaults to stored.
+		cached  []*coreapi.OperationNotification
+		postErr error
+
+		wantPosts        []string
+		wantSuccess      float64
+		wantFailure      float64
+		wantDeadLettered float64
+		wantPending      float64
+		wantDelivered    float64
+		wantDeadGauge    float64
+		wantOldestAge    time.Duration
+		verify           func(t *testing.T, notification *coreapi.OperationNotification)
+	}{
+		{
+			name: "due notification is delivered once",
+			stored: []*coreapi.OperationNotification{
+				newTestNotification("op-due", now.Add(-time.Minute), coreapi.OperationNotificationStatus{}),
+			},
+			wantPosts:     []string{"op-due"},
+			wantSuccess:   1,
+			wantDelivered: 1,
+			verify: func(t *testing.T, notification *coreapi.OperationNotification) {
+				require.NotNil(t, notification.Status.DeliveredTime)
+				require.Equal(t, int32(1), notification.Status.Attempts)
+			},
+		},
+		{
+			name: "failed attempt is rescheduled with backoff and stays pending",
+			stored: []*coreapi.OperationNotification{
+				newTestNotification("op-due", now.Add(-time.Hour), coreapi.OperationNotificationStatus{
+					Attempts:        1,
+					NextAttemptTime: &metav1.Time{Time: now.Add(-time.Second)},
+				}),
+			},
+			postErr:       errors.New("503 Service Unavailable"),
+			wantPosts:     []string{"op-due"},
+			wantFailure:   1,
+			wantPending:   1,
+			wantOldestAge: time.Hour,
+			verify: func(t *testing.T, notification *coreapi.OperationNotification) {
+				require.Nil(t, notification.Status.DeliveredTime)
+				require.Equal(t, int32(2), notification.Status.Attempts)
+				require.True(t, now.Add(2*operationutils.OperationNotificationInitialBackoff).Equal(notification.Status.NextAttemptTime.Time))
+				require.Equal(t, "503 Service Unavailable", notification.Status.LastError)
+			},
+		},
+		{
+			name: "notification past the deadline is dead-lettered without posting",
+			stored: []*coreapi.OperationNotification{
+				newTestNotification("op-expired", now.Add(-operationutils.OperationNotificationDeliveryDeadline-time.Minute), coreapi.OperationNotificationStatus{
+					Attempts:        12,
+					NextAttemptTime: &metav1.Time{Time: now.Add(-time.Second)},
+				}),
+			},
+			wantDeadLettered: 1,
+			wantDeadGauge:    1,
+			verify: func(t *testing.T, notification *coreapi.OperationNotification) {
+				require.NotNil(t, notification.Status.DeadLetteredTime)
+				require.Nil(t, notification.Status.NextAttemptTime)
+			},
+		},
+		{
+			name: "stale cache entry does not cause a duplicate post",
+			stored: []*coreapi.OperationNotification{
+				newTestNotification("op-delivered", now.Add(-time.Minute), coreapi.OperationNotificationStatus{
+					Attempts:      1,
+					DeliveredTime: &metav1.Time{Time: now.Add(-time.Second)},
+				}),
+			},
+			cached: []*coreapi.OperationNotification{
+				newTestNotification("op-delivered", now.Add(-time.Minute), coreapi.OperationNotificationStatus{}),
+			},
+			wantDelivered: 1,
+			verify: func(t *testing.T, notification *coreapi.OperationNotification) {
+				require.Equal(t, int32(1), notification.Status.Attempts)
+			},
+		},
+		{
+			name: "notification that is not yet due is not posted",
+			stored: []*coreapi.OperationNotification{
+				newTestNotification("op-backoff", now.Add(-10*time.Minute), coreapi.OperationNotificationStatus{
+					Attempts:        3,
+					NextAttemptTime: &metav1.Time{Time: now.Add(time.Minute)},
+				}),
+			},
+			wantPending:   1,
+			wantOldestAge: 10 * time.Minute,
+			verify: func(t *testing.T, notification *coreapi.OperationNotification) {
+				require.Equal(t, int32(3), notification.Status.Attempts)
+			},
+		},
+		{
+			name: "notification removed from cosmos is skipped",
+			cached: []*coreapi.OperationNotification{
+				newTestNotification("op-gone", now.Add(-time.Minute), coreapi.OperationNotificationStatus{}),
+			},
+			wantPending:   1,
+			wantOldestAge: time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			resourcesDBClient := corecosmosstoragetesting.NewMockResourcesDBClient()
+			for _, notification := range tt.stored {
+				_, err := resourcesDBClient.OperationNotifications(testSubscriptionID).Create(ctx, notification.DeepCopy(), nil)
+				require.NoError(t, err)
+			}
+			cached := tt.cached
+			if cached == nil {
+				for _, notification := range tt.stored {
+					stored, err := resourcesDBClient.OperationNotifications(testSubscriptionID).Get(ctx, notification.Spec.OperationID.Name)
+					require.NoError(t, err)
+					cached = append(cached, stored)
+				}
+			}
+
+			var posts []string
+			postFn := func(ctx context.Context, notification *coreapi.OperationNotification) error {
+				posts = append(posts, notification.Spec.OperationID.Name)
+				return tt.postErr
+			}
+
+			registry := prometheus.NewRegistry()
+			controller := NewOperationNotificationDeliveryController(
+				clocktesting.NewFakePassiveClock(now),
+				resourcesDBClient,
+				&fakeOperationNotificationLister{notifications: cached},
+				postFn,
+				registry,
+			)
+			c := controller.(*operationNotificationDelivery)
+
+			require.NoError(t, c.SyncOnce(ctx, nil))
+			require.Equal(t, tt.wantPosts, posts)
+
+			require.Equal(t, tt.wantSuccess, testutil.ToFloat64(c.deliveryAttemptsTotal.WithLabelValues("success")))
+			require.Equal(t, tt.wantFailure, testutil.ToFloat64(c.deliveryAttemptsTotal.WithLabelValues("failure")))
+			require.Equal(t, tt.wantDeadLettered, testutil.ToFloat64(c.deadLetteredTotal))
+			require.Equal(t, tt.wantPending, testutil.ToFloat64(c.notifications.WithLabelValues(notificationStatePending)))
+			require.Equal(t, tt.wantDelivered, testutil.ToFloat64(c.notifications.WithLabelValues(notificationStateDelivered)))
+			require.Equal(t, tt.wantDeadGauge, testutil.ToFloat64(c.notifications.WithLabelValues(notificationStateDeadLettered)))
+			require.Equal(t, tt.wantOldestAge.Seconds(), testutil.ToFloat64(c.oldestPendingAge))
+
+			if tt.verify != nil {
+				for _, notification := range tt.stored {
+					persisted, err := resourcesDBClient.OperationNotifications(testSubscriptionID).Get(ctx, notification.Spec.OperationID.Name)
+					require.NoError(t, err)
+					tt.verify(t, persisted)
+				}
+			}
+
+			// A second pass at the same instant must not post again: everything is either
+			// finished or waiting on its backoff.
+			posts = nil
+			require.NoError(t, c.SyncOnce(ctx, nil))
+			require.Empty(t, posts)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeapplierhelpers
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-HCP/internal/api/kubeapplierapi"
+)
+
+// ReadinessConditionEstablished is the condition a CustomResourceDefinition
+// reports once its API is served.
+const ReadinessConditionEstablished = "Established"
+
+// ReadinessConditionAvailable is the condition a Deployment reports once its
+// minimum number of replicas is available.
+const ReadinessConditionAvailable = "Available"
+
+// ConditionReadiness returns a readiness check that passes once the target
+// object reports conditionType=True in .status.conditions.
+func ConditionReadiness(conditionType string) *kubeapplierapi.ReadinessCheck {
+	return &kubeapplierapi.ReadinessCheck{
+		Type: kubeapplierapi.ReadinessCheckTypeCondition,
+		Condition: &kubeapplierapi.ConditionReadinessCheck{
+			Type:   conditionType,
+			Status: metav1.ConditionTrue,
+		},
+	}
+}
+
+// JSONPathReadiness returns a readiness check that passes once the JSONPath
+// expression yields value on the target object.
+func JSONPathReadiness(path, value string) *kubeapplierapi.ReadinessCheck {
+	return &kubeapplierapi.ReadinessCheck{
+		Type: kubeapplierapi.ReadinessCheckTypeJSONPath,
+		JSONPath: &kubeapplierapi.JSONPathReadinessCheck{
+			Path:  path,
+			Value: value,
+		},
+	}
+}
+
+// AddDependency makes desire wait until dependency has succeeded and, when
+// readiness is non-nil, its target passes the readiness check. Both desires
+// must be for the same management cluster and dependency must already carry
+// its resource ID.
+func AddDependency(desire, dependency *kubeapplierapi.ApplyDesire, readiness *kubeapplierapi.ReadinessCheck) {
+	desire.Spec.DependsOn = append(desire.Spec.DependsOn, kubeapplierapi.ApplyDesireDependency{
+		ApplyDesire: dependency.GetResourceID(),
+		Readiness:   readiness,
+	})
+}
+
+// OrderApplyDesires chains desires into an ordered rollout: every desire
+// depends on the one before it having succeeded. Use AddDependency instead
+// when a step also needs a readiness check, e.g. a CustomResourceDefinition
+// that must be Established before custom resources of its kind are applied.
+func OrderApplyDesires(desires ...*kubeapplierapi.ApplyDesire) {
+	for i := 1; i < len(desires); i++ {
+		AddDependency(desires[i], desires[i-1], nil)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeapplierhelpers
+
+import (
+	"context"
+	"fmt"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/util/json"
+
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/listers/kubeapplierlisters"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+// ReadDesireNameKubeAPIServerHorizontalPodAutoscaler is the well-known ReadDesire name the
+// backend writes the per-cluster kube-apiserver HorizontalPodAutoscaler mirror under.
+const ReadDesireNameKubeAPIServerHorizontalPodAutoscaler = "kube-apiserver-horizontalpodautoscaler"
+
+// GetCachedKubeAPIServerHorizontalPodAutoscalerForCluster reads the kube-apiserver
+// HorizontalPodAutoscaler mirror from the per-cluster ReadDesire.
+func GetCachedKubeAPIServerHorizontalPodAutoscalerForCluster(
+	ctx context.Context,
+	readDesireLister kubeapplierlisters.ReadDesireLister,
+	subscriptionName, resourceGroupName, clusterName string,
+) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	readDesire, err := readDesireLister.GetForCluster(ctx, subscriptionName, resourceGroupName, clusterName, ReadDesireNameKubeAPIServerHorizontalPodAutoscaler)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, utils.TrackError(fmt.Errorf("failed to get ReadDesire for kube-apiserver HorizontalPodAutoscaler: %w", err))
+	}
+	if readDesire.Status.KubeContent == nil || len(readDesire.Status.KubeContent.Raw) == 0 {
+		return nil, nil
+	}
+	horizontalPodAutoscaler := &autoscalingv2.HorizontalPodAutoscaler{}
+	if err := json.Unmarshal(readDesire.Status.KubeContent.Raw, horizontalPodAutoscaler); err != nil {
+		return nil, utils.TrackError(fmt.Errorf("failed to unmarshal HorizontalPodAutoscaler from ReadDesire kubeContent: %w", err))
+	}
+	return horizontalPodAutoscaler, nil
+}
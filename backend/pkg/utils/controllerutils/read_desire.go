@@ -45,6 +45,14 @@ func BuildReadDesire(resourceIDString string, managementCluster *azcorearm.Resou
 	}
 }
 
+// BuildCollectionReadDesire is BuildReadDesire for a ReadDesire that mirrors
+// every object matched by collection rather than a single named object.
+func BuildCollectionReadDesire(resourceIDString string, managementCluster *azcorearm.ResourceID, collection kubeapplierapi.CollectionReference) *kubeapplierapi.ReadDesire {
+	desire := BuildReadDesire(resourceIDString, managementCluster, kubeapplierapi.ResourceReference{})
+	desire.Spec.TargetCollection = &collection
+	return desire
+}
+
 // GetExistingReadDesire returns the named ReadDesire from cosmos, or nil
 // when the document doesn't exist. Non-NotFound errors are propagated.
 func GetExistingReadDesire(
@@ -61,8 +69,9 @@ func GetExistingReadDesire(
 }
 
 // ReadDesireNeedsWork reports whether existing matches desired in the
-// fields the backend writes (Spec.ManagementCluster, Spec.TargetItem).
-// A nil existing means "doesn't exist yet" — work is required.
+// fields the backend writes (Spec.ManagementCluster, Spec.TargetItem,
+// Spec.TargetCollection). A nil existing means "doesn't exist yet" — work
+// is required.
 func ReadDesireNeedsWork(existing, desired *kubeapplierapi.ReadDesire) bool {
 	if existing == nil {
 		return true
@@ -70,5 +79,11 @@ func ReadDesireNeedsWork(existing, desired *kubeapplierapi.ReadDesire) bool {
 	if !controllerutil.ResourceIDsEqual(existing.Spec.ManagementCluster, desired.Spec.ManagementCluster) {
 		return true
 	}
-	return existing.Spec.TargetItem != desired.Spec.TargetItem
+	if existing.Spec.TargetItem != desired.Spec.TargetItem {
+		return true
+	}
+	if (existing.Spec.TargetCollection == nil) != (desired.Spec.TargetCollection == nil) {
+		return true
+	}
+	return existing.Spec.TargetCollection != nil && *existing.Spec.TargetCollection != *desired.Spec.TargetCollection
 }
@@ -0,0 +1,58 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operationutils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+// WaitingForMaintenanceWindowMessage is the operation status message used while
+// an upgrade is held back by a closed maintenance window.
+const WaitingForMaintenanceWindowMessage = "waiting for maintenance window"
+
+// MaintenanceWindowStatusMessage returns the operation status message for an
+// upgrade that cannot start until the maintenance window opens, or an empty
+// string if the upgrade may start at now.
+func MaintenanceWindowStatusMessage(window *coreapi.MaintenanceWindowProfile, now time.Time) (string, error) {
+	upgradeAllowed, err := window.UpgradeAllowed(now)
+	if err != nil {
+		return "", utils.TrackError(err)
+	}
+	if upgradeAllowed {
+		return "", nil
+	}
+
+	nextOpening, ok, err := window.NextOpening(now)
+	if err != nil {
+		return "", utils.TrackError(err)
+	}
+	if !ok {
+		return WaitingForMaintenanceWindowMessage, nil
+	}
+	return fmt.Sprintf("%s; next opening at %s", WaitingForMaintenanceWindowMessage, nextOpening.UTC().Format(time.RFC3339)), nil
+}
+
+// MaintenanceWindowOperationState holds an operation in Updating with a
+// maintenance window message while message is non-empty.
+func MaintenanceWindowOperationState(message string) *OperationState {
+	if len(message) == 0 {
+		return NewOperationState(coreapi.ProvisioningStateSucceeded, "")
+	}
+	return NewOperationState(coreapi.ProvisioningStateUpdating, message)
+}
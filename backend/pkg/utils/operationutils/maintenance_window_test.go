@@ -0,0 +1,95 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operationutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+)
+
+func TestMaintenanceWindowStatusMessage(t *testing.T) {
+	t.Parallel()
+
+	// 2026-10-17 is a Saturday.
+	saturdayNight := &coreapi.MaintenanceWindowProfile{
+		DaysOfWeek:    []metadataapi.DayOfWeek{metadataapi.DayOfWeekSaturday},
+		StartTime:     "22:00",
+		DurationHours: 4,
+	}
+
+	tests := []struct {
+		name    string
+		window  *coreapi.MaintenanceWindowProfile
+		now     time.Time
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "no window",
+			window: nil,
+			now:    time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC),
+			want:   "",
+		},
+		{
+			name:   "window open",
+			window: saturdayNight,
+			now:    time.Date(2026, 10, 17, 23, 0, 0, 0, time.UTC),
+			want:   "",
+		},
+		{
+			name:   "window closed",
+			window: saturdayNight,
+			now:    time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC),
+			want:   "waiting for maintenance window; next opening at 2026-10-17T22:00:00Z",
+		},
+		{
+			name: "invalid window",
+			window: &coreapi.MaintenanceWindowProfile{
+				DaysOfWeek:    []metadataapi.DayOfWeek{metadataapi.DayOfWeekSaturday},
+				StartTime:     "25:00",
+				DurationHours: 4,
+			},
+			now:     time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := MaintenanceWindowStatusMessage(tt.window, tt.now)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			state := MaintenanceWindowOperationState(got)
+			if len(tt.want) == 0 {
+				assert.Equal(t, coreapi.ProvisioningStateSucceeded, state.ProvisioningState)
+			} else {
+				assert.Equal(t, coreapi.ProvisioningStateUpdating, state.ProvisioningState)
+				assert.Equal(t, tt.want, state.Message)
+			}
+		})
+	}
+}
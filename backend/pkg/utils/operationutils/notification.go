@@ -0,0 +1,159 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operationutils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilsclock "k8s.io/utils/clock"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/corecosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+const (
+	// OperationNotificationInitialBackoff is the delay before retrying the first failed delivery attempt.
+	OperationNotificationInitialBackoff = 15 * time.Second
+	// OperationNotificationMaxBackoff caps the delay between delivery attempts.
+	OperationNotificationMaxBackoff = 15 * time.Minute
+	// OperationNotificationDeliveryDeadline is how long after an operation reaches a terminal state we keep
+	// trying to notify ARM before dead-lettering the notification.  ARM falls back to polling the
+	// operation status, so a dead-lettered notification delays but does not lose the result.
+	OperationNotificationDeliveryDeadline = 24 * time.Hour
+)
+
+// OperationNotificationBackoff returns the delay to wait after the given number of failed attempts.
+func OperationNotificationBackoff(attempts int32) time.Duration {
+	backoff := OperationNotificationInitialBackoff
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= OperationNotificationMaxBackoff {
+			return OperationNotificationMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// OperationNotificationIsDue returns true if a delivery attempt should be made for the notification now.
+func OperationNotificationIsDue(notification *coreapi.OperationNotification, now time.Time) bool {
+	if !notification.IsPending() {
+		return false
+	}
+	if notification.Status.NextAttemptTime == nil {
+		return true
+	}
+	return !now.Before(notification.Status.NextAttemptTime.Time)
+}
+
+// DeliverOperationNotification makes one delivery attempt for a pending notification and records the
+// outcome on the document. A failed attempt is not an error: it schedules the next attempt, or
+// dead-letters the notification once OperationNotificationDeliveryDeadline has passed. Errors are only
+// returned when the outcome could not be persisted.
+func DeliverOperationNotification(ctx context.Context, clock utilsclock.PassiveClock, resourcesDBClient corecosmosstorage.ResourcesDBClient, notification *coreapi.OperationNotification, postAsyncNotificationFn PostAsyncNotificationFunc) (*coreapi.OperationNotification, error) {
+	logger := utils.LoggerFromContext(ctx)
+
+	if !notification.IsPending() {
+		return notification, nil
+	}
+
+	now := clock.Now()
+	updated := notification.DeepCopy()
+	if now.Sub(notification.Spec.CreationTime.Time) > OperationNotificationDeliveryDeadline {
+		logger.Error(nil, "Async notification delivery deadline exceeded, dead-lettering",
+			"operationID", notification.Spec.OperationID, "attempts", notification.Status.Attempts, "lastError", notification.Status.LastError)
+		updated.Status.DeadLetteredTime = &metav1.Time{Time: now}
+		updated.Status.NextAttemptTime = nil
+	} else {
+		postErr := postAsyncNotificationFn(ctx, notification)
+		updated.Status.Attempts++
+		updated.Status.LastAttemptTime = &metav1.Time{Time: now}
+		if postErr == nil {
+			logger.Info("Posted async notification", "operationID", notification.Spec.OperationID, "attempts", updated.Status.Attempts)
+			updated.Status.DeliveredTime = &metav1.Time{Time: now}
+			updated.Status.NextAttemptTime = nil
+			updated.Status.LastError = ""
+		} else {
+			nextAttemptTime := now.Add(OperationNotificationBackoff(updated.Status.Attempts))
+			logger.Error(postErr, "Failed to post async notification",
+				"operationID", notification.Spec.OperationID, "attempts", updated.Status.Attempts, "nextAttemptTime", nextAttemptTime)
+			updated.Status.NextAttemptTime = &metav1.Time{Time: nextAttemptTime}
+			updated.Status.LastError = postErr.Error()
+		}
+	}
+
+	// The etag guards against two backends recording conflicting outcomes. If we lose, the
+	// other writer's outcome stands and the worst case is a duplicate notification, which
+	// ARM tolerates.
+	latest, err := resourcesDBClient.OperationNotifications(notification.Spec.OperationID.SubscriptionID).Replace(ctx, updated, nil)
+	if err != nil {
+		return nil, utils.TrackError(err)
+	}
+
+	return latest, nil
+}
+
+// deliverNewOperationNotification is the fast path taken right after the notification document is
+// committed. Failures are left for the OperationNotificationDelivery controller to retry.
+func deliverNewOperationNotification(ctx context.Context, clock utilsclock.PassiveClock, resourcesDBClient corecosmosstorage.ResourcesDBClient, notification *coreapi.OperationNotification, postAsyncNotificationFn PostAsyncNotificationFunc) {
+	logger := utils.LoggerFromContext(ctx)
+
+	if notification == nil || postAsyncNotificationFn == nil {
+		return
+	}
+
+	// Re-read the notification to get the ETag assigned when the
+	// transactional batch was committed.
+	current, err := resourcesDBClient.OperationNotifications(notification.Spec.OperationID.SubscriptionID).Get(ctx, notification.Spec.OperationID.Name)
+	if err != nil {
+		logger.Error(err, "Failed to re-read async notification, leaving delivery to retry")
+		return
+	}
+	if _, err := DeliverOperationNotification(ctx, clock, resourcesDBClient, current, postAsyncNotificationFn); err != nil {
+		logger.Error(err, "Failed to record async notification delivery attempt")
+	}
+}
+
+func PostAsyncNotificationFn(notificationClient *http.Client) PostAsyncNotificationFunc {
+	return func(ctx context.Context, notification *coreapi.OperationNotification) error {
+		return PostAsyncNotification(ctx, notificationClient, notification)
+	}
+}
+
+func PostAsyncNotification(ctx context.Context, notificationClient *http.Client, notification *coreapi.OperationNotification) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, notification.Spec.NotificationURI, bytes.NewReader(notification.Spec.Payload))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := notificationClient.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+	if response.StatusCode >= 400 {
+		return errors.New(response.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,276 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operationutils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tj/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/corecosmosstoragetesting"
+)
+
+func TestOperationNotificationBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		expected time.Duration
+	}{
+		{attempts: 0, expected: OperationNotificationInitialBackoff},
+		{attempts: 1, expected: OperationNotificationInitialBackoff},
+		{attempts: 2, expected: 2 * OperationNotificationInitialBackoff},
+		{attempts: 3, expected: 4 * OperationNotificationInitialBackoff},
+		{attempts: 6, expected: 32 * OperationNotificationInitialBackoff},
+		{attempts: 7, expected: OperationNotificationMaxBackoff},
+		{attempts: 1000, expected: OperationNotificationMaxBackoff},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, OperationNotificationBackoff(tt.attempts), "attempts=%d", tt.attempts)
+	}
+}
+
+func TestOperationNotificationIsDue(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		status   coreapi.OperationNotificationStatus
+		expected bool
+	}{
+		{
+			name:     "never attempted",
+			expected: true,
+		},
+		{
+			name:     "next attempt in the past",
+			status:   coreapi.OperationNotificationStatus{NextAttemptTime: &metav1.Time{Time: now.Add(-time.Second)}},
+			expected: true,
+		},
+		{
+			name:     "next attempt now",
+			status:   coreapi.OperationNotificationStatus{NextAttemptTime: &metav1.Time{Time: now}},
+			expected: true,
+		},
+		{
+			name:     "next attempt in the future",
+			status:   coreapi.OperationNotificationStatus{NextAttemptTime: &metav1.Time{Time: now.Add(time.Second)}},
+			expected: false,
+		},
+		{
+			name:     "delivered",
+			status:   coreapi.OperationNotificationStatus{DeliveredTime: &metav1.Time{Time: now.Add(-time.Minute)}},
+			expected: false,
+		},
+		{
+			name:     "dead-lettered",
+			status:   coreapi.OperationNotificationStatus{DeadLetteredTime: &metav1.Time{Time: now.Add(-time.Minute)}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notification := &coreapi.OperationNotification{Status: tt.status}
+			assert.Equal(t, tt.expected, OperationNotificationIsDue(notification, now))
+		})
+	}
+}
+
+func newTestOperationNotification(subscriptionID, operationName string, creationTime time.Time, status coreapi.OperationNotificationStatus) *coreapi.OperationNotification {
+	notification := &coreapi.OperationNotification{
+		CosmosMetadata: coreapi.CosmosMetadata{
+			ResourceID: metadataapi.Must(coreapi.ToOperationNotificationResourceID(subscriptionID, operationName)),
+		},
+		Spec: coreapi.OperationNotificationSpec{
+			OperationID:     metadataapi.Must(azcorearm.ParseResourceID(coreapi.ToOperationResourceIDString(subscriptionID, operationName))),
+			NotificationURI: "https://arm.example.com/notify",
+			Payload:         []byte(`{"status":"Succeeded"}`),
+			CreationTime:    metav1.NewTime(creationTime),
+		},
+		Status: status,
+	}
+	notification.SetPartitionKey(subscriptionID)
+	return notification
+}
+
+func TestDeliverOperationNotification(t *testing.T) {
+	const (
+		subscriptionID = "00000000-0000-0000-0000-000000000000"
+		operationName  = "test-operation"
+	)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		creationTime time.Time
+		status       coreapi.OperationNotificationStatus
+		postErr      error
+		wantPosts    int
+		verify       func(t *testing.T, updated *coreapi.OperationNotification)
+	}{
+		{
+			name:         "successful first attempt marks the notification delivered",
+			creationTime: now.Add(-time.Minute),
+			wantPosts:    1,
+			verify: func(t *testing.T, updated *coreapi.OperationNotification) {
+				assert.Equal(t, int32(1), updated.Status.Attempts)
+				assert.NotNil(t, updated.Status.DeliveredTime)
+				assert.True(t, now.Equal(updated.Status.DeliveredTime.Time))
+				assert.True(t, now.Equal(updated.Status.LastAttemptTime.Time))
+				assert.Nil(t, updated.Status.NextAttemptTime)
+				assert.Empty(t, updated.Status.LastError)
+			},
+		},
+		{
+			name:         "successful retry clears the last error",
+			creationTime: now.Add(-time.Hour),
+			status: coreapi.OperationNotificationStatus{
+				Attempts:        2,
+				NextAttemptTime: &metav1.Time{Time: now.Add(-time.Second)},
+				LastError:       "503 Service Unavailable",
+			},
+			wantPosts: 1,
+			verify: func(t *testing.T, updated *coreapi.OperationNotification) {
+				assert.Equal(t, int32(3), updated.Status.Attempts)
+				assert.NotNil(t, updated.Status.DeliveredTime)
+				assert.Nil(t, updated.Status.NextAttemptTime)
+				assert.Empty(t, updated.Status.LastError)
+			},
+		},
+		{
+			name:         "failed first attempt schedules the initial backoff",
+			creationTime: now.Add(-time.Minute),
+			postErr:      errors.New("503 Service Unavailable"),
+			wantPosts:    1,
+			verify: func(t *testing.T, updated *coreapi.OperationNotification) {
+				assert.Equal(t, int32(1), updated.Status.Attempts)
+				assert.Nil(t, updated.Status.DeliveredTime)
+				assert.Nil(t, updated.Status.DeadLetteredTime)
+				assert.NotNil(t, updated.Status.NextAttemptTime)
+				assert.True(t, now.Add(OperationNotificationInitialBackoff).Equal(updated.Status.NextAttemptTime.Time))
+				assert.Equal(t, "503 Service Unavailable", updated.Status.LastError)
+			},
+		},
+		{
+			name:         "repeated failure doubles the backoff",
+			creationTime: now.Add(-time.Hour),
+			status: coreapi.OperationNotificationStatus{
+				Attempts:  2,
+				LastError: "503 Service Unavailable",
+			},
+			postErr:   errors.New("connection reset"),
+			wantPosts: 1,
+			verify: func(t *testing.T, updated *coreapi.OperationNotification) {
+				assert.Equal(t, int32(3), updated.Status.Attempts)
+				assert.True(t, now.Add(4*OperationNotificationInitialBackoff).Equal(updated.Status.NextAttemptTime.Time))
+				assert.Equal(t, "connection reset", updated.Status.LastError)
+			},
+		},
+		{
+			name:         "notification past the deadline is dead-lettered without posting",
+			creationTime: now.Add(-OperationNotificationDeliveryDeadline - time.Second),
+			status: coreapi.OperationNotificationStatus{
+				Attempts:        20,
+				NextAttemptTime: &metav1.Time{Time: now.Add(-time.Second)},
+				LastError:       "503 Service Unavailable",
+			},
+			wantPosts: 0,
+			verify: func(t *testing.T, updated *coreapi.OperationNotification) {
+				assert.Equal(t, int32(20), updated.Status.Attempts)
+				assert.NotNil(t, updated.Status.DeadLetteredTime)
+				assert.True(t, now.Equal(updated.Status.DeadLetteredTime.Time))
+				assert.Nil(t, updated.Status.NextAttemptTime)
+				assert.Nil(t, updated.Status.DeliveredTime)
+			},
+		},
+		{
+			name:         "delivered notification is left alone",
+			creationTime: now.Add(-time.Hour),
+			status: coreapi.OperationNotificationStatus{
+				Attempts:      1,
+				DeliveredTime: &metav1.Time{Time: now.Add(-time.Minute)},
+			},
+			wantPosts: 0,
+			verify: func(t *testing.T, updated *coreapi.OperationNotification) {
+				assert.Equal(t, int32(1), updated.Status.Attempts)
+				assert.True(t, now.Add(-time.Minute).Equal(updated.Status.DeliveredTime.Time))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			resourcesDBClient := corecosmosstoragetesting.NewMockResourcesDBClient()
+			notificationCRUD := resourcesDBClient.OperationNotifications(subscriptionID)
+			stored, err := notificationCRUD.Create(ctx, newTestOperationNotification(subscriptionID, operationName, tt.creationTime, tt.status), nil)
+			assert.NoError(t, err)
+
+			posts := 0
+			postFn := func(ctx context.Context, notification *coreapi.OperationNotification) error {
+				posts++
+				assert.Equal(t, stored.Spec.NotificationURI, notification.Spec.NotificationURI)
+				assert.Equal(t, string(stored.Spec.Payload), string(notification.Spec.Payload))
+				return tt.postErr
+			}
+
+			updated, err := DeliverOperationNotification(ctx, clocktesting.NewFakePassiveClock(now), resourcesDBClient, stored, postFn)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPosts, posts)
+			tt.verify(t, updated)
+
+			persisted, err := notificationCRUD.Get(ctx, operationName)
+			assert.NoError(t, err)
+			assert.Equal(t, updated.Status, persisted.Status)
+		})
+	}
+}
+
+func TestDeliverOperationNotification_StaleETag(t *testing.T) {
+	const subscriptionID = "00000000-0000-0000-0000-000000000000"
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	resourcesDBClient := corecosmosstoragetesting.NewMockResourcesDBClient()
+	notificationCRUD := resourcesDBClient.OperationNotifications(subscriptionID)
+	stale, err := notificationCRUD.Create(ctx, newTestOperationNotification(subscriptionID, "test-operation", now.Add(-time.Minute), coreapi.OperationNotificationStatus{}), nil)
+	assert.NoError(t, err)
+
+	// Another backend records a delivery first.
+	delivered := stale.DeepCopy()
+	delivered.Status.Attempts = 1
+	delivered.Status.DeliveredTime = &metav1.Time{Time: now.Add(-time.Second)}
+	_, err = notificationCRUD.Replace(ctx, delivered, nil)
+	assert.NoError(t, err)
+
+	_, err = DeliverOperationNotification(ctx, clocktesting.NewFakePassiveClock(now), resourcesDBClient, stale, func(ctx context.Context, notification *coreapi.OperationNotification) error {
+		return nil
+	})
+	assert.Error(t, err, "recording an outcome over a newer document must fail on the etag")
+
+	persisted, err := notificationCRUD.Get(ctx, "test-operation")
+	assert.NoError(t, err)
+	assert.True(t, now.Add(-time.Second).Equal(persisted.Status.DeliveredTime.Time))
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operationutils
+
+import (
+	"math"
+	"slices"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+)
+
+// OperationStatusDetails is the customer-visible detail recorded on an
+// operation alongside its status.
+type OperationStatusDetails struct {
+	// StatusMessage describes what a non-terminal operation is waiting on.
+	StatusMessage string
+	// PercentComplete estimates how far the operation has progressed.
+	PercentComplete float64
+	// Phases lists the steps of the operation and whether each has completed.
+	Phases []coreapi.OperationPhase
+}
+
+// NewOperationStatusDetailsFromPhases returns details whose PercentComplete
+// is the share of phases that have completed.
+func NewOperationStatusDetailsFromPhases(phases []coreapi.OperationPhase) OperationStatusDetails {
+	return OperationStatusDetails{
+		PercentComplete: PercentComplete(phases),
+		Phases:          phases,
+	}
+}
+
+// equal returns true if the details already match what is recorded on the operation.
+func (d OperationStatusDetails) equal(operation *coreapi.Operation) bool {
+	return operation.StatusMessage == d.StatusMessage &&
+		operation.PercentComplete == d.PercentComplete &&
+		slices.Equal(operation.Phases, d.Phases)
+}
+
+// NewOperationPhase returns a phase that is completed if completed is true and pending otherwise.
+func NewOperationPhase(name string, completed bool) coreapi.OperationPhase {
+	phase := coreapi.OperationPhase{
+		Name:   name,
+		Status: coreapi.OperationPhaseStatusPending,
+	}
+	if completed {
+		phase.Status = coreapi.OperationPhaseStatusCompleted
+	}
+	return phase
+}
+
+// OperationPhaseFromState returns a phase that is completed once state has succeeded.
+func OperationPhaseFromState(name string, state *OperationState) coreapi.OperationPhase {
+	return NewOperationPhase(name, state != nil && state.ProvisioningState == coreapi.ProvisioningStateSucceeded)
+}
+
+// PercentComplete returns the share of phases that have completed, rounded
+// down to a whole percentage so it only reaches 100 when every phase has.
+func PercentComplete(phases []coreapi.OperationPhase) float64 {
+	if len(phases) == 0 {
+		return 0
+	}
+	completed := 0
+	for _, phase := range phases {
+		if phase.Status == coreapi.OperationPhaseStatusCompleted {
+			completed++
+		}
+	}
+	return math.Floor(float64(completed) * 100 / float64(len(phases)))
+}
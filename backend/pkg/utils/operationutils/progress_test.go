@@ -0,0 +1,78 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operationutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+)
+
+func TestPercentComplete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		phases []coreapi.OperationPhase
+		want   float64
+	}{
+		{
+			name: "no phases",
+			want: 0,
+		},
+		{
+			name: "none completed",
+			phases: []coreapi.OperationPhase{
+				NewOperationPhase("First", false),
+				NewOperationPhase("Second", false),
+			},
+			want: 0,
+		},
+		{
+			name: "rounds down",
+			phases: []coreapi.OperationPhase{
+				NewOperationPhase("First", true),
+				NewOperationPhase("Second", true),
+				NewOperationPhase("Third", false),
+			},
+			want: 66,
+		},
+		{
+			name: "all completed",
+			phases: []coreapi.OperationPhase{
+				NewOperationPhase("First", true),
+				OperationPhaseFromState("Second", NewOperationState(coreapi.ProvisioningStateSucceeded, "")),
+			},
+			want: 100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, PercentComplete(tt.phases))
+		})
+	}
+}
+
+func TestOperationPhaseFromState(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, coreapi.OperationPhaseStatusCompleted, OperationPhaseFromState("Phase", NewOperationState(coreapi.ProvisioningStateSucceeded, "")).Status)
+	assert.Equal(t, coreapi.OperationPhaseStatusPending, OperationPhaseFromState("Phase", NewOperationState(coreapi.ProvisioningStateProvisioning, "waiting")).Status)
+	assert.Equal(t, coreapi.OperationPhaseStatusPending, OperationPhaseFromState("Phase", nil).Status)
+}
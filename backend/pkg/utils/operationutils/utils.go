@@ -15,7 +15,6 @@
 package operationutils
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -43,7 +42,8 @@ const (
 	InflightChecksFailedProvisionErrorCode = "OCM4001"
 )
 
-type PostAsyncNotificationFunc func(ctx context.Context, operation *coreapi.Operation) error
+// PostAsyncNotificationFunc delivers a single ARM async operation notification.
+type PostAsyncNotificationFunc func(ctx context.Context, notification *coreapi.OperationNotification) error
 
 // Copied from uhc-clusters-service, because the
 // OCM SDK does not define this for some reason.
@@ -86,22 +86,44 @@ const (
 //
 // In all of these cases the operation document is still persisted and ARM is
 // notified, so the operation reaches its terminal state and does not get stuck.
+//
+// When the operation becomes terminal and has a NotificationURI, an
+// OperationNotification outbox document is created in the same transaction so
+// the ARM notification survives a crash or a failed POST and is retried by the
+// OperationNotificationDelivery controller.
 func UpdateOperationStatus(ctx context.Context, clock utilsclock.PassiveClock, resourcesDBClient corecosmosstorage.ResourcesDBClient, existingOperation *coreapi.Operation, newOperationStatus coreapi.ProvisioningState, newOperationError *coreapi.CloudErrorBody, postAsyncNotificationFn PostAsyncNotificationFunc) error {
+	return UpdateOperationStatusWithDetails(ctx, clock, resourcesDBClient, existingOperation, newOperationStatus, newOperationError, OperationStatusDetails{}, postAsyncNotificationFn)
+}
+
+// UpdateOperationStatusWithDetails is UpdateOperationStatus that also records
+// customer-visible details such as a status message and progress. The status
+// message is dropped once the operation becomes terminal.
+func UpdateOperationStatusWithDetails(ctx context.Context, clock utilsclock.PassiveClock, resourcesDBClient corecosmosstorage.ResourcesDBClient, existingOperation *coreapi.Operation, newOperationStatus coreapi.ProvisioningState, newOperationError *coreapi.CloudErrorBody, details OperationStatusDetails, postAsyncNotificationFn PostAsyncNotificationFunc) error {
 	logger := utils.LoggerFromContext(ctx)
 	if existingOperation == nil {
 		return nil
 	}
 
-	if !NeedToPatchOperation(existingOperation, newOperationStatus, newOperationError) {
+	if newOperationStatus.IsTerminal() {
+		details.StatusMessage = ""
+	}
+	needToPatch := NeedToPatchOperation(existingOperation, newOperationStatus, newOperationError)
+	if !needToPatch && details.equal(existingOperation) {
 		return nil
 	}
 
 	updatedOperation := existingOperation.DeepCopy()
-	updatedOperation.LastTransitionTime = clock.Now()
+	// Progress alone is not a status transition.
+	if needToPatch {
+		updatedOperation.LastTransitionTime = clock.Now()
+	}
 	updatedOperation.Status = newOperationStatus
 	if newOperationError != nil {
 		updatedOperation.Error = newOperationError
 	}
+	updatedOperation.StatusMessage = details.StatusMessage
+	updatedOperation.PercentComplete = details.PercentComplete
+	updatedOperation.Phases = details.Phases
 
 	// Create a transaction to atomically update operation and resource documents.
 	// All documents in the transaction must share the same partition key. Both
@@ -109,6 +131,13 @@ func UpdateOperationStatus(ctx context.Context, clock utilsclock.PassiveClock, r
 	// partition key scheme changes the transaction creation here must be updated accordingly.
 	transaction := resourcesDBClient.NewTransaction(updatedOperation.OperationID.SubscriptionID)
 
+	// Hand the ARM notification to the outbox before the operation is serialized
+	// into the transaction, since doing so clears the operation's NotificationURI.
+	notification, err := corecosmosstorage.AddOperationNotificationToTransaction(ctx, resourcesDBClient, transaction, updatedOperation, clock.Now())
+	if err != nil {
+		return err
+	}
+
 	// Add the operation document replace to the transaction.
 	if _, err := resourcesDBClient.Operations(updatedOperation.OperationID.SubscriptionID).AddReplaceToTransaction(ctx, transaction, updatedOperation, nil); err != nil {
 		return utils.TrackError(err)
@@ -168,12 +197,13 @@ func UpdateOperationStatus(ctx context.Context, clock utilsclock.PassiveClock, r
 
 	// Execute the transaction atomically.
 
-	logger.Info("Updating operation status", "oldStatus", existingOperation.Status, "newStatus", newOperationStatus, "operationError", newOperationError)
+	logger.Info("Updating operation status", "oldStatus", existingOperation.Status, "newStatus", newOperationStatus, "operationError", newOperationError, "statusMessage", details.StatusMessage, "percentComplete", details.PercentComplete)
 	if _, err := transaction.Execute(ctx, &azcosmos.TransactionalBatchOptions{}); err != nil {
 		return utils.TrackError(err)
 	}
 
-	notifyOperationOwner(ctx, resourcesDBClient, updatedOperation, postAsyncNotificationFn)
+	logOperationResult(ctx, updatedOperation)
+	deliverNewOperationNotification(ctx, clock, resourcesDBClient, notification, postAsyncNotificationFn)
 
 	return nil
 }
@@ -209,6 +239,17 @@ func getClusterForUpdate(ctx context.Context, logger logr.Logger, dbClient corec
 	if newOperationStatus.IsTerminal() {
 		updated.ServiceProviderProperties.ActiveOperationID = ""
 	}
+	// A stop or start only changes the power state once it has succeeded.
+	// A failed one leaves it at "Stopping" or "Starting" so either action
+	// can be retried.
+	if newOperationStatus == coreapi.ProvisioningStateSucceeded {
+		switch existingOperation.Request {
+		case cosmosstorageutils.OperationRequestClusterStop:
+			updated.ServiceProviderProperties.PowerState = coreapi.PowerStateStopped
+		case cosmosstorageutils.OperationRequestClusterStart:
+			updated.ServiceProviderProperties.PowerState = coreapi.PowerStateRunning
+		}
+	}
 	return updated, nil
 }
 
@@ -307,27 +348,37 @@ func PatchOperation(ctx context.Context, clock utilsclock.PassiveClock, resource
 	if newOperationError != nil {
 		operationToWrite.Error = newOperationError
 	}
+	if newOperationStatus.IsTerminal() {
+		operationToWrite.StatusMessage = ""
+	}
+
+	// The operation and its notification outbox document share the subscription partition,
+	// so both are written atomically.
+	transaction := resourcesDBClient.NewTransaction(operationToWrite.OperationID.SubscriptionID)
+	notification, err := corecosmosstorage.AddOperationNotificationToTransaction(ctx, resourcesDBClient, transaction, operationToWrite, clock.Now())
+	if err != nil {
+		return err
+	}
 
 	// TODO see if we want to plumb etags through to prevent stomping.  Right now this will stomp a concurrent write.
 	// we don't expect concurrent writes and the last one winning is ok.
+	if _, err := resourcesDBClient.Operations(operationToWrite.OperationID.SubscriptionID).AddReplaceToTransaction(ctx, transaction, operationToWrite, nil); err != nil {
+		return utils.TrackError(err)
+	}
+
 	logger.Info("Updating operation status", "oldStatus", oldOperation.Status, "newStatus", newOperationStatus, "operationError", newOperationError)
-	latestOperation, err := resourcesDBClient.Operations(operationToWrite.OperationID.SubscriptionID).Replace(ctx, operationToWrite, nil)
-	if err != nil {
+	if _, err := transaction.Execute(ctx, &azcosmos.TransactionalBatchOptions{}); err != nil {
 		return utils.TrackError(err)
 	}
 
-	notifyOperationOwner(ctx, resourcesDBClient, latestOperation, postAsyncNotificationFn)
+	logOperationResult(ctx, operationToWrite)
+	deliverNewOperationNotification(ctx, clock, resourcesDBClient, notification, postAsyncNotificationFn)
 
 	return nil
 }
 
-// notifyOperationOwner logs the operation result and, if applicable, sends an async
-// notification to ARM and clears the notification URI.
-//
-// The notification URI is cleared in a separate write after the notification is
-// sent successfully. If the process crashes between sending the notification and
-// clearing the URI, the notification may be sent again on the next reconcile.
-func notifyOperationOwner(ctx context.Context, resourcesDBClient corecosmosstorage.ResourcesDBClient, operation *coreapi.Operation, postAsyncNotificationFn PostAsyncNotificationFunc) {
+// logOperationResult logs the outcome of an operation status update.
+func logOperationResult(ctx context.Context, operation *coreapi.Operation) {
 	logger := utils.LoggerFromContext(ctx)
 
 	message := fmt.Sprintf("Updated status to '%s'", operation.Status)
@@ -344,6 +395,12 @@ func notifyOperationOwner(ctx context.Context, resourcesDBClient corecosmosstora
 			message = "Credential request succeeded"
 		case cosmosstorageutils.OperationRequestSystemAdminCredentialRevocation:
 			message = "Credential revocation succeeded"
+		case cosmosstorageutils.OperationRequestNodePoolReplace:
+			message = "Node pool replacement succeeded"
+		case cosmosstorageutils.OperationRequestClusterStop:
+			message = "Cluster stop succeeded"
+		case cosmosstorageutils.OperationRequestClusterStart:
+			message = "Cluster start succeeded"
 		}
 	case coreapi.ProvisioningStateFailed:
 		switch operation.Request {
@@ -357,6 +414,12 @@ func notifyOperationOwner(ctx context.Context, resourcesDBClient corecosmosstora
 			message = "Credential request failed"
 		case cosmosstorageutils.OperationRequestSystemAdminCredentialRevocation:
 			message = "Credential revocation failed"
+		case cosmosstorageutils.OperationRequestNodePoolReplace:
+			message = "Node pool replacement failed"
+		case cosmosstorageutils.OperationRequestClusterStop:
+			message = "Cluster stop failed"
+		case cosmosstorageutils.OperationRequestClusterStart:
+			message = "Cluster start failed"
 		}
 	}
 	if operation.Error != nil {
@@ -368,65 +431,6 @@ func notifyOperationOwner(ctx context.Context, resourcesDBClient corecosmosstora
 	} else {
 		logger.Info(message)
 	}
-
-	if postAsyncNotificationFn != nil && operation.Status.IsTerminal() && len(operation.NotificationURI) > 0 {
-		err := postAsyncNotificationFn(ctx, operation)
-		if err == nil {
-			logger.Info("Posted async notification")
-
-			// Remove the notification URI from the document
-			// so the ARM notification is only sent once.
-			// Re-read the operation to get the current ETag,
-			// since the in-memory copy may have a stale ETag
-			// from before a transactional batch commit.
-			operationsCRUD := resourcesDBClient.Operations(operation.OperationID.SubscriptionID)
-			currentOperation, err := operationsCRUD.Get(ctx, operation.OperationID.Name)
-			if err != nil {
-				logger.Error(err, "Failed to re-read operation to clear notification URI")
-			} else {
-				replacement := currentOperation.DeepCopy()
-				replacement.NotificationURI = ""
-				_, err = operationsCRUD.Replace(ctx, replacement, nil)
-				if err != nil {
-					logger.Error(err, "Failed to clear notification URI")
-				}
-			}
-		} else {
-			logger.Error(err, "Failed to post async notification")
-		}
-	}
-}
-
-func PostAsyncNotificationFn(notificationClient *http.Client) PostAsyncNotificationFunc {
-	return func(ctx context.Context, operation *coreapi.Operation) error {
-		return PostAsyncNotification(ctx, notificationClient, operation)
-	}
-}
-
-func PostAsyncNotification(ctx context.Context, notificationClient *http.Client, operation *coreapi.Operation) error {
-	data, err := coreapi.MarshalJSON(cosmosstorageutils.ToStatus(operation))
-	if err != nil {
-		return err
-	}
-
-	request, err := http.NewRequestWithContext(ctx, http.MethodPost, operation.NotificationURI, bytes.NewBuffer(data))
-	if err != nil {
-		return err
-	}
-
-	request.Header.Set("Content-Type", "application/json")
-
-	response, err := notificationClient.Do(request)
-	if err != nil {
-		return err
-	}
-
-	defer response.Body.Close()
-	if response.StatusCode >= 400 {
-		return errors.New(response.Status)
-	}
-
-	return nil
 }
 
 // ConvertClusterStatus attempts to translate a ClusterStatus object from
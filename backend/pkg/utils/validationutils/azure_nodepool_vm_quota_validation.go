@@ -75,7 +75,7 @@ func (v *AzureNodePoolVMQuotaValidation) Validate(ctx context.Context, _ *coreap
 	}
 	family := *sku.Family
 
-	vcpusPerInstance, ok := lookupCapabilityVCPUs(sku)
+	vcpusPerInstance, ok := LookupCapabilityVCPUs(sku)
 	if !ok {
 		return utils.TrackError(fmt.Errorf("resource SKU for VM size %q is missing %s capability", vmSize, computeResourceSKUCapabilityNameVCPUs))
 	}
@@ -69,13 +69,13 @@ func isCapabilityEphemeralOSDiskSupported(sku *armcompute.ResourceSKU) (bool, bo
 	return strings.EqualFold(*value, "True"), true
 }
 
-// lookupCapabilityVCPUs returns the Resource SKU vCPUs capability parsed as an int.
+// LookupCapabilityVCPUs returns the Resource SKU vCPUs capability parsed as an int.
 //
 // The bool is true when the capability is present and its value parses as an
 // integer (after TrimSpace). When the bool is false, the int is always 0
 // (capability missing, empty, or not an integer). A successfully parsed value
 // of 0 is returned as (0, true).
-func lookupCapabilityVCPUs(sku *armcompute.ResourceSKU) (int, bool) {
+func LookupCapabilityVCPUs(sku *armcompute.ResourceSKU) (int, bool) {
 	value := resourceSKUCapability(sku, computeResourceSKUCapabilityNameVCPUs)
 	if value == nil {
 		return 0, false
@@ -203,7 +203,7 @@ func TestLookupCapabilityVCPUs(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, found := lookupCapabilityVCPUs(tt.sku)
+			got, found := LookupCapabilityVCPUs(tt.sku)
 			assert.Equal(t, tt.want, got)
 			assert.Equal(t, tt.wantFound, found)
 		})
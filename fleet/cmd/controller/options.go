@@ -19,6 +19,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	utilsclock "k8s.io/utils/clock"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -37,6 +39,8 @@ import (
 	maestroopenapi "github.com/openshift-online/maestro/pkg/api/openapi"
 	ocmsdk "github.com/openshift-online/ocm-sdk-go"
 
+	"github.com/Azure/ARO-HCP/fleet/pkg/controllers/capacity"
+	"github.com/Azure/ARO-HCP/fleet/pkg/controllers/drain"
 	"github.com/Azure/ARO-HCP/fleet/pkg/controllers/maestroregistration"
 	"github.com/Azure/ARO-HCP/fleet/pkg/manager"
 	"github.com/Azure/ARO-HCP/internal/azsdk"
@@ -71,6 +75,16 @@ type RawControllerOptions struct {
 
 	AMWWorkspaceResourceIDs []string
 	AMWScalingPollInterval  time.Duration
+
+	DisableCapacityController       bool
+	CapacityCordon                  bool
+	CapacityHighWaterMark           int32
+	CapacityLowWaterMark            int32
+	CapacityMaxHostedControlPlanes  int32
+	CapacityMaxAMWActiveTimeSeries  int64
+	CapacityPrometheusQueryEndpoint string
+
+	DrainMigrationTimeout time.Duration
 }
 
 func DefaultControllerOptions() *RawControllerOptions {
@@ -79,6 +93,13 @@ func DefaultControllerOptions() *RawControllerOptions {
 		MetricsListenAddress:   defaultMetricsListenAddress,
 		LeaderElectionID:       defaultLeaderElectionID,
 		AMWScalingPollInterval: 30 * time.Minute,
+
+		CapacityHighWaterMark:          capacity.DefaultHighWaterMark,
+		CapacityLowWaterMark:           capacity.DefaultLowWaterMark,
+		CapacityMaxHostedControlPlanes: capacity.DefaultMaxHostedControlPlanes,
+		CapacityMaxAMWActiveTimeSeries: capacity.DefaultMaxAMWActiveTimeSeries,
+
+		DrainMigrationTimeout: drain.DefaultMigrationTimeout,
 	}
 }
 
@@ -96,6 +117,14 @@ func BindControllerOptions(opts *RawControllerOptions, cmd *cobra.Command) error
 	cmd.Flags().StringVar(&opts.MetricsListenAddress, "metrics-listen-address", opts.MetricsListenAddress, "listen address for metrics server")
 	cmd.Flags().StringArrayVar(&opts.AMWWorkspaceResourceIDs, "amw-workspace-resource-id", opts.AMWWorkspaceResourceIDs, "Azure Monitor Workspace resource ID to manage ingestion limits for. Can be specified multiple times.")
 	cmd.Flags().DurationVar(&opts.AMWScalingPollInterval, "amw-scaling-poll-interval", opts.AMWScalingPollInterval, "Interval at which the AMW ingestion limits scaling controller checks utilization and scales limits.")
+	cmd.Flags().BoolVar(&opts.DisableCapacityController, "disable-capacity-controller", opts.DisableCapacityController, "Do not score management cluster capacity or cordon management clusters that run out of it.")
+	cmd.Flags().BoolVar(&opts.CapacityCordon, "capacity-cordon", opts.CapacityCordon, "Cordon management clusters whose capacity score reaches the high-water mark. Without it the score is only reported.")
+	cmd.Flags().Int32Var(&opts.CapacityHighWaterMark, "capacity-high-water-mark", opts.CapacityHighWaterMark, "Capacity score (0-100) at which a management cluster is cordoned.")
+	cmd.Flags().Int32Var(&opts.CapacityLowWaterMark, "capacity-low-water-mark", opts.CapacityLowWaterMark, "Capacity score (0-100) at which a management cluster cordoned for capacity is uncordoned. Must be lower than the high-water mark.")
+	cmd.Flags().Int32Var(&opts.CapacityMaxHostedControlPlanes, "capacity-max-hosted-control-planes", opts.CapacityMaxHostedControlPlanes, "Number of hosted control planes a management cluster is sized for.")
+	cmd.Flags().Int64Var(&opts.CapacityMaxAMWActiveTimeSeries, "capacity-max-amw-active-time-series", opts.CapacityMaxAMWActiveTimeSeries, "Active time series a management cluster may send to its Azure Monitor Workspace, its share of the workspace ingestion limit. 0 leaves AMW ingestion unscored.")
+	cmd.Flags().StringVar(&opts.CapacityPrometheusQueryEndpoint, "capacity-prometheus-query-endpoint", opts.CapacityPrometheusQueryEndpoint, "Prometheus query endpoint of the Azure Monitor Workspace holding the management cluster kube-state-metrics. When unset, CPU, memory, zone usage and AMW ingestion are not scored.")
+	cmd.Flags().DurationVar(&opts.DrainMigrationTimeout, "drain-migration-timeout", opts.DrainMigrationTimeout, "How long a hosted control plane migration started by a management cluster drain may take before it is recorded as failed.")
 
 	for _, flag := range []string{
 		"cloud-environment",
@@ -113,6 +142,15 @@ func BindControllerOptions(opts *RawControllerOptions, cmd *cobra.Command) error
 	return nil
 }
 
+func (o *RawControllerOptions) capacityThresholds() capacity.Thresholds {
+	return capacity.Thresholds{
+		HighWaterMark:          o.CapacityHighWaterMark,
+		LowWaterMark:           o.CapacityLowWaterMark,
+		MaxHostedControlPlanes: o.CapacityMaxHostedControlPlanes,
+		MaxAMWActiveTimeSeries: o.CapacityMaxAMWActiveTimeSeries,
+	}
+}
+
 type validatedControllerOptions struct {
 	*RawControllerOptions
 	cloudConfiguration cloud.Configuration
@@ -155,6 +193,21 @@ func (o *RawControllerOptions) Validate(ctx context.Context) (*ValidatedControll
 		return nil, utils.TrackError(fmt.Errorf("--amw-scaling-poll-interval must be positive when AMW workspaces are configured"))
 	}
 
+	if !o.DisableCapacityController {
+		if err := o.capacityThresholds().Validate(); err != nil {
+			return nil, utils.TrackError(fmt.Errorf("--capacity-*: %w", err))
+		}
+		if len(o.CapacityPrometheusQueryEndpoint) > 0 {
+			if u, err := url.Parse(o.CapacityPrometheusQueryEndpoint); err != nil || u.Scheme != "https" || len(u.Host) == 0 {
+				return nil, utils.TrackError(fmt.Errorf("--capacity-prometheus-query-endpoint %q must be an https URL", o.CapacityPrometheusQueryEndpoint))
+			}
+		}
+	}
+
+	if o.DrainMigrationTimeout <= 0 {
+		return nil, utils.TrackError(fmt.Errorf("--drain-migration-timeout must be positive"))
+	}
+
 	return &ValidatedControllerOptions{
 		validatedControllerOptions: &validatedControllerOptions{
 			RawControllerOptions: o,
@@ -175,6 +228,12 @@ type controllerOptions struct {
 	amwScalingPollInterval       time.Duration
 	azureCredential              azcore.TokenCredential
 	azureClientOptions           *policy.ClientOptions
+	disableCapacityController    bool
+	capacityCordon               bool
+	capacityThresholds           capacity.Thresholds
+	capacityNodeUsageReader      capacity.NodeUsageReader
+	capacityIngestionUsageReader capacity.IngestionUsageReader
+	drainMigrationTimeout        time.Duration
 }
 
 type ControllerOptions struct {
@@ -219,19 +278,29 @@ func (o *ValidatedControllerOptions) Complete(ctx context.Context) (*ControllerO
 
 	var azureCredential azcore.TokenCredential
 	var azureClientOptions *policy.ClientOptions
-	if len(o.AMWWorkspaceResourceIDs) > 0 {
+	scoreNodeUsage := !o.DisableCapacityController && len(o.CapacityPrometheusQueryEndpoint) > 0
+	if len(o.AMWWorkspaceResourceIDs) > 0 || scoreNodeUsage {
 		azureCredential, err = azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
 			ClientOptions:                clientOpts,
 			RequireAzureTokenCredentials: true,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to create Azure credential for AMW scaling: %w", err)
+			return nil, fmt.Errorf("failed to create Azure credential for AMW scaling and capacity scoring: %w", err)
 		}
 		azureClientOptions = &policy.ClientOptions{
 			Cloud: clientOpts.Cloud,
 		}
 	}
 
+	var capacityNodeUsageReader capacity.NodeUsageReader
+	var capacityIngestionUsageReader capacity.IngestionUsageReader
+	if scoreNodeUsage {
+		capacityNodeUsageReader = capacity.NewPrometheusNodeUsageReader(o.CapacityPrometheusQueryEndpoint, azureCredential, azureClientOptions)
+		if o.CapacityMaxAMWActiveTimeSeries > 0 {
+			capacityIngestionUsageReader = capacity.NewPrometheusIngestionUsageReader(o.CapacityPrometheusQueryEndpoint, azureCredential, azureClientOptions)
+		}
+	}
+
 	return &ControllerOptions{
 		controllerOptions: &controllerOptions{
 			fleetDBClient:                fleetDBClient,
@@ -245,12 +314,19 @@ func (o *ValidatedControllerOptions) Complete(ctx context.Context) (*ControllerO
 			amwScalingPollInterval:       o.AMWScalingPollInterval,
 			azureCredential:              azureCredential,
 			azureClientOptions:           azureClientOptions,
+			disableCapacityController:    o.DisableCapacityController,
+			capacityCordon:               o.CapacityCordon,
+			capacityThresholds:           o.capacityThresholds(),
+			capacityNodeUsageReader:      capacityNodeUsageReader,
+			capacityIngestionUsageReader: capacityIngestionUsageReader,
+			drainMigrationTimeout:        o.DrainMigrationTimeout,
 		},
 	}, nil
 }
 
 func (o *ControllerOptions) Run(ctx context.Context) error {
 	mgr := &manager.Manager{
+		Clock:                        utilsclock.RealClock{},
 		FleetDBClient:                o.fleetDBClient,
 		ClustersServiceClient:        o.clustersServiceClient,
 		MaestroConsumerClientFactory: o.maestroConsumerClientFactory,
@@ -262,6 +338,12 @@ func (o *ControllerOptions) Run(ctx context.Context) error {
 		AMWScalingPollInterval:       o.amwScalingPollInterval,
 		AzureCredential:              o.azureCredential,
 		AzureClientOptions:           o.azureClientOptions,
+		DisableCapacityController:    o.disableCapacityController,
+		CapacityCordon:               o.capacityCordon,
+		CapacityThresholds:           o.capacityThresholds,
+		CapacityNodeUsageReader:      o.capacityNodeUsageReader,
+		CapacityIngestionUsageReader: o.capacityIngestionUsageReader,
+		DrainMigrationTimeout:        o.drainMigrationTimeout,
 	}
 	return mgr.Run(ctx)
 }
@@ -17,6 +17,7 @@ package controller
 import (
 	"context"
 	"testing"
+	"time"
 )
 
 func TestValidate(t *testing.T) {
@@ -31,6 +32,12 @@ func TestValidate(t *testing.T) {
 			HealthzListenAddress: ":8080",
 			MetricsListenAddress: ":8081",
 			LeaderElectionID:     "fleet-controller",
+
+			CapacityHighWaterMark:          85,
+			CapacityLowWaterMark:           70,
+			CapacityMaxHostedControlPlanes: 300,
+
+			DrainMigrationTimeout: 2 * time.Hour,
 		}
 	}
 
@@ -68,6 +75,44 @@ func TestValidate(t *testing.T) {
 			modify:  func(opts *RawControllerOptions) { opts.KubeNamespace = "" },
 			wantErr: true,
 		},
+		{
+			name:    "capacity low-water mark not below high-water mark",
+			modify:  func(opts *RawControllerOptions) { opts.CapacityLowWaterMark = 85 },
+			wantErr: true,
+		},
+		{
+			name:    "capacity high-water mark above 100",
+			modify:  func(opts *RawControllerOptions) { opts.CapacityHighWaterMark = 101 },
+			wantErr: true,
+		},
+		{
+			name: "capacity prometheus query endpoint set",
+			modify: func(opts *RawControllerOptions) {
+				opts.CapacityPrometheusQueryEndpoint = "https://amw-1234.westus3.prometheus.monitor.azure.com"
+			},
+		},
+		{
+			name:    "capacity max AMW active time series negative",
+			modify:  func(opts *RawControllerOptions) { opts.CapacityMaxAMWActiveTimeSeries = -1 },
+			wantErr: true,
+		},
+		{
+			name:    "capacity prometheus query endpoint not https",
+			modify:  func(opts *RawControllerOptions) { opts.CapacityPrometheusQueryEndpoint = "http://prometheus:9090" },
+			wantErr: true,
+		},
+		{
+			name: "capacity thresholds ignored when the capacity controller is disabled",
+			modify: func(opts *RawControllerOptions) {
+				opts.DisableCapacityController = true
+				opts.CapacityLowWaterMark = 85
+			},
+		},
+		{
+			name:    "drain migration timeout not positive",
+			modify:  func(opts *RawControllerOptions) { opts.DrainMigrationTimeout = 0 },
+			wantErr: true,
+		},
 		{
 			name:    "invalid cloud-environment",
 			modify:  func(opts *RawControllerOptions) { opts.CloudEnvironment = "InvalidCloud" },
@@ -86,10 +86,13 @@ func (o *RegisterOptions) registerStamp(ctx context.Context) error {
 				PartitionKey: strings.ToLower(o.stampIdentifier),
 			},
 			ResourceID: o.stampResourceID,
+			Spec: fleetapi.StampSpec{
+				ApprovalPolicy: o.approvalPolicy,
+			},
 		}
 		o.applyAutoApprove(newStamp)
 
-		logger.Info("Creating stamp", "autoApprove", o.autoApprove)
+		logger.Info("Creating stamp", "autoApprove", o.autoApprove, "approvalPolicy", o.approvalPolicy != nil)
 		if _, err := stampsCRUD.Create(ctx, newStamp, nil); err != nil {
 			return fmt.Errorf("failed to create stamp %q: %w", o.stampIdentifier, err)
 		}
@@ -97,6 +100,8 @@ func (o *RegisterOptions) registerStamp(ctx context.Context) error {
 		return nil
 	}
 
+	// The approval policy only applies to new stamps: re-running registration
+	// must not pull a stamp that already serves customers back into soak.
 	updated := existing.DeepCopy()
 	o.applyAutoApprove(updated)
 
@@ -18,6 +18,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/require"
@@ -117,6 +118,44 @@ func TestRun(t *testing.T) {
 				require.Nil(t, condition)
 			},
 		},
+		{
+			name: "create stamp with approval policy",
+			modify: func(t *testing.T, opts *RegisterOptions) {
+				opts.approvalPolicy = &fleetapi.StampApprovalPolicy{
+					RequiredApprovals: 2,
+					RequestedBy:       "requester@example.com",
+					SoakDuration:      metav1.Duration{Duration: 24 * time.Hour},
+				}
+			},
+			verify: func(t *testing.T, client *fleetcosmosstoragetesting.MockFleetDBClient) {
+				ctx := testContext(t)
+				stamp, err := client.Stamps().Get(ctx, testStampIdentifier)
+				require.NoError(t, err)
+				require.NotNil(t, stamp.Spec.ApprovalPolicy)
+				require.Equal(t, int32(2), stamp.Spec.ApprovalPolicy.RequiredApprovals)
+				require.Equal(t, "requester@example.com", stamp.Spec.ApprovalPolicy.RequestedBy)
+				require.Nil(t, apimeta.FindStatusCondition(stamp.Status.Conditions, string(fleetapi.StampConditionApproved)))
+			},
+		},
+		{
+			name: "update existing stamp keeps its approval policy",
+			seed: func(t *testing.T) []any {
+				stamp := &fleetapi.Stamp{
+					CosmosMetadata: coreapi.CosmosMetadata{ResourceID: metadataapi.Must(fleetapi.ToStampResourceID(testStampIdentifier)), PartitionKey: strings.ToLower(testStampIdentifier)},
+					ResourceID:     metadataapi.Must(fleetapi.ToStampResourceID(testStampIdentifier)),
+				}
+				return []any{stamp}
+			},
+			modify: func(t *testing.T, opts *RegisterOptions) {
+				opts.approvalPolicy = &fleetapi.StampApprovalPolicy{RequiredApprovals: 2}
+			},
+			verify: func(t *testing.T, client *fleetcosmosstoragetesting.MockFleetDBClient) {
+				ctx := testContext(t)
+				stamp, err := client.Stamps().Get(ctx, testStampIdentifier)
+				require.NoError(t, err)
+				require.Nil(t, stamp.Spec.ApprovalPolicy)
+			},
+		},
 		{
 			name: "update existing stamp preserves conditions",
 			seed: func(t *testing.T) []any {
@@ -17,9 +17,12 @@ package register
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 
@@ -35,6 +38,10 @@ type RawRegisterOptions struct {
 	CosmosName                                           string
 	StampIdentifier                                      string
 	AutoApprove                                          bool
+	RequiredApprovals                                    int32
+	ApprovalSoakDuration                                 time.Duration
+	ApprovalRequiredManagementClusterConditions          []string
+	ApprovalRequestedBy                                  string
 	SchedulingPolicy                                     string
 	AKSResourceID                                        string
 	PublicDNSZoneResourceID                              string
@@ -48,7 +55,12 @@ type RawRegisterOptions struct {
 }
 
 func DefaultRegisterOptions() *RawRegisterOptions {
-	return &RawRegisterOptions{}
+	return &RawRegisterOptions{
+		ApprovalRequiredManagementClusterConditions: []string{
+			string(fleetapi.ManagementClusterConditionClustersServiceRegistered),
+			string(fleetapi.ManagementClusterConditionMaestroRegistered),
+		},
+	}
 }
 
 func BindRegisterOptions(opts *RawRegisterOptions, cmd *cobra.Command) error {
@@ -57,6 +69,10 @@ func BindRegisterOptions(opts *RawRegisterOptions, cmd *cobra.Command) error {
 	cmd.Flags().StringVar(&opts.CosmosName, "cosmos-name", opts.CosmosName, "CosmosDB database name")
 	cmd.Flags().StringVar(&opts.StampIdentifier, "stamp-identifier", opts.StampIdentifier, "stamp identifier")
 	cmd.Flags().BoolVar(&opts.AutoApprove, "auto-approve", opts.AutoApprove, "automatically approve the stamp during registration")
+	cmd.Flags().Int32Var(&opts.RequiredApprovals, "required-approvals", opts.RequiredApprovals, "number of distinct approvers required before a new stamp is approved; 0 disables the approval policy")
+	cmd.Flags().DurationVar(&opts.ApprovalSoakDuration, "approval-soak-duration", opts.ApprovalSoakDuration, "how long the management cluster must be Ready after approval before the stamp is production ready")
+	cmd.Flags().StringSliceVar(&opts.ApprovalRequiredManagementClusterConditions, "approval-required-management-cluster-conditions", opts.ApprovalRequiredManagementClusterConditions, "management cluster conditions that must be True before the stamp is production ready")
+	cmd.Flags().StringVar(&opts.ApprovalRequestedBy, "approval-requested-by", opts.ApprovalRequestedBy, "identity requesting the stamp, which may not approve it; required with --required-approvals")
 	cmd.Flags().StringVar(&opts.SchedulingPolicy, "scheduling-policy", opts.SchedulingPolicy, "scheduling policy (Schedulable or Unschedulable)")
 	cmd.Flags().StringVar(&opts.AKSResourceID, "aks-resource-id", opts.AKSResourceID, "AKS cluster ARM resource ID")
 	cmd.Flags().StringVar(&opts.PublicDNSZoneResourceID, "public-dns-zone-resource-id", opts.PublicDNSZoneResourceID, "public DNS zone ARM resource ID")
@@ -100,6 +116,7 @@ type validatedRegisterOptions struct {
 	aksResourceID               *azcorearm.ResourceID
 	publicDNSZoneResourceID     *azcorearm.ResourceID
 	schedulingPolicy            fleetapi.ManagementClusterSchedulingPolicy
+	approvalPolicy              *fleetapi.StampApprovalPolicy
 }
 
 type ValidatedRegisterOptions struct {
@@ -126,6 +143,11 @@ func (o *RawRegisterOptions) Validate(ctx context.Context) (*ValidatedRegisterOp
 		return nil, fmt.Errorf("invalid scheduling policy %q: must be Schedulable or Unschedulable", o.SchedulingPolicy)
 	}
 
+	approvalPolicy, err := o.buildApprovalPolicy()
+	if err != nil {
+		return nil, err
+	}
+
 	aksID, err := azcorearm.ParseResourceID(o.AKSResourceID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid aks-resource-id: %w", err)
@@ -145,16 +167,51 @@ func (o *RawRegisterOptions) Validate(ctx context.Context) (*ValidatedRegisterOp
 			aksResourceID:               aksID,
 			publicDNSZoneResourceID:     dnsID,
 			schedulingPolicy:            schedulingPolicy,
+			approvalPolicy:              approvalPolicy,
 		},
 	}, nil
 }
 
+// buildApprovalPolicy returns the approval policy requested by the flags, or nil
+// when --required-approvals is 0.
+func (o *RawRegisterOptions) buildApprovalPolicy() (*fleetapi.StampApprovalPolicy, error) {
+	if o.RequiredApprovals < 0 {
+		return nil, fmt.Errorf("--required-approvals must not be negative")
+	}
+	if o.RequiredApprovals == 0 {
+		return nil, nil
+	}
+	if o.AutoApprove {
+		return nil, fmt.Errorf("--auto-approve cannot be combined with --required-approvals")
+	}
+	// Without a requester the self-approval check has nothing to compare
+	// approvers against, so the two-person control would be toothless.
+	if len(o.ApprovalRequestedBy) == 0 {
+		return nil, fmt.Errorf("--approval-requested-by is required with --required-approvals")
+	}
+	if o.ApprovalSoakDuration < 0 {
+		return nil, fmt.Errorf("--approval-soak-duration must not be negative")
+	}
+	for _, conditionType := range o.ApprovalRequiredManagementClusterConditions {
+		if len(conditionType) == 0 {
+			return nil, fmt.Errorf("--approval-required-management-cluster-conditions must not contain empty condition types")
+		}
+	}
+	return &fleetapi.StampApprovalPolicy{
+		RequiredApprovals:                   o.RequiredApprovals,
+		RequestedBy:                         o.ApprovalRequestedBy,
+		SoakDuration:                        metav1.Duration{Duration: o.ApprovalSoakDuration},
+		RequiredManagementClusterConditions: o.ApprovalRequiredManagementClusterConditions,
+	}, nil
+}
+
 type registerOptions struct {
 	fleetDBClient                                        fleetcosmosstorage.FleetDBClient
 	stampIdentifier                                      string
 	stampResourceID                                      *azcorearm.ResourceID
 	managementClusterResourceID                          *azcorearm.ResourceID
 	autoApprove                                          bool
+	approvalPolicy                                       *fleetapi.StampApprovalPolicy
 	schedulingPolicy                                     fleetapi.ManagementClusterSchedulingPolicy
 	aksResourceID                                        *azcorearm.ResourceID
 	publicDNSZoneResourceID                              *azcorearm.ResourceID
@@ -187,21 +244,22 @@ func (o *ValidatedRegisterOptions) Complete(ctx context.Context) (*RegisterOptio
 
 	return &RegisterOptions{
 		registerOptions: &registerOptions{
-			fleetDBClient:                              fleetDBClient,
-			stampIdentifier:                            o.StampIdentifier,
-			stampResourceID:                            o.stampResourceID,
-			managementClusterResourceID:                o.managementClusterResourceID,
-			autoApprove:                                o.AutoApprove,
-			schedulingPolicy:                           o.schedulingPolicy,
-			aksResourceID:                              o.aksResourceID,
-			publicDNSZoneResourceID:                    o.publicDNSZoneResourceID,
-			hostedClustersSecretsKeyVaultURL:           o.HostedClustersSecretsKeyVaultURL,
-			hostedClustersManagedIdentitiesKeyVaultURL: o.HostedClustersManagedIdentitiesKeyVaultURL,
+			fleetDBClient:                    fleetDBClient,
+			stampIdentifier:                  o.StampIdentifier,
+			stampResourceID:                  o.stampResourceID,
+			managementClusterResourceID:      o.managementClusterResourceID,
+			autoApprove:                      o.AutoApprove,
+			approvalPolicy:                   o.approvalPolicy,
+			schedulingPolicy:                 o.schedulingPolicy,
+			aksResourceID:                    o.aksResourceID,
+			publicDNSZoneResourceID:          o.publicDNSZoneResourceID,
+			hostedClustersSecretsKeyVaultURL: o.HostedClustersSecretsKeyVaultURL,
+			hostedClustersManagedIdentitiesKeyVaultURL:           o.HostedClustersManagedIdentitiesKeyVaultURL,
 			hostedClustersSecretsKeyVaultManagedIdentityClientID: o.HostedClustersSecretsKeyVaultManagedIdentityClientID,
-			maestroConsumerName:            o.MaestroConsumerName,
-			maestroRESTAPIURL:              o.MaestroRESTAPIURL,
-			maestroGRPCTarget:              o.MaestroGRPCTarget,
-			kubeApplierCosmosContainerName: o.KubeApplierCosmosContainerName,
+			maestroConsumerName:                                  o.MaestroConsumerName,
+			maestroRESTAPIURL:                                    o.MaestroRESTAPIURL,
+			maestroGRPCTarget:                                    o.MaestroGRPCTarget,
+			kubeApplierCosmosContainerName:                       o.KubeApplierCosmosContainerName,
 		},
 	}, nil
 }
@@ -16,6 +16,7 @@ package register
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -66,6 +67,42 @@ func TestValidate(t *testing.T) {
 			modify:    func(opts *RawRegisterOptions) { opts.SchedulingPolicy = "InvalidPolicy" },
 			expectErr: "invalid scheduling policy",
 		},
+		{
+			name: "approval policy passes validation",
+			modify: func(opts *RawRegisterOptions) {
+				opts.RequiredApprovals = 2
+				opts.ApprovalSoakDuration = 24 * time.Hour
+				opts.ApprovalRequestedBy = "requester@example.com"
+				opts.ApprovalRequiredManagementClusterConditions = []string{"ClustersServiceRegistered"}
+			},
+		},
+		{
+			name: "approval policy with auto-approve",
+			modify: func(opts *RawRegisterOptions) {
+				opts.RequiredApprovals = 2
+				opts.AutoApprove = true
+			},
+			expectErr: "--auto-approve cannot be combined with --required-approvals",
+		},
+		{
+			name:      "negative required approvals",
+			modify:    func(opts *RawRegisterOptions) { opts.RequiredApprovals = -1 },
+			expectErr: "--required-approvals must not be negative",
+		},
+		{
+			name:      "approval policy without requester",
+			modify:    func(opts *RawRegisterOptions) { opts.RequiredApprovals = 2 },
+			expectErr: "--approval-requested-by is required with --required-approvals",
+		},
+		{
+			name: "negative approval soak duration",
+			modify: func(opts *RawRegisterOptions) {
+				opts.RequiredApprovals = 1
+				opts.ApprovalRequestedBy = "requester@example.com"
+				opts.ApprovalSoakDuration = -time.Minute
+			},
+			expectErr: "--approval-soak-duration must not be negative",
+		},
 		{
 			name:      "invalid AKS resource ID",
 			modify:    func(opts *RawRegisterOptions) { opts.AKSResourceID = "not-a-resource-id" },
@@ -0,0 +1,208 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package capacity implements a controller that scores how full each
+// management cluster is and, when enabled, cordons management clusters that
+// run out of capacity, so Cluster Service stops placing new hosted control
+// planes there.
+package capacity
+
+import (
+	"context"
+	"fmt"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	utilsclock "k8s.io/utils/clock"
+
+	fleetcontrollers "github.com/Azure/ARO-HCP/fleet/pkg/controllers/base"
+	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/controllerutils"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/fleetcosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+// HostedControlPlaneLister lists the hosted control planes placed on a
+// Cluster Service provision shard.
+type HostedControlPlaneLister interface {
+	ListHostedControlPlanes(ctx context.Context, provisionShardID metadataapi.InternalID) ([]metadataapi.InternalID, error)
+}
+
+type capacitySyncer struct {
+	clock                    utilsclock.PassiveClock
+	fleetDBClient            fleetcosmosstorage.FleetDBClient
+	hostedControlPlaneLister HostedControlPlaneLister
+	nodeUsageReader          NodeUsageReader
+	ingestionUsageReader     IngestionUsageReader
+	thresholds               Thresholds
+	cordon                   bool
+}
+
+// NewManagementClusterCapacityController creates a StampWatchingController
+// that publishes the capacity score of every management cluster in
+// Status.Capacity and the CapacityAvailable condition. With cordon set it
+// also flips Spec.SchedulingPolicy between the high- and low-water marks;
+// otherwise reaching the high-water mark is only reported. A nil
+// nodeUsageReader leaves the CPU, memory and zone dimensions unmeasured and
+// a nil ingestionUsageReader leaves AMW ingestion unmeasured.
+func NewManagementClusterCapacityController(
+	clock utilsclock.PassiveClock,
+	managementClusterInformer cache.SharedIndexInformer,
+	fleetDBClient fleetcosmosstorage.FleetDBClient,
+	hostedControlPlaneLister HostedControlPlaneLister,
+	nodeUsageReader NodeUsageReader,
+	ingestionUsageReader IngestionUsageReader,
+	thresholds Thresholds,
+	cordon bool,
+	cfg fleetcontrollers.StampWatchingControllerConfig,
+) *fleetcontrollers.StampWatchingController {
+	syncer := &capacitySyncer{
+		clock:                    clock,
+		fleetDBClient:            fleetDBClient,
+		hostedControlPlaneLister: hostedControlPlaneLister,
+		nodeUsageReader:          nodeUsageReader,
+		ingestionUsageReader:     ingestionUsageReader,
+		thresholds:               thresholds,
+		cordon:                   cordon,
+	}
+
+	controller := fleetcontrollers.NewStampWatchingController(
+		"ManagementClusterCapacityController",
+		syncer,
+		cfg,
+	)
+
+	if err := controller.QueueForInformers(fleetcontrollers.DefaultInformerResyncPeriod, managementClusterInformer); err != nil {
+		panic(err) // coding error
+	}
+
+	return controller
+}
+
+func (s *capacitySyncer) SyncOnce(ctx context.Context, key fleetcontrollers.StampKey) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	managementClusterCRUD := s.fleetDBClient.Stamps().ManagementClusters(key.StampIdentifier)
+	managementCluster, err := managementClusterCRUD.Get(ctx, fleetapi.ManagementClusterResourceName)
+	if err != nil {
+		if cosmosstorageutils.IsNotFoundError(err) {
+			return nil
+		}
+		return utils.TrackError(err)
+	}
+
+	// Usage can only be measured once the management cluster is registered.
+	if managementCluster.Status.ClusterServiceProvisionShardID == nil || managementCluster.Status.AKSResourceID == nil {
+		return nil
+	}
+
+	updated := managementCluster.DeepCopy()
+
+	usage, readErr := s.readUsage(ctx, managementCluster)
+	if readErr != nil {
+		apimeta.SetStatusCondition(&updated.Status.Conditions, metav1.Condition{
+			Type:    string(fleetapi.ManagementClusterConditionCapacityAvailable),
+			Status:  metav1.ConditionUnknown,
+			Reason:  string(fleetapi.ManagementClusterConditionReasonCapacityUnknown),
+			Message: fmt.Sprintf("Failed to read usage: %v", readErr),
+		})
+	} else {
+		capacity := computeCapacity(*usage, s.thresholds)
+		decision := decideScheduling(managementCluster, capacity.Score, s.thresholds, s.cordon)
+		capacity.Cordoned = decision.cordoned
+		// Only a change of score or cordon state is worth a new timestamp;
+		// otherwise every resync would rewrite an unchanged document.
+		previous := managementCluster.Status.Capacity
+		if previous != nil && previous.Score == capacity.Score && previous.Cordoned == capacity.Cordoned {
+			capacity.LastUpdateTime = previous.LastUpdateTime
+		} else {
+			capacity.LastUpdateTime = metav1.NewTime(s.clock.Now())
+		}
+		updated.Status.Capacity = capacity
+		if len(decision.schedulingPolicy) > 0 && decision.schedulingPolicy != updated.Spec.SchedulingPolicy {
+			logger.Info("changing scheduling policy for capacity",
+				"score", capacity.Score,
+				"from", updated.Spec.SchedulingPolicy,
+				"to", decision.schedulingPolicy,
+			)
+			updated.Spec.SchedulingPolicy = decision.schedulingPolicy
+		}
+
+		switch {
+		case capacity.Cordoned:
+			apimeta.SetStatusCondition(&updated.Status.Conditions, metav1.Condition{
+				Type:   string(fleetapi.ManagementClusterConditionCapacityAvailable),
+				Status: metav1.ConditionFalse,
+				Reason: string(fleetapi.ManagementClusterConditionReasonAboveHighWaterMark),
+				Message: fmt.Sprintf("Capacity score %d reached the high-water mark %d; cordoned until it falls to %d",
+					capacity.Score, s.thresholds.HighWaterMark, s.thresholds.LowWaterMark),
+			})
+		case capacity.Score >= s.thresholds.HighWaterMark && !s.cordon:
+			apimeta.SetStatusCondition(&updated.Status.Conditions, metav1.Condition{
+				Type:   string(fleetapi.ManagementClusterConditionCapacityAvailable),
+				Status: metav1.ConditionFalse,
+				Reason: string(fleetapi.ManagementClusterConditionReasonAboveHighWaterMark),
+				Message: fmt.Sprintf("Capacity score %d reached the high-water mark %d; automatic cordoning is disabled",
+					capacity.Score, s.thresholds.HighWaterMark),
+			})
+		default:
+			apimeta.SetStatusCondition(&updated.Status.Conditions, metav1.Condition{
+				Type:    string(fleetapi.ManagementClusterConditionCapacityAvailable),
+				Status:  metav1.ConditionTrue,
+				Reason:  string(fleetapi.ManagementClusterConditionReasonBelowHighWaterMark),
+				Message: fmt.Sprintf("Capacity score %d is below the high-water mark %d", capacity.Score, s.thresholds.HighWaterMark),
+			})
+		}
+		recordCapacityMetrics(key.StampIdentifier, capacity)
+	}
+
+	if controllerutils.NeedsUpdate(managementCluster, updated) {
+		if _, err := managementClusterCRUD.Replace(ctx, updated, managementCluster, nil); err != nil {
+			return utils.TrackError(err)
+		}
+	}
+
+	if readErr != nil {
+		return utils.TrackError(readErr)
+	}
+	return nil
+}
+
+// readUsage measures every capacity dimension of the management cluster.
+func (s *capacitySyncer) readUsage(ctx context.Context, managementCluster *fleetapi.ManagementCluster) (*Usage, error) {
+	hostedControlPlanes, err := s.hostedControlPlaneLister.ListHostedControlPlanes(ctx, *managementCluster.Status.ClusterServiceProvisionShardID)
+	if err != nil {
+		return nil, fmt.Errorf("listing hosted control planes: %w", err)
+	}
+	usage := &Usage{HostedControlPlanes: len(hostedControlPlanes)}
+
+	if s.nodeUsageReader != nil {
+		usage.Nodes, err = s.nodeUsageReader.ReadNodeUsage(ctx, managementCluster.Status.AKSResourceID.Name)
+		if err != nil {
+			return nil, fmt.Errorf("reading node usage: %w", err)
+		}
+	}
+
+	if s.ingestionUsageReader != nil {
+		usage.AMWActiveTimeSeries, err = s.ingestionUsageReader.ReadAMWActiveTimeSeries(ctx, managementCluster.Status.AKSResourceID.Name)
+		if err != nil {
+			return nil, fmt.Errorf("reading AMW ingestion: %w", err)
+		}
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,245 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capacity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	fleetcontrollers "github.com/Azure/ARO-HCP/fleet/pkg/controllers/base"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/fleetcosmosstoragetesting"
+)
+
+const testStamp = "s1"
+
+var (
+	testNow            = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	testLastUpdateTime = testNow.Add(-time.Hour)
+)
+
+type fakeHostedControlPlaneLister struct {
+	count int
+	err   error
+}
+
+func (f *fakeHostedControlPlaneLister) ListHostedControlPlanes(ctx context.Context, provisionShardID metadataapi.InternalID) ([]metadataapi.InternalID, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	clusterIDs := make([]metadataapi.InternalID, f.count)
+	for i := range clusterIDs {
+		clusterIDs[i] = metadataapi.Must(metadataapi.NewInternalID(fmt.Sprintf("/api/aro_hcp/v1alpha1/clusters/c%d", i)))
+	}
+	return clusterIDs, nil
+}
+
+type fakeNodeUsageReader struct {
+	usage       *NodeUsage
+	clusterName string
+}
+
+func (f *fakeNodeUsageReader) ReadNodeUsage(ctx context.Context, aksClusterName string) (*NodeUsage, error) {
+	f.clusterName = aksClusterName
+	return f.usage, nil
+}
+
+type fakeIngestionUsageReader struct {
+	activeTimeSeries int64
+}
+
+func (f *fakeIngestionUsageReader) ReadAMWActiveTimeSeries(ctx context.Context, aksClusterName string) (int64, error) {
+	return f.activeTimeSeries, nil
+}
+
+func testManagementCluster(policy fleetapi.ManagementClusterSchedulingPolicy) *fleetapi.ManagementCluster {
+	resourceID := metadataapi.Must(fleetapi.ToManagementClusterResourceID(testStamp))
+	aksResourceID := metadataapi.Must(azcorearm.ParseResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/mgmt-" + testStamp))
+	dnsResourceID := metadataapi.Must(azcorearm.ParseResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dns-rg/providers/Microsoft.Network/dnszones/example.com"))
+	shardID := metadataapi.Must(metadataapi.NewInternalID("/api/aro_hcp/v1alpha1/provision_shards/shard-" + testStamp))
+	return &fleetapi.ManagementCluster{
+		CosmosMetadata: coreapi.CosmosMetadata{ResourceID: resourceID, PartitionKey: strings.ToLower(testStamp)},
+		ResourceID:     resourceID,
+		Spec: fleetapi.ManagementClusterSpec{
+			SchedulingPolicy: policy,
+		},
+		Status: fleetapi.ManagementClusterStatus{
+			AKSResourceID:                                        aksResourceID,
+			PublicDNSZoneResourceID:                              dnsResourceID,
+			HostedClustersSecretsKeyVaultURL:                     "https://kv-secrets.vault.azure.net",
+			HostedClustersManagedIdentitiesKeyVaultURL:           "https://kv-mi.vault.azure.net",
+			HostedClustersSecretsKeyVaultManagedIdentityClientID: "12345678-1234-1234-1234-123456789012",
+			ClusterServiceProvisionShardID:                       &shardID,
+			MaestroConsumerName:                                  "consumer-" + testStamp,
+			MaestroRESTAPIURL:                                    "http://maestro:8000",
+			MaestroGRPCTarget:                                    "maestro:8090",
+			KubeApplierCosmosContainerName:                       "kube-applier-" + testStamp,
+		},
+	}
+}
+
+func TestSyncOnce(t *testing.T) {
+	cordonedManagementCluster := testManagementCluster(fleetapi.ManagementClusterSchedulingPolicyUnschedulable)
+	cordonedManagementCluster.Status.Capacity = &fleetapi.ManagementClusterCapacity{Score: 90, Cordoned: true, LastUpdateTime: metav1.NewTime(testLastUpdateTime)}
+	scoredManagementCluster := testManagementCluster(fleetapi.ManagementClusterSchedulingPolicySchedulable)
+	scoredManagementCluster.Status.Capacity = &fleetapi.ManagementClusterCapacity{Score: 45, HostedControlPlanes: 20, LastUpdateTime: metav1.NewTime(testLastUpdateTime)}
+
+	tests := []struct {
+		name              string
+		managementCluster *fleetapi.ManagementCluster
+		lister            *fakeHostedControlPlaneLister
+		nodeUsage         *NodeUsage
+		activeTimeSeries  int64
+		disableCordon     bool
+		wantErr           bool
+		wantScore         int32
+		wantPolicy        fleetapi.ManagementClusterSchedulingPolicy
+		wantCordoned      bool
+		wantReason        fleetapi.ManagementClusterConditionReason
+		// wantLastUpdateTime defaults to testNow.
+		wantLastUpdateTime time.Time
+	}{
+		{
+			name:              "below high-water mark publishes the score",
+			managementCluster: testManagementCluster(fleetapi.ManagementClusterSchedulingPolicySchedulable),
+			lister:            &fakeHostedControlPlaneLister{count: 30},
+			nodeUsage:         &NodeUsage{CPURequestedPercent: 40, MemoryRequestedPercent: 45},
+			wantScore:         45,
+			wantPolicy:        fleetapi.ManagementClusterSchedulingPolicySchedulable,
+			wantReason:        fleetapi.ManagementClusterConditionReasonBelowHighWaterMark,
+		},
+		{
+			name:               "unchanged score keeps the last update time",
+			managementCluster:  scoredManagementCluster,
+			lister:             &fakeHostedControlPlaneLister{count: 30},
+			nodeUsage:          &NodeUsage{CPURequestedPercent: 40, MemoryRequestedPercent: 45},
+			wantScore:          45,
+			wantPolicy:         fleetapi.ManagementClusterSchedulingPolicySchedulable,
+			wantReason:         fleetapi.ManagementClusterConditionReasonBelowHighWaterMark,
+			wantLastUpdateTime: testLastUpdateTime,
+		},
+		{
+			name:              "above high-water mark cordons",
+			managementCluster: testManagementCluster(fleetapi.ManagementClusterSchedulingPolicySchedulable),
+			lister:            &fakeHostedControlPlaneLister{count: 30},
+			nodeUsage:         &NodeUsage{CPURequestedPercent: 92, MemoryRequestedPercent: 45},
+			wantScore:         92,
+			wantPolicy:        fleetapi.ManagementClusterSchedulingPolicyUnschedulable,
+			wantCordoned:      true,
+			wantReason:        fleetapi.ManagementClusterConditionReasonAboveHighWaterMark,
+		},
+		{
+			name:              "above high-water mark is only reported when cordoning is disabled",
+			managementCluster: testManagementCluster(fleetapi.ManagementClusterSchedulingPolicySchedulable),
+			lister:            &fakeHostedControlPlaneLister{count: 30},
+			nodeUsage:         &NodeUsage{CPURequestedPercent: 92, MemoryRequestedPercent: 45},
+			disableCordon:     true,
+			wantScore:         92,
+			wantPolicy:        fleetapi.ManagementClusterSchedulingPolicySchedulable,
+			wantReason:        fleetapi.ManagementClusterConditionReasonAboveHighWaterMark,
+		},
+		{
+			name:              "AMW ingestion of the management cluster cordons",
+			managementCluster: testManagementCluster(fleetapi.ManagementClusterSchedulingPolicySchedulable),
+			lister:            &fakeHostedControlPlaneLister{count: 30},
+			nodeUsage:         &NodeUsage{CPURequestedPercent: 40, MemoryRequestedPercent: 45},
+			activeTimeSeries:  950,
+			wantScore:         95,
+			wantPolicy:        fleetapi.ManagementClusterSchedulingPolicyUnschedulable,
+			wantCordoned:      true,
+			wantReason:        fleetapi.ManagementClusterConditionReasonAboveHighWaterMark,
+		},
+		{
+			name:              "below low-water mark uncordons",
+			managementCluster: cordonedManagementCluster,
+			lister:            &fakeHostedControlPlaneLister{count: 30},
+			nodeUsage:         &NodeUsage{CPURequestedPercent: 50, MemoryRequestedPercent: 45},
+			wantScore:         50,
+			wantPolicy:        fleetapi.ManagementClusterSchedulingPolicySchedulable,
+			wantReason:        fleetapi.ManagementClusterConditionReasonBelowHighWaterMark,
+		},
+		{
+			name:               "usage read failure reports unknown capacity",
+			managementCluster:  cordonedManagementCluster,
+			lister:             &fakeHostedControlPlaneLister{err: fmt.Errorf("clusters service unavailable")},
+			wantErr:            true,
+			wantScore:          90,
+			wantPolicy:         fleetapi.ManagementClusterSchedulingPolicyUnschedulable,
+			wantCordoned:       true,
+			wantReason:         fleetapi.ManagementClusterConditionReasonCapacityUnknown,
+			wantLastUpdateTime: testLastUpdateTime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			mockDB, err := fleetcosmosstoragetesting.NewMockFleetDBClientWithResources(ctx, []any{tt.managementCluster})
+			require.NoError(t, err)
+
+			nodeUsageReader := &fakeNodeUsageReader{usage: tt.nodeUsage}
+			syncer := &capacitySyncer{
+				clock:                    clocktesting.NewFakePassiveClock(testNow),
+				fleetDBClient:            mockDB,
+				hostedControlPlaneLister: tt.lister,
+				nodeUsageReader:          nodeUsageReader,
+				ingestionUsageReader:     &fakeIngestionUsageReader{activeTimeSeries: tt.activeTimeSeries},
+				thresholds:               Thresholds{HighWaterMark: 85, LowWaterMark: 70, MaxHostedControlPlanes: 200, MaxAMWActiveTimeSeries: 1000},
+				cordon:                   !tt.disableCordon,
+			}
+
+			err = syncer.SyncOnce(ctx, fleetcontrollers.StampKey{StampIdentifier: testStamp})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "mgmt-"+testStamp, nodeUsageReader.clusterName)
+			}
+
+			managementCluster, err := mockDB.Stamps().ManagementClusters(testStamp).Get(ctx, fleetapi.ManagementClusterResourceName)
+			require.NoError(t, err)
+
+			require.NotNil(t, managementCluster.Status.Capacity)
+			assert.Equal(t, tt.wantScore, managementCluster.Status.Capacity.Score)
+			assert.Equal(t, tt.wantCordoned, managementCluster.Status.Capacity.Cordoned)
+			assert.Equal(t, tt.wantPolicy, managementCluster.Spec.SchedulingPolicy)
+			wantLastUpdateTime := tt.wantLastUpdateTime
+			if wantLastUpdateTime.IsZero() {
+				wantLastUpdateTime = testNow
+			}
+			assert.True(t, wantLastUpdateTime.Equal(managementCluster.Status.Capacity.LastUpdateTime.Time),
+				"expected last update time %v, got %v", wantLastUpdateTime, managementCluster.Status.Capacity.LastUpdateTime)
+
+			condition := apimeta.FindStatusCondition(managementCluster.Status.Conditions, string(fleetapi.ManagementClusterConditionCapacityAvailable))
+			require.NotNil(t, condition)
+			assert.Equal(t, string(tt.wantReason), condition.Reason)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capacity
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"k8s.io/component-base/metrics/legacyregistry"
+
+	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
+)
+
+var (
+	capacityScore = promauto.With(legacyregistry.Registerer()).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "fleet_management_cluster_capacity_score",
+			Help: "Capacity score of the management cluster, from 0 (empty) to 100 (full).",
+		},
+		[]string{"stamp"},
+	)
+
+	capacityUtilization = promauto.With(legacyregistry.Registerer()).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "fleet_management_cluster_capacity_utilization_percent",
+			Help: "Utilization of one capacity dimension of the management cluster, in percent.",
+		},
+		[]string{"stamp", "dimension"},
+	)
+
+	capacityHostedControlPlanes = promauto.With(legacyregistry.Registerer()).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "fleet_management_cluster_hosted_control_planes",
+			Help: "Number of hosted control planes placed on the management cluster.",
+		},
+		[]string{"stamp"},
+	)
+
+	capacityCordoned = promauto.With(legacyregistry.Registerer()).NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "fleet_management_cluster_capacity_cordoned",
+			Help: "1 when the management cluster is cordoned because its capacity score reached the high-water mark.",
+		},
+		[]string{"stamp"},
+	)
+)
+
+func recordCapacityMetrics(stampIdentifier string, capacity *fleetapi.ManagementClusterCapacity) {
+	capacityScore.WithLabelValues(stampIdentifier).Set(float64(capacity.Score))
+	capacityHostedControlPlanes.WithLabelValues(stampIdentifier).Set(float64(capacity.HostedControlPlanes))
+	for dimension, value := range map[string]int32{
+		"hosted_control_planes": capacity.HostedControlPlanesPercent,
+		"cpu_requested":         capacity.CPURequestedPercent,
+		"memory_requested":      capacity.MemoryRequestedPercent,
+		"amw_ingestion":         capacity.AMWIngestionPercent,
+	} {
+		capacityUtilization.WithLabelValues(stampIdentifier, dimension).Set(float64(value))
+	}
+	cordoned := 0.0
+	if capacity.Cordoned {
+		cordoned = 1
+	}
+	capacityCordoned.WithLabelValues(stampIdentifier).Set(cordoned)
+}
@@ -0,0 +1,257 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capacity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+const (
+	// prometheusAudience is the token audience of Azure Monitor managed Prometheus.
+	prometheusAudience = "https://prometheus.monitor.azure.com"
+
+	// zoneLabel is the kube-state-metrics label carrying the node's
+	// topology.kubernetes.io/zone label. It is only exported when the label
+	// is on the metricLabelsAllowlist of the AKS cluster.
+	zoneLabel = "label_topology_kubernetes_io_zone"
+)
+
+// aksClusterNamePattern matches valid AKS cluster names. The name is
+// interpolated into PromQL, so anything else is rejected.
+var aksClusterNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,62}$`)
+
+// NodeUsage is the requested CPU and memory of the nodes of a management
+// cluster, as percentages of the allocatable resources.
+type NodeUsage struct {
+	CPURequestedPercent    float64
+	MemoryRequestedPercent float64
+	Zones                  []ZoneUsage
+}
+
+// ZoneUsage is the NodeUsage of the nodes in one availability zone.
+type ZoneUsage struct {
+	Zone                   string
+	CPURequestedPercent    float64
+	MemoryRequestedPercent float64
+}
+
+// NodeUsageReader reads the node usage of a management cluster.
+type NodeUsageReader interface {
+	ReadNodeUsage(ctx context.Context, aksClusterName string) (*NodeUsage, error)
+}
+
+// IngestionUsageReader reads how much a management cluster sends to its Azure
+// Monitor Workspace.
+type IngestionUsageReader interface {
+	ReadAMWActiveTimeSeries(ctx context.Context, aksClusterName string) (int64, error)
+}
+
+type prometheusUsageReader struct {
+	pipeline      runtime.Pipeline
+	queryEndpoint string
+}
+
+// NewPrometheusNodeUsageReader returns a NodeUsageReader that queries the
+// kube-state-metrics of the management clusters from the Azure Monitor
+// Workspace behind queryEndpoint. Series are selected by the cluster label,
+// which managed Prometheus sets to the AKS cluster name.
+func NewPrometheusNodeUsageReader(queryEndpoint string, credential azcore.TokenCredential, clientOptions *policy.ClientOptions) NodeUsageReader {
+	return newPrometheusUsageReader(queryEndpoint, credential, clientOptions)
+}
+
+// NewPrometheusIngestionUsageReader returns an IngestionUsageReader that
+// counts the series each management cluster sends to the Azure Monitor
+// Workspace behind queryEndpoint.
+func NewPrometheusIngestionUsageReader(queryEndpoint string, credential azcore.TokenCredential, clientOptions *policy.ClientOptions) IngestionUsageReader {
+	return newPrometheusUsageReader(queryEndpoint, credential, clientOptions)
+}
+
+func newPrometheusUsageReader(queryEndpoint string, credential azcore.TokenCredential, clientOptions *policy.ClientOptions) *prometheusUsageReader {
+	if clientOptions == nil {
+		clientOptions = &policy.ClientOptions{}
+	}
+
+	// Copy PerRetryPolicies to avoid mutating the caller's slice.
+	perRetryPolicies := make([]policy.Policy, len(clientOptions.PerRetryPolicies), len(clientOptions.PerRetryPolicies)+1)
+	copy(perRetryPolicies, clientOptions.PerRetryPolicies)
+	perRetryPolicies = append(perRetryPolicies, runtime.NewBearerTokenPolicy(credential, []string{prometheusAudience + "/.default"}, nil))
+
+	return &prometheusUsageReader{
+		pipeline: runtime.NewPipeline("capacity", "v1.0.0", runtime.PipelineOptions{}, &policy.ClientOptions{
+			Cloud:            clientOptions.Cloud,
+			Telemetry:        clientOptions.Telemetry,
+			Transport:        clientOptions.Transport,
+			PerCallPolicies:  clientOptions.PerCallPolicies,
+			PerRetryPolicies: perRetryPolicies,
+		}),
+		queryEndpoint: strings.TrimRight(queryEndpoint, "/"),
+	}
+}
+
+// ReadNodeUsage sums the resource requests of running pods and the
+// allocatable resources of the nodes per zone, then derives the cluster-wide
+// and per-zone percentages from them.
+func (r *prometheusUsageReader) ReadNodeUsage(ctx context.Context, aksClusterName string) (*NodeUsage, error) {
+	if !aksClusterNamePattern.MatchString(aksClusterName) {
+		return nil, fmt.Errorf("invalid AKS cluster name %q", aksClusterName)
+	}
+
+	sums := map[string]map[string]float64{}
+	for _, resource := range []string{"cpu", "memory"} {
+		for kind, query := range map[string]string{
+			"requested":   requestedByZoneQuery(aksClusterName, resource),
+			"allocatable": allocatableByZoneQuery(aksClusterName, resource),
+		} {
+			byZone, err := r.queryByZone(ctx, query)
+			if err != nil {
+				return nil, fmt.Errorf("querying %s %s: %w", kind, resource, err)
+			}
+			sums[resource+"/"+kind] = byZone
+		}
+	}
+
+	usage := &NodeUsage{
+		CPURequestedPercent:    ratio(total(sums["cpu/requested"]), total(sums["cpu/allocatable"])),
+		MemoryRequestedPercent: ratio(total(sums["memory/requested"]), total(sums["memory/allocatable"])),
+	}
+	for zone, allocatableCPU := range sums["cpu/allocatable"] {
+		usage.Zones = append(usage.Zones, ZoneUsage{
+			Zone:                   zone,
+			CPURequestedPercent:    ratio(sums["cpu/requested"][zone], allocatableCPU),
+			MemoryRequestedPercent: ratio(sums["memory/requested"][zone], sums["memory/allocatable"][zone]),
+		})
+	}
+	return usage, nil
+}
+
+// ReadAMWActiveTimeSeries sums the samples every scrape target of the
+// management cluster keeps after relabeling. Each sample is one active series,
+// so the sum is what the cluster holds against the workspace limit.
+func (r *prometheusUsageReader) ReadAMWActiveTimeSeries(ctx context.Context, aksClusterName string) (int64, error) {
+	if !aksClusterNamePattern.MatchString(aksClusterName) {
+		return 0, fmt.Errorf("invalid AKS cluster name %q", aksClusterName)
+	}
+
+	byZone, err := r.queryByZone(ctx, activeTimeSeriesQuery(aksClusterName))
+	if err != nil {
+		return 0, fmt.Errorf("querying active time series: %w", err)
+	}
+	return int64(math.Round(total(byZone))), nil
+}
+
+func activeTimeSeriesQuery(aksClusterName string) string {
+	return fmt.Sprintf(`sum(scrape_samples_post_metric_relabeling{cluster=%q})`, aksClusterName)
+}
+
+// nodeZoneJoin attaches the zone label of the node to a per-node series.
+func nodeZoneJoin(aksClusterName string) string {
+	return fmt.Sprintf(`* on (node) group_left (%[2]s) max by (node, %[2]s) (kube_node_labels{cluster=%[1]q})`, aksClusterName, zoneLabel)
+}
+
+func requestedByZoneQuery(aksClusterName, resource string) string {
+	return fmt.Sprintf(
+		`sum by (%[1]s) (sum by (node) (kube_pod_container_resource_requests{cluster=%[2]q,resource=%[3]q} unless on (namespace, pod) (kube_pod_status_phase{cluster=%[2]q,phase=~"Succeeded|Failed"} == 1)) %[4]s)`,
+		zoneLabel, aksClusterName, resource, nodeZoneJoin(aksClusterName),
+	)
+}
+
+func allocatableByZoneQuery(aksClusterName, resource string) string {
+	return fmt.Sprintf(
+		`sum by (%[1]s) (sum by (node) (kube_node_status_allocatable{cluster=%[2]q,resource=%[3]q}) %[4]s)`,
+		zoneLabel, aksClusterName, resource, nodeZoneJoin(aksClusterName),
+	)
+}
+
+func total(byZone map[string]float64) float64 {
+	var sum float64
+	for _, value := range byZone {
+		sum += value
+	}
+	return sum
+}
+
+func ratio(used, available float64) float64 {
+	if available <= 0 {
+		return 0
+	}
+	return used * 100 / available
+}
+
+// queryByZone runs an instant query that returns one sample per zone.
+func (r *prometheusUsageReader) queryByZone(ctx context.Context, query string) (map[string]float64, error) {
+	req, err := runtime.NewRequest(ctx, http.MethodGet, r.queryEndpoint+"/api/v1/query?"+url.Values{"query": {query}}.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.pipeline.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	var result prometheusVectorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+	if result.Status != "success" {
+		return nil, fmt.Errorf("query failed (%s): %s", result.ErrorType, result.Error)
+	}
+
+	byZone := map[string]float64{}
+	for _, sample := range result.Data.Result {
+		if len(sample.Value) != 2 {
+			return nil, fmt.Errorf("unexpected sample %v", sample.Value)
+		}
+		raw, ok := sample.Value[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected sample value %v", sample.Value[1])
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing sample value: %w", err)
+		}
+		byZone[sample.Metric[zoneLabel]] += value
+	}
+	return byZone, nil
+}
+
+type prometheusVectorResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Data      struct {
+		Result []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []any             `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
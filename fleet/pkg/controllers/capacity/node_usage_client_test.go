@@ -0,0 +1,118 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capacity
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+)
+
+// fakePrometheus answers instant queries with per-zone samples chosen by
+// which kube-state-metrics series and resource the query selects.
+type fakePrometheus struct {
+	samples map[string]map[string]string
+	queries []string
+}
+
+func (f *fakePrometheus) Do(req *http.Request) (*http.Response, error) {
+	query := req.URL.Query().Get("query")
+	f.queries = append(f.queries, query)
+
+	key := "allocatable"
+	if strings.Contains(query, "kube_pod_container_resource_requests") {
+		key = "requested"
+	}
+	if strings.Contains(query, `resource="memory"`) {
+		key += "/memory"
+	} else {
+		key += "/cpu"
+	}
+	if strings.Contains(query, "scrape_samples_post_metric_relabeling") {
+		key = "series"
+	}
+
+	var results []string
+	for zone, value := range f.samples[key] {
+		results = append(results, fmt.Sprintf(`{"metric":{%q:%q},"value":[1700000000,%q]}`, zoneLabel, zone, value))
+	}
+	body := fmt.Sprintf(`{"status":"success","data":{"resultType":"vector","result":[%s]}}`, strings.Join(results, ","))
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+}
+
+func newTestNodeUsageReader(transport policy.Transporter) *prometheusUsageReader {
+	return &prometheusUsageReader{
+		pipeline:      runtime.NewPipeline("test", "v1.0.0", runtime.PipelineOptions{}, &policy.ClientOptions{Transport: transport}),
+		queryEndpoint: "https://amw.eastus.prometheus.monitor.azure.com",
+	}
+}
+
+func TestReadNodeUsage(t *testing.T) {
+	transport := &fakePrometheus{samples: map[string]map[string]string{
+		"requested/cpu":      {"eastus-1": "30", "eastus-2": "45"},
+		"allocatable/cpu":    {"eastus-1": "100", "eastus-2": "50"},
+		"requested/memory":   {"eastus-1": "200", "eastus-2": "100"},
+		"allocatable/memory": {"eastus-1": "400", "eastus-2": "400"},
+	}}
+
+	usage, err := newTestNodeUsageReader(transport).ReadNodeUsage(context.Background(), "mgmt-1")
+	require.NoError(t, err)
+
+	assert.InDelta(t, 50.0, usage.CPURequestedPercent, 0.001)
+	assert.InDelta(t, 37.5, usage.MemoryRequestedPercent, 0.001)
+
+	sort.Slice(usage.Zones, func(i, j int) bool { return usage.Zones[i].Zone < usage.Zones[j].Zone })
+	require.Len(t, usage.Zones, 2)
+	assert.Equal(t, "eastus-1", usage.Zones[0].Zone)
+	assert.InDelta(t, 30.0, usage.Zones[0].CPURequestedPercent, 0.001)
+	assert.InDelta(t, 50.0, usage.Zones[0].MemoryRequestedPercent, 0.001)
+	assert.Equal(t, "eastus-2", usage.Zones[1].Zone)
+	assert.InDelta(t, 90.0, usage.Zones[1].CPURequestedPercent, 0.001)
+	assert.InDelta(t, 25.0, usage.Zones[1].MemoryRequestedPercent, 0.001)
+
+	require.Len(t, transport.queries, 4)
+	for _, query := range transport.queries {
+		assert.Contains(t, query, `cluster="mgmt-1"`)
+	}
+}
+
+func TestReadNodeUsageRejectsInvalidClusterName(t *testing.T) {
+	transport := &fakePrometheus{}
+	_, err := newTestNodeUsageReader(transport).ReadNodeUsage(context.Background(), `mgmt"} or vector(1) #`)
+	require.Error(t, err)
+	assert.Empty(t, transport.queries)
+}
+
+func TestReadAMWActiveTimeSeries(t *testing.T) {
+	transport := &fakePrometheus{samples: map[string]map[string]string{
+		"series": {"": "123456"},
+	}}
+
+	series, err := newTestNodeUsageReader(transport).ReadAMWActiveTimeSeries(context.Background(), "mgmt-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(123456), series)
+	require.Len(t, transport.queries, 1)
+	assert.Contains(t, transport.queries[0], `cluster="mgmt-1"`)
+}
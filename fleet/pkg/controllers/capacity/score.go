@@ -0,0 +1,187 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capacity
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
+)
+
+const (
+	// DefaultHighWaterMark is the capacity score at which a management
+	// cluster is cordoned.
+	DefaultHighWaterMark int32 = 85
+
+	// DefaultLowWaterMark is the capacity score at which a management cluster
+	// cordoned by the capacity controller is uncordoned again.
+	DefaultLowWaterMark int32 = 70
+
+	// DefaultMaxHostedControlPlanes is the number of hosted control planes a
+	// management cluster is sized for.
+	DefaultMaxHostedControlPlanes int32 = 300
+
+	// DefaultMaxAMWActiveTimeSeries is the share of the Azure Monitor
+	// Workspace active time series limit reserved for each management
+	// cluster: half of the 2,000,000 Azure approves without review, so two
+	// management clusters can share a workspace at that limit.
+	DefaultMaxAMWActiveTimeSeries int64 = 1_000_000
+)
+
+// Thresholds configure how the capacity score is computed and acted on.
+type Thresholds struct {
+	// HighWaterMark is the score at or above which the management cluster is
+	// cordoned.
+	HighWaterMark int32
+	// LowWaterMark is the score at or below which a cordon placed by the
+	// capacity controller is lifted. It must be lower than HighWaterMark so
+	// the scheduling policy does not flap around a single threshold.
+	LowWaterMark int32
+	// MaxHostedControlPlanes is the hosted control plane count that scores 100.
+	MaxHostedControlPlanes int32
+	// MaxAMWActiveTimeSeries is the active time series count that scores 100,
+	// the share of the Azure Monitor Workspace ingestion limit reserved for
+	// one management cluster. Zero leaves AMW ingestion unscored.
+	MaxAMWActiveTimeSeries int64
+}
+
+// DefaultThresholds returns the default capacity thresholds.
+func DefaultThresholds() Thresholds {
+	return Thresholds{
+		HighWaterMark:          DefaultHighWaterMark,
+		LowWaterMark:           DefaultLowWaterMark,
+		MaxHostedControlPlanes: DefaultMaxHostedControlPlanes,
+		MaxAMWActiveTimeSeries: DefaultMaxAMWActiveTimeSeries,
+	}
+}
+
+// Validate returns an error if the thresholds are inconsistent.
+func (t Thresholds) Validate() error {
+	if t.HighWaterMark < 1 || t.HighWaterMark > 100 {
+		return fmt.Errorf("high-water mark must be between 1 and 100, got %d", t.HighWaterMark)
+	}
+	if t.LowWaterMark < 0 || t.LowWaterMark >= t.HighWaterMark {
+		return fmt.Errorf("low-water mark must be between 0 and the high-water mark %d, got %d", t.HighWaterMark, t.LowWaterMark)
+	}
+	if t.MaxHostedControlPlanes < 1 {
+		return fmt.Errorf("max hosted control planes must be positive, got %d", t.MaxHostedControlPlanes)
+	}
+	if t.MaxAMWActiveTimeSeries < 0 {
+		return fmt.Errorf("max AMW active time series must not be negative, got %d", t.MaxAMWActiveTimeSeries)
+	}
+	return nil
+}
+
+// Usage is the measured usage of a management cluster. Fields that could not
+// be measured are left at their zero value.
+type Usage struct {
+	HostedControlPlanes int
+	Nodes               *NodeUsage
+	AMWActiveTimeSeries int64
+}
+
+// computeCapacity turns the usage of a management cluster into its capacity.
+// The score is the utilization of the most constrained dimension, so a
+// management cluster is full as soon as any one resource runs out.
+// LastUpdateTime is left for the caller to set.
+func computeCapacity(usage Usage, thresholds Thresholds) *fleetapi.ManagementClusterCapacity {
+	capacity := &fleetapi.ManagementClusterCapacity{
+		HostedControlPlanes:        int32(usage.HostedControlPlanes),
+		HostedControlPlanesPercent: percent(float64(usage.HostedControlPlanes) * 100 / float64(thresholds.MaxHostedControlPlanes)),
+	}
+	score := capacity.HostedControlPlanesPercent
+
+	if thresholds.MaxAMWActiveTimeSeries > 0 {
+		capacity.AMWActiveTimeSeries = usage.AMWActiveTimeSeries
+		capacity.AMWIngestionPercent = percent(float64(usage.AMWActiveTimeSeries) * 100 / float64(thresholds.MaxAMWActiveTimeSeries))
+		score = max(score, capacity.AMWIngestionPercent)
+	}
+
+	if usage.Nodes != nil {
+		capacity.CPURequestedPercent = percent(usage.Nodes.CPURequestedPercent)
+		capacity.MemoryRequestedPercent = percent(usage.Nodes.MemoryRequestedPercent)
+		score = max(score, capacity.CPURequestedPercent, capacity.MemoryRequestedPercent)
+
+		for _, zone := range usage.Nodes.Zones {
+			zoneCapacity := fleetapi.ManagementClusterZoneCapacity{
+				Zone:                   zone.Zone,
+				CPURequestedPercent:    percent(zone.CPURequestedPercent),
+				MemoryRequestedPercent: percent(zone.MemoryRequestedPercent),
+			}
+			capacity.Zones = append(capacity.Zones, zoneCapacity)
+			score = max(score, zoneCapacity.CPURequestedPercent, zoneCapacity.MemoryRequestedPercent)
+		}
+		sort.Slice(capacity.Zones, func(i, j int) bool {
+			return capacity.Zones[i].Zone < capacity.Zones[j].Zone
+		})
+	}
+
+	capacity.Score = score
+	return capacity
+}
+
+// percent rounds a utilization percentage and clamps it to [0, 100].
+func percent(value float64) int32 {
+	if math.IsNaN(value) || value <= 0 {
+		return 0
+	}
+	if value >= 100 {
+		return 100
+	}
+	return int32(math.Round(value))
+}
+
+// schedulingDecision is the outcome of comparing a capacity score against the
+// water marks.
+type schedulingDecision struct {
+	// cordoned is whether the capacity controller holds the management
+	// cluster cordoned after this decision.
+	cordoned bool
+	// schedulingPolicy is the scheduling policy to write, or empty to leave
+	// the current one alone.
+	schedulingPolicy fleetapi.ManagementClusterSchedulingPolicy
+}
+
+// decideScheduling applies the water marks to a score. A management cluster
+// is cordoned when the score reaches the high-water mark and uncordoned when
+// it falls to the low-water mark; in between the previous state is kept.
+// Only a cordon placed by the capacity controller is ever lifted, and a
+// draining management cluster is never uncordoned. Without cordon no new
+// cordon is placed; one placed before is still lifted at the low-water mark.
+func decideScheduling(managementCluster *fleetapi.ManagementCluster, score int32, thresholds Thresholds, cordon bool) schedulingDecision {
+	wasCordoned := managementCluster.Status.Capacity != nil && managementCluster.Status.Capacity.Cordoned
+	schedulable := managementCluster.Spec.SchedulingPolicy == fleetapi.ManagementClusterSchedulingPolicySchedulable
+
+	switch {
+	case score >= thresholds.HighWaterMark && schedulable && !cordon:
+		return schedulingDecision{}
+	case score >= thresholds.HighWaterMark && schedulable:
+		return schedulingDecision{cordoned: true, schedulingPolicy: fleetapi.ManagementClusterSchedulingPolicyUnschedulable}
+	case score >= thresholds.HighWaterMark:
+		// Already unschedulable: only keep ownership of a cordon we placed.
+		return schedulingDecision{cordoned: wasCordoned}
+	case score <= thresholds.LowWaterMark && wasCordoned:
+		if managementCluster.Spec.Drain != nil || schedulable {
+			return schedulingDecision{}
+		}
+		return schedulingDecision{schedulingPolicy: fleetapi.ManagementClusterSchedulingPolicySchedulable}
+	default:
+		// Within the hysteresis band, or an admin uncordoned the management
+		// cluster after it was cordoned for capacity.
+		return schedulingDecision{cordoned: wasCordoned && !schedulable}
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capacity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
+)
+
+func TestComputeCapacity(t *testing.T) {
+	thresholds := Thresholds{HighWaterMark: 85, LowWaterMark: 70, MaxHostedControlPlanes: 200, MaxAMWActiveTimeSeries: 1000}
+
+	tests := []struct {
+		name  string
+		usage Usage
+		want  *fleetapi.ManagementClusterCapacity
+	}{
+		{
+			name:  "empty management cluster",
+			usage: Usage{},
+			want:  &fleetapi.ManagementClusterCapacity{},
+		},
+		{
+			name:  "hosted control plane count drives the score without node usage",
+			usage: Usage{HostedControlPlanes: 100},
+			want: &fleetapi.ManagementClusterCapacity{
+				Score:                      50,
+				HostedControlPlanes:        100,
+				HostedControlPlanesPercent: 50,
+			},
+		},
+		{
+			name: "fullest zone drives the score",
+			usage: Usage{
+				HostedControlPlanes: 20,
+				Nodes: &NodeUsage{
+					CPURequestedPercent:    60,
+					MemoryRequestedPercent: 55,
+					Zones: []ZoneUsage{
+						{Zone: "eastus-2", CPURequestedPercent: 88.6, MemoryRequestedPercent: 60},
+						{Zone: "eastus-1", CPURequestedPercent: 40, MemoryRequestedPercent: 50},
+					},
+				},
+			},
+			want: &fleetapi.ManagementClusterCapacity{
+				Score:                      89,
+				HostedControlPlanes:        20,
+				HostedControlPlanesPercent: 10,
+				CPURequestedPercent:        60,
+				MemoryRequestedPercent:     55,
+				Zones: []fleetapi.ManagementClusterZoneCapacity{
+					{Zone: "eastus-1", CPURequestedPercent: 40, MemoryRequestedPercent: 50},
+					{Zone: "eastus-2", CPURequestedPercent: 89, MemoryRequestedPercent: 60},
+				},
+			},
+		},
+		{
+			name:  "AMW ingestion of the management cluster drives the score",
+			usage: Usage{HostedControlPlanes: 20, AMWActiveTimeSeries: 904},
+			want: &fleetapi.ManagementClusterCapacity{
+				Score:                      90,
+				HostedControlPlanes:        20,
+				HostedControlPlanesPercent: 10,
+				AMWActiveTimeSeries:        904,
+				AMWIngestionPercent:        90,
+			},
+		},
+		{
+			name:  "overcommitted dimensions are clamped to 100",
+			usage: Usage{HostedControlPlanes: 250, AMWActiveTimeSeries: 1300},
+			want: &fleetapi.ManagementClusterCapacity{
+				Score:                      100,
+				HostedControlPlanes:        250,
+				HostedControlPlanesPercent: 100,
+				AMWActiveTimeSeries:        1300,
+				AMWIngestionPercent:        100,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, computeCapacity(tt.usage, thresholds))
+		})
+	}
+
+	t.Run("AMW ingestion is not scored without a reserved share", func(t *testing.T) {
+		unscored := thresholds
+		unscored.MaxAMWActiveTimeSeries = 0
+		assert.Equal(t, &fleetapi.ManagementClusterCapacity{Score: 10, HostedControlPlanes: 20, HostedControlPlanesPercent: 10},
+			computeCapacity(Usage{HostedControlPlanes: 20, AMWActiveTimeSeries: 904}, unscored))
+	})
+}
+
+func TestDecideScheduling(t *testing.T) {
+	thresholds := Thresholds{HighWaterMark: 85, LowWaterMark: 70, MaxHostedControlPlanes: 200}
+
+	managementCluster := func(policy fleetapi.ManagementClusterSchedulingPolicy, cordoned, draining bool) *fleetapi.ManagementCluster {
+		mc := &fleetapi.ManagementCluster{
+			Spec: fleetapi.ManagementClusterSpec{SchedulingPolicy: policy},
+			Status: fleetapi.ManagementClusterStatus{
+				Capacity: &fleetapi.ManagementClusterCapacity{Cordoned: cordoned},
+			},
+		}
+		if draining {
+			mc.Spec.Drain = &fleetapi.ManagementClusterDrain{Reason: "decommission"}
+		}
+		return mc
+	}
+	schedulable := fleetapi.ManagementClusterSchedulingPolicySchedulable
+	unschedulable := fleetapi.ManagementClusterSchedulingPolicyUnschedulable
+
+	tests := []struct {
+		name              string
+		managementCluster *fleetapi.ManagementCluster
+		score             int32
+		disableCordon     bool
+		want              schedulingDecision
+	}{
+		{
+			name:              "below high-water mark stays schedulable",
+			managementCluster: managementCluster(schedulable, false, false),
+			score:             84,
+			want:              schedulingDecision{},
+		},
+		{
+			name:              "reaching the high-water mark cordons",
+			managementCluster: managementCluster(schedulable, false, false),
+			score:             85,
+			want:              schedulingDecision{cordoned: true, schedulingPolicy: unschedulable},
+		},
+		{
+			name:              "reaching the high-water mark does not cordon when cordoning is disabled",
+			managementCluster: managementCluster(schedulable, false, false),
+			score:             95,
+			disableCordon:     true,
+			want:              schedulingDecision{},
+		},
+		{
+			name:              "cordon placed before cordoning was disabled is still lifted",
+			managementCluster: managementCluster(unschedulable, true, false),
+			score:             70,
+			disableCordon:     true,
+			want:              schedulingDecision{schedulingPolicy: schedulable},
+		},
+		{
+			name:              "cordon stays within the hysteresis band",
+			managementCluster: managementCluster(unschedulable, true, false),
+			score:             75,
+			want:              schedulingDecision{cordoned: true},
+		},
+		{
+			name:              "falling to the low-water mark uncordons",
+			managementCluster: managementCluster(unschedulable, true, false),
+			score:             70,
+			want:              schedulingDecision{schedulingPolicy: schedulable},
+		},
+		{
+			name:              "admin cordon is never lifted",
+			managementCluster: managementCluster(unschedulable, false, false),
+			score:             10,
+			want:              schedulingDecision{},
+		},
+		{
+			name:              "admin cordon is not taken over above the high-water mark",
+			managementCluster: managementCluster(unschedulable, false, false),
+			score:             95,
+			want:              schedulingDecision{},
+		},
+		{
+			name:              "draining management cluster is not uncordoned",
+			managementCluster: managementCluster(unschedulable, true, true),
+			score:             10,
+			want:              schedulingDecision{},
+		},
+		{
+			name:              "admin uncordon within the band releases ownership",
+			managementCluster: managementCluster(schedulable, true, false),
+			score:             80,
+			want:              schedulingDecision{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, decideScheduling(tt.managementCluster, tt.score, thresholds, !tt.disableCordon))
+		})
+	}
+}
+
+func TestThresholdsValidate(t *testing.T) {
+	assert.NoError(t, DefaultThresholds().Validate())
+	assert.Error(t, Thresholds{HighWaterMark: 0, LowWaterMark: 0, MaxHostedControlPlanes: 1}.Validate())
+	assert.Error(t, Thresholds{HighWaterMark: 80, LowWaterMark: 80, MaxHostedControlPlanes: 1}.Validate())
+	assert.Error(t, Thresholds{HighWaterMark: 80, LowWaterMark: 70, MaxHostedControlPlanes: 0}.Validate())
+	assert.Error(t, Thresholds{HighWaterMark: 80, LowWaterMark: 70, MaxHostedControlPlanes: 1, MaxAMWActiveTimeSeries: -1}.Validate())
+}
@@ -112,7 +112,7 @@ func (s *clustersServiceRegistrationSyncer) SyncOnce(ctx context.Context, key fl
 		fleetcontrollers.SetRegistrationCondition(&updated.Status.Conditions, string(fleetapi.ManagementClusterConditionClustersServiceRegistered), fleetcontrollers.ErrStampNotApproved)
 	} else {
 		var shardID *metadataapi.InternalID
-		shardID, syncErr = s.reconcileProvisionShard(ctx, updated)
+		shardID, syncErr = s.reconcileProvisionShard(ctx, stamp, updated)
 		if shardID != nil {
 			updated.Status.ClusterServiceProvisionShardID = shardID
 		}
@@ -134,6 +134,7 @@ func (s *clustersServiceRegistrationSyncer) SyncOnce(ctx context.Context, key fl
 
 func (s *clustersServiceRegistrationSyncer) reconcileProvisionShard(
 	ctx context.Context,
+	stamp *fleetapi.Stamp,
 	managementCluster *fleetapi.ManagementCluster,
 ) (*metadataapi.InternalID, error) {
 	logger := utils.LoggerFromContext(ctx)
@@ -145,7 +146,7 @@ func (s *clustersServiceRegistrationSyncer) reconcileProvisionShard(
 
 	// shard exists
 	if existingID != nil {
-		if err := s.updateShardStatusIfNeeded(ctx, *existingID, existing, managementCluster); err != nil {
+		if err := s.updateShardStatusIfNeeded(ctx, *existingID, existing, effectiveSchedulingPolicy(stamp, managementCluster)); err != nil {
 			return nil, err
 		}
 		return existingID, nil
@@ -166,7 +167,7 @@ func (s *clustersServiceRegistrationSyncer) reconcileProvisionShard(
 	}
 
 	// CS ignores status on create (defaults to maintenance), so a separate update may be needed.
-	if err := s.updateShardStatusIfNeeded(ctx, createdID, created, managementCluster); err != nil {
+	if err := s.updateShardStatusIfNeeded(ctx, createdID, created, effectiveSchedulingPolicy(stamp, managementCluster)); err != nil {
 		return nil, fmt.Errorf("setting provision shard status after create: %w", err)
 	}
 
@@ -178,9 +179,9 @@ func (s *clustersServiceRegistrationSyncer) updateShardStatusIfNeeded(
 	ctx context.Context,
 	shardID metadataapi.InternalID,
 	shard *arohcpv1alpha1.ProvisionShard,
-	managementCluster *fleetapi.ManagementCluster,
+	schedulingPolicy fleetapi.ManagementClusterSchedulingPolicy,
 ) error {
-	builder, err := provisionShardStatusUpdateBuilder(shard, managementCluster.Spec.SchedulingPolicy)
+	builder, err := provisionShardStatusUpdateBuilder(shard, schedulingPolicy)
 	if err != nil {
 		return err
 	}
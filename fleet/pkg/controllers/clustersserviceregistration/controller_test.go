@@ -369,7 +369,7 @@ func TestReconcileProvisionShard(t *testing.T) {
 				region:                "westus3",
 			}
 
-			shardID, err := syncer.reconcileProvisionShard(ctx, tt.managementCluster)
+			shardID, err := syncer.reconcileProvisionShard(ctx, testStamp("s1", true), tt.managementCluster)
 
 			if len(tt.wantErrContains) > 0 {
 				if err == nil {
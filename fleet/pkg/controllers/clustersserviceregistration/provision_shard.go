@@ -21,6 +21,8 @@ import (
 
 	arohcpv1alpha1 "github.com/openshift-online/ocm-sdk-go/arohcp/v1alpha1"
 
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+
 	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
 	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
 	"github.com/Azure/ARO-HCP/internal/ocm"
@@ -91,6 +93,18 @@ func buildProvisionShardForCreate(managementCluster *fleetapi.ManagementCluster,
 		Topology(ocm.CSProvisionShardTopologyShared), nil
 }
 
+// effectiveSchedulingPolicy returns the scheduling policy published on the
+// provision shard. A stamp with an approval policy keeps its shard in
+// maintenance until it is ProductionReady, whatever the management cluster's
+// own scheduling policy is, so Cluster Service does not place customer hosted
+// control planes on a stamp that is still soaking.
+func effectiveSchedulingPolicy(stamp *fleetapi.Stamp, managementCluster *fleetapi.ManagementCluster) fleetapi.ManagementClusterSchedulingPolicy {
+	if stamp.Spec.ApprovalPolicy != nil && !apimeta.IsStatusConditionTrue(stamp.Status.Conditions, string(fleetapi.StampConditionProductionReady)) {
+		return fleetapi.ManagementClusterSchedulingPolicyUnschedulable
+	}
+	return managementCluster.Spec.SchedulingPolicy
+}
+
 // provisionShardStatusUpdateBuilder builds a patch that only sets the shard status.
 // All other fields are immutable after create in CS.
 // If the shard is already in the desired state, returns nil.
@@ -20,6 +20,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 
 	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
@@ -159,3 +161,59 @@ func TestBuildProvisionShardForCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestEffectiveSchedulingPolicy(t *testing.T) {
+	productionReady := metav1.Condition{
+		Type:   string(fleetapi.StampConditionProductionReady),
+		Status: metav1.ConditionTrue,
+		Reason: string(fleetapi.StampConditionReasonRolloutGatesPassed),
+	}
+
+	tests := []struct {
+		name   string
+		stamp  *fleetapi.Stamp
+		policy fleetapi.ManagementClusterSchedulingPolicy
+		want   fleetapi.ManagementClusterSchedulingPolicy
+	}{
+		{
+			name:   "stamp without approval policy follows the management cluster",
+			stamp:  &fleetapi.Stamp{},
+			policy: fleetapi.ManagementClusterSchedulingPolicySchedulable,
+			want:   fleetapi.ManagementClusterSchedulingPolicySchedulable,
+		},
+		{
+			name: "stamp with approval policy stays in maintenance until production ready",
+			stamp: &fleetapi.Stamp{
+				Spec: fleetapi.StampSpec{ApprovalPolicy: &fleetapi.StampApprovalPolicy{RequiredApprovals: 2}},
+			},
+			policy: fleetapi.ManagementClusterSchedulingPolicySchedulable,
+			want:   fleetapi.ManagementClusterSchedulingPolicyUnschedulable,
+		},
+		{
+			name: "production ready stamp follows the management cluster",
+			stamp: &fleetapi.Stamp{
+				Spec:   fleetapi.StampSpec{ApprovalPolicy: &fleetapi.StampApprovalPolicy{RequiredApprovals: 2}},
+				Status: fleetapi.StampStatus{Conditions: []metav1.Condition{productionReady}},
+			},
+			policy: fleetapi.ManagementClusterSchedulingPolicySchedulable,
+			want:   fleetapi.ManagementClusterSchedulingPolicySchedulable,
+		},
+		{
+			name: "production ready stamp keeps a cordon",
+			stamp: &fleetapi.Stamp{
+				Spec:   fleetapi.StampSpec{ApprovalPolicy: &fleetapi.StampApprovalPolicy{RequiredApprovals: 2}},
+				Status: fleetapi.StampStatus{Conditions: []metav1.Condition{productionReady}},
+			},
+			policy: fleetapi.ManagementClusterSchedulingPolicyUnschedulable,
+			want:   fleetapi.ManagementClusterSchedulingPolicyUnschedulable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			managementCluster := validManagementCluster()
+			managementCluster.Spec.SchedulingPolicy = tt.policy
+			assert.Equal(t, tt.want, effectiveSchedulingPolicy(tt.stamp, managementCluster))
+		})
+	}
+}
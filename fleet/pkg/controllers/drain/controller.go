@@ -0,0 +1,343 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	utilsclock "k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	fleetcontrollers "github.com/Azure/ARO-HCP/fleet/pkg/controllers/base"
+	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/controllerutils"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/fleetcosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/listers/fleetlisters"
+	"github.com/Azure/ARO-HCP/internal/ocm"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+// DefaultMigrationTimeout is how long a hosted control plane migration may
+// stay in flight before the drain gives up on it.
+const DefaultMigrationTimeout = 2 * time.Hour
+
+type drainSyncer struct {
+	clock                   utilsclock.PassiveClock
+	fleetDBClient           fleetcosmosstorage.FleetDBClient
+	managementClusterLister fleetlisters.ManagementClusterLister
+	stampLister             fleetlisters.StampLister
+	migrationClient         HostedControlPlaneMigrationClient
+	migrationTimeout        time.Duration
+}
+
+// NewManagementClusterDrainController creates a StampWatchingController that
+// migrates the hosted control planes off management clusters with a drain
+// requested in their spec. At most Spec.Drain.MaxConcurrentMigrations
+// migrations run at a time. Progress is reported in the Drained condition
+// and in Status.Drain, which lists only the migrations in flight. A migration
+// completes once Cluster Service places the hosted control plane on its
+// target provision shard; one still in flight after migrationTimeout is
+// recorded as failed in Status.Drain.FailedMigrations, frees its slot and is
+// not retried by the same drain. Migrations are polled on the informer resync
+// period.
+func NewManagementClusterDrainController(
+	clock utilsclock.PassiveClock,
+	managementClusterInformer cache.SharedIndexInformer,
+	fleetDBClient fleetcosmosstorage.FleetDBClient,
+	managementClusterLister fleetlisters.ManagementClusterLister,
+	stampLister fleetlisters.StampLister,
+	migrationClient HostedControlPlaneMigrationClient,
+	migrationTimeout time.Duration,
+	cfg fleetcontrollers.StampWatchingControllerConfig,
+) *fleetcontrollers.StampWatchingController {
+	syncer := &drainSyncer{
+		clock:                   clock,
+		fleetDBClient:           fleetDBClient,
+		managementClusterLister: managementClusterLister,
+		stampLister:             stampLister,
+		migrationClient:         migrationClient,
+		migrationTimeout:        migrationTimeout,
+	}
+
+	controller := fleetcontrollers.NewStampWatchingController(
+		"ManagementClusterDrainController",
+		syncer,
+		cfg,
+	)
+
+	if err := controller.QueueForInformers(fleetcontrollers.DefaultInformerResyncPeriod, managementClusterInformer); err != nil {
+		panic(err) // coding error
+	}
+
+	return controller
+}
+
+func (s *drainSyncer) SyncOnce(ctx context.Context, key fleetcontrollers.StampKey) error {
+	managementClusterCRUD := s.fleetDBClient.Stamps().ManagementClusters(key.StampIdentifier)
+	managementCluster, err := managementClusterCRUD.Get(ctx, fleetapi.ManagementClusterResourceName)
+	if err != nil {
+		if cosmosstorageutils.IsNotFoundError(err) {
+			return nil
+		}
+		return utils.TrackError(err)
+	}
+
+	updated := managementCluster.DeepCopy()
+
+	var syncErr error
+	if updated.Spec.Drain == nil {
+		// Conditions are never removed; a withdrawn drain is recorded instead.
+		updated.Status.Drain = nil
+		if drained := apimeta.FindStatusCondition(updated.Status.Conditions, string(fleetapi.ManagementClusterConditionDrained)); drained != nil {
+			apimeta.SetStatusCondition(&updated.Status.Conditions, metav1.Condition{
+				Type:    string(fleetapi.ManagementClusterConditionDrained),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(fleetapi.ManagementClusterConditionReasonDrainNotRequested),
+				Message: "No drain is requested",
+			})
+		}
+	} else {
+		syncErr = s.reconcileDrain(ctx, updated)
+	}
+
+	if controllerutils.NeedsUpdate(managementCluster, updated) {
+		if _, writeErr := managementClusterCRUD.Replace(ctx, updated, managementCluster, nil); writeErr != nil {
+			return utils.TrackError(writeErr)
+		}
+	}
+
+	if syncErr != nil {
+		return utils.TrackError(syncErr)
+	}
+	return nil
+}
+
+// reconcileDrain advances the drain of managementCluster by one step and
+// records the progress in Status.Drain and the Drained condition.
+func (s *drainSyncer) reconcileDrain(ctx context.Context, managementCluster *fleetapi.ManagementCluster) error {
+	logger := utils.LoggerFromContext(ctx)
+	now := s.clock.Now()
+
+	drain := managementCluster.Status.Drain
+	if drain == nil || !drain.RequestedTime.Equal(&managementCluster.Spec.Drain.RequestedTime) {
+		drain = &fleetapi.ManagementClusterDrainStatus{RequestedTime: managementCluster.Spec.Drain.RequestedTime}
+		managementCluster.Status.Drain = drain
+	}
+	if drain.CompletedTime != nil {
+		return nil
+	}
+
+	if managementCluster.Status.ClusterServiceProvisionShardID == nil {
+		return fmt.Errorf("management cluster has no ClustersService provision shard to drain")
+	}
+
+	// A migration completes once Cluster Service places the hosted control
+	// plane on the target provision shard. Asking for the move is not enough.
+	// One that takes longer than the timeout is given up on, so a stuck
+	// migration cannot hold its slot forever.
+	var errs []error
+	var inFlight []fleetapi.HostedControlPlaneMigration
+	for _, migration := range drain.Migrations {
+		clusterID, err := metadataapi.NewInternalID(ocm.GenerateAROHCPClusterHREF(migration.ClusterServiceID))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("parsing cluster ID %q: %w", migration.ClusterServiceID, err))
+			inFlight = append(inFlight, migration)
+			continue
+		}
+		provisionShardID, err := s.migrationClient.GetHostedControlPlaneProvisionShard(ctx, clusterID)
+		if err != nil {
+			errs = append(errs, err)
+			inFlight = append(inFlight, migration)
+			continue
+		}
+		if provisionShardID == nil || !strings.EqualFold(provisionShardID.ID(), migration.TargetProvisionShardID) {
+			if now.Sub(migration.StartedTime.Time) < s.migrationTimeout {
+				inFlight = append(inFlight, migration)
+				continue
+			}
+			migration.FailedTime = ptr.To(metav1.NewTime(now))
+			drain.FailedMigrations = append(drain.FailedMigrations, migration)
+			logger.Info("hosted control plane migration timed out", "clusterServiceID", migration.ClusterServiceID, "targetProvisionShardID", migration.TargetProvisionShardID, "startedTime", migration.StartedTime)
+			continue
+		}
+		drain.MigratedCount++
+		logger.Info("hosted control plane migration completed", "clusterServiceID", migration.ClusterServiceID, "targetProvisionShardID", migration.TargetProvisionShardID)
+	}
+	drain.Migrations = inFlight
+
+	hostedControlPlanes, err := s.migrationClient.ListHostedControlPlanes(ctx, *managementCluster.Status.ClusterServiceProvisionShardID)
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+	// Hosted control planes whose migration timed out are left in place.
+	skip := sets.New[string]()
+	for _, migration := range drain.Migrations {
+		skip.Insert(migration.ClusterServiceID)
+	}
+	for _, migration := range drain.FailedMigrations {
+		skip.Insert(migration.ClusterServiceID)
+	}
+
+	targets, err := s.drainTargets(ctx, managementCluster)
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+
+	maxConcurrent := int(managementCluster.Spec.Drain.MaxConcurrentMigrations)
+	started := 0
+	failed := 0
+	unsupported := false
+	for _, clusterID := range hostedControlPlanes {
+		if len(targets) == 0 || len(drain.Migrations) >= maxConcurrent {
+			break
+		}
+		if skip.Has(clusterID.ID()) {
+			continue
+		}
+
+		// The hosted control plane list may be a few minutes old; confirm the
+		// cluster has not left already, e.g. through a migration that just
+		// completed.
+		provisionShardID, err := s.migrationClient.GetHostedControlPlaneProvisionShard(ctx, clusterID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if provisionShardID == nil || !strings.EqualFold(provisionShardID.ID(), managementCluster.Status.ClusterServiceProvisionShardID.ID()) {
+			continue
+		}
+
+		// Spread the migrations started in one pass across the targets.
+		target := targets[started%len(targets)]
+		started++
+		if err := s.migrationClient.MigrateHostedControlPlane(ctx, clusterID, *target.Status.ClusterServiceProvisionShardID); err != nil {
+			if errors.Is(err, ErrMigrationUnsupported) {
+				unsupported = true
+				break
+			}
+			failed++
+			errs = append(errs, fmt.Errorf("migrating hosted control plane %s to management cluster %s: %w", clusterID.ID(), target.ResourceID, err))
+			continue
+		}
+		drain.Migrations = append(drain.Migrations, fleetapi.HostedControlPlaneMigration{
+			ClusterServiceID:       clusterID.ID(),
+			TargetProvisionShardID: target.Status.ClusterServiceProvisionShardID.ID(),
+			StartedTime:            metav1.NewTime(now),
+		})
+		logger.Info("hosted control plane migration started", "clusterServiceID", clusterID.ID(), "targetManagementCluster", target.ResourceID)
+	}
+
+	switch {
+	case len(hostedControlPlanes) == 0 && len(drain.Migrations) == 0:
+		drain.CompletedTime = ptr.To(metav1.NewTime(now))
+		apimeta.SetStatusCondition(&managementCluster.Status.Conditions, metav1.Condition{
+			Type:    string(fleetapi.ManagementClusterConditionDrained),
+			Status:  metav1.ConditionTrue,
+			Reason:  string(fleetapi.ManagementClusterConditionReasonDrainComplete),
+			Message: fmt.Sprintf("No hosted control planes remain on this management cluster, %d were migrated", drain.MigratedCount),
+		})
+	case unsupported && len(drain.Migrations) == 0:
+		apimeta.SetStatusCondition(&managementCluster.Status.Conditions, metav1.Condition{
+			Type:    string(fleetapi.ManagementClusterConditionDrained),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(fleetapi.ManagementClusterConditionReasonMigrationUnavailable),
+			Message: fmt.Sprintf("%d hosted control planes remain and Cluster Service cannot migrate them; the drain completes once they are deleted", len(hostedControlPlanes)),
+		})
+	case len(targets) == 0 && len(drain.Migrations) == 0:
+		apimeta.SetStatusCondition(&managementCluster.Status.Conditions, metav1.Condition{
+			Type:    string(fleetapi.ManagementClusterConditionDrained),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(fleetapi.ManagementClusterConditionReasonNoDrainTarget),
+			Message: fmt.Sprintf("%d hosted control planes remain and no other management cluster is Ready, Schedulable and scored for capacity", len(hostedControlPlanes)),
+		})
+	default:
+		message := fmt.Sprintf("%d hosted control planes remain, %d migrating, %d migrated", len(hostedControlPlanes), len(drain.Migrations), drain.MigratedCount)
+		if failed > 0 {
+			message += fmt.Sprintf(", %d failed to start and will be retried", failed)
+		}
+		if len(drain.FailedMigrations) > 0 {
+			message += fmt.Sprintf(", %d timed out and will not be retried by this drain", len(drain.FailedMigrations))
+		}
+		apimeta.SetStatusCondition(&managementCluster.Status.Conditions, metav1.Condition{
+			Type:    string(fleetapi.ManagementClusterConditionDrained),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(fleetapi.ManagementClusterConditionReasonDrainInProgress),
+			Message: message,
+		})
+	}
+
+	return errors.Join(errs...)
+}
+
+// drainTargets returns the management clusters that can receive hosted
+// control planes from managementCluster, least loaded first by capacity score
+// and then by stamp identifier. Every
+// stamp hosts a single management cluster, so targets are the other stamps
+// of the region that are Ready, Schedulable, registered with ClustersService,
+// scored by the capacity controller and not draining themselves. A stamp with
+// an approval policy is only a target once it is ProductionReady, the same
+// gate that keeps Cluster Service from placing new hosted control planes on
+// it.
+func (s *drainSyncer) drainTargets(ctx context.Context, managementCluster *fleetapi.ManagementCluster) ([]*fleetapi.ManagementCluster, error) {
+	candidates, err := s.managementClusterLister.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing management clusters: %w", err)
+	}
+
+	var targets []*fleetapi.ManagementCluster
+	for _, candidate := range candidates {
+		// An unscored management cluster would sort as empty and receive
+		// every migration before its load is known.
+		if strings.EqualFold(candidate.GetStampIdentifier(), managementCluster.GetStampIdentifier()) ||
+			candidate.Spec.SchedulingPolicy != fleetapi.ManagementClusterSchedulingPolicySchedulable ||
+			candidate.Spec.Drain != nil ||
+			candidate.Status.ClusterServiceProvisionShardID == nil ||
+			candidate.Status.Capacity == nil ||
+			!apimeta.IsStatusConditionTrue(candidate.Status.Conditions, string(fleetapi.ManagementClusterConditionReady)) {
+			continue
+		}
+
+		stamp, err := s.stampLister.Get(ctx, candidate.GetStampIdentifier())
+		if err != nil {
+			if cosmosstorageutils.IsNotFoundError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("getting stamp %s: %w", candidate.GetStampIdentifier(), err)
+		}
+		if stamp.Spec.ApprovalPolicy != nil && !apimeta.IsStatusConditionTrue(stamp.Status.Conditions, string(fleetapi.StampConditionProductionReady)) {
+			continue
+		}
+
+		targets = append(targets, candidate)
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		if scoreI, scoreJ := targets[i].Status.Capacity.Score, targets[j].Status.Capacity.Score; scoreI != scoreJ {
+			return scoreI < scoreJ
+		}
+		return targets[i].GetStampIdentifier() < targets[j].GetStampIdentifier()
+	})
+	return targets, nil
+}
@@ -0,0 +1,524 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	fleetcontrollers "github.com/Azure/ARO-HCP/fleet/pkg/controllers/base"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/fleetcosmosstoragetesting"
+	"github.com/Azure/ARO-HCP/internal/database/listertesting/fleetlistertesting"
+)
+
+const drainingStamp = "s1"
+
+var (
+	testNow           = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	testRequestedTime = metav1.NewTime(testNow.Add(-time.Hour))
+)
+
+type fakeMigrationClient struct {
+	hostedControlPlanes []string
+	// placements maps cluster IDs to the provision shard Cluster Service
+	// reports for them. Unlisted clusters are still on the draining shard.
+	placements map[string]string
+	migrateErr error
+	migrations map[string]string
+}
+
+func (f *fakeMigrationClient) ListHostedControlPlanes(ctx context.Context, provisionShardID metadataapi.InternalID) ([]metadataapi.InternalID, error) {
+	var clusterIDs []metadataapi.InternalID
+	for _, id := range f.hostedControlPlanes {
+		clusterIDs = append(clusterIDs, metadataapi.Must(metadataapi.NewInternalID("/api/aro_hcp/v1alpha1/clusters/"+id)))
+	}
+	return clusterIDs, nil
+}
+
+func (f *fakeMigrationClient) GetHostedControlPlaneProvisionShard(ctx context.Context, clusterID metadataapi.InternalID) (*metadataapi.InternalID, error) {
+	shardID, ok := f.placements[clusterID.ID()]
+	if !ok {
+		shardID = "shard-" + drainingStamp
+	}
+	return ptr.To(metadataapi.Must(metadataapi.NewInternalID("/api/aro_hcp/v1alpha1/provision_shards/" + shardID))), nil
+}
+
+func (f *fakeMigrationClient) MigrateHostedControlPlane(ctx context.Context, clusterID, targetProvisionShardID metadataapi.InternalID) error {
+	if f.migrateErr != nil {
+		return f.migrateErr
+	}
+	if f.migrations == nil {
+		f.migrations = map[string]string{}
+	}
+	f.migrations[clusterID.ID()] = targetProvisionShardID.ID()
+	return nil
+}
+
+func testManagementCluster(stampIdentifier string, ready bool) *fleetapi.ManagementCluster {
+	resourceID := metadataapi.Must(fleetapi.ToManagementClusterResourceID(stampIdentifier))
+	aksResourceID := metadataapi.Must(azcorearm.ParseResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.ContainerService/managedClusters/" + stampIdentifier))
+	dnsResourceID := metadataapi.Must(azcorearm.ParseResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/dns-rg/providers/Microsoft.Network/dnszones/example.com"))
+	shardID := metadataapi.Must(metadataapi.NewInternalID("/api/aro_hcp/v1alpha1/provision_shards/shard-" + stampIdentifier))
+	readyStatus := metav1.ConditionFalse
+	if ready {
+		readyStatus = metav1.ConditionTrue
+	}
+	return &fleetapi.ManagementCluster{
+		CosmosMetadata: coreapi.CosmosMetadata{ResourceID: resourceID, PartitionKey: strings.ToLower(stampIdentifier)},
+		ResourceID:     resourceID,
+		Spec: fleetapi.ManagementClusterSpec{
+			SchedulingPolicy: fleetapi.ManagementClusterSchedulingPolicySchedulable,
+		},
+		Status: fleetapi.ManagementClusterStatus{
+			AKSResourceID:                                        aksResourceID,
+			PublicDNSZoneResourceID:                              dnsResourceID,
+			HostedClustersSecretsKeyVaultURL:                     "https://kv-secrets.vault.azure.net",
+			HostedClustersManagedIdentitiesKeyVaultURL:           "https://kv-mi.vault.azure.net",
+			HostedClustersSecretsKeyVaultManagedIdentityClientID: "12345678-1234-1234-1234-123456789012",
+			ClusterServiceProvisionShardID:                       &shardID,
+			MaestroConsumerName:                                  "consumer-" + stampIdentifier,
+			MaestroRESTAPIURL:                                    "http://maestro:8000",
+			MaestroGRPCTarget:                                    "maestro:8090",
+			KubeApplierCosmosContainerName:                       "kube-applier-" + stampIdentifier,
+			Capacity:                                             &fleetapi.ManagementClusterCapacity{},
+			Conditions: []metav1.Condition{{
+				Type:   string(fleetapi.ManagementClusterConditionReady),
+				Status: readyStatus,
+				Reason: string(fleetapi.ManagementClusterConditionReasonAllRegistered),
+			}},
+		},
+	}
+}
+
+// testStamp returns a stamp, with an approval policy when gated is set.
+func testStamp(stampIdentifier string, gated, productionReady bool) *fleetapi.Stamp {
+	resourceID := metadataapi.Must(fleetapi.ToStampResourceID(stampIdentifier))
+	stamp := &fleetapi.Stamp{
+		CosmosMetadata: coreapi.CosmosMetadata{ResourceID: resourceID, PartitionKey: strings.ToLower(stampIdentifier)},
+		ResourceID:     resourceID,
+	}
+	if gated {
+		stamp.Spec.ApprovalPolicy = &fleetapi.StampApprovalPolicy{}
+	}
+	if productionReady {
+		apimeta.SetStatusCondition(&stamp.Status.Conditions, metav1.Condition{
+			Type:   string(fleetapi.StampConditionProductionReady),
+			Status: metav1.ConditionTrue,
+			Reason: "Test",
+		})
+	}
+	return stamp
+}
+
+func drainingManagementCluster(maxConcurrent int32, drain *fleetapi.ManagementClusterDrainStatus) *fleetapi.ManagementCluster {
+	mc := testManagementCluster(drainingStamp, true)
+	mc.Spec.SchedulingPolicy = fleetapi.ManagementClusterSchedulingPolicyUnschedulable
+	mc.Spec.Drain = &fleetapi.ManagementClusterDrain{
+		Reason:                  "decommission",
+		RequestedTime:           testRequestedTime,
+		MaxConcurrentMigrations: maxConcurrent,
+	}
+	mc.Status.Drain = drain
+	return mc
+}
+
+func migration(clusterID, targetShardID string) fleetapi.HostedControlPlaneMigration {
+	return fleetapi.HostedControlPlaneMigration{
+		ClusterServiceID:       clusterID,
+		TargetProvisionShardID: targetShardID,
+		StartedTime:            metav1.NewTime(testNow.Add(-time.Minute)),
+	}
+}
+
+func TestSyncOnce(t *testing.T) {
+	tests := []struct {
+		name                string
+		managementCluster   *fleetapi.ManagementCluster
+		targets             []*fleetapi.ManagementCluster
+		stamps              []*fleetapi.Stamp
+		hostedControlPlanes []string
+		placements          map[string]string
+		migrateErr          error
+		wantErr             bool
+		wantMigrations      map[string]string
+		wantDrained         *metav1.Condition
+		wantDrain           *fleetapi.ManagementClusterDrainStatus
+	}{
+		{
+			name:              "no drain requested: no-op",
+			managementCluster: testManagementCluster(drainingStamp, true),
+		},
+		{
+			name: "withdrawn drain is recorded and its status removed",
+			managementCluster: func() *fleetapi.ManagementCluster {
+				mc := testManagementCluster(drainingStamp, true)
+				mc.Status.Conditions = append(mc.Status.Conditions, metav1.Condition{
+					Type:   string(fleetapi.ManagementClusterConditionDrained),
+					Status: metav1.ConditionFalse,
+					Reason: string(fleetapi.ManagementClusterConditionReasonDrainInProgress),
+				})
+				mc.Status.Drain = &fleetapi.ManagementClusterDrainStatus{
+					RequestedTime: testRequestedTime,
+					Migrations:    []fleetapi.HostedControlPlaneMigration{migration("a", "shard-s2")},
+				}
+				return mc
+			}(),
+			wantDrained: &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonDrainNotRequested)},
+		},
+		{
+			name:                "migrations are limited by the concurrency and spread across targets",
+			managementCluster:   drainingManagementCluster(2, nil),
+			targets:             []*fleetapi.ManagementCluster{testManagementCluster("s3", true), testManagementCluster("s2", true)},
+			hostedControlPlanes: []string{"a", "b", "c"},
+			wantMigrations:      map[string]string{"a": "shard-s2", "b": "shard-s3"},
+			wantDrained:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonDrainInProgress)},
+			wantDrain: &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				Migrations: []fleetapi.HostedControlPlaneMigration{
+					{ClusterServiceID: "a", TargetProvisionShardID: "shard-s2", StartedTime: metav1.NewTime(testNow)},
+					{ClusterServiceID: "b", TargetProvisionShardID: "shard-s3", StartedTime: metav1.NewTime(testNow)},
+				},
+			},
+		},
+		{
+			name:              "least loaded target receives the first migration",
+			managementCluster: drainingManagementCluster(1, nil),
+			targets: []*fleetapi.ManagementCluster{
+				func() *fleetapi.ManagementCluster {
+					mc := testManagementCluster("s2", true)
+					mc.Status.Capacity = &fleetapi.ManagementClusterCapacity{Score: 60}
+					return mc
+				}(),
+				func() *fleetapi.ManagementCluster {
+					mc := testManagementCluster("s3", true)
+					mc.Status.Capacity = &fleetapi.ManagementClusterCapacity{Score: 20}
+					return mc
+				}(),
+			},
+			hostedControlPlanes: []string{"a", "b"},
+			wantMigrations:      map[string]string{"a": "shard-s3"},
+			wantDrained:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonDrainInProgress)},
+			wantDrain: &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				Migrations: []fleetapi.HostedControlPlaneMigration{
+					{ClusterServiceID: "a", TargetProvisionShardID: "shard-s3", StartedTime: metav1.NewTime(testNow)},
+				},
+			},
+		},
+		{
+			name: "migration placed on its target frees a slot",
+			managementCluster: drainingManagementCluster(1, &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				Migrations:    []fleetapi.HostedControlPlaneMigration{migration("a", "shard-s2")},
+			}),
+			targets:             []*fleetapi.ManagementCluster{testManagementCluster("s2", true)},
+			hostedControlPlanes: []string{"b"},
+			placements:          map[string]string{"a": "shard-s2"},
+			wantMigrations:      map[string]string{"b": "shard-s2"},
+			wantDrained:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonDrainInProgress)},
+			wantDrain: &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				Migrations: []fleetapi.HostedControlPlaneMigration{
+					{ClusterServiceID: "b", TargetProvisionShardID: "shard-s2", StartedTime: metav1.NewTime(testNow)},
+				},
+				MigratedCount: 1,
+			},
+		},
+		{
+			name: "migration is in flight until Cluster Service reports the target shard",
+			managementCluster: drainingManagementCluster(1, &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				Migrations:    []fleetapi.HostedControlPlaneMigration{migration("a", "shard-s2")},
+			}),
+			targets:             []*fleetapi.ManagementCluster{testManagementCluster("s2", true)},
+			hostedControlPlanes: []string{"a", "b"},
+			wantDrained:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonDrainInProgress)},
+			wantDrain: &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				Migrations:    []fleetapi.HostedControlPlaneMigration{migration("a", "shard-s2")},
+			},
+		},
+		{
+			name:              "unready, unschedulable and draining clusters are not targets",
+			managementCluster: drainingManagementCluster(5, nil),
+			targets: []*fleetapi.ManagementCluster{
+				testManagementCluster("s2", false),
+				func() *fleetapi.ManagementCluster {
+					mc := testManagementCluster("s3", true)
+					mc.Spec.SchedulingPolicy = fleetapi.ManagementClusterSchedulingPolicyUnschedulable
+					return mc
+				}(),
+				func() *fleetapi.ManagementCluster {
+					mc := testManagementCluster("s4", true)
+					mc.Spec.Drain = &fleetapi.ManagementClusterDrain{Reason: "incident", MaxConcurrentMigrations: 1}
+					return mc
+				}(),
+			},
+			hostedControlPlanes: []string{"a"},
+			wantDrained:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonNoDrainTarget)},
+			wantDrain:           &fleetapi.ManagementClusterDrainStatus{RequestedTime: testRequestedTime},
+		},
+		{
+			name: "migration that outlives the timeout is recorded as failed and frees its slot",
+			managementCluster: drainingManagementCluster(1, &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				Migrations: []fleetapi.HostedControlPlaneMigration{{
+					ClusterServiceID:       "a",
+					TargetProvisionShardID: "shard-s2",
+					StartedTime:            metav1.NewTime(testNow.Add(-DefaultMigrationTimeout)),
+				}},
+			}),
+			targets:             []*fleetapi.ManagementCluster{testManagementCluster("s2", true)},
+			hostedControlPlanes: []string{"a", "b"},
+			wantMigrations:      map[string]string{"b": "shard-s2"},
+			wantDrained:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonDrainInProgress)},
+			wantDrain: &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				Migrations: []fleetapi.HostedControlPlaneMigration{
+					{ClusterServiceID: "b", TargetProvisionShardID: "shard-s2", StartedTime: metav1.NewTime(testNow)},
+				},
+				FailedMigrations: []fleetapi.HostedControlPlaneMigration{{
+					ClusterServiceID:       "a",
+					TargetProvisionShardID: "shard-s2",
+					StartedTime:            metav1.NewTime(testNow.Add(-DefaultMigrationTimeout)),
+					FailedTime:             ptr.To(metav1.NewTime(testNow)),
+				}},
+			},
+		},
+		{
+			name: "hosted control plane whose migration timed out is not migrated again",
+			managementCluster: drainingManagementCluster(5, &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				FailedMigrations: []fleetapi.HostedControlPlaneMigration{{
+					ClusterServiceID:       "a",
+					TargetProvisionShardID: "shard-s2",
+					StartedTime:            metav1.NewTime(testNow.Add(-3 * time.Hour)),
+					FailedTime:             ptr.To(metav1.NewTime(testNow.Add(-time.Hour))),
+				}},
+			}),
+			targets:             []*fleetapi.ManagementCluster{testManagementCluster("s2", true)},
+			hostedControlPlanes: []string{"a"},
+			wantDrained:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonDrainInProgress)},
+			wantDrain: &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				FailedMigrations: []fleetapi.HostedControlPlaneMigration{{
+					ClusterServiceID:       "a",
+					TargetProvisionShardID: "shard-s2",
+					StartedTime:            metav1.NewTime(testNow.Add(-3 * time.Hour)),
+					FailedTime:             ptr.To(metav1.NewTime(testNow.Add(-time.Hour))),
+				}},
+			},
+		},
+		{
+			name:                "hosted control plane that already left the shard is not migrated",
+			managementCluster:   drainingManagementCluster(5, nil),
+			targets:             []*fleetapi.ManagementCluster{testManagementCluster("s2", true)},
+			hostedControlPlanes: []string{"a"},
+			placements:          map[string]string{"a": "shard-s3"},
+			wantDrained:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonDrainInProgress)},
+			wantDrain:           &fleetapi.ManagementClusterDrainStatus{RequestedTime: testRequestedTime},
+		},
+		{
+			name:                "drain waits for deletion when Cluster Service cannot migrate",
+			managementCluster:   drainingManagementCluster(5, nil),
+			targets:             []*fleetapi.ManagementCluster{testManagementCluster("s2", true)},
+			hostedControlPlanes: []string{"a"},
+			migrateErr:          ErrMigrationUnsupported,
+			wantDrained:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonMigrationUnavailable)},
+			wantDrain:           &fleetapi.ManagementClusterDrainStatus{RequestedTime: testRequestedTime},
+		},
+		{
+			name:              "unscored management cluster is not a target",
+			managementCluster: drainingManagementCluster(5, nil),
+			targets: []*fleetapi.ManagementCluster{
+				func() *fleetapi.ManagementCluster {
+					mc := testManagementCluster("s2", true)
+					mc.Status.Capacity = nil
+					return mc
+				}(),
+				func() *fleetapi.ManagementCluster {
+					mc := testManagementCluster("s3", true)
+					mc.Status.Capacity = &fleetapi.ManagementClusterCapacity{Score: 60}
+					return mc
+				}(),
+			},
+			hostedControlPlanes: []string{"a"},
+			wantMigrations:      map[string]string{"a": "shard-s3"},
+			wantDrained:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonDrainInProgress)},
+			wantDrain: &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				Migrations: []fleetapi.HostedControlPlaneMigration{
+					{ClusterServiceID: "a", TargetProvisionShardID: "shard-s3", StartedTime: metav1.NewTime(testNow)},
+				},
+			},
+		},
+		{
+			name:                "stamp gated by an approval policy is a target only once ProductionReady",
+			managementCluster:   drainingManagementCluster(5, nil),
+			targets:             []*fleetapi.ManagementCluster{testManagementCluster("s2", true), testManagementCluster("s3", true)},
+			stamps:              []*fleetapi.Stamp{testStamp("s2", true, false), testStamp("s3", true, true)},
+			hostedControlPlanes: []string{"a", "b"},
+			wantMigrations:      map[string]string{"a": "shard-s3", "b": "shard-s3"},
+			wantDrained:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonDrainInProgress)},
+			wantDrain: &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				Migrations: []fleetapi.HostedControlPlaneMigration{
+					{ClusterServiceID: "a", TargetProvisionShardID: "shard-s3", StartedTime: metav1.NewTime(testNow)},
+					{ClusterServiceID: "b", TargetProvisionShardID: "shard-s3", StartedTime: metav1.NewTime(testNow)},
+				},
+			},
+		},
+		{
+			name:                "failed migration is not recorded as in flight and is retried",
+			managementCluster:   drainingManagementCluster(5, nil),
+			targets:             []*fleetapi.ManagementCluster{testManagementCluster("s2", true)},
+			hostedControlPlanes: []string{"a"},
+			migrateErr:          fmt.Errorf("boom"),
+			wantErr:             true,
+			wantDrained:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonDrainInProgress)},
+			wantDrain:           &fleetapi.ManagementClusterDrainStatus{RequestedTime: testRequestedTime},
+		},
+		{
+			name: "drain completes when no hosted control planes remain",
+			managementCluster: drainingManagementCluster(5, &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				Migrations:    []fleetapi.HostedControlPlaneMigration{migration("a", "shard-s2")},
+				MigratedCount: 2,
+			}),
+			placements:  map[string]string{"a": "shard-s2"},
+			wantDrained: &metav1.Condition{Status: metav1.ConditionTrue, Reason: string(fleetapi.ManagementClusterConditionReasonDrainComplete)},
+			wantDrain: &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				MigratedCount: 3,
+				CompletedTime: ptr.To(metav1.NewTime(testNow)),
+			},
+		},
+		{
+			name: "completed drain starts no further migrations",
+			managementCluster: drainingManagementCluster(5, &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				MigratedCount: 3,
+				CompletedTime: ptr.To(metav1.NewTime(testNow.Add(-time.Minute))),
+			}),
+			targets:             []*fleetapi.ManagementCluster{testManagementCluster("s2", true)},
+			hostedControlPlanes: []string{"a"},
+			wantDrain: &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				MigratedCount: 3,
+				CompletedTime: ptr.To(metav1.NewTime(testNow.Add(-time.Minute))),
+			},
+		},
+		{
+			name: "new drain request starts from a fresh status",
+			managementCluster: drainingManagementCluster(5, &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: metav1.NewTime(testNow.Add(-24 * time.Hour)),
+				MigratedCount: 3,
+				CompletedTime: ptr.To(metav1.NewTime(testNow.Add(-23 * time.Hour))),
+			}),
+			targets:             []*fleetapi.ManagementCluster{testManagementCluster("s2", true)},
+			hostedControlPlanes: []string{"a"},
+			wantMigrations:      map[string]string{"a": "shard-s2"},
+			wantDrained:         &metav1.Condition{Status: metav1.ConditionFalse, Reason: string(fleetapi.ManagementClusterConditionReasonDrainInProgress)},
+			wantDrain: &fleetapi.ManagementClusterDrainStatus{
+				RequestedTime: testRequestedTime,
+				Migrations: []fleetapi.HostedControlPlaneMigration{
+					{ClusterServiceID: "a", TargetProvisionShardID: "shard-s2", StartedTime: metav1.NewTime(testNow)},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			mockDB, err := fleetcosmosstoragetesting.NewMockFleetDBClientWithResources(ctx, []any{tt.managementCluster})
+			require.NoError(t, err)
+
+			// Every target is on an ungated stamp unless the case says otherwise.
+			stamps := tt.stamps
+			if stamps == nil {
+				for _, target := range tt.targets {
+					stamps = append(stamps, testStamp(target.GetStampIdentifier(), false, false))
+				}
+			}
+
+			migrationClient := &fakeMigrationClient{
+				hostedControlPlanes: tt.hostedControlPlanes,
+				placements:          tt.placements,
+				migrateErr:          tt.migrateErr,
+			}
+			syncer := &drainSyncer{
+				clock:         clocktesting.NewFakePassiveClock(testNow),
+				fleetDBClient: mockDB,
+				managementClusterLister: &fleetlistertesting.SliceManagementClusterLister{
+					ManagementClusters: append([]*fleetapi.ManagementCluster{tt.managementCluster}, tt.targets...),
+				},
+				stampLister:      &fleetlistertesting.SliceStampLister{Stamps: stamps},
+				migrationClient:  migrationClient,
+				migrationTimeout: DefaultMigrationTimeout,
+			}
+
+			err = syncer.SyncOnce(ctx, fleetcontrollers.StampKey{StampIdentifier: drainingStamp})
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.wantMigrations == nil {
+				assert.Empty(t, migrationClient.migrations)
+			} else {
+				assert.Equal(t, tt.wantMigrations, migrationClient.migrations)
+			}
+
+			managementCluster, err := mockDB.Stamps().ManagementClusters(drainingStamp).Get(ctx, fleetapi.ManagementClusterResourceName)
+			require.NoError(t, err)
+
+			drained := apimeta.FindStatusCondition(managementCluster.Status.Conditions, string(fleetapi.ManagementClusterConditionDrained))
+			if tt.wantDrained == nil {
+				assert.Nil(t, drained)
+			} else {
+				require.NotNil(t, drained)
+				assert.Equal(t, tt.wantDrained.Status, drained.Status)
+				assert.Equal(t, tt.wantDrained.Reason, drained.Reason)
+			}
+
+			if tt.wantDrain == nil {
+				assert.Nil(t, managementCluster.Status.Drain)
+			} else {
+				require.NotNil(t, managementCluster.Status.Drain)
+				assert.True(t, equality.Semantic.DeepEqual(tt.wantDrain, managementCluster.Status.Drain), "unexpected drain status: %+v", managementCluster.Status.Drain)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	utilsclock "k8s.io/utils/clock"
+
+	arohcpv1alpha1 "github.com/openshift-online/ocm-sdk-go/arohcp/v1alpha1"
+
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/ocm"
+)
+
+// ErrMigrationUnsupported is returned by MigrateHostedControlPlane when the
+// hosted control plane cannot be moved to another provision shard.
+var ErrMigrationUnsupported = errors.New("migrating hosted control planes between provision shards is not supported by Cluster Service")
+
+// hostedControlPlanePlacementTTL bounds how stale the provision shard
+// placement returned by ListHostedControlPlanes may be.
+const hostedControlPlanePlacementTTL = 5 * time.Minute
+
+// HostedControlPlaneMigrationClient finds the hosted control planes placed on
+// a provision shard and moves them to another one.
+type HostedControlPlaneMigrationClient interface {
+	// ListHostedControlPlanes returns the Cluster Service IDs of the clusters
+	// placed on the provision shard. The placement may be a few minutes old.
+	ListHostedControlPlanes(ctx context.Context, provisionShardID metadataapi.InternalID) ([]metadataapi.InternalID, error)
+
+	// GetHostedControlPlaneProvisionShard returns the provision shard Cluster
+	// Service currently places the cluster on, or nil before one is allocated.
+	GetHostedControlPlaneProvisionShard(ctx context.Context, clusterID metadataapi.InternalID) (*metadataapi.InternalID, error)
+
+	// MigrateHostedControlPlane asks Cluster Service to move the cluster to
+	// the target provision shard. It is idempotent and returns
+	// ErrMigrationUnsupported when no migration can be requested.
+	MigrateHostedControlPlane(ctx context.Context, clusterID, targetProvisionShardID metadataapi.InternalID) error
+}
+
+// ClustersServiceClient is the subset of ocm.ClusterServiceClientSpec used
+// to migrate hosted control planes.
+type ClustersServiceClient interface {
+	GetClusterProvisionShard(ctx context.Context, internalID metadataapi.InternalID) (*arohcpv1alpha1.ProvisionShard, error)
+	ListClusters(searchExpression string) ocm.ClusterListIterator
+}
+
+type clustersServiceMigrationClient struct {
+	clock                 utilsclock.PassiveClock
+	clustersServiceClient ClustersServiceClient
+
+	lock sync.Mutex
+	// placements maps lower-cased provision shard IDs to the clusters placed
+	// on them, as of placementsTime.
+	placements     map[string][]metadataapi.InternalID
+	placementsTime time.Time
+}
+
+// NewClustersServiceMigrationClient returns a HostedControlPlaneMigrationClient
+// backed by Cluster Service. Placement is read from the cluster's provision
+// shard endpoint, the same way management cluster placement sync resolves it.
+//
+// Cluster Service has no API to move a hosted control plane to another
+// provision shard yet; its provision_shard_id property only pins placement
+// at creation. Until one exists, MigrateHostedControlPlane returns
+// ErrMigrationUnsupported and drains wait for the hosted control planes to be
+// deleted.
+func NewClustersServiceMigrationClient(clock utilsclock.PassiveClock, clustersServiceClient ClustersServiceClient) HostedControlPlaneMigrationClient {
+	return &clustersServiceMigrationClient{
+		clock:                 clock,
+		clustersServiceClient: clustersServiceClient,
+	}
+}
+
+// ListHostedControlPlanes answers from a snapshot of the placement of every
+// cluster in the region, refreshed at most every
+// hostedControlPlanePlacementTTL. Cluster Service cannot search clusters by
+// their actual placement, so a refresh pages through all clusters and
+// resolves each provision shard; the snapshot lets the drain and capacity
+// controllers share that cost across every management cluster.
+func (c *clustersServiceMigrationClient) ListHostedControlPlanes(ctx context.Context, provisionShardID metadataapi.InternalID) ([]metadataapi.InternalID, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := c.clock.Now()
+	if c.placements == nil || now.Sub(c.placementsTime) >= hostedControlPlanePlacementTTL {
+		placements, err := c.listPlacements(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.placements = placements
+		c.placementsTime = now
+	}
+	return slices.Clone(c.placements[strings.ToLower(provisionShardID.ID())]), nil
+}
+
+func (c *clustersServiceMigrationClient) listPlacements(ctx context.Context) (map[string][]metadataapi.InternalID, error) {
+	placements := map[string][]metadataapi.InternalID{}
+	iter := c.clustersServiceClient.ListClusters("")
+	for cluster := range iter.Items(ctx) {
+		clusterID, err := metadataapi.NewInternalID(cluster.HREF())
+		if err != nil {
+			return nil, fmt.Errorf("parsing cluster HREF: %w", err)
+		}
+		clusterShardID, err := c.GetHostedControlPlaneProvisionShard(ctx, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		if clusterShardID != nil {
+			key := strings.ToLower(clusterShardID.ID())
+			placements[key] = append(placements[key], clusterID)
+		}
+	}
+	if err := iter.GetError(); err != nil {
+		return nil, fmt.Errorf("listing clusters: %w", err)
+	}
+	return placements, nil
+}
+
+func (c *clustersServiceMigrationClient) GetHostedControlPlaneProvisionShard(ctx context.Context, clusterID metadataapi.InternalID) (*metadataapi.InternalID, error) {
+	provisionShard, err := c.clustersServiceClient.GetClusterProvisionShard(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("getting provision shard of cluster %s: %w", clusterID.ID(), err)
+	}
+	if len(provisionShard.HREF()) == 0 {
+		return nil, nil
+	}
+	provisionShardID, err := metadataapi.NewInternalID(provisionShard.HREF())
+	if err != nil {
+		return nil, fmt.Errorf("parsing provision shard HREF of cluster %s: %w", clusterID.ID(), err)
+	}
+	return &provisionShardID, nil
+}
+
+func (c *clustersServiceMigrationClient) MigrateHostedControlPlane(ctx context.Context, clusterID, targetProvisionShardID metadataapi.InternalID) error {
+	return ErrMigrationUnsupported
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clocktesting "k8s.io/utils/clock/testing"
+
+	arohcpv1alpha1 "github.com/openshift-online/ocm-sdk-go/arohcp/v1alpha1"
+
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/ocm"
+)
+
+type fakeClustersServiceClient struct {
+	// placements maps cluster IDs to their provision shard IDs.
+	placements        map[string]string
+	listCalls         int
+	provisionShardGet int
+}
+
+func (f *fakeClustersServiceClient) GetClusterProvisionShard(ctx context.Context, internalID metadataapi.InternalID) (*arohcpv1alpha1.ProvisionShard, error) {
+	f.provisionShardGet++
+	return arohcpv1alpha1.NewProvisionShard().HREF("/api/aro_hcp/v1alpha1/provision_shards/" + f.placements[internalID.ID()]).Build()
+}
+
+func (f *fakeClustersServiceClient) ListClusters(searchExpression string) ocm.ClusterListIterator {
+	f.listCalls++
+	var clusters []*arohcpv1alpha1.Cluster
+	for id := range f.placements {
+		cluster, err := arohcpv1alpha1.NewCluster().HREF("/api/aro_hcp/v1alpha1/clusters/" + id).Build()
+		if err != nil {
+			return ocm.NewSimpleClusterListIterator(nil, err)
+		}
+		clusters = append(clusters, cluster)
+	}
+	return ocm.NewSimpleClusterListIterator(clusters, nil)
+}
+
+func clusterIDs(ids []metadataapi.InternalID) []string {
+	var out []string
+	for _, id := range ids {
+		out = append(out, id.ID())
+	}
+	return out
+}
+
+func TestListHostedControlPlanesReusesPlacementSnapshot(t *testing.T) {
+	ctx := context.Background()
+	clock := clocktesting.NewFakePassiveClock(testNow)
+	csClient := &fakeClustersServiceClient{placements: map[string]string{"a": "shard-s1", "b": "shard-s2", "c": "shard-s1"}}
+	client := NewClustersServiceMigrationClient(clock, csClient)
+
+	shardS1 := metadataapi.Must(metadataapi.NewInternalID("/api/aro_hcp/v1alpha1/provision_shards/shard-s1"))
+	shardS2 := metadataapi.Must(metadataapi.NewInternalID("/api/aro_hcp/v1alpha1/provision_shards/shard-s2"))
+
+	onS1, err := client.ListHostedControlPlanes(ctx, shardS1)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a", "c"}, clusterIDs(onS1))
+
+	onS2, err := client.ListHostedControlPlanes(ctx, shardS2)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"b"}, clusterIDs(onS2))
+	assert.Equal(t, 1, csClient.listCalls, "shards listed within the TTL share one snapshot")
+	assert.Equal(t, 3, csClient.provisionShardGet)
+
+	csClient.placements["c"] = "shard-s2"
+	clock.SetTime(testNow.Add(hostedControlPlanePlacementTTL))
+
+	onS2, err = client.ListHostedControlPlanes(ctx, shardS2)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"b", "c"}, clusterIDs(onS2))
+	assert.Equal(t, 2, csClient.listCalls, "the snapshot is refreshed once the TTL elapsed")
+}
+
+func TestMigrateHostedControlPlaneUnsupported(t *testing.T) {
+	client := NewClustersServiceMigrationClient(clocktesting.NewFakePassiveClock(testNow), &fakeClustersServiceClient{})
+	clusterID := metadataapi.Must(metadataapi.NewInternalID("/api/aro_hcp/v1alpha1/clusters/a"))
+	shardID := metadataapi.Must(metadataapi.NewInternalID("/api/aro_hcp/v1alpha1/provision_shards/shard-s2"))
+
+	err := client.MigrateHostedControlPlane(context.Background(), clusterID, shardID)
+	assert.True(t, errors.Is(err, ErrMigrationUnsupported))
+}
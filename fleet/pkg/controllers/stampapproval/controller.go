@@ -0,0 +1,209 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stampapproval implements a controller that evaluates stamp
+// approval policies: it approves a stamp once enough distinct approvers
+// signed off, and marks it ProductionReady once its management cluster
+// passed the staged rollout gates.
+package stampapproval
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	utilsclock "k8s.io/utils/clock"
+
+	fleetcontrollers "github.com/Azure/ARO-HCP/fleet/pkg/controllers/base"
+	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
+	"github.com/Azure/ARO-HCP/internal/controllerutils"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/fleetcosmosstorage"
+	"github.com/Azure/ARO-HCP/internal/database/listers/fleetlisters"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+type stampApprovalSyncer struct {
+	clock                   utilsclock.PassiveClock
+	fleetDBClient           fleetcosmosstorage.FleetDBClient
+	managementClusterLister fleetlisters.ManagementClusterLister
+}
+
+// NewStampApprovalController creates a StampWatchingController that owns the
+// Approved and ProductionReady conditions of stamps with an approval policy.
+// Stamps without a policy are left alone; their Approved condition is set
+// directly by registration or the admin API.
+//
+// The soak gate is re-evaluated on informer resync, so a stamp becomes
+// ProductionReady at most one resync period after its soak elapsed.
+func NewStampApprovalController(
+	clock utilsclock.PassiveClock,
+	stampInformer cache.SharedIndexInformer,
+	managementClusterInformer cache.SharedIndexInformer,
+	fleetDBClient fleetcosmosstorage.FleetDBClient,
+	managementClusterLister fleetlisters.ManagementClusterLister,
+	cfg fleetcontrollers.StampWatchingControllerConfig,
+) *fleetcontrollers.StampWatchingController {
+	syncer := &stampApprovalSyncer{
+		clock:                   clock,
+		fleetDBClient:           fleetDBClient,
+		managementClusterLister: managementClusterLister,
+	}
+
+	controller := fleetcontrollers.NewStampWatchingController(
+		"StampApprovalController",
+		syncer,
+		cfg,
+	)
+
+	if err := controller.QueueForInformers(fleetcontrollers.DefaultInformerResyncPeriod, stampInformer, managementClusterInformer); err != nil {
+		panic(err) // coding error
+	}
+
+	return controller
+}
+
+func (s *stampApprovalSyncer) SyncOnce(ctx context.Context, key fleetcontrollers.StampKey) error {
+	logger := utils.LoggerFromContext(ctx)
+
+	stamp, err := s.fleetDBClient.Stamps().Get(ctx, key.StampIdentifier)
+	if err != nil {
+		if cosmosstorageutils.IsNotFoundError(err) {
+			return nil
+		}
+		return utils.TrackError(err)
+	}
+	if stamp.Spec.ApprovalPolicy == nil {
+		return nil
+	}
+
+	updated := stamp.DeepCopy()
+	setApprovedCondition(updated)
+
+	managementCluster, err := s.managementClusterLister.Get(ctx, key.StampIdentifier)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		managementCluster = nil
+	} else if err != nil {
+		return utils.TrackError(err)
+	}
+	setProductionReadyCondition(updated, managementCluster, s.clock.Now())
+
+	if !controllerutils.NeedsUpdate(stamp, updated) {
+		return nil
+	}
+	if productionReady := apimeta.FindStatusCondition(updated.Status.Conditions, string(fleetapi.StampConditionProductionReady)); productionReady != nil {
+		logger.Info("evaluated stamp approval policy",
+			"approvers", updated.DistinctApprovers(),
+			"requiredApprovals", updated.Spec.ApprovalPolicy.RequiredApprovals,
+			"productionReady", productionReady.Status,
+			"reason", productionReady.Reason,
+		)
+	}
+	if _, err := s.fleetDBClient.Stamps().Replace(ctx, updated, stamp, nil); err != nil {
+		return utils.TrackError(err)
+	}
+	return nil
+}
+
+// setApprovedCondition approves the stamp once it collected the required
+// number of distinct approvers, not counting the identity that requested it.
+// A revocation written by the admin API stays visible until new approvals
+// arrive.
+func setApprovedCondition(stamp *fleetapi.Stamp) {
+	policy := stamp.Spec.ApprovalPolicy
+	approvers := stamp.DistinctApprovers()
+
+	if approvers >= policy.RequiredApprovals {
+		apimeta.SetStatusCondition(&stamp.Status.Conditions, metav1.Condition{
+			Type:    string(fleetapi.StampConditionApproved),
+			Status:  metav1.ConditionTrue,
+			Reason:  string(fleetapi.StampConditionReasonApprovalsReceived),
+			Message: fmt.Sprintf("Approved by %d of %d required approvers", approvers, policy.RequiredApprovals),
+		})
+		return
+	}
+
+	existing := apimeta.FindStatusCondition(stamp.Status.Conditions, string(fleetapi.StampConditionApproved))
+	if approvers == 0 && existing != nil && existing.Status == metav1.ConditionFalse &&
+		existing.Reason != string(fleetapi.StampConditionReasonAwaitingApprovals) {
+		return
+	}
+	apimeta.SetStatusCondition(&stamp.Status.Conditions, metav1.Condition{
+		Type:    string(fleetapi.StampConditionApproved),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(fleetapi.StampConditionReasonAwaitingApprovals),
+		Message: fmt.Sprintf("Approved by %d of %d required approvers", approvers, policy.RequiredApprovals),
+	})
+}
+
+// setProductionReadyCondition evaluates the rollout gates of an approved
+// stamp: the management cluster conditions required by the policy must be
+// True, and the management cluster must have been Ready for the soak
+// duration since it became Ready or the stamp was approved, whichever is
+// later. Once True the condition latches, so a later health regression is
+// handled by cordoning or draining the management cluster rather than by
+// pulling the stamp out of production; only revoking approval clears it.
+func setProductionReadyCondition(stamp *fleetapi.Stamp, managementCluster *fleetapi.ManagementCluster, now time.Time) {
+	policy := stamp.Spec.ApprovalPolicy
+	setCondition := func(status metav1.ConditionStatus, reason fleetapi.StampConditionReason, message string) {
+		apimeta.SetStatusCondition(&stamp.Status.Conditions, metav1.Condition{
+			Type:    string(fleetapi.StampConditionProductionReady),
+			Status:  status,
+			Reason:  string(reason),
+			Message: message,
+		})
+	}
+
+	approved := apimeta.FindStatusCondition(stamp.Status.Conditions, string(fleetapi.StampConditionApproved))
+	if approved == nil || approved.Status != metav1.ConditionTrue {
+		setCondition(metav1.ConditionFalse, fleetapi.StampConditionReasonNotApproved, "Stamp is not approved")
+		return
+	}
+	if apimeta.IsStatusConditionTrue(stamp.Status.Conditions, string(fleetapi.StampConditionProductionReady)) {
+		return
+	}
+
+	if managementCluster == nil {
+		setCondition(metav1.ConditionFalse, fleetapi.StampConditionReasonHealthCheckFailing, "Management cluster does not exist yet")
+		return
+	}
+	for _, conditionType := range policy.RequiredManagementClusterConditions {
+		if !apimeta.IsStatusConditionTrue(managementCluster.Status.Conditions, conditionType) {
+			setCondition(metav1.ConditionFalse, fleetapi.StampConditionReasonHealthCheckFailing,
+				fmt.Sprintf("Management cluster condition %s is not True", conditionType))
+			return
+		}
+	}
+
+	ready := apimeta.FindStatusCondition(managementCluster.Status.Conditions, string(fleetapi.ManagementClusterConditionReady))
+	if ready == nil || ready.Status != metav1.ConditionTrue {
+		setCondition(metav1.ConditionFalse, fleetapi.StampConditionReasonHealthCheckFailing, "Management cluster is not Ready")
+		return
+	}
+	soakStart := ready.LastTransitionTime.Time
+	if approved.LastTransitionTime.After(soakStart) {
+		soakStart = approved.LastTransitionTime.Time
+	}
+	if soakEnd := soakStart.Add(policy.SoakDuration.Duration); now.Before(soakEnd) {
+		setCondition(metav1.ConditionFalse, fleetapi.StampConditionReasonSoaking,
+			fmt.Sprintf("Soaking until %s", soakEnd.UTC().Format(time.RFC3339)))
+		return
+	}
+
+	setCondition(metav1.ConditionTrue, fleetapi.StampConditionReasonRolloutGatesPassed,
+		fmt.Sprintf("Approved by %d approvers and soaked for %s", stamp.DistinctApprovers(), policy.SoakDuration.Duration))
+}
@@ -0,0 +1,295 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stampapproval
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	fleetcontrollers "github.com/Azure/ARO-HCP/fleet/pkg/controllers/base"
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/fleetapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/fleetcosmosstoragetesting"
+	"github.com/Azure/ARO-HCP/internal/database/listertesting/fleetlistertesting"
+)
+
+const testStampIdentifier = "s1"
+
+var (
+	readyTime    = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	approvedTime = readyTime.Add(-time.Hour)
+)
+
+func testStamp(approvers ...string) *fleetapi.Stamp {
+	resourceID := metadataapi.Must(fleetapi.ToStampResourceID(testStampIdentifier))
+	stamp := &fleetapi.Stamp{
+		CosmosMetadata: coreapi.CosmosMetadata{ResourceID: resourceID, PartitionKey: strings.ToLower(testStampIdentifier)},
+		ResourceID:     resourceID,
+		Spec: fleetapi.StampSpec{
+			ApprovalPolicy: &fleetapi.StampApprovalPolicy{
+				RequiredApprovals:                   2,
+				RequestedBy:                         "requester@example.com",
+				SoakDuration:                        metav1.Duration{Duration: 24 * time.Hour},
+				RequiredManagementClusterConditions: []string{string(fleetapi.ManagementClusterConditionClustersServiceRegistered)},
+			},
+		},
+	}
+	for _, approver := range approvers {
+		stamp.Status.Approvals = append(stamp.Status.Approvals, fleetapi.StampApproval{
+			Approver:     approver,
+			ApprovedTime: metav1.NewTime(approvedTime),
+		})
+	}
+	return stamp
+}
+
+func approvedStamp() *fleetapi.Stamp {
+	stamp := testStamp("alice@example.com", "bob@example.com")
+	stamp.Status.Conditions = []metav1.Condition{{
+		Type:               string(fleetapi.StampConditionApproved),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(fleetapi.StampConditionReasonApprovalsReceived),
+		LastTransitionTime: metav1.NewTime(approvedTime),
+	}}
+	return stamp
+}
+
+func testManagementCluster(conditions ...metav1.Condition) *fleetapi.ManagementCluster {
+	resourceID := metadataapi.Must(fleetapi.ToManagementClusterResourceID(testStampIdentifier))
+	return &fleetapi.ManagementCluster{
+		CosmosMetadata: coreapi.CosmosMetadata{ResourceID: resourceID, PartitionKey: strings.ToLower(testStampIdentifier)},
+		ResourceID:     resourceID,
+		Status:         fleetapi.ManagementClusterStatus{Conditions: conditions},
+	}
+}
+
+func trueCondition(conditionType fleetapi.ManagementClusterConditionType) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(conditionType),
+		Status:             metav1.ConditionTrue,
+		Reason:             "Test",
+		LastTransitionTime: metav1.NewTime(readyTime),
+	}
+}
+
+func TestSyncOnce(t *testing.T) {
+	healthyManagementCluster := testManagementCluster(
+		trueCondition(fleetapi.ManagementClusterConditionReady),
+		trueCondition(fleetapi.ManagementClusterConditionClustersServiceRegistered),
+	)
+
+	tests := []struct {
+		name                string
+		stamp               *fleetapi.Stamp
+		managementCluster   *fleetapi.ManagementCluster
+		now                 time.Time
+		wantApproved        metav1.ConditionStatus
+		wantApprovedReason  fleetapi.StampConditionReason
+		wantProductionReady metav1.ConditionStatus
+		wantReadyReason     fleetapi.StampConditionReason
+	}{
+		{
+			name:                "one approver is not enough",
+			stamp:               testStamp("alice@example.com"),
+			now:                 readyTime,
+			wantApproved:        metav1.ConditionFalse,
+			wantApprovedReason:  fleetapi.StampConditionReasonAwaitingApprovals,
+			wantProductionReady: metav1.ConditionFalse,
+			wantReadyReason:     fleetapi.StampConditionReasonNotApproved,
+		},
+		{
+			name:                "the same approver twice counts once",
+			stamp:               testStamp("alice@example.com", "ALICE@example.com"),
+			now:                 readyTime,
+			wantApproved:        metav1.ConditionFalse,
+			wantApprovedReason:  fleetapi.StampConditionReasonAwaitingApprovals,
+			wantProductionReady: metav1.ConditionFalse,
+			wantReadyReason:     fleetapi.StampConditionReasonNotApproved,
+		},
+		{
+			name:                "the requester does not count as an approver",
+			stamp:               testStamp("alice@example.com", "requester@example.com"),
+			now:                 readyTime,
+			wantApproved:        metav1.ConditionFalse,
+			wantApprovedReason:  fleetapi.StampConditionReasonAwaitingApprovals,
+			wantProductionReady: metav1.ConditionFalse,
+			wantReadyReason:     fleetapi.StampConditionReasonNotApproved,
+		},
+		{
+			name:                "two approvers approve before the management cluster exists",
+			stamp:               testStamp("alice@example.com", "bob@example.com"),
+			now:                 readyTime,
+			wantApproved:        metav1.ConditionTrue,
+			wantApprovedReason:  fleetapi.StampConditionReasonApprovalsReceived,
+			wantProductionReady: metav1.ConditionFalse,
+			wantReadyReason:     fleetapi.StampConditionReasonHealthCheckFailing,
+		},
+		{
+			name:                "required management cluster condition not True",
+			stamp:               approvedStamp(),
+			managementCluster:   testManagementCluster(trueCondition(fleetapi.ManagementClusterConditionReady)),
+			now:                 readyTime.Add(48 * time.Hour),
+			wantApproved:        metav1.ConditionTrue,
+			wantApprovedReason:  fleetapi.StampConditionReasonApprovalsReceived,
+			wantProductionReady: metav1.ConditionFalse,
+			wantReadyReason:     fleetapi.StampConditionReasonHealthCheckFailing,
+		},
+		{
+			name:                "healthy management cluster soaks",
+			stamp:               approvedStamp(),
+			managementCluster:   healthyManagementCluster,
+			now:                 readyTime.Add(23 * time.Hour),
+			wantApproved:        metav1.ConditionTrue,
+			wantApprovedReason:  fleetapi.StampConditionReasonApprovalsReceived,
+			wantProductionReady: metav1.ConditionFalse,
+			wantReadyReason:     fleetapi.StampConditionReasonSoaking,
+		},
+		{
+			name:                "soaked management cluster passes the rollout gates",
+			stamp:               approvedStamp(),
+			managementCluster:   healthyManagementCluster,
+			now:                 readyTime.Add(24 * time.Hour),
+			wantApproved:        metav1.ConditionTrue,
+			wantApprovedReason:  fleetapi.StampConditionReasonApprovalsReceived,
+			wantProductionReady: metav1.ConditionTrue,
+			wantReadyReason:     fleetapi.StampConditionReasonRolloutGatesPassed,
+		},
+		{
+			name: "production ready latches across health regressions",
+			stamp: func() *fleetapi.Stamp {
+				stamp := approvedStamp()
+				apimeta.SetStatusCondition(&stamp.Status.Conditions, metav1.Condition{
+					Type:   string(fleetapi.StampConditionProductionReady),
+					Status: metav1.ConditionTrue,
+					Reason: string(fleetapi.StampConditionReasonRolloutGatesPassed),
+				})
+				return stamp
+			}(),
+			managementCluster:   testManagementCluster(),
+			now:                 readyTime,
+			wantApproved:        metav1.ConditionTrue,
+			wantApprovedReason:  fleetapi.StampConditionReasonApprovalsReceived,
+			wantProductionReady: metav1.ConditionTrue,
+			wantReadyReason:     fleetapi.StampConditionReasonRolloutGatesPassed,
+		},
+		{
+			name: "revoked approval clears production ready",
+			stamp: func() *fleetapi.Stamp {
+				stamp := testStamp()
+				stamp.Status.Conditions = []metav1.Condition{
+					{
+						Type:   string(fleetapi.StampConditionApproved),
+						Status: metav1.ConditionFalse,
+						Reason: string(fleetapi.StampConditionReasonApprovalRevoked),
+					},
+					{
+						Type:   string(fleetapi.StampConditionProductionReady),
+						Status: metav1.ConditionTrue,
+						Reason: string(fleetapi.StampConditionReasonRolloutGatesPassed),
+					},
+				}
+				return stamp
+			}(),
+			managementCluster:   healthyManagementCluster,
+			now:                 readyTime.Add(48 * time.Hour),
+			wantApproved:        metav1.ConditionFalse,
+			wantApprovedReason:  fleetapi.StampConditionReasonApprovalRevoked,
+			wantProductionReady: metav1.ConditionFalse,
+			wantReadyReason:     fleetapi.StampConditionReasonNotApproved,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			mockDB, err := fleetcosmosstoragetesting.NewMockFleetDBClientWithResources(ctx, []any{tt.stamp})
+			require.NoError(t, err)
+
+			lister := &fleetlistertesting.SliceManagementClusterLister{}
+			if tt.managementCluster != nil {
+				lister.ManagementClusters = []*fleetapi.ManagementCluster{tt.managementCluster}
+			}
+			syncer := &stampApprovalSyncer{
+				fleetDBClient:           mockDB,
+				managementClusterLister: lister,
+				clock:                   clocktesting.NewFakePassiveClock(tt.now),
+			}
+
+			require.NoError(t, syncer.SyncOnce(ctx, fleetcontrollers.StampKey{StampIdentifier: testStampIdentifier}))
+
+			stamp, err := mockDB.Stamps().Get(ctx, testStampIdentifier)
+			require.NoError(t, err)
+
+			approved := apimeta.FindStatusCondition(stamp.Status.Conditions, string(fleetapi.StampConditionApproved))
+			require.NotNil(t, approved)
+			assert.Equal(t, tt.wantApproved, approved.Status)
+			assert.Equal(t, string(tt.wantApprovedReason), approved.Reason)
+
+			productionReady := apimeta.FindStatusCondition(stamp.Status.Conditions, string(fleetapi.StampConditionProductionReady))
+			require.NotNil(t, productionReady)
+			assert.Equal(t, tt.wantProductionReady, productionReady.Status)
+			assert.Equal(t, string(tt.wantReadyReason), productionReady.Reason)
+		})
+	}
+}
+
+func TestSyncOnceIgnoresStampsWithoutPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	stamp := testStamp()
+	stamp.Spec.ApprovalPolicy = nil
+	mockDB, err := fleetcosmosstoragetesting.NewMockFleetDBClientWithResources(ctx, []any{stamp})
+	require.NoError(t, err)
+
+	syncer := &stampApprovalSyncer{
+		fleetDBClient:           mockDB,
+		managementClusterLister: &fleetlistertesting.SliceManagementClusterLister{},
+		clock:                   clocktesting.NewFakePassiveClock(readyTime),
+	}
+	require.NoError(t, syncer.SyncOnce(ctx, fleetcontrollers.StampKey{StampIdentifier: testStampIdentifier}))
+
+	got, err := mockDB.Stamps().Get(ctx, testStampIdentifier)
+	require.NoError(t, err)
+	assert.Empty(t, got.Status.Conditions)
+}
+
+func TestSyncOnceStampNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	mockDB, err := fleetcosmosstoragetesting.NewMockFleetDBClientWithResources(ctx, nil)
+	require.NoError(t, err)
+
+	syncer := &stampApprovalSyncer{
+		fleetDBClient:           mockDB,
+		managementClusterLister: &fleetlistertesting.SliceManagementClusterLister{},
+		clock:                   clocktesting.NewFakePassiveClock(readyTime),
+	}
+	require.NoError(t, syncer.SyncOnce(ctx, fleetcontrollers.StampKey{StampIdentifier: testStampIdentifier}))
+
+	_, err = mockDB.Stamps().Get(ctx, testStampIdentifier)
+	assert.True(t, cosmosstorageutils.IsNotFoundError(err))
+}
@@ -33,16 +33,20 @@ import (
 	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"k8s.io/component-base/metrics/legacyregistry"
+	utilsclock "k8s.io/utils/clock"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 
 	"github.com/Azure/ARO-HCP/fleet/pkg/controllers/amwscaling"
 	"github.com/Azure/ARO-HCP/fleet/pkg/controllers/base"
+	"github.com/Azure/ARO-HCP/fleet/pkg/controllers/capacity"
 	"github.com/Azure/ARO-HCP/fleet/pkg/controllers/clustersserviceregistration"
 	"github.com/Azure/ARO-HCP/fleet/pkg/controllers/datadump"
+	"github.com/Azure/ARO-HCP/fleet/pkg/controllers/drain"
 	"github.com/Azure/ARO-HCP/fleet/pkg/controllers/lifecycle"
 	"github.com/Azure/ARO-HCP/fleet/pkg/controllers/maestroregistration"
+	"github.com/Azure/ARO-HCP/fleet/pkg/controllers/stampapproval"
 	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/fleetcosmosstorage"
 	"github.com/Azure/ARO-HCP/internal/database/informers/fleetinformers"
 	sharedleaderelection "github.com/Azure/ARO-HCP/internal/leaderelection"
@@ -61,6 +65,7 @@ const (
 // Manager is the fleet controller manager. It runs informers, leader election,
 // and the fleet controllers.
 type Manager struct {
+	Clock                        utilsclock.PassiveClock
 	FleetDBClient                fleetcosmosstorage.FleetDBClient
 	ClustersServiceClient        ocm.ClusterServiceClientSpec
 	MaestroConsumerClientFactory maestroregistration.MaestroConsumerClientFactory
@@ -72,6 +77,17 @@ type Manager struct {
 	AMWScalingPollInterval       time.Duration
 	AzureCredential              azcore.TokenCredential
 	AzureClientOptions           *policy.ClientOptions
+	DisableCapacityController    bool
+	// CapacityCordon lets the capacity controller cordon management clusters
+	// that reach the high-water mark; without it the score is only reported.
+	CapacityCordon     bool
+	CapacityThresholds capacity.Thresholds
+	// CapacityNodeUsageReader and CapacityIngestionUsageReader are optional;
+	// without them management clusters are scored on hosted control plane
+	// count only.
+	CapacityNodeUsageReader      capacity.NodeUsageReader
+	CapacityIngestionUsageReader capacity.IngestionUsageReader
+	DrainMigrationTimeout        time.Duration
 }
 
 // Run starts the fleet controller manager. It serves /healthz and /metrics,
@@ -192,6 +208,30 @@ func (m *Manager) runControllersUnderLeaderElection(
 		base.StampWatchingControllerConfig{Cooldown: base.DefaultRegistrationAwareCooldown(managementClusterLister)},
 	)
 
+	stampApprovalController := stampapproval.NewStampApprovalController(
+		m.Clock,
+		stampInformer,
+		managementClusterInformer,
+		m.FleetDBClient,
+		managementClusterLister,
+		base.StampWatchingControllerConfig{CooldownPeriod: time.Minute},
+	)
+
+	// Shared by the drain and capacity controllers so they read one
+	// hosted control plane placement snapshot.
+	migrationClient := drain.NewClustersServiceMigrationClient(m.Clock, m.ClustersServiceClient)
+
+	drainController := drain.NewManagementClusterDrainController(
+		m.Clock,
+		managementClusterInformer,
+		m.FleetDBClient,
+		managementClusterLister,
+		stampLister,
+		migrationClient,
+		m.DrainMigrationTimeout,
+		base.StampWatchingControllerConfig{CooldownPeriod: time.Minute},
+	)
+
 	dataDumpController := datadump.NewStampDataDumpController(
 		stampInformer,
 		managementClusterInformer,
@@ -207,6 +247,18 @@ func (m *Manager) runControllersUnderLeaderElection(
 		m.AzureClientOptions,
 	)
 
+	capacityController := capacity.NewManagementClusterCapacityController(
+		m.Clock,
+		managementClusterInformer,
+		m.FleetDBClient,
+		migrationClient,
+		m.CapacityNodeUsageReader,
+		m.CapacityIngestionUsageReader,
+		m.CapacityThresholds,
+		m.CapacityCordon,
+		base.StampWatchingControllerConfig{CooldownPeriod: 5 * time.Minute},
+	)
+
 	leaderElectionConfig := leaderelection.LeaderElectionConfig{
 		Lock:          m.LeaderElectionLock,
 		LeaseDuration: sharedleaderelection.RecommendedLeaseDuration,
@@ -227,8 +279,13 @@ func (m *Manager) runControllersUnderLeaderElection(
 				go csRegistrationController.Run(ctx, 4)
 				go maestroRegistrationController.Run(ctx, 4)
 				go lifecycleController.Run(ctx, 1)
+				go stampApprovalController.Run(ctx, 1)
+				go drainController.Run(ctx, 1)
 				go dataDumpController.Run(ctx, 1)
 				go amwScalingController.Run(ctx)
+				if !m.DisableCapacityController {
+					go capacityController.Run(ctx, 1)
+				}
 			},
 			OnStoppedLeading: func() {
 				logger.Info("lost leader election lease")
@@ -66,6 +66,8 @@ type FrontendOpts struct {
 
 	exitOnPanic  bool
 	logVerbosity int
+
+	limits frontend.Limits
 }
 
 func NewRootCmd() *cobra.Command {
@@ -106,6 +108,24 @@ func NewRootCmd() *cobra.Command {
 	rootCmd.Flags().IntVar(&opts.logVerbosity, "log-verbosity", opts.logVerbosity,
 		"Log verbosity, as a go-logr/logr log level. 0 is the default verbosity level (INFO). It must be a value >= 0, where a higher value means more verbose output.",
 	)
+	rootCmd.Flags().Float64Var(&opts.limits.Reads.RequestsPerSecond, "rate-limit-reads-per-second", 50,
+		"Sustained rate of read requests allowed per subscription and client class. Zero disables read rate limiting.",
+	)
+	rootCmd.Flags().IntVar(&opts.limits.Reads.Burst, "rate-limit-reads-burst", 250,
+		"Number of read requests a subscription and client class may make at once before being rate limited.",
+	)
+	rootCmd.Flags().Float64Var(&opts.limits.Writes.RequestsPerSecond, "rate-limit-writes-per-second", 5,
+		"Sustained rate of write requests allowed per subscription and client class. Zero disables write rate limiting.",
+	)
+	rootCmd.Flags().IntVar(&opts.limits.Writes.Burst, "rate-limit-writes-burst", 50,
+		"Number of write requests a subscription and client class may make at once before being rate limited.",
+	)
+	rootCmd.Flags().IntVar(&opts.limits.MaxClustersPerSubscription, "max-clusters-per-subscription", 0,
+		"Maximum number of clusters a subscription may hold. Zero means unlimited.",
+	)
+	rootCmd.Flags().IntVar(&opts.limits.MaxNodePoolsPerSubscription, "max-node-pools-per-subscription", 0,
+		"Maximum number of node pools a subscription may hold across all of its clusters. Zero means unlimited.",
+	)
 	rootCmd.MarkFlagsRequiredTogether("cosmos-name", "cosmos-url")
 
 	return rootCmd
@@ -144,6 +164,15 @@ func (opts *FrontendOpts) Validate() error {
 		return utils.TrackError(fmt.Errorf("--location is required"))
 	}
 
+	if opts.limits.Reads.RequestsPerSecond < 0 || opts.limits.Writes.RequestsPerSecond < 0 {
+		return utils.TrackError(fmt.Errorf("--rate-limit-reads-per-second and --rate-limit-writes-per-second must be values >= 0"))
+	}
+	if opts.limits.Reads.Burst < 0 || opts.limits.Writes.Burst < 0 {
+		return utils.TrackError(fmt.Errorf("--rate-limit-reads-burst and --rate-limit-writes-burst must be values >= 0"))
+	}
+	if opts.limits.MaxClustersPerSubscription < 0 || opts.limits.MaxNodePoolsPerSubscription < 0 {
+		return utils.TrackError(fmt.Errorf("--max-clusters-per-subscription and --max-node-pools-per-subscription must be values >= 0"))
+	}
 	if opts.logVerbosity < 0 {
 		return utils.TrackError(fmt.Errorf("--log-verbosity must be a value >= 0"))
 	}
@@ -259,6 +288,7 @@ func (opts *FrontendOpts) Run() error {
 		logger, listener, metricsListener,
 		legacyregistry.Registerer(), legacyregistry.DefaultGatherer,
 		resourcesDBClient, csClient, auditClient, opts.location, opts.exitOnPanic,
+		opts.limits,
 	)
 
 	runErrCh := make(chan error, 1)
@@ -49,3 +49,26 @@ func userAgentMetricLabel(ua string) string {
 	}
 	return label
 }
+
+// Client classes used to partition per-subscription rate limits.
+const (
+	clientClassCAPZ  = "capz"
+	clientClassASO   = "aso"
+	clientClassOther = userAgentOther
+)
+
+// clientClass buckets the request User-Agent into a coarse client class.
+// CapZ drives ASO, so its requests carry both product tokens and are
+// classified as CapZ.
+func clientClass(ua string) string {
+	class := clientClassOther
+	for _, tok := range strings.Fields(ua) {
+		switch {
+		case strings.HasPrefix(tok, userAgentTokenCAPZ):
+			return clientClassCAPZ
+		case strings.HasPrefix(tok, userAgentTokenASO):
+			class = clientClassASO
+		}
+	}
+	return class
+}
@@ -78,3 +78,48 @@ func TestUserAgentMetricLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestClientClass(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		ua   string
+		want string
+	}{
+		{
+			name: "empty",
+			ua:   "",
+			want: clientClassOther,
+		},
+		{
+			name: "generic",
+			ua:   "azsdk-go-armresources/v1.2.0 (go1.24.13; linux)",
+			want: clientClassOther,
+		},
+		{
+			name: "aso",
+			ua:   "azsdk-go-generic/v2.13.0 aso-controller/v2.13.0-hcpclusters.9",
+			want: clientClassASO,
+		},
+		{
+			name: "capz driving aso",
+			ua:   "azsdk-go-generic/v2.13.0 aso-controller/v2.13.0-hcpclusters.9 cluster-api-provider-azure/v1.22.1-mce-217",
+			want: clientClassCAPZ,
+		},
+		{
+			name: "embedded token in unrelated product is ignored",
+			ua:   "evil-aso-controller/v9",
+			want: clientClassOther,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := clientClass(tt.ua); got != tt.want {
+				t.Fatalf("clientClass(%q) = %q, want %q", tt.ua, got, tt.want)
+			}
+		})
+	}
+}
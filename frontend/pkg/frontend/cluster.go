@@ -90,7 +90,11 @@ func (f *Frontend) ArmResourceListClusters(writer http.ResponseWriter, request *
 	// the requirements of a skipToken for ARM pagination, so it is used directly as the
 	// nextLink token below.
 
-	internalClusterIterator, err := f.resourcesDBClient.HCPClusters(subscriptionID, resourceGroupName).List(ctx, dbListOptionsFromRequest(request))
+	listOptions, err := dbListOptionsFromRequest(request, clusterListFields)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	internalClusterIterator, err := f.resourcesDBClient.HCPClusters(subscriptionID, resourceGroupName).List(ctx, listOptions)
 	if err != nil {
 		return utils.TrackError(err)
 	}
@@ -264,6 +268,7 @@ func (f *Frontend) newClusterAdmissionContext(ctx context.Context, op operation.
 		Clock:           f.clock,
 		Subscription:    subscription,
 		OriginalCluster: originalCluster.DeepCopy(),
+		Quota:           f.subscriptionQuota(),
 	}
 
 	if op.Type == operation.Create {
@@ -360,6 +365,11 @@ func (f *Frontend) createHCPCluster(writer http.ResponseWriter, request *http.Re
 		return utils.TrackError(err)
 	}
 
+	dryRun, err := dryRunFromRequest(request)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
 	newInternalCluster, err := decodeDesiredClusterCreate(ctx, f.azureLocation, request.Header)
 	if err != nil {
 		return utils.TrackError(err)
@@ -381,6 +391,9 @@ func (f *Frontend) createHCPCluster(writer http.ResponseWriter, request *http.Re
 	if err := coreapi.CloudErrorFromFieldErrors(validationErrs); err != nil {
 		return utils.TrackError(err)
 	}
+	if err := admission.AdmitClusterQuota(ctx, admissionContext, validationOp, newInternalCluster); err != nil {
+		return utils.TrackError(err)
+	}
 
 	// we must validate using user provided .Identity.UserAssignedIdentities because that is the intent expressed by the user to allow
 	// us to use these identities. The information contained in those key is not trusted to be accurate, so we clear this field and set to
@@ -390,6 +403,10 @@ func (f *Frontend) createHCPCluster(writer http.ResponseWriter, request *http.Re
 	}
 	completeClusterIdentity(newInternalCluster, nil)
 
+	if dryRun {
+		return writeDryRunResponse(writer, newInternalCluster.ID, nil, versionedInterface.NewHCPOpenShiftCluster(newInternalCluster))
+	}
+
 	logger.Info(fmt.Sprintf("creating resource %s", newInternalCluster.ID))
 
 	transaction := f.resourcesDBClient.NewTransaction(newInternalCluster.ID.SubscriptionID)
@@ -608,6 +625,11 @@ func (f *Frontend) patchHCPCluster(writer http.ResponseWriter, request *http.Req
 }
 
 func (f *Frontend) updateHCPClusterInCosmos(ctx context.Context, writer http.ResponseWriter, request *http.Request, httpStatusCode int, newInternalCluster, oldInternalCluster *coreapi.HCPOpenShiftCluster) error {
+	dryRun, err := dryRunFromRequest(request)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
 	subscription, err := f.resourcesDBClient.Subscriptions().Get(ctx, oldInternalCluster.ID.SubscriptionID)
 	if err != nil {
 		return utils.TrackError(err)
@@ -652,6 +674,19 @@ func (f *Frontend) updateHCPClusterInCosmos(ctx context.Context, writer http.Res
 	}
 	completeClusterIdentity(newInternalCluster, existingUserAssignedIdentities)
 
+	if dryRun {
+		return writeDryRunResponse(writer, newInternalCluster.ID,
+			versionedInterface.NewHCPOpenShiftCluster(oldInternalCluster),
+			versionedInterface.NewHCPOpenShiftCluster(newInternalCluster))
+	}
+
+	changes, err := diffResourceProperties(
+		versionedInterface.NewHCPOpenShiftCluster(oldInternalCluster),
+		versionedInterface.NewHCPOpenShiftCluster(newInternalCluster))
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
 	transaction := f.resourcesDBClient.NewTransaction(oldInternalCluster.ID.SubscriptionID)
 	clusterUpdateOperation := cosmosstorageutils.NewOperation(
 		cosmosstorageutils.OperationRequestUpdate,
@@ -668,6 +703,11 @@ func (f *Frontend) updateHCPClusterInCosmos(ctx context.Context, writer http.Res
 		return utils.TrackError(err)
 	}
 
+	err = f.addResourceChangeToTransaction(ctx, transaction, newInternalCluster.ID, clusterUpdateOperation.ResourceID.Name, newInternalCluster.SystemData, correlationData, changes)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
 	// set fields that were not known until the operation doc instance was created.
 	// TODO once we we have separate creation/validation of operation documents, this can be done ahead of time.
 	newInternalCluster.ServiceProviderProperties.ActiveOperationID = clusterUpdateOperation.ResourceID.Name
@@ -835,6 +875,43 @@ func (f *Frontend) addDeleteClusterToTransaction(ctx context.Context, writer htt
 	return nil
 }
 
+// addCancelClusterCreateToTransaction rolls back a cluster creation that has not
+// yet been accepted by Cluster Service. The cluster is handed to the backend
+// deletion controllers exactly as though it had been deleted, which keeps the
+// Cluster Service creation controller from picking it up.
+func (f *Frontend) addCancelClusterCreateToTransaction(ctx context.Context, transaction cosmosstorageutils.DBTransaction, operation *coreapi.Operation) error {
+	cluster, err := f.resourcesDBClient.HCPClusters(operation.ExternalID.SubscriptionID, operation.ExternalID.ResourceGroupName).Get(ctx, operation.ExternalID.Name)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return coreapi.NewResourceNotFoundError(operation.ExternalID)
+	}
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	if cluster.ServiceProviderProperties.ActiveOperationID != operation.ResourceID.Name {
+		return coreapi.NewConflictError(operation.OperationID, "Operation is no longer the active operation on resource %s", cluster.ID)
+	}
+	if cluster.ServiceProviderProperties.ClusterServiceID != nil {
+		return coreapi.NewConflictError(operation.OperationID, "Cluster creation has already started and can no longer be canceled; delete the cluster instead")
+	}
+
+	if cluster.ServiceProviderProperties.DeletionTimestamp == nil {
+		cluster.ServiceProviderProperties.DeletionTimestamp = &metav1.Time{Time: f.clock.Now().UTC()}
+	}
+	cluster.ServiceProviderProperties.ActiveOperationID = ""
+	cluster.ServiceProviderProperties.ProvisioningState = coreapi.ProvisioningStateDeleting
+	cluster.ServiceProviderProperties.UsesNewClusterDeletionApproach = true
+	cluster.ServiceProviderProperties.DeleteOperationCompletionDeadline = computeDeleteOperationCompletionDeadline(cluster)
+
+	_, err = f.resourcesDBClient.HCPClusters(cluster.ID.SubscriptionID, cluster.ID.ResourceGroupName).
+		AddReplaceToTransaction(ctx, transaction, cluster, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	return nil
+}
+
 func computeDeleteOperationCompletionDeadline(cluster *coreapi.HCPOpenShiftCluster) *metav1.Time {
 	duration := admission.DefaultDeleteOperationCompletionDeadlineDuration
 	if cluster.ServiceProviderProperties.DeleteOperationCompletionTimeout != nil {
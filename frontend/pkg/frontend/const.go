@@ -36,6 +36,8 @@ const (
 	requestCounterName  = "frontend_http_requests_total"
 	requestDurationName = "frontend_http_requests_duration_seconds"
 
+	throttledRequestCounterName = "frontend_http_requests_throttled_total"
+
 	noMatchRouteLabel   = "<no match>"
 	unknownVersionLabel = "<unknown>"
 
@@ -0,0 +1,210 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+// queryParameterDryRun asks a PUT or PATCH request to run decoding, mutation,
+// admission and validation as usual but to report the resulting change instead
+// of storing it. No resource or operation document is written.
+const queryParameterDryRun = "dryRun"
+
+// dryRunIgnoredProperties are top-level properties that change on every
+// request and so say nothing about what the request changes.
+var dryRunIgnoredProperties = []string{"systemData"}
+
+// DryRunChangeType follows the change types of an ARM what-if result.
+type DryRunChangeType string
+
+const (
+	DryRunChangeTypeCreate   DryRunChangeType = "Create"
+	DryRunChangeTypeModify   DryRunChangeType = "Modify"
+	DryRunChangeTypeNoChange DryRunChangeType = "NoChange"
+)
+
+// DryRunPropertyChangeType follows the property change types of an ARM
+// what-if result.
+type DryRunPropertyChangeType string
+
+const (
+	DryRunPropertyChangeTypeCreate DryRunPropertyChangeType = "Create"
+	DryRunPropertyChangeTypeDelete DryRunPropertyChangeType = "Delete"
+	DryRunPropertyChangeTypeModify DryRunPropertyChangeType = "Modify"
+)
+
+// DryRunResult is the response body of a dry run request. Its shape matches
+// the ARM what-if operation result so existing what-if tooling can read it.
+type DryRunResult struct {
+	Status     string                 `json:"status"`
+	Properties DryRunResultProperties `json:"properties"`
+}
+
+type DryRunResultProperties struct {
+	Changes []DryRunChange `json:"changes"`
+}
+
+// DryRunChange describes how a request would change a single resource.
+// Before and After are the resource as a GET would return it.
+type DryRunChange struct {
+	ResourceID string                 `json:"resourceId"`
+	ChangeType DryRunChangeType       `json:"changeType"`
+	Before     map[string]any         `json:"before,omitempty"`
+	After      map[string]any         `json:"after,omitempty"`
+	Delta      []DryRunPropertyChange `json:"delta,omitempty"`
+}
+
+// DryRunPropertyChange describes a single changed property. Path is the JSON
+// path of the property using dots between object keys. Arrays are compared as
+// a whole.
+type DryRunPropertyChange struct {
+	Path               string                   `json:"path"`
+	PropertyChangeType DryRunPropertyChangeType `json:"propertyChangeType"`
+	Before             any                      `json:"before,omitempty"`
+	After              any                      `json:"after,omitempty"`
+}
+
+// dryRunFromRequest reports whether the request asks for a dry run.
+func dryRunFromRequest(request *http.Request) (bool, error) {
+	urlQuery := request.URL.Query()
+	if !urlQuery.Has(queryParameterDryRun) {
+		return false, nil
+	}
+	dryRun, err := strconv.ParseBool(urlQuery.Get(queryParameterDryRun))
+	if err != nil {
+		return false, coreapi.NewCloudError(
+			http.StatusBadRequest,
+			coreapi.CloudErrorCodeInvalidRequestContent,
+			queryParameterDryRun,
+			"The %s query parameter must be true or false", queryParameterDryRun)
+	}
+	return dryRun, nil
+}
+
+// newDryRunChange computes the change from before to after. A nil before
+// means the request would create the resource.
+func newDryRunChange(resourceID *azcorearm.ResourceID, before, after any) (*DryRunChange, error) {
+	afterMap, err := toDryRunMap(after)
+	if err != nil {
+		return nil, err
+	}
+	change := &DryRunChange{
+		ResourceID: resourceID.String(),
+		After:      afterMap,
+	}
+	if before == nil {
+		change.ChangeType = DryRunChangeTypeCreate
+		return change, nil
+	}
+
+	beforeMap, err := toDryRunMap(before)
+	if err != nil {
+		return nil, err
+	}
+	change.Before = beforeMap
+	change.Delta = diffDryRunValues("", beforeMap, afterMap, nil)
+	if len(change.Delta) == 0 {
+		change.ChangeType = DryRunChangeTypeNoChange
+	} else {
+		change.ChangeType = DryRunChangeTypeModify
+	}
+	return change, nil
+}
+
+// toDryRunMap round-trips a versioned resource through JSON so it can be
+// compared key by key.
+func toDryRunMap(obj any) (map[string]any, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %T: %w", obj, err)
+	}
+	out := map[string]any{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %T: %w", obj, err)
+	}
+	for _, key := range dryRunIgnoredProperties {
+		delete(out, key)
+	}
+	return out, nil
+}
+
+// diffDryRunValues appends the property changes between before and after,
+// recursing into objects, and returns the changes sorted by path.
+func diffDryRunValues(path string, before, after any, changes []DryRunPropertyChange) []DryRunPropertyChange {
+	beforeMap, beforeIsMap := before.(map[string]any)
+	afterMap, afterIsMap := after.(map[string]any)
+	if beforeIsMap && afterIsMap {
+		keys := make([]string, 0, len(beforeMap)+len(afterMap))
+		for key := range beforeMap {
+			keys = append(keys, key)
+		}
+		for key := range afterMap {
+			if _, ok := beforeMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			childPath := key
+			if len(path) > 0 {
+				childPath = path + "." + key
+			}
+			changes = diffDryRunValues(childPath, beforeMap[key], afterMap[key], changes)
+		}
+		return changes
+	}
+
+	switch {
+	case reflect.DeepEqual(before, after):
+		return changes
+	case before == nil:
+		return append(changes, DryRunPropertyChange{Path: path, PropertyChangeType: DryRunPropertyChangeTypeCreate, After: after})
+	case after == nil:
+		return append(changes, DryRunPropertyChange{Path: path, PropertyChangeType: DryRunPropertyChangeTypeDelete, Before: before})
+	default:
+		return append(changes, DryRunPropertyChange{Path: path, PropertyChangeType: DryRunPropertyChangeTypeModify, Before: before, After: after})
+	}
+}
+
+// writeDryRunResponse writes the change a request would have made in place of
+// storing it.
+func writeDryRunResponse(writer http.ResponseWriter, resourceID *azcorearm.ResourceID, before, after any) error {
+	change, err := newDryRunChange(resourceID, before, after)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	result := DryRunResult{
+		Status: string(coreapi.ProvisioningStateSucceeded),
+		Properties: DryRunResultProperties{
+			Changes: []DryRunChange{*change},
+		},
+	}
+	_, err = coreapi.WriteJSONResponse(writer, http.StatusOK, result)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	return nil
+}
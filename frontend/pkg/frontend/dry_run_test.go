@@ -0,0 +1,149 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/apitesting/coreapitesting"
+)
+
+func TestDryRunFromRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		want      bool
+		expectErr bool
+	}{
+		{
+			name: "absent",
+		},
+		{
+			name:  "true",
+			query: "?dryRun=true",
+			want:  true,
+		},
+		{
+			name:  "false",
+			query: "?dryRun=false",
+		},
+		{
+			name:      "invalid",
+			query:     "?dryRun=maybe",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPut, "/"+tt.query, nil)
+			got, err := dryRunFromRequest(request)
+			if tt.expectErr {
+				var cloudError *coreapi.CloudError
+				require.ErrorAs(t, err, &cloudError)
+				assert.Equal(t, http.StatusBadRequest, cloudError.StatusCode)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewDryRunChange(t *testing.T) {
+	resourceID, err := azcorearm.ParseResourceID(coreapitesting.TestClusterResourceID)
+	require.NoError(t, err)
+
+	type profile struct {
+		Version string   `json:"version,omitempty"`
+		Labels  []string `json:"labels,omitempty"`
+		Count   *int     `json:"count,omitempty"`
+	}
+	type resource struct {
+		Name       string            `json:"name"`
+		SystemData map[string]string `json:"systemData,omitempty"`
+		Properties profile           `json:"properties"`
+	}
+	count := 3
+
+	tests := []struct {
+		name           string
+		before         any
+		after          any
+		wantChangeType DryRunChangeType
+		wantDelta      []DryRunPropertyChange
+	}{
+		{
+			name:           "create",
+			after:          resource{Name: "c", Properties: profile{Version: "4.19"}},
+			wantChangeType: DryRunChangeTypeCreate,
+		},
+		{
+			name:           "no change ignores system data",
+			before:         resource{Name: "c", SystemData: map[string]string{"lastModifiedAt": "yesterday"}},
+			after:          resource{Name: "c", SystemData: map[string]string{"lastModifiedAt": "today"}},
+			wantChangeType: DryRunChangeTypeNoChange,
+		},
+		{
+			name:           "modify, create and delete properties",
+			before:         resource{Name: "c", Properties: profile{Version: "4.19", Labels: []string{"a"}}},
+			after:          resource{Name: "c", Properties: profile{Version: "4.20", Count: &count}},
+			wantChangeType: DryRunChangeTypeModify,
+			wantDelta: []DryRunPropertyChange{
+				{Path: "properties.count", PropertyChangeType: DryRunPropertyChangeTypeCreate, After: float64(3)},
+				{Path: "properties.labels", PropertyChangeType: DryRunPropertyChangeTypeDelete, Before: []any{"a"}},
+				{Path: "properties.version", PropertyChangeType: DryRunPropertyChangeTypeModify, Before: "4.19", After: "4.20"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change, err := newDryRunChange(resourceID, tt.before, tt.after)
+			require.NoError(t, err)
+			assert.Equal(t, resourceID.String(), change.ResourceID)
+			assert.Equal(t, tt.wantChangeType, change.ChangeType)
+			assert.Equal(t, tt.wantDelta, change.Delta)
+			assert.NotContains(t, change.After, "systemData")
+		})
+	}
+}
+
+func TestWriteDryRunResponse(t *testing.T) {
+	resourceID, err := azcorearm.ParseResourceID(coreapitesting.TestClusterResourceID)
+	require.NoError(t, err)
+
+	writer := httptest.NewRecorder()
+	require.NoError(t, writeDryRunResponse(writer, resourceID, map[string]any{"a": "1"}, map[string]any{"a": "2"}))
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	var result DryRunResult
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &result))
+	assert.Equal(t, "Succeeded", result.Status)
+	require.Len(t, result.Properties.Changes, 1)
+	assert.Equal(t, DryRunChangeTypeModify, result.Properties.Changes[0].ChangeType)
+	assert.Equal(t, []DryRunPropertyChange{
+		{Path: "a", PropertyChangeType: DryRunPropertyChangeTypeModify, Before: "1", After: "2"},
+	}, result.Properties.Changes[0].Delta)
+}
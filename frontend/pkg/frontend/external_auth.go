@@ -87,7 +87,11 @@ func (f *Frontend) ArmResourceListExternalAuths(writer http.ResponseWriter, requ
 
 	pagedResponse := coreapi.NewPagedResponse()
 
-	internalExternalAuthIterator, err := f.resourcesDBClient.HCPClusters(subscriptionID, resourceGroupName).ExternalAuth(resourceName).List(ctx, dbListOptionsFromRequest(request))
+	listOptions, err := dbListOptionsFromRequest(request, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	internalExternalAuthIterator, err := f.resourcesDBClient.HCPClusters(subscriptionID, resourceGroupName).ExternalAuth(resourceName).List(ctx, listOptions)
 	if err != nil {
 		return utils.TrackError(err)
 	}
@@ -480,6 +484,13 @@ func (f *Frontend) updateExternalAuthInCosmos(ctx context.Context, writer http.R
 		return utils.TrackError(err)
 	}
 
+	changes, err := diffResourceProperties(
+		versionedInterface.NewHCPOpenShiftClusterExternalAuth(oldInternalExternalAuth),
+		versionedInterface.NewHCPOpenShiftClusterExternalAuth(newInternalExternalAuth))
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
 	logger.Info(fmt.Sprintf("updating resource %s", oldInternalExternalAuth.ID))
 
 	transaction := f.resourcesDBClient.NewTransaction(oldInternalExternalAuth.ID.SubscriptionID)
@@ -499,6 +510,11 @@ func (f *Frontend) updateExternalAuthInCosmos(ctx context.Context, writer http.R
 		return utils.TrackError(err)
 	}
 
+	err = f.addResourceChangeToTransaction(ctx, transaction, newInternalExternalAuth.ID, externalAuthUpdateOperation.ResourceID.Name, newInternalExternalAuth.SystemData, correlationData, changes)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
 	// set fields that were not known until the operation doc instance was created.
 	// TODO once we we have separate creation/validation of operation documents, this can be done ahead of time.
 	newInternalExternalAuth.ServiceProviderProperties.ActiveOperationID = externalAuthUpdateOperation.ResourceID.Name
@@ -52,6 +52,7 @@ import (
 	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
 	"github.com/Azure/ARO-HCP/internal/ocm"
 	"github.com/Azure/ARO-HCP/internal/utils"
+	"github.com/Azure/ARO-HCP/internal/utils/apihelpers"
 	"github.com/Azure/ARO-HCP/internal/utils/armhelpers"
 	"github.com/Azure/ARO-HCP/internal/validation"
 )
@@ -72,6 +73,9 @@ type Frontend struct {
 
 	apiRegistry coreapi.APIRegistry
 
+	// limits holds the per-subscription request rate limits and resource quotas.
+	limits Limits
+
 	exitOnPanic bool
 }
 
@@ -86,6 +90,7 @@ func NewFrontend(
 	auditClient audit.Client,
 	azureLocation string,
 	exitOnPanic bool,
+	limits Limits,
 ) *Frontend {
 	// zero side-effect registration path
 	apiRegistry := coreapi.NewAPIRegistry()
@@ -122,6 +127,7 @@ func NewFrontend(
 		),
 		azureLocation: azureLocation,
 		apiRegistry:   apiRegistry,
+		limits:        limits,
 		exitOnPanic:   exitOnPanic,
 	}
 
@@ -131,6 +137,15 @@ func NewFrontend(
 	return f
 }
 
+// subscriptionQuota returns the resource quotas admission enforces on every
+// subscription.
+func (f *Frontend) subscriptionQuota() admission.SubscriptionQuota {
+	return admission.SubscriptionQuota{
+		MaxClusters:  f.limits.MaxClustersPerSubscription,
+		MaxNodePools: f.limits.MaxNodePoolsPerSubscription,
+	}
+}
+
 func (f *Frontend) Run(ctx context.Context) error {
 	ctx, cancel := context.WithCancelCause(ctx)
 	defer func() {
@@ -221,7 +236,11 @@ func (f *Frontend) Location(writer http.ResponseWriter, request *http.Request) {
 	_, _ = writer.Write([]byte(f.azureLocation))
 }
 
-func dbListOptionsFromRequest(request *http.Request) *cosmosstorageutils.DBClientListResourceDocsOptions {
+// dbListOptionsFromRequest translates the paging, $filter and $orderby query
+// parameters of a collection GET request into list options. Fields lists the
+// fields that may be filtered and sorted on; if empty, $filter and $orderby
+// are rejected.
+func dbListOptionsFromRequest(request *http.Request, fields listFields) (*cosmosstorageutils.DBClientListResourceDocsOptions, error) {
 	// FIXME We may want to cap pageSizeHint. If we get a large enough
 	//       $top argument (and there's enough actual clusters to reach
 	//       that), we could potentially hit the 8MB response size limit.
@@ -241,7 +260,25 @@ func dbListOptionsFromRequest(request *http.Request) *cosmosstorageutils.DBClien
 			options.PageSizeHint = metadataapi.Ptr(int32(top))
 		}
 	}
-	return options
+
+	// The nextLink is built from the original request URL, so $filter and
+	// $orderby are present on every page and the query matches the one the
+	// continuation token was issued for.
+	if urlQuery.Has(listQueryParameterFilter) {
+		filters, err := parseListFilter(urlQuery.Get(listQueryParameterFilter), fields)
+		if err != nil {
+			return nil, err
+		}
+		options.Filters = filters
+	}
+	if urlQuery.Has(listQueryParameterOrderBy) {
+		orderBy, err := parseListOrderBy(urlQuery.Get(listQueryParameterOrderBy), fields)
+		if err != nil {
+			return nil, err
+		}
+		options.OrderBy = orderBy
+	}
+	return options, nil
 }
 
 func (f *Frontend) ArmResourceListVersion(writer http.ResponseWriter, request *http.Request) error {
@@ -468,6 +505,124 @@ func (f *Frontend) ArmResourceActionRevokeCredentials(writer http.ResponseWriter
 	return nil
 }
 
+func (f *Frontend) ArmResourceActionStopCluster(writer http.ResponseWriter, request *http.Request) error {
+	return f.armResourceActionClusterPowerState(writer, request, cosmosstorageutils.OperationRequestClusterStop)
+}
+
+func (f *Frontend) ArmResourceActionStartCluster(writer http.ResponseWriter, request *http.Request) error {
+	return f.armResourceActionClusterPowerState(writer, request, cosmosstorageutils.OperationRequestClusterStart)
+}
+
+// armResourceActionClusterPowerState accepts a request to stop or start a
+// cluster. The backend scales the node pools and the hosted control plane
+// and flips the cluster's power state once the operation succeeds.
+func (f *Frontend) armResourceActionClusterPowerState(writer http.ResponseWriter, request *http.Request, operationRequest cosmosstorageutils.OperationRequest) error {
+	ctx := request.Context()
+	logger := utils.LoggerFromContext(ctx)
+
+	resourceID, err := utils.ResourceIDFromContext(ctx)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	// Parent resource is the hcpOpenShiftCluster.
+	clusterResourceID := resourceID.Parent
+
+	correlationData, err := CorrelationDataFromContext(ctx)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	cluster, err := f.resourcesDBClient.HCPClusters(clusterResourceID.SubscriptionID, clusterResourceID.ResourceGroupName).Get(ctx, clusterResourceID.Name)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	// CheckForProvisioningStateConflict does not log conflict errors
+	// but does log unexpected errors like database failures.
+	if err := checkForProvisioningStateConflict(ctx, f.resourcesDBClient, operationRequest, cluster.ID, cluster.ServiceProviderProperties.ProvisioningState); err != nil {
+		return utils.TrackError(err)
+	}
+	if cluster.ServiceProviderProperties.ClusterServiceID == nil {
+		return utils.TrackError(fmt.Errorf("cluster %s has no ClusterServiceID", cluster.ID))
+	}
+
+	// A failed stop or start leaves the power state in transition,
+	// so either action may be retried from there.
+	powerState := cluster.ServiceProviderProperties.PowerState
+	var newPowerState coreapi.PowerState
+	switch operationRequest {
+	case cosmosstorageutils.OperationRequestClusterStop:
+		if powerState == coreapi.PowerStateStopped {
+			return coreapi.NewConflictError(clusterResourceID, "Cluster is already stopped")
+		}
+		newPowerState = coreapi.PowerStateStopping
+	case cosmosstorageutils.OperationRequestClusterStart:
+		if powerState.IsRunning() {
+			return coreapi.NewConflictError(clusterResourceID, "Cluster is already running")
+		}
+		newPowerState = coreapi.PowerStateStarting
+	default:
+		return utils.TrackError(fmt.Errorf("unhandled request type: %s", operationRequest))
+	}
+
+	// Node pool operations in flight would fight over the node pool scaling.
+	nodePoolIterator, err := f.resourcesDBClient.HCPClusters(cluster.ID.SubscriptionID, cluster.ID.ResourceGroupName).NodePools(cluster.ID.Name).List(ctx, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	for _, nodePool := range nodePoolIterator.Items(ctx) {
+		if !nodePool.Properties.ProvisioningState.IsTerminal() {
+			writer.Header().Set("Retry-After", strconv.Itoa(10))
+			return coreapi.NewConflictError(
+				clusterResourceID,
+				"Cannot %s resource while node pool %s is %q",
+				strings.ToLower(string(operationRequest)),
+				nodePool.Name,
+				strings.ToLower(string(nodePool.Properties.ProvisioningState)))
+		}
+	}
+	if err := nodePoolIterator.GetError(); err != nil {
+		return utils.TrackError(err)
+	}
+
+	logger.Info(fmt.Sprintf("%s resource %s", strings.ToLower(string(newPowerState)), cluster.ID))
+
+	transaction := f.resourcesDBClient.NewTransaction(clusterResourceID.SubscriptionID)
+
+	operationDoc := cosmosstorageutils.NewOperation(
+		operationRequest,
+		clusterResourceID,
+		*cluster.ServiceProviderProperties.ClusterServiceID,
+		f.azureLocation,
+		request.Header.Get(coreapi.HeaderNameHomeTenantID),
+		request.Header.Get(coreapi.HeaderNameClientObjectID),
+		request.Header.Get(coreapi.HeaderNameAsyncNotificationURI),
+		correlationData)
+
+	transaction.OnSuccess(addOperationResponseHeaders(writer, request, operationDoc.NotificationURI, operationDoc.OperationID))
+	_, err = f.resourcesDBClient.Operations(operationDoc.OperationID.SubscriptionID).AddCreateToTransaction(ctx, transaction, operationDoc, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	cluster.ServiceProviderProperties.ActiveOperationID = operationDoc.ResourceID.Name
+	cluster.ServiceProviderProperties.ProvisioningState = operationDoc.Status
+	cluster.ServiceProviderProperties.PowerState = newPowerState
+
+	_, err = f.resourcesDBClient.HCPClusters(cluster.ID.SubscriptionID, cluster.ID.ResourceGroupName).AddReplaceToTransaction(ctx, transaction, cluster, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	_, err = transaction.Execute(ctx, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	writer.WriteHeader(http.StatusAccepted)
+	return nil
+}
+
 func (f *Frontend) ArmOperationsList(writer http.ResponseWriter, request *http.Request) error {
 	pagedResponse := coreapi.NewPagedResponse()
 
@@ -877,6 +1032,81 @@ func (f *Frontend) OperationStatus(writer http.ResponseWriter, request *http.Req
 	return nil
 }
 
+// OperationCancel cancels an in-flight asynchronous operation. Only cluster
+// creation that has not yet reached Cluster Service and node pool updates can
+// be canceled; any other operation responds with a conflict.
+func (f *Frontend) OperationCancel(writer http.ResponseWriter, request *http.Request) error {
+	ctx := request.Context()
+	logger := utils.LoggerFromContext(ctx)
+
+	resourceID, err := utils.ResourceIDFromContext(ctx)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	// Parent resource is the hcpOperationStatus.
+	operationID := resourceID.Parent
+
+	operation, err := f.resourcesDBClient.Operations(operationID.SubscriptionID).Get(ctx, operationID.Name)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return coreapi.NewResourceNotFoundError(operationID)
+	}
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	// Validate the identity canceling the operation is the
+	// same identity that triggered the operation. Return 404 if not.
+	if !f.OperationIsVisible(request, operation) {
+		return coreapi.NewResourceNotFoundError(operationID)
+	}
+
+	if operation.Status.IsTerminal() {
+		return coreapi.NewConflictError(operationID, "Cannot cancel operation while operation is %q", strings.ToLower(string(operation.Status)))
+	}
+
+	transaction := f.resourcesDBClient.NewTransaction(operationID.SubscriptionID)
+
+	switch {
+	case operation.Request == cosmosstorageutils.OperationRequestCreate &&
+		armhelpers.ResourceTypeEqual(operation.ExternalID.ResourceType, coreapi.ClusterResourceType):
+		err = f.addCancelClusterCreateToTransaction(ctx, transaction, operation)
+	case operation.Request == cosmosstorageutils.OperationRequestUpdate &&
+		armhelpers.ResourceTypeEqual(operation.ExternalID.ResourceType, coreapi.NodePoolResourceType):
+		err = f.addCancelNodePoolUpdateToTransaction(ctx, transaction, operation)
+	default:
+		err = coreapi.NewConflictError(operationID, "Cannot cancel %s operation on %s", strings.ToLower(string(operation.Request)), operation.ExternalID.ResourceType)
+	}
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	operationToWrite := operation.DeepCopy()
+	apihelpers.CancelOperationOnRequest(operationToWrite, f.clock.Now().UTC())
+	// The backend delivers the ARM notification for the canceled operation from the outbox.
+	_, err = corecosmosstorage.AddOperationNotificationToTransaction(ctx, f.resourcesDBClient, transaction, operationToWrite, f.clock.Now())
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	_, err = f.resourcesDBClient.Operations(operationID.SubscriptionID).AddReplaceToTransaction(ctx, transaction, operationToWrite, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	logger.Info(fmt.Sprintf("canceling operation %s on resource %s", operationID, operation.ExternalID))
+
+	_, err = transaction.Execute(ctx, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	_, err = coreapi.WriteJSONResponse(writer, http.StatusOK, cosmosstorageutils.ToStatus(operationToWrite))
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	return nil
+}
+
 func getSubscriptionDifferences(oldSub, newSub *coreapi.Subscription) []string {
 	var messages []string
 
@@ -988,14 +1218,16 @@ func (f *Frontend) OperationResult(writer http.ResponseWriter, request *http.Req
 	switch operation.Request {
 	case cosmosstorageutils.OperationRequestCreate:
 		successStatusCode = http.StatusCreated
-	case cosmosstorageutils.OperationRequestUpdate:
+	case cosmosstorageutils.OperationRequestUpdate, cosmosstorageutils.OperationRequestNodePoolReplace:
 		successStatusCode = http.StatusOK
 	case cosmosstorageutils.OperationRequestDelete:
 		writer.WriteHeader(http.StatusNoContent)
 		return nil
 	case cosmosstorageutils.OperationRequestSystemAdminCredentialRequest:
 		successStatusCode = http.StatusOK
-	case cosmosstorageutils.OperationRequestSystemAdminCredentialRevocation:
+	case cosmosstorageutils.OperationRequestSystemAdminCredentialRevocation,
+		cosmosstorageutils.OperationRequestClusterStop,
+		cosmosstorageutils.OperationRequestClusterStart:
 		writer.WriteHeader(http.StatusNoContent)
 		return nil
 	default:
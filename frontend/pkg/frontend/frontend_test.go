@@ -96,6 +96,7 @@ func TestOperationsList(t *testing.T) {
 		newNoopAuditClient(t),
 		coreapitesting.TestLocation,
 		true,
+		Limits{},
 	)
 
 	ctx := utils.ContextWithLogger(t.Context(), testr.New(t))
@@ -199,6 +200,7 @@ func TestSubscriptionsGET(t *testing.T) {
 				newNoopAuditClient(t),
 				coreapitesting.TestLocation,
 				true,
+				Limits{},
 			)
 
 			// Pre-populate subscription in the mock database
@@ -348,6 +350,7 @@ func TestSubscriptionsPUT(t *testing.T) {
 				newNoopAuditClient(t),
 				coreapitesting.TestLocation,
 				true,
+				Limits{},
 			)
 
 			body, err := json.Marshal(&test.subscription)
@@ -586,6 +589,7 @@ func TestDeploymentPreflight(t *testing.T) {
 				newNoopAuditClient(t),
 				coreapitesting.TestLocation,
 				true,
+				Limits{},
 			)
 
 			subs := map[string]*coreapi.Subscription{
@@ -711,6 +715,7 @@ func TestRequestAdminCredential(t *testing.T) {
 				newNoopAuditClient(t),
 				coreapitesting.TestLocation,
 				true,
+				Limits{},
 			)
 
 			// Pre-populate the mock database with cluster and subscription
@@ -825,6 +830,7 @@ func TestRevokeCredentials(t *testing.T) {
 				newNoopAuditClient(t),
 				coreapitesting.TestLocation,
 				true,
+				Limits{},
 			)
 
 			// Pre-populate the mock database with cluster
@@ -906,6 +912,362 @@ func TestRevokeCredentials(t *testing.T) {
 	}
 }
 
+func TestClusterPowerStateActions(t *testing.T) {
+	tests := []struct {
+		name                  string
+		action                string
+		powerState            coreapi.PowerState
+		nodePoolState         coreapi.ProvisioningState
+		statusCode            int
+		expectedPowerState    coreapi.PowerState
+		expectedOperationType cosmosstorageutils.OperationRequest
+	}{
+		{
+			name:                  "stop running cluster",
+			action:                "stop",
+			statusCode:            http.StatusAccepted,
+			expectedPowerState:    coreapi.PowerStateStopping,
+			expectedOperationType: cosmosstorageutils.OperationRequestClusterStop,
+		},
+		{
+			name:               "stop stopped cluster conflicts",
+			action:             "stop",
+			powerState:         coreapi.PowerStateStopped,
+			statusCode:         http.StatusConflict,
+			expectedPowerState: coreapi.PowerStateStopped,
+		},
+		{
+			name:               "stop with node pool updating conflicts",
+			action:             "stop",
+			nodePoolState:      coreapi.ProvisioningStateUpdating,
+			statusCode:         http.StatusConflict,
+			expectedPowerState: "",
+		},
+		{
+			name:                  "start stopped cluster",
+			action:                "start",
+			powerState:            coreapi.PowerStateStopped,
+			statusCode:            http.StatusAccepted,
+			expectedPowerState:    coreapi.PowerStateStarting,
+			expectedOperationType: cosmosstorageutils.OperationRequestClusterStart,
+		},
+		{
+			name:                  "start after failed stop",
+			action:                "start",
+			powerState:            coreapi.PowerStateStopping,
+			statusCode:            http.StatusAccepted,
+			expectedPowerState:    coreapi.PowerStateStarting,
+			expectedOperationType: cosmosstorageutils.OperationRequestClusterStart,
+		},
+		{
+			name:               "start running cluster conflicts",
+			action:             "start",
+			powerState:         coreapi.PowerStateRunning,
+			statusCode:         http.StatusConflict,
+			expectedPowerState: coreapi.PowerStateRunning,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clusterResourceID := newClusterResourceID(t)
+			clusterInternalID := newClusterInternalID(t)
+
+			reg := prometheus.NewRegistry()
+			mockResourcesDBClient := corecosmosstoragetesting.NewMockResourcesDBClient()
+
+			f := NewFrontend(
+				testr.New(t),
+				nil,
+				nil,
+				reg,
+				reg,
+				mockResourcesDBClient,
+				nil,
+				newNoopAuditClient(t),
+				coreapitesting.TestLocation,
+				true,
+				Limits{},
+			)
+
+			ctx := utils.ContextWithLogger(t.Context(), testr.New(t))
+
+			cluster := &coreapi.HCPOpenShiftCluster{
+				CosmosMetadata: coreapi.CosmosMetadata{
+					ResourceID:   clusterResourceID,
+					PartitionKey: strings.ToLower(clusterResourceID.SubscriptionID),
+				},
+				TrackedResource: coreapi.TrackedResource{
+					Resource: coreapi.Resource{
+						ID: clusterResourceID,
+					},
+				},
+				ServiceProviderProperties: coreapi.HCPOpenShiftClusterServiceProviderProperties{
+					ProvisioningState: coreapi.ProvisioningStateSucceeded,
+					ClusterServiceID:  &clusterInternalID,
+					PowerState:        test.powerState,
+				},
+			}
+			_, err := mockResourcesDBClient.HCPClusters(clusterResourceID.SubscriptionID, clusterResourceID.ResourceGroupName).Create(ctx, cluster, nil)
+			require.NoError(t, err)
+
+			if len(test.nodePoolState) > 0 {
+				nodePoolResourceID := metadataapi.Must(azcorearm.ParseResourceID(clusterResourceID.String() + "/nodePools/np1"))
+				nodePool := &coreapi.HCPOpenShiftClusterNodePool{
+					CosmosMetadata: coreapi.CosmosMetadata{
+						ResourceID:   nodePoolResourceID,
+						PartitionKey: strings.ToLower(nodePoolResourceID.SubscriptionID),
+					},
+					TrackedResource: coreapi.TrackedResource{
+						Resource: coreapi.Resource{
+							ID:   nodePoolResourceID,
+							Name: nodePoolResourceID.Name,
+						},
+					},
+					Properties: coreapi.HCPOpenShiftClusterNodePoolProperties{
+						ProvisioningState: test.nodePoolState,
+					},
+				}
+				_, err := mockResourcesDBClient.HCPClusters(clusterResourceID.SubscriptionID, clusterResourceID.ResourceGroupName).NodePools(clusterResourceID.Name).Create(ctx, nodePool, nil)
+				require.NoError(t, err)
+			}
+
+			subs := map[string]*coreapi.Subscription{
+				coreapitesting.TestSubscriptionID: newTestSubscription(coreapitesting.TestSubscriptionID, coreapi.SubscriptionStateRegistered, nil),
+			}
+			ts := newHTTPServer(ctx, f, mockResourcesDBClient, subs)
+
+			url := ts.URL + path.Join(clusterResourceID.String(), test.action) + "?api-version=" + coreapitesting.TestAPIVersion
+			resp, err := ts.Client().Post(url, "", nil)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, test.statusCode, resp.StatusCode)
+
+			updated, err := mockResourcesDBClient.HCPClusters(clusterResourceID.SubscriptionID, clusterResourceID.ResourceGroupName).Get(ctx, clusterResourceID.Name)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPowerState, updated.ServiceProviderProperties.PowerState)
+
+			if test.statusCode == http.StatusAccepted {
+				assert.Equal(t, coreapi.ProvisioningStateAccepted, updated.ServiceProviderProperties.ProvisioningState)
+				operation, err := mockResourcesDBClient.Operations(clusterResourceID.SubscriptionID).Get(ctx, updated.ServiceProviderProperties.ActiveOperationID)
+				require.NoError(t, err)
+				assert.Equal(t, test.expectedOperationType, operation.Request)
+			}
+		})
+	}
+}
+
+func TestOperationCancel(t *testing.T) {
+	tests := []struct {
+		name                    string
+		request                 cosmosstorageutils.OperationRequest
+		nodePool                bool
+		operationStatus         coreapi.ProvisioningState
+		clusterHasCSID          bool
+		notActive               bool
+		statusCode              int
+		expectedResourceState   coreapi.ProvisioningState
+		expectDeletionTimestamp bool
+	}{
+		{
+			name:                    "cluster create before Cluster Service is rolled back",
+			request:                 cosmosstorageutils.OperationRequestCreate,
+			operationStatus:         coreapi.ProvisioningStateAccepted,
+			statusCode:              http.StatusOK,
+			expectedResourceState:   coreapi.ProvisioningStateDeleting,
+			expectDeletionTimestamp: true,
+		},
+		{
+			name:            "cluster create accepted by Cluster Service conflicts",
+			request:         cosmosstorageutils.OperationRequestCreate,
+			operationStatus: coreapi.ProvisioningStateProvisioning,
+			clusterHasCSID:  true,
+			statusCode:      http.StatusConflict,
+		},
+		{
+			name:            "cluster update conflicts",
+			request:         cosmosstorageutils.OperationRequestUpdate,
+			operationStatus: coreapi.ProvisioningStateUpdating,
+			clusterHasCSID:  true,
+			statusCode:      http.StatusConflict,
+		},
+		{
+			name:                  "node pool update is canceled",
+			request:               cosmosstorageutils.OperationRequestUpdate,
+			nodePool:              true,
+			operationStatus:       coreapi.ProvisioningStateUpdating,
+			clusterHasCSID:        true,
+			statusCode:            http.StatusOK,
+			expectedResourceState: coreapi.ProvisioningStateCanceled,
+		},
+		{
+			name:            "node pool update that is no longer active conflicts",
+			request:         cosmosstorageutils.OperationRequestUpdate,
+			nodePool:        true,
+			operationStatus: coreapi.ProvisioningStateUpdating,
+			clusterHasCSID:  true,
+			notActive:       true,
+			statusCode:      http.StatusConflict,
+		},
+		{
+			name:            "terminal operation conflicts",
+			request:         cosmosstorageutils.OperationRequestUpdate,
+			nodePool:        true,
+			operationStatus: coreapi.ProvisioningStateSucceeded,
+			clusterHasCSID:  true,
+			statusCode:      http.StatusConflict,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clusterResourceID := newClusterResourceID(t)
+			nodePoolResourceID := metadataapi.Must(azcorearm.ParseResourceID(coreapitesting.TestNodePoolResourceID))
+
+			reg := prometheus.NewRegistry()
+			mockResourcesDBClient := corecosmosstoragetesting.NewMockResourcesDBClient()
+
+			f := NewFrontend(
+				testr.New(t),
+				nil,
+				nil,
+				reg,
+				reg,
+				mockResourcesDBClient,
+				nil,
+				newNoopAuditClient(t),
+				coreapitesting.TestLocation,
+				true,
+				Limits{},
+			)
+
+			ctx := utils.ContextWithLogger(t.Context(), testr.New(t))
+
+			operationName := uuid.New().String()
+			operationID := metadataapi.Must(azcorearm.ParseResourceID(coreapitesting.TestSubscriptionResourceID + "/providers/" + coreapi.ProviderNamespace + "/locations/" + coreapitesting.TestLocation + "/" + coreapi.OperationStatusResourceTypeName + "/" + operationName))
+			activeOperationID := operationName
+			if test.notActive {
+				activeOperationID = uuid.New().String()
+			}
+
+			cluster := &coreapi.HCPOpenShiftCluster{
+				CosmosMetadata: coreapi.CosmosMetadata{
+					ResourceID:   clusterResourceID,
+					PartitionKey: strings.ToLower(clusterResourceID.SubscriptionID),
+				},
+				TrackedResource: coreapi.TrackedResource{
+					Resource: coreapi.Resource{
+						ID: clusterResourceID,
+					},
+				},
+				ServiceProviderProperties: coreapi.HCPOpenShiftClusterServiceProviderProperties{
+					ProvisioningState: test.operationStatus,
+				},
+			}
+			if test.clusterHasCSID {
+				clusterInternalID := newClusterInternalID(t)
+				cluster.ServiceProviderProperties.ClusterServiceID = &clusterInternalID
+			}
+			externalID := clusterResourceID
+			if test.nodePool {
+				cluster.ServiceProviderProperties.ProvisioningState = coreapi.ProvisioningStateSucceeded
+				externalID = nodePoolResourceID
+
+				nodePool := &coreapi.HCPOpenShiftClusterNodePool{
+					CosmosMetadata: coreapi.CosmosMetadata{
+						ResourceID:   nodePoolResourceID,
+						PartitionKey: strings.ToLower(nodePoolResourceID.SubscriptionID),
+					},
+					TrackedResource: coreapi.TrackedResource{
+						Resource: coreapi.Resource{
+							ID: nodePoolResourceID,
+						},
+					},
+					Properties: coreapi.HCPOpenShiftClusterNodePoolProperties{
+						ProvisioningState: test.operationStatus,
+						Replicas:          5,
+					},
+					ServiceProviderProperties: coreapi.HCPOpenShiftClusterNodePoolServiceProviderProperties{
+						ActiveOperationID: activeOperationID,
+						UpdateRollback: &coreapi.NodePoolUpdateRollback{
+							OperationID: activeOperationID,
+							Properties: coreapi.HCPOpenShiftClusterNodePoolProperties{
+								ProvisioningState: coreapi.ProvisioningStateSucceeded,
+								Replicas:          3,
+							},
+						},
+					},
+				}
+				_, err := mockResourcesDBClient.HCPClusters(clusterResourceID.SubscriptionID, clusterResourceID.ResourceGroupName).NodePools(clusterResourceID.Name).Create(ctx, nodePool, nil)
+				require.NoError(t, err)
+			} else {
+				cluster.ServiceProviderProperties.ActiveOperationID = activeOperationID
+			}
+			_, err := mockResourcesDBClient.HCPClusters(clusterResourceID.SubscriptionID, clusterResourceID.ResourceGroupName).Create(ctx, cluster, nil)
+			require.NoError(t, err)
+
+			operation := &coreapi.Operation{
+				CosmosMetadata: coreapi.CosmosMetadata{
+					ResourceID:   operationID,
+					PartitionKey: strings.ToLower(operationID.SubscriptionID),
+				},
+				OperationID:     operationID,
+				Request:         test.request,
+				ExternalID:      externalID,
+				Status:          test.operationStatus,
+				NotificationURI: "https://arm.example.com/notify",
+			}
+			_, err = mockResourcesDBClient.Operations(operationID.SubscriptionID).Create(ctx, operation, nil)
+			require.NoError(t, err)
+
+			subs := map[string]*coreapi.Subscription{
+				coreapitesting.TestSubscriptionID: newTestSubscription(coreapitesting.TestSubscriptionID, coreapi.SubscriptionStateRegistered, nil),
+			}
+			ts := newHTTPServer(ctx, f, mockResourcesDBClient, subs)
+
+			url := ts.URL + path.Join(operationID.String(), ActionCancel) + "?api-version=" + coreapitesting.TestAPIVersion
+			resp, err := ts.Client().Post(url, "", nil)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, test.statusCode, resp.StatusCode, string(body))
+
+			resultingOperation, err := mockResourcesDBClient.Operations(operationID.SubscriptionID).Get(ctx, operationName)
+			require.NoError(t, err)
+			if test.statusCode != http.StatusOK {
+				assert.Equal(t, test.operationStatus, resultingOperation.Status)
+				return
+			}
+			assert.Equal(t, coreapi.ProvisioningStateCanceled, resultingOperation.Status)
+			assert.Empty(t, resultingOperation.NotificationURI)
+
+			notification, err := mockResourcesDBClient.OperationNotifications(operationID.SubscriptionID).Get(ctx, operationName)
+			require.NoError(t, err)
+			assert.Equal(t, "https://arm.example.com/notify", notification.Spec.NotificationURI)
+			assert.True(t, notification.IsPending())
+
+			if test.nodePool {
+				resultingNodePool, err := mockResourcesDBClient.HCPClusters(clusterResourceID.SubscriptionID, clusterResourceID.ResourceGroupName).NodePools(clusterResourceID.Name).Get(ctx, nodePoolResourceID.Name)
+				require.NoError(t, err)
+				assert.Equal(t, test.expectedResourceState, resultingNodePool.Properties.ProvisioningState)
+				assert.Equal(t, int32(3), resultingNodePool.Properties.Replicas)
+				assert.Empty(t, resultingNodePool.ServiceProviderProperties.ActiveOperationID)
+				assert.Nil(t, resultingNodePool.ServiceProviderProperties.UpdateRollback)
+				return
+			}
+
+			resultingCluster, err := mockResourcesDBClient.HCPClusters(clusterResourceID.SubscriptionID, clusterResourceID.ResourceGroupName).Get(ctx, clusterResourceID.Name)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResourceState, resultingCluster.ServiceProviderProperties.ProvisioningState)
+			assert.Empty(t, resultingCluster.ServiceProviderProperties.ActiveOperationID)
+			assert.Equal(t, test.expectDeletionTimestamp, resultingCluster.ServiceProviderProperties.DeletionTimestamp != nil)
+			assert.True(t, resultingCluster.ServiceProviderProperties.UsesNewClusterDeletionApproach)
+		})
+	}
+}
+
 func lintMetrics(t *testing.T, r prometheus.Gatherer) {
 	t.Helper()
 
@@ -96,6 +96,27 @@ func checkForProvisioningStateConflict(
 				"Cannot revoke credentials while resource is %q",
 				strings.ToLower(string(provisioningState)))
 		}
+	case cosmosstorageutils.OperationRequestNodePoolReplace:
+		if !provisioningState.IsTerminal() {
+			return coreapi.NewConflictError(
+				resourceID,
+				"Cannot replace resource while resource is %q",
+				strings.ToLower(string(provisioningState)))
+		}
+	case cosmosstorageutils.OperationRequestClusterStop:
+		if !provisioningState.IsTerminal() {
+			return coreapi.NewConflictError(
+				resourceID,
+				"Cannot stop resource while resource is %q",
+				strings.ToLower(string(provisioningState)))
+		}
+	case cosmosstorageutils.OperationRequestClusterStart:
+		if !provisioningState.IsTerminal() {
+			return coreapi.NewConflictError(
+				resourceID,
+				"Cannot start resource while resource is %q",
+				strings.ToLower(string(provisioningState)))
+		}
 	}
 
 	// For nested resource types, check the provisioning state of the parent cluster.
@@ -128,6 +149,22 @@ func checkForProvisioningStateConflict(
 				"Cannot %s resource while parent resource is deleting",
 				strings.ToLower(string(operationRequest)))
 		}
+
+		// Node pools of a stopped cluster are held at zero nodes until the
+		// cluster is started again, so their scaling cannot change meanwhile.
+		powerState := cluster.ServiceProviderProperties.PowerState
+		if strings.EqualFold(resourceID.ResourceType.String(), coreapi.NodePoolResourceType.String()) && !powerState.IsRunning() {
+			switch operationRequest {
+			case cosmosstorageutils.OperationRequestCreate,
+				cosmosstorageutils.OperationRequestUpdate,
+				cosmosstorageutils.OperationRequestNodePoolReplace:
+				return coreapi.NewConflictError(
+					resourceID,
+					"Cannot %s resource while parent resource is %q",
+					strings.ToLower(string(operationRequest)),
+					strings.ToLower(string(powerState)))
+			}
+		}
 	}
 
 	return nil
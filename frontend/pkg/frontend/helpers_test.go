@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -78,6 +79,18 @@ func TestCheckForProvisioningStateConflict(t *testing.T) {
 			operationRequest: cosmosstorageutils.OperationRequestSystemAdminCredentialRevocation,
 			directConflict:   func(s coreapi.ProvisioningState) bool { return !s.IsTerminal() },
 		},
+		{
+			name:             "Stop cluster",
+			resourceID:       coreapitesting.TestClusterResourceID,
+			operationRequest: cosmosstorageutils.OperationRequestClusterStop,
+			directConflict:   func(s coreapi.ProvisioningState) bool { return !s.IsTerminal() },
+		},
+		{
+			name:             "Start cluster",
+			resourceID:       coreapitesting.TestClusterResourceID,
+			operationRequest: cosmosstorageutils.OperationRequestClusterStart,
+			directConflict:   func(s coreapi.ProvisioningState) bool { return !s.IsTerminal() },
+		},
 		{
 			name:             "Create node pool",
 			resourceID:       coreapitesting.TestNodePoolResourceID,
@@ -206,3 +219,85 @@ func TestCheckForProvisioningStateConflict(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckForProvisioningStateConflictStoppedParent(t *testing.T) {
+	nodePoolResourceID, err := azcorearm.ParseResourceID(coreapitesting.TestNodePoolResourceID)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		powerState       coreapi.PowerState
+		operationRequest cosmosstorageutils.OperationRequest
+		expectConflict   bool
+	}{
+		{
+			name:             "Create node pool in running cluster",
+			powerState:       coreapi.PowerStateRunning,
+			operationRequest: cosmosstorageutils.OperationRequestCreate,
+		},
+		{
+			name:             "Create node pool in cluster without power state",
+			operationRequest: cosmosstorageutils.OperationRequestCreate,
+		},
+		{
+			name:             "Create node pool in stopped cluster",
+			powerState:       coreapi.PowerStateStopped,
+			operationRequest: cosmosstorageutils.OperationRequestCreate,
+			expectConflict:   true,
+		},
+		{
+			name:             "Update node pool in stopping cluster",
+			powerState:       coreapi.PowerStateStopping,
+			operationRequest: cosmosstorageutils.OperationRequestUpdate,
+			expectConflict:   true,
+		},
+		{
+			name:             "Replace node pool in starting cluster",
+			powerState:       coreapi.PowerStateStarting,
+			operationRequest: cosmosstorageutils.OperationRequestNodePoolReplace,
+			expectConflict:   true,
+		},
+		{
+			name:             "Delete node pool in stopped cluster",
+			powerState:       coreapi.PowerStateStopped,
+			operationRequest: cosmosstorageutils.OperationRequestDelete,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := utils.ContextWithLogger(context.Background(), testr.New(t))
+			mockResourcesDBClient := corecosmosstoragetesting.NewMockResourcesDBClient()
+
+			parentResourceID := nodePoolResourceID.Parent
+			clusterInternalID := metadataapi.Must(metadataapi.NewInternalID(ocm.GenerateOCMCommercialClusterHREF("testCluster")))
+			parentCluster := &coreapi.HCPOpenShiftCluster{
+				CosmosMetadata: coreapi.CosmosMetadata{
+					ResourceID:   parentResourceID,
+					PartitionKey: strings.ToLower(parentResourceID.SubscriptionID),
+				},
+				TrackedResource: coreapi.TrackedResource{
+					Resource: coreapi.Resource{
+						ID: parentResourceID,
+					},
+				},
+				ServiceProviderProperties: coreapi.HCPOpenShiftClusterServiceProviderProperties{
+					ProvisioningState: coreapi.ProvisioningStateSucceeded,
+					ClusterServiceID:  &clusterInternalID,
+					PowerState:        tt.powerState,
+				},
+			}
+			_, err := mockResourcesDBClient.HCPClusters(parentResourceID.SubscriptionID, parentResourceID.ResourceGroupName).Create(ctx, parentCluster, nil)
+			require.NoError(t, err)
+
+			err = checkForProvisioningStateConflict(ctx, mockResourcesDBClient, tt.operationRequest, nodePoolResourceID, coreapi.ProvisioningStateSucceeded)
+			if !tt.expectConflict {
+				require.NoError(t, err)
+				return
+			}
+			var cloudError *coreapi.CloudError
+			require.ErrorAs(t, err, &cloudError)
+			assert.Equal(t, http.StatusConflict, cloudError.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+)
+
+const (
+	listQueryParameterFilter  = "$filter"
+	listQueryParameterOrderBy = "$orderby"
+
+	// listFilterTagPrefix selects a tag value in a $filter expression, as in "tags/team eq 'payments'".
+	listFilterTagPrefix = "tags/"
+)
+
+// listFields maps the lowercase field names accepted in $filter and $orderby
+// expressions to their JSON path in the internal API type.
+type listFields map[string][]string
+
+var (
+	clusterListFields = listFields{
+		"name":                         {"name"},
+		"location":                     {"location"},
+		"properties/provisioningstate": {"serviceProviderProperties", "provisioningState"},
+		"properties/version/id":        {"customerProperties", "version", "id"},
+	}
+	nodePoolListFields = listFields{
+		"name":                         {"name"},
+		"location":                     {"location"},
+		"properties/provisioningstate": {"properties", "provisioningState"},
+		"properties/version/id":        {"properties", "version", "id"},
+	}
+)
+
+// path returns the JSON path for field. Tag keys are case-sensitive; every
+// other field name is matched case-insensitively.
+func (fields listFields) path(field string) ([]string, bool) {
+	if len(fields) == 0 {
+		return nil, false
+	}
+	if len(field) > len(listFilterTagPrefix) && strings.EqualFold(field[:len(listFilterTagPrefix)], listFilterTagPrefix) {
+		return []string{"tags", field[len(listFilterTagPrefix):]}, true
+	}
+	path, ok := fields[strings.ToLower(field)]
+	return path, ok
+}
+
+// newInvalidFilterError returns the ARM error for an unsupported $filter or
+// $orderby expression.
+func newInvalidFilterError(target, format string, a ...interface{}) *coreapi.CloudError {
+	return coreapi.NewCloudError(
+		http.StatusBadRequest,
+		coreapi.CloudErrorCodeInvalidFilter,
+		target, "Invalid %s expression: %s", target, fmt.Sprintf(format, a...))
+}
+
+type listFilterTokenKind int
+
+const (
+	listFilterTokenWord listFilterTokenKind = iota
+	listFilterTokenString
+	listFilterTokenOpenParen
+	listFilterTokenCloseParen
+	listFilterTokenComma
+)
+
+type listFilterToken struct {
+	kind  listFilterTokenKind
+	value string
+}
+
+// tokenizeListFilter splits an OData expression into words, single-quoted
+// string literals, in which two consecutive quotes escape a quote, and
+// punctuation.
+func tokenizeListFilter(expression string) ([]listFilterToken, error) {
+	var tokens []listFilterToken
+	for i := 0; i < len(expression); {
+		switch c := expression[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(':
+			tokens = append(tokens, listFilterToken{kind: listFilterTokenOpenParen, value: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, listFilterToken{kind: listFilterTokenCloseParen, value: ")"})
+			i++
+		case c == ',':
+			tokens = append(tokens, listFilterToken{kind: listFilterTokenComma, value: ","})
+			i++
+		case c == '\'':
+			var literal strings.Builder
+			i++
+			for {
+				if i >= len(expression) {
+					return nil, fmt.Errorf("unterminated string literal")
+				}
+				if expression[i] == '\'' {
+					if i+1 < len(expression) && expression[i+1] == '\'' {
+						literal.WriteByte('\'')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				literal.WriteByte(expression[i])
+				i++
+			}
+			tokens = append(tokens, listFilterToken{kind: listFilterTokenString, value: literal.String()})
+		default:
+			start := i
+			for i < len(expression) && !strings.ContainsRune(" \t(),'", rune(expression[i])) {
+				i++
+			}
+			tokens = append(tokens, listFilterToken{kind: listFilterTokenWord, value: expression[start:i]})
+		}
+	}
+	return tokens, nil
+}
+
+// parseListFilter parses the subset of OData $filter syntax supported by list
+// endpoints: comparisons of the form "<field> eq|ne '<value>'" and
+// "startswith(<field>, '<value>')", joined by "and".
+func parseListFilter(expression string, fields listFields) ([]cosmosstorageutils.ListFilter, error) {
+	tokens, err := tokenizeListFilter(expression)
+	if err != nil {
+		return nil, newInvalidFilterError(listQueryParameterFilter, "%v", err)
+	}
+	if len(tokens) == 0 {
+		return nil, newInvalidFilterError(listQueryParameterFilter, "expression is empty")
+	}
+
+	var filters []cosmosstorageutils.ListFilter
+	for {
+		filter, rest, err := parseListFilterComparison(tokens, fields)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+		if len(rest) == 0 {
+			return filters, nil
+		}
+		if rest[0].kind != listFilterTokenWord || !strings.EqualFold(rest[0].value, "and") {
+			return nil, newInvalidFilterError(listQueryParameterFilter, "expected 'and' but found %q; only 'and' is supported for combining conditions", rest[0].value)
+		}
+		tokens = rest[1:]
+	}
+}
+
+func parseListFilterComparison(tokens []listFilterToken, fields listFields) (cosmosstorageutils.ListFilter, []listFilterToken, error) {
+	expectKinds := func(kinds ...listFilterTokenKind) error {
+		if len(tokens) < len(kinds) {
+			return newInvalidFilterError(listQueryParameterFilter, "unexpected end of expression")
+		}
+		for i, kind := range kinds {
+			if tokens[i].kind != kind {
+				return newInvalidFilterError(listQueryParameterFilter, "unexpected %q", tokens[i].value)
+			}
+		}
+		return nil
+	}
+	fieldPath := func(field string) ([]string, error) {
+		path, ok := fields.path(field)
+		if !ok {
+			return nil, newInvalidFilterError(listQueryParameterFilter, "filtering on %q is not supported", field)
+		}
+		return path, nil
+	}
+
+	// startswith(<field>, '<value>')
+	if len(tokens) > 0 && tokens[0].kind == listFilterTokenWord && strings.EqualFold(tokens[0].value, "startswith") {
+		if err := expectKinds(listFilterTokenWord, listFilterTokenOpenParen, listFilterTokenWord, listFilterTokenComma, listFilterTokenString, listFilterTokenCloseParen); err != nil {
+			return cosmosstorageutils.ListFilter{}, nil, err
+		}
+		path, err := fieldPath(tokens[2].value)
+		if err != nil {
+			return cosmosstorageutils.ListFilter{}, nil, err
+		}
+		return cosmosstorageutils.ListFilter{
+			Path:     path,
+			Operator: cosmosstorageutils.ListFilterOperatorStartsWith,
+			Value:    tokens[4].value,
+		}, tokens[6:], nil
+	}
+
+	// <field> eq|ne '<value>'
+	if err := expectKinds(listFilterTokenWord, listFilterTokenWord, listFilterTokenString); err != nil {
+		return cosmosstorageutils.ListFilter{}, nil, err
+	}
+	path, err := fieldPath(tokens[0].value)
+	if err != nil {
+		return cosmosstorageutils.ListFilter{}, nil, err
+	}
+	var operator cosmosstorageutils.ListFilterOperator
+	switch strings.ToLower(tokens[1].value) {
+	case "eq":
+		operator = cosmosstorageutils.ListFilterOperatorEquals
+	case "ne":
+		operator = cosmosstorageutils.ListFilterOperatorNotEquals
+	default:
+		return cosmosstorageutils.ListFilter{}, nil, newInvalidFilterError(listQueryParameterFilter, "operator %q is not supported", tokens[1].value)
+	}
+	return cosmosstorageutils.ListFilter{
+		Path:     path,
+		Operator: operator,
+		Value:    tokens[2].value,
+	}, tokens[3:], nil
+}
+
+// parseListOrderBy parses an OData $orderby expression of the form
+// "<field> [asc|desc]". Sorting by more than one field is not supported.
+func parseListOrderBy(expression string, fields listFields) (*cosmosstorageutils.ListOrderBy, error) {
+	words := strings.Fields(expression)
+	if len(words) == 0 || len(words) > 2 {
+		return nil, newInvalidFilterError(listQueryParameterOrderBy, "expected a single field optionally followed by 'asc' or 'desc'")
+	}
+	path, ok := fields.path(words[0])
+	if !ok {
+		return nil, newInvalidFilterError(listQueryParameterOrderBy, "sorting by %q is not supported", words[0])
+	}
+
+	orderBy := &cosmosstorageutils.ListOrderBy{Path: path}
+	if len(words) == 2 {
+		switch strings.ToLower(words[1]) {
+		case "asc":
+		case "desc":
+			orderBy.Descending = true
+		default:
+			return nil, newInvalidFilterError(listQueryParameterOrderBy, "expected 'asc' or 'desc' but found %q", words[1])
+		}
+	}
+	return orderBy, nil
+}
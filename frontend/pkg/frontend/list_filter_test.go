@@ -0,0 +1,211 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+)
+
+func TestParseListFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		expression string
+		fields     listFields
+		want       []cosmosstorageutils.ListFilter
+		wantErr    bool
+	}{
+		{
+			name:       "cluster provisioning state",
+			expression: "properties/provisioningState eq 'Failed'",
+			fields:     clusterListFields,
+			want: []cosmosstorageutils.ListFilter{
+				{Path: []string{"serviceProviderProperties", "provisioningState"}, Operator: cosmosstorageutils.ListFilterOperatorEquals, Value: "Failed"},
+			},
+		},
+		{
+			name:       "node pool version",
+			expression: "properties/version/id NE '4.19.7'",
+			fields:     nodePoolListFields,
+			want: []cosmosstorageutils.ListFilter{
+				{Path: []string{"properties", "version", "id"}, Operator: cosmosstorageutils.ListFilterOperatorNotEquals, Value: "4.19.7"},
+			},
+		},
+		{
+			name:       "combined conditions",
+			expression: "startswith(name, 'prod-') and tags/Team eq 'O''Brien' and location eq 'eastus'",
+			fields:     clusterListFields,
+			want: []cosmosstorageutils.ListFilter{
+				{Path: []string{"name"}, Operator: cosmosstorageutils.ListFilterOperatorStartsWith, Value: "prod-"},
+				{Path: []string{"tags", "Team"}, Operator: cosmosstorageutils.ListFilterOperatorEquals, Value: "O'Brien"},
+				{Path: []string{"location"}, Operator: cosmosstorageutils.ListFilterOperatorEquals, Value: "eastus"},
+			},
+		},
+		{
+			name:       "empty",
+			expression: " ",
+			fields:     clusterListFields,
+			wantErr:    true,
+		},
+		{
+			name:       "unsupported field",
+			expression: "properties/dns/baseDomain eq 'example.com'",
+			fields:     clusterListFields,
+			wantErr:    true,
+		},
+		{
+			name:       "unsupported operator",
+			expression: "name gt 'a'",
+			fields:     clusterListFields,
+			wantErr:    true,
+		},
+		{
+			name:       "or is not supported",
+			expression: "name eq 'a' or name eq 'b'",
+			fields:     clusterListFields,
+			wantErr:    true,
+		},
+		{
+			name:       "unquoted value",
+			expression: "name eq a",
+			fields:     clusterListFields,
+			wantErr:    true,
+		},
+		{
+			name:       "unterminated string",
+			expression: "name eq 'a",
+			fields:     clusterListFields,
+			wantErr:    true,
+		},
+		{
+			name:       "incomplete startswith",
+			expression: "startswith(name, 'a'",
+			fields:     clusterListFields,
+			wantErr:    true,
+		},
+		{
+			name:       "no fields",
+			expression: "name eq 'a'",
+			fields:     nil,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseListFilter(tt.expression, tt.fields)
+			if tt.wantErr {
+				var cloudErr *coreapi.CloudError
+				require.ErrorAs(t, err, &cloudErr)
+				assert.Equal(t, http.StatusBadRequest, cloudErr.StatusCode)
+				assert.Equal(t, coreapi.CloudErrorCodeInvalidFilter, cloudErr.Code)
+				assert.Equal(t, listQueryParameterFilter, cloudErr.Target)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseListOrderBy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		expression string
+		want       *cosmosstorageutils.ListOrderBy
+		wantErr    bool
+	}{
+		{
+			name:       "default ascending",
+			expression: "name",
+			want:       &cosmosstorageutils.ListOrderBy{Path: []string{"name"}},
+		},
+		{
+			name:       "descending",
+			expression: "properties/version/id desc",
+			want:       &cosmosstorageutils.ListOrderBy{Path: []string{"customerProperties", "version", "id"}, Descending: true},
+		},
+		{
+			name:       "unsupported field",
+			expression: "systemData/createdAt",
+			wantErr:    true,
+		},
+		{
+			name:       "multiple fields",
+			expression: "name asc, location desc",
+			wantErr:    true,
+		},
+		{
+			name:       "invalid direction",
+			expression: "name up",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseListOrderBy(tt.expression, clusterListFields)
+			if tt.wantErr {
+				var cloudErr *coreapi.CloudError
+				require.ErrorAs(t, err, &cloudErr)
+				assert.Equal(t, coreapi.CloudErrorCodeInvalidFilter, cloudErr.Code)
+				assert.Equal(t, listQueryParameterOrderBy, cloudErr.Target)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDBListOptionsFromRequest(t *testing.T) {
+	t.Parallel()
+
+	query := url.Values{}
+	query.Set("$skipToken", "token")
+	query.Set("$top", "5")
+	query.Set("$filter", "properties/provisioningState eq 'Failed'")
+	query.Set("$orderby", "name desc")
+	request := httptest.NewRequest(http.MethodGet, "/clusters?"+query.Encode(), nil)
+
+	options, err := dbListOptionsFromRequest(request, clusterListFields)
+	require.NoError(t, err)
+	assert.Equal(t, "token", *options.ContinuationToken)
+	assert.Equal(t, int32(5), *options.PageSizeHint)
+	assert.Equal(t, []cosmosstorageutils.ListFilter{
+		{Path: []string{"serviceProviderProperties", "provisioningState"}, Operator: cosmosstorageutils.ListFilterOperatorEquals, Value: "Failed"},
+	}, options.Filters)
+	assert.Equal(t, &cosmosstorageutils.ListOrderBy{Path: []string{"name"}, Descending: true}, options.OrderBy)
+
+	// Resources without filterable fields reject $filter rather than ignoring it.
+	_, err = dbListOptionsFromRequest(request, nil)
+	var cloudErr *coreapi.CloudError
+	require.ErrorAs(t, err, &cloudErr)
+	assert.Equal(t, coreapi.CloudErrorCodeInvalidFilter, cloudErr.Code)
+}
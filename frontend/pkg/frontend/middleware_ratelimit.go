@@ -0,0 +1,212 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	utilsclock "k8s.io/utils/clock"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/utils"
+)
+
+const (
+	TooManyRequestsMessage = "Subscription '%s' has exceeded its %s request limit. Retry after %d seconds."
+
+	rateLimitKindRead  = "read"
+	rateLimitKindWrite = "write"
+
+	// rateLimiterSweepInterval bounds how often idle buckets are dropped so the
+	// limiter does not grow with every subscription that ever called us.
+	rateLimiterSweepInterval = 10 * time.Minute
+)
+
+// RateLimit is a token bucket: Burst requests may be served at once, after
+// which requests are admitted at RequestsPerSecond. A zero RequestsPerSecond
+// disables the limit.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+func (l RateLimit) enabled() bool {
+	return l.RequestsPerSecond > 0 && l.Burst > 0
+}
+
+// Limits configures per-subscription request rate limits and resource
+// quotas. The zero value disables all of them.
+type Limits struct {
+	// Reads limits GET requests of a single subscription.
+	Reads RateLimit
+	// Writes limits every other request of a single subscription.
+	Writes RateLimit
+
+	// MaxClustersPerSubscription caps the number of clusters a subscription
+	// may hold. Zero means unlimited.
+	MaxClustersPerSubscription int
+	// MaxNodePoolsPerSubscription caps the number of node pools a subscription
+	// may hold across all of its clusters. Zero means unlimited.
+	MaxNodePoolsPerSubscription int
+}
+
+type rateLimitKey struct {
+	subscriptionID string
+	clientClass    string
+	kind           string
+}
+
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+type middlewareRateLimit struct {
+	clock  utilsclock.PassiveClock
+	reads  RateLimit
+	writes RateLimit
+
+	throttledCounter *prometheus.CounterVec
+
+	mu        sync.Mutex
+	buckets   map[rateLimitKey]*tokenBucket
+	lastSweep time.Time
+}
+
+func newMiddlewareRateLimit(r prometheus.Registerer, clock utilsclock.PassiveClock, limits Limits) *middlewareRateLimit {
+	return &middlewareRateLimit{
+		clock:  clock,
+		reads:  limits.Reads,
+		writes: limits.Writes,
+		throttledCounter: promauto.With(r).NewCounterVec(
+			prometheus.CounterOpts{
+				Name: throttledRequestCounterName,
+				Help: "Counter for HTTP requests rejected by the per-subscription rate limiter, by kind and client_class.",
+			},
+			[]string{"kind", "client_class"},
+		),
+		buckets:   map[rateLimitKey]*tokenBucket{},
+		lastSweep: clock.Now(),
+	}
+}
+
+// handleRequest limits the request rate of each subscription. Every client
+// class of a subscription gets its own buckets so a hot-looping controller
+// cannot starve the customer's own portal or CLI requests. Reads and writes
+// are limited separately, as ARM does.
+func (h *middlewareRateLimit) handleRequest(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	subscriptionID := strings.ToLower(r.PathValue(PathSegmentSubscriptionID))
+	if subscriptionID == "" {
+		next(w, r)
+		return
+	}
+
+	kind, limit, header := rateLimitKindRead, h.reads, coreapi.HeaderNameRateLimitRemainingSubscriptionReads
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		kind, limit, header = rateLimitKindWrite, h.writes, coreapi.HeaderNameRateLimitRemainingSubscriptionWrites
+	}
+	if !limit.enabled() {
+		next(w, r)
+		return
+	}
+
+	class := clientClass(r.UserAgent())
+	allowed, remaining, retryAfter := h.take(rateLimitKey{
+		subscriptionID: subscriptionID,
+		clientClass:    class,
+		kind:           kind,
+	}, limit)
+
+	w.Header().Set(header, strconv.Itoa(remaining))
+	if allowed {
+		next(w, r)
+		return
+	}
+
+	utils.LoggerFromContext(r.Context()).Info("request throttled",
+		"subscriptionId", subscriptionID, "kind", kind, "clientClass", class, "retryAfter", retryAfter)
+	h.throttledCounter.With(prometheus.Labels{
+		"kind":         kind,
+		"client_class": class,
+	}).Inc()
+
+	retryAfterSeconds := int(math.Ceil(retryAfter.Seconds()))
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds))
+	coreapi.WriteError(w, http.StatusTooManyRequests,
+		coreapi.CloudErrorCodeTooManyRequests, "",
+		TooManyRequestsMessage,
+		subscriptionID, kind, retryAfterSeconds)
+}
+
+// take removes a token from the bucket of key. It returns whether the request
+// is allowed, the whole number of tokens left and, for a rejected request, how
+// long until the next token is available.
+func (h *middlewareRateLimit) take(key rateLimitKey, limit RateLimit) (bool, int, time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.clock.Now()
+	h.sweepLocked(now)
+
+	bucket, ok := h.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(limit.Burst), lastRefill: now}
+		h.buckets[key] = bucket
+	}
+	refillBucket(bucket, limit, now)
+
+	if bucket.tokens < 1 {
+		missing := 1 - bucket.tokens
+		return false, 0, time.Duration(missing / limit.RequestsPerSecond * float64(time.Second))
+	}
+	bucket.tokens--
+	return true, int(bucket.tokens), 0
+}
+
+// sweepLocked drops buckets that have refilled completely, since a new bucket
+// would be identical.
+func (h *middlewareRateLimit) sweepLocked(now time.Time) {
+	if now.Sub(h.lastSweep) < rateLimiterSweepInterval {
+		return
+	}
+	h.lastSweep = now
+	for key, bucket := range h.buckets {
+		limit := h.reads
+		if key.kind == rateLimitKindWrite {
+			limit = h.writes
+		}
+		refillBucket(bucket, limit, now)
+		if bucket.tokens >= float64(limit.Burst) {
+			delete(h.buckets, key)
+		}
+	}
+}
+
+func refillBucket(bucket *tokenBucket, limit RateLimit, now time.Time) {
+	elapsed := now.Sub(bucket.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	bucket.tokens = math.Min(float64(limit.Burst), bucket.tokens+elapsed*limit.RequestsPerSecond)
+	bucket.lastRefill = now
+}
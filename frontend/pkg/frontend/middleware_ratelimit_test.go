@@ -0,0 +1,120 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/apitesting/coreapitesting"
+)
+
+func TestMiddlewareRateLimit(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Now())
+	reg := prometheus.NewRegistry()
+	middleware := newMiddlewareRateLimit(reg, fakeClock, Limits{
+		Reads:  RateLimit{RequestsPerSecond: 1, Burst: 2},
+		Writes: RateLimit{RequestsPerSecond: 0.5, Burst: 1},
+	})
+
+	serve := func(method, subscriptionID, userAgent string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, "/", nil)
+		request.SetPathValue(PathSegmentSubscriptionID, subscriptionID)
+		request.Header.Set("User-Agent", userAgent)
+		writer := httptest.NewRecorder()
+		middleware.handleRequest(writer, request, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		return writer
+	}
+
+	// Reads are served up to the burst, then throttled.
+	writer := serve(http.MethodGet, coreapitesting.TestSubscriptionID, "")
+	assert.Equal(t, http.StatusOK, writer.Code)
+	assert.Equal(t, "1", writer.Header().Get(coreapi.HeaderNameRateLimitRemainingSubscriptionReads))
+	writer = serve(http.MethodGet, coreapitesting.TestSubscriptionID, "")
+	assert.Equal(t, http.StatusOK, writer.Code)
+	assert.Equal(t, "0", writer.Header().Get(coreapi.HeaderNameRateLimitRemainingSubscriptionReads))
+	writer = serve(http.MethodGet, coreapitesting.TestSubscriptionID, "")
+	require.Equal(t, http.StatusTooManyRequests, writer.Code)
+	assert.Equal(t, "1", writer.Header().Get("Retry-After"))
+	assert.Equal(t, "0", writer.Header().Get(coreapi.HeaderNameRateLimitRemainingSubscriptionReads))
+	assert.Contains(t, writer.Body.String(), coreapi.CloudErrorCodeTooManyRequests)
+
+	// Writes, other client classes and other subscriptions have their own buckets.
+	writer = serve(http.MethodPut, coreapitesting.TestSubscriptionID, "")
+	assert.Equal(t, http.StatusOK, writer.Code)
+	assert.Equal(t, "0", writer.Header().Get(coreapi.HeaderNameRateLimitRemainingSubscriptionWrites))
+	writer = serve(http.MethodGet, coreapitesting.TestSubscriptionID, "aso-controller/v2.13.0")
+	assert.Equal(t, http.StatusOK, writer.Code)
+	writer = serve(http.MethodGet, "00000000-0000-0000-0000-000000000001", "")
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	writer = serve(http.MethodDelete, coreapitesting.TestSubscriptionID, "")
+	require.Equal(t, http.StatusTooManyRequests, writer.Code)
+	assert.Equal(t, "2", writer.Header().Get("Retry-After"))
+
+	// Tokens are refilled over time.
+	fakeClock.SetTime(fakeClock.Now().Add(time.Second))
+	writer = serve(http.MethodGet, coreapitesting.TestSubscriptionID, "")
+	assert.Equal(t, http.StatusOK, writer.Code)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(middleware.throttledCounter.WithLabelValues(rateLimitKindRead, clientClassOther)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(middleware.throttledCounter.WithLabelValues(rateLimitKindWrite, clientClassOther)))
+}
+
+func TestMiddlewareRateLimitDisabled(t *testing.T) {
+	middleware := newMiddlewareRateLimit(prometheus.NewRegistry(), clocktesting.NewFakePassiveClock(time.Now()), Limits{})
+
+	for range 10 {
+		request := httptest.NewRequest(http.MethodPut, "/", nil)
+		request.SetPathValue(PathSegmentSubscriptionID, coreapitesting.TestSubscriptionID)
+		writer := httptest.NewRecorder()
+		middleware.handleRequest(writer, request, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+		require.Equal(t, http.StatusOK, writer.Code)
+		assert.Empty(t, writer.Header().Get(coreapi.HeaderNameRateLimitRemainingSubscriptionWrites))
+	}
+}
+
+func TestMiddlewareRateLimitSweep(t *testing.T) {
+	fakeClock := clocktesting.NewFakePassiveClock(time.Now())
+	middleware := newMiddlewareRateLimit(prometheus.NewRegistry(), fakeClock, Limits{
+		Reads: RateLimit{RequestsPerSecond: 1, Burst: 1},
+	})
+
+	key := rateLimitKey{subscriptionID: coreapitesting.TestSubscriptionID, clientClass: clientClassOther, kind: rateLimitKindRead}
+	allowed, _, _ := middleware.take(key, middleware.reads)
+	require.True(t, allowed)
+	require.Len(t, middleware.buckets, 1)
+
+	fakeClock.SetTime(fakeClock.Now().Add(rateLimiterSweepInterval))
+	otherKey := rateLimitKey{subscriptionID: "00000000-0000-0000-0000-000000000001", clientClass: clientClassOther, kind: rateLimitKindRead}
+	allowed, _, _ = middleware.take(otherKey, middleware.reads)
+	require.True(t, allowed)
+	assert.Len(t, middleware.buckets, 1)
+	assert.Contains(t, middleware.buckets, otherKey)
+}
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"maps"
 	"net/http"
+	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -36,6 +38,7 @@ import (
 	"github.com/Azure/ARO-HCP/internal/conversion"
 	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/corecosmosstorage"
 	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/ocm"
 	"github.com/Azure/ARO-HCP/internal/utils"
 	"github.com/Azure/ARO-HCP/internal/validation"
 )
@@ -88,7 +91,11 @@ func (f *Frontend) ArmResourceListNodePools(writer http.ResponseWriter, request
 
 	pagedResponse := coreapi.NewPagedResponse()
 
-	internalNodePoolIterator, err := f.resourcesDBClient.HCPClusters(subscriptionID, resourceGroupName).NodePools(clusterName).List(ctx, dbListOptionsFromRequest(request))
+	listOptions, err := dbListOptionsFromRequest(request, nodePoolListFields)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	internalNodePoolIterator, err := f.resourcesDBClient.HCPClusters(subscriptionID, resourceGroupName).NodePools(clusterName).List(ctx, listOptions)
 	if err != nil {
 		return utils.TrackError(err)
 	}
@@ -239,14 +246,27 @@ func (f *Frontend) newNodePoolAdmissionContext(ctx context.Context, op operation
 		}
 	}
 
-	return &admission.NodePoolAdmissionContext{
+	admissionContext := &admission.NodePoolAdmissionContext{
 		Clock:                   f.clock,
 		Subscription:            subscription,
 		OriginalNodePool:        originalNodePool.DeepCopy(),
 		Cluster:                 cluster,
 		ServiceProviderCluster:  spCluster,
 		ServiceProviderNodePool: spNodePool,
-	}, nil
+		Quota:                   f.subscriptionQuota(),
+	}
+
+	// Counting node pools costs a cross-cluster query over the subscription
+	// partition, so only do it when there is a quota to check against.
+	if op.Type == operation.Create && admissionContext.Quota.MaxNodePools > 0 {
+		count, err := f.resourcesDBClient.CountNodePools(ctx, cluster.ID.SubscriptionID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot count node pools for node pool admission: %w", err)
+		}
+		admissionContext.SubscriptionNodePoolCount = count
+	}
+
+	return admissionContext, nil
 }
 
 func (f *Frontend) createNodePool(writer http.ResponseWriter, request *http.Request) error {
@@ -266,6 +286,11 @@ func (f *Frontend) createNodePool(writer http.ResponseWriter, request *http.Requ
 		return utils.TrackError(err)
 	}
 
+	dryRun, err := dryRunFromRequest(request)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
 	newInternalNodePool, err := decodeDesiredNodePoolCreate(ctx, f.azureLocation)
 	if err != nil {
 		return utils.TrackError(err)
@@ -304,12 +329,19 @@ func (f *Frontend) createNodePool(writer http.ResponseWriter, request *http.Requ
 	if err := coreapi.CloudErrorFromFieldErrors(validationErrs); err != nil {
 		return utils.TrackError(err)
 	}
+	if err := admission.AdmitNodePoolQuota(ctx, admissionContext, restOperation, newInternalNodePool); err != nil {
+		return utils.TrackError(err)
+	}
 
 	logger.Info(fmt.Sprintf("creating resource %s", resourceID))
 	if err := checkForProvisioningStateConflict(ctx, f.resourcesDBClient, cosmosstorageutils.OperationRequestCreate, newInternalNodePool.ID, newInternalNodePool.Properties.ProvisioningState); err != nil {
 		return utils.TrackError(err)
 	}
 
+	if dryRun {
+		return writeDryRunResponse(writer, newInternalNodePool.ID, nil, versionedInterface.NewHCPOpenShiftClusterNodePool(newInternalNodePool))
+	}
+
 	transaction := f.resourcesDBClient.NewTransaction(newInternalNodePool.ID.SubscriptionID)
 
 	createNodePoolOperation := cosmosstorageutils.NewOperation(
@@ -530,6 +562,11 @@ func (f *Frontend) patchNodePool(writer http.ResponseWriter, request *http.Reque
 func (f *Frontend) updateNodePoolInCosmos(ctx context.Context, writer http.ResponseWriter, request *http.Request, httpStatusCode int, newInternalNodePool, oldInternalNodePool *coreapi.HCPOpenShiftClusterNodePool) error {
 	logger := utils.LoggerFromContext(ctx)
 
+	dryRun, err := dryRunFromRequest(request)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
 	subscription, err := f.resourcesDBClient.Subscriptions().Get(ctx, oldInternalNodePool.ID.SubscriptionID)
 	if err != nil {
 		return err
@@ -580,6 +617,19 @@ func (f *Frontend) updateNodePoolInCosmos(ctx context.Context, writer http.Respo
 		return utils.TrackError(err)
 	}
 
+	if dryRun {
+		return writeDryRunResponse(writer, newInternalNodePool.ID,
+			versionedInterface.NewHCPOpenShiftClusterNodePool(oldInternalNodePool),
+			versionedInterface.NewHCPOpenShiftClusterNodePool(newInternalNodePool))
+	}
+
+	changes, err := diffResourceProperties(
+		versionedInterface.NewHCPOpenShiftClusterNodePool(oldInternalNodePool),
+		versionedInterface.NewHCPOpenShiftClusterNodePool(newInternalNodePool))
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
 	logger.Info(fmt.Sprintf("updating resource %s", oldInternalNodePool.ID))
 
 	// The cosmos representation the new desired version
@@ -602,9 +652,15 @@ func (f *Frontend) updateNodePoolInCosmos(ctx context.Context, writer http.Respo
 		return utils.TrackError(err)
 	}
 
+	err = f.addResourceChangeToTransaction(ctx, transaction, newInternalNodePool.ID, nodePoolUpdateOperation.ResourceID.Name, newInternalNodePool.SystemData, correlationData, changes)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
 	// set fields that were not known until the operation doc instance was created.
 	// TODO once we we have separate creation/validation of operation documents, this can be done ahead of time.
 	newInternalNodePool.ServiceProviderProperties.ActiveOperationID = nodePoolUpdateOperation.ResourceID.Name
+	newInternalNodePool.ServiceProviderProperties.UpdateRollback = newNodePoolUpdateRollback(oldInternalNodePool, nodePoolUpdateOperation.ResourceID.Name)
 	newInternalNodePool.Properties.ProvisioningState = nodePoolUpdateOperation.Status
 
 	_, err = f.resourcesDBClient.HCPClusters(newInternalNodePool.ID.SubscriptionID, newInternalNodePool.ID.ResourceGroupName).
@@ -767,6 +823,247 @@ func (f *Frontend) addDeleteNodePoolToTransaction(ctx context.Context, writer ht
 	return nil
 }
 
+// ArmResourceActionReplaceNodePool replaces the Azure infrastructure of a node
+// pool with a new platform profile, which is otherwise immutable. The backend
+// creates a surge node pool with the new platform, waits for it to be ready,
+// and then drains and deletes the previous node pool. The request body is the
+// new platform profile.
+func (f *Frontend) ArmResourceActionReplaceNodePool(writer http.ResponseWriter, request *http.Request) error {
+	const operationRequest = cosmosstorageutils.OperationRequestNodePoolReplace
+
+	ctx := request.Context()
+	logger := utils.LoggerFromContext(ctx)
+
+	resourceID, err := utils.ResourceIDFromContext(ctx)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	// Parent resource is the node pool.
+	nodePoolResourceID := resourceID.Parent
+
+	correlationData, err := CorrelationDataFromContext(ctx)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	nodePool, err := f.getInternalNodePoolFromStorage(ctx, nodePoolResourceID)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	// CheckForProvisioningStateConflict does not log conflict errors
+	// but does log unexpected errors like database failures.
+	if err := checkForProvisioningStateConflict(ctx, f.resourcesDBClient, operationRequest, nodePool.ID, nodePool.Properties.ProvisioningState); err != nil {
+		return utils.TrackError(err)
+	}
+	if nodePool.ServiceProviderProperties.ClusterServiceID == nil {
+		return utils.TrackError(fmt.Errorf("node pool %s has no ClusterServiceID", nodePool.ID))
+	}
+
+	platform, err := decodeNodePoolReplacementPlatform(ctx, nodePool)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	subscription, err := f.resourcesDBClient.Subscriptions().Get(ctx, nodePool.ID.SubscriptionID)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	versionedInterface, err := VersionFromContext(ctx)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	// Validate the node pool as it will be once the replacement completes.
+	// Platform fields are immutable on update, so validate it as a create.
+	restOperation := operation.Operation{
+		Type:    operation.Create,
+		Options: validation.BuildValidationOptions(subscription.GetRegisteredFeatures(), metadataapi.APIVersion(versionedInterface.String())),
+	}
+	replacedNodePool := nodePool.DeepCopy()
+	replacedNodePool.Properties.Platform = *platform
+	if err := coreapi.CloudErrorFromFieldErrors(validation.ValidateNodePool(ctx, restOperation, replacedNodePool, nil)); err != nil {
+		return utils.TrackError(err)
+	}
+	if reflect.DeepEqual(*platform, nodePool.Properties.Platform) {
+		return coreapi.NewCloudError(
+			http.StatusBadRequest,
+			coreapi.CloudErrorCodeInvalidRequestContent,
+			"", "The requested platform matches the current platform of node pool %s", nodePool.ID.Name)
+	}
+
+	// The surge node pool needs a Cluster Service ID that no other node pool
+	// in the cluster uses or is about to use.
+	surgeName := ocm.ReplacementNodePoolName(nodePool.Name, nodePool.ServiceProviderProperties.ClusterServiceID)
+	nodePoolIterator, err := f.resourcesDBClient.HCPClusters(nodePool.ID.SubscriptionID, nodePool.ID.ResourceGroupName).NodePools(nodePool.ID.Parent.Name).List(ctx, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	for _, clusterNodePool := range nodePoolIterator.Items(ctx) {
+		if strings.EqualFold(clusterNodePool.Name, nodePool.Name) {
+			continue
+		}
+		if nodePoolUsesClusterServiceName(clusterNodePool, surgeName) {
+			return coreapi.NewConflictError(nodePool.ID,
+				"Cannot replace node pool %s while node pool %s exists; rename or delete node pool %s first",
+				nodePool.Name, clusterNodePool.Name, clusterNodePool.Name)
+		}
+	}
+	if err := nodePoolIterator.GetError(); err != nil {
+		return utils.TrackError(err)
+	}
+
+	logger.Info(fmt.Sprintf("replacing resource %s", nodePool.ID))
+
+	transaction := f.resourcesDBClient.NewTransaction(nodePool.ID.SubscriptionID)
+
+	operationDoc := cosmosstorageutils.NewOperation(
+		operationRequest,
+		nodePool.ID,
+		*nodePool.ServiceProviderProperties.ClusterServiceID,
+		f.azureLocation,
+		request.Header.Get(coreapi.HeaderNameHomeTenantID),
+		request.Header.Get(coreapi.HeaderNameClientObjectID),
+		request.Header.Get(coreapi.HeaderNameAsyncNotificationURI),
+		correlationData)
+
+	transaction.OnSuccess(addOperationResponseHeaders(writer, request, operationDoc.NotificationURI, operationDoc.OperationID))
+	_, err = f.resourcesDBClient.Operations(operationDoc.OperationID.SubscriptionID).AddCreateToTransaction(ctx, transaction, operationDoc, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	nodePool.ServiceProviderProperties.ActiveOperationID = operationDoc.ResourceID.Name
+	nodePool.ServiceProviderProperties.Replacement = &coreapi.NodePoolReplacement{
+		OperationID: operationDoc.ResourceID.Name,
+		Platform:    *platform,
+		Phase:       coreapi.NodePoolReplacementPhaseCreatingSurgeNodePool,
+	}
+	nodePool.Properties.ProvisioningState = operationDoc.Status
+
+	_, err = f.resourcesDBClient.HCPClusters(nodePool.ID.SubscriptionID, nodePool.ID.ResourceGroupName).
+		NodePools(nodePool.ID.Parent.Name).
+		AddReplaceToTransaction(ctx, transaction, nodePool, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	_, err = transaction.Execute(ctx, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	writer.WriteHeader(http.StatusAccepted)
+	return nil
+}
+
+// decodeNodePoolReplacementPlatform decodes the platform profile in the body of
+// a node pool replace request. Fields omitted from the request take the API
+// version's defaults, except the subnet, which defaults to the current subnet.
+func decodeNodePoolReplacementPlatform(ctx context.Context, nodePool *coreapi.HCPOpenShiftClusterNodePool) (*coreapi.NodePoolPlatformProfile, error) {
+	versionedInterface, err := VersionFromContext(ctx)
+	if err != nil {
+		return nil, utils.TrackError(err)
+	}
+	body, err := BodyFromContext(ctx)
+	if err != nil {
+		return nil, utils.TrackError(err)
+	}
+	if len(body) == 0 {
+		return nil, coreapi.NewInvalidRequestContentError(fmt.Errorf("request body must contain a platform profile"))
+	}
+
+	// Decode the platform as part of a node pool so the versioned type's
+	// defaulting and conversion apply.
+	nodePoolBody, err := json.Marshal(map[string]any{
+		"properties": map[string]json.RawMessage{"platform": body},
+	})
+	if err != nil {
+		return nil, coreapi.NewInvalidRequestContentError(err)
+	}
+	externalNodePoolFromRequest := versionedInterface.NewHCPOpenShiftClusterNodePool(nil)
+	if err := json.Unmarshal(nodePoolBody, &externalNodePoolFromRequest); err != nil {
+		return nil, coreapi.NewInvalidRequestContentError(err)
+	}
+	newInternalNodePool, err := externalNodePoolFromRequest.ConvertToInternal(nodePool)
+	if err != nil {
+		return nil, utils.TrackError(err)
+	}
+	// Backstop for fields unknown to this API version's SetDefaultValues*.
+	// See docs/api-version-defaults-and-storage.md.
+	newInternalNodePool.EnsureDefaults()
+
+	platform := newInternalNodePool.Properties.Platform.DeepCopy()
+	if platform.SubnetID == nil {
+		platform.SubnetID = nodePool.Properties.Platform.SubnetID
+	}
+	return platform, nil
+}
+
+// nodePoolUsesClusterServiceName returns true if nodePool owns, or is about to
+// own, a Cluster Service node pool with the given ID.
+func nodePoolUsesClusterServiceName(nodePool *coreapi.HCPOpenShiftClusterNodePool, csNodePoolName string) bool {
+	names := []string{strings.ToLower(nodePool.Name)}
+	internalIDs := []*metadataapi.InternalID{nodePool.ServiceProviderProperties.ClusterServiceID}
+	if replacement := nodePool.ServiceProviderProperties.Replacement; replacement != nil && !replacement.Phase.IsTerminal() {
+		internalIDs = append(internalIDs, replacement.SurgeClusterServiceID, replacement.PreviousClusterServiceID)
+		if replacement.SurgeClusterServiceID == nil {
+			names = append(names, ocm.ReplacementNodePoolName(nodePool.Name, nodePool.ServiceProviderProperties.ClusterServiceID))
+		}
+	}
+	for _, internalID := range internalIDs {
+		if internalID != nil {
+			names = append(names, internalID.ID())
+		}
+	}
+	return slices.Contains(names, csNodePoolName)
+}
+
+// newNodePoolUpdateRollback records the properties a new update operation
+// replaces. An update that supersedes another update still in flight keeps
+// the properties from before the superseded one, since those are the last
+// ones the customer did not cancel.
+func newNodePoolUpdateRollback(oldNodePool *coreapi.HCPOpenShiftClusterNodePool, operationName string) *coreapi.NodePoolUpdateRollback {
+	rollback := oldNodePool.ServiceProviderProperties.UpdateRollback.DeepCopy()
+	if rollback == nil || rollback.OperationID != oldNodePool.ServiceProviderProperties.ActiveOperationID {
+		rollback = &coreapi.NodePoolUpdateRollback{
+			Properties: *oldNodePool.Properties.DeepCopy(),
+		}
+	}
+	rollback.OperationID = operationName
+	return rollback
+}
+
+// addCancelNodePoolUpdateToTransaction restores the node pool properties from
+// before the update. The backend pod then dispatches the restored
+// configuration to Cluster Service, which reverts any part of the update
+// Cluster Service had already accepted.
+func (f *Frontend) addCancelNodePoolUpdateToTransaction(ctx context.Context, transaction cosmosstorageutils.DBTransaction, operation *coreapi.Operation) error {
+	nodePool, err := f.getInternalNodePoolFromStorage(ctx, operation.ExternalID)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	if nodePool.ServiceProviderProperties.ActiveOperationID != operation.ResourceID.Name {
+		return coreapi.NewConflictError(operation.OperationID, "Operation is no longer the active operation on resource %s", nodePool.ID)
+	}
+
+	if rollback := nodePool.ServiceProviderProperties.UpdateRollback; rollback != nil && rollback.OperationID == operation.ResourceID.Name {
+		nodePool.Properties = rollback.Properties
+	}
+	nodePool.ServiceProviderProperties.ActiveOperationID = ""
+	nodePool.ServiceProviderProperties.UpdateRollback = nil
+	nodePool.Properties.ProvisioningState = coreapi.ProvisioningStateCanceled
+
+	_, err = f.resourcesDBClient.HCPClusters(nodePool.ID.SubscriptionID, nodePool.ID.ResourceGroupName).
+		NodePools(nodePool.ID.Parent.Name).
+		AddReplaceToTransaction(ctx, transaction, nodePool, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	return nil
+}
+
 func (f *Frontend) getInternalNodePoolFromStorage(ctx context.Context, resourceID *azcorearm.ResourceID) (*coreapi.HCPOpenShiftClusterNodePool, error) {
 	internalNodePool, err := f.resourcesDBClient.HCPClusters(resourceID.SubscriptionID, resourceID.ResourceGroupName).NodePools(resourceID.Parent.Name).Get(ctx, resourceID.Name)
 	if cosmosstorageutils.IsNotFoundError(err) {
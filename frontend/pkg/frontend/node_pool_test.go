@@ -0,0 +1,161 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+)
+
+func TestNodePoolUsesClusterServiceName(t *testing.T) {
+	internalID := func(name string) *metadataapi.InternalID {
+		id, err := metadataapi.NewInternalID("/api/aro_hcp/v1alpha1/clusters/abc123/node_pools/" + name)
+		require.NoError(t, err)
+		return &id
+	}
+
+	newNodePool := func(replacement *coreapi.NodePoolReplacement) *coreapi.HCPOpenShiftClusterNodePool {
+		nodePool := &coreapi.HCPOpenShiftClusterNodePool{}
+		nodePool.Name = "Workers"
+		nodePool.ServiceProviderProperties.ClusterServiceID = internalID("workers")
+		nodePool.ServiceProviderProperties.Replacement = replacement
+		return nodePool
+	}
+
+	tests := []struct {
+		name           string
+		replacement    *coreapi.NodePoolReplacement
+		csNodePoolName string
+		expected       bool
+	}{
+		{
+			name:           "node pool name",
+			csNodePoolName: "workers",
+			expected:       true,
+		},
+		{
+			name:           "unrelated name",
+			csNodePoolName: "workers-rp",
+			expected:       false,
+		},
+		{
+			name: "pending surge node pool",
+			replacement: &coreapi.NodePoolReplacement{
+				Phase: coreapi.NodePoolReplacementPhaseCreatingSurgeNodePool,
+			},
+			csNodePoolName: "workers-rp",
+			expected:       true,
+		},
+		{
+			name: "created surge node pool",
+			replacement: &coreapi.NodePoolReplacement{
+				Phase:                 coreapi.NodePoolReplacementPhaseCreatingSurgeNodePool,
+				SurgeClusterServiceID: internalID("workers-rp"),
+			},
+			csNodePoolName: "workers-rp",
+			expected:       true,
+		},
+		{
+			name: "previous node pool being removed",
+			replacement: &coreapi.NodePoolReplacement{
+				Phase:                    coreapi.NodePoolReplacementPhaseRemovingPreviousNodePool,
+				PreviousClusterServiceID: internalID("workers-old"),
+			},
+			csNodePoolName: "workers-old",
+			expected:       true,
+		},
+		{
+			name: "finished replacement",
+			replacement: &coreapi.NodePoolReplacement{
+				Phase:                    coreapi.NodePoolReplacementPhaseSucceeded,
+				PreviousClusterServiceID: internalID("workers-old"),
+			},
+			csNodePoolName: "workers-old",
+			expected:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, nodePoolUsesClusterServiceName(newNodePool(tt.replacement), tt.csNodePoolName))
+		})
+	}
+}
+
+func TestNewNodePoolUpdateRollback(t *testing.T) {
+	tests := []struct {
+		name             string
+		activeOperation  string
+		existingRollback *coreapi.NodePoolUpdateRollback
+		expectedReplicas int32
+	}{
+		{
+			name:             "no update in flight snapshots current properties",
+			expectedReplicas: 5,
+		},
+		{
+			name:            "completed update snapshots current properties",
+			activeOperation: "",
+			existingRollback: &coreapi.NodePoolUpdateRollback{
+				OperationID: "completed-update",
+				Properties:  coreapi.HCPOpenShiftClusterNodePoolProperties{Replicas: 3},
+			},
+			expectedReplicas: 5,
+		},
+		{
+			name:            "superseding an update in flight keeps its rollback properties",
+			activeOperation: "in-flight-update",
+			existingRollback: &coreapi.NodePoolUpdateRollback{
+				OperationID: "in-flight-update",
+				Properties:  coreapi.HCPOpenShiftClusterNodePoolProperties{Replicas: 3},
+			},
+			expectedReplicas: 3,
+		},
+		{
+			name:            "in-flight operation that is not an update snapshots current properties",
+			activeOperation: "replace-operation",
+			existingRollback: &coreapi.NodePoolUpdateRollback{
+				OperationID: "older-update",
+				Properties:  coreapi.HCPOpenShiftClusterNodePoolProperties{Replicas: 3},
+			},
+			expectedReplicas: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodePool := &coreapi.HCPOpenShiftClusterNodePool{
+				Properties: coreapi.HCPOpenShiftClusterNodePoolProperties{Replicas: 5},
+				ServiceProviderProperties: coreapi.HCPOpenShiftClusterNodePoolServiceProviderProperties{
+					ActiveOperationID: tt.activeOperation,
+					UpdateRollback:    tt.existingRollback,
+				},
+			}
+
+			rollback := newNodePoolUpdateRollback(nodePool, "new-update")
+			require.NotNil(t, rollback)
+			assert.Equal(t, "new-update", rollback.OperationID)
+			assert.Equal(t, tt.expectedReplicas, rollback.Properties.Replicas)
+			if tt.existingRollback != nil {
+				assert.NotEqual(t, "new-update", tt.existingRollback.OperationID, "existing rollback must not be mutated")
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/internal/utils"
+	"github.com/Azure/ARO-HCP/internal/utils/armhelpers"
+)
+
+// resourceChangeIgnoredPaths are properties that the frontend changes on every
+// update and so say nothing about what the customer changed.
+var resourceChangeIgnoredPaths = []string{"properties.provisioningState"}
+
+// diffResourceProperties computes the customer-visible property changes
+// between two versioned representations of the same resource.
+func diffResourceProperties(before, after any) ([]coreapi.ResourcePropertyChange, error) {
+	beforeMap, err := toDryRunMap(before)
+	if err != nil {
+		return nil, err
+	}
+	afterMap, err := toDryRunMap(after)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []coreapi.ResourcePropertyChange
+	for _, delta := range diffDryRunValues("", beforeMap, afterMap, nil) {
+		if isResourceChangeIgnoredPath(delta.Path) {
+			continue
+		}
+		change := coreapi.ResourcePropertyChange{Path: delta.Path}
+		switch delta.PropertyChangeType {
+		case DryRunPropertyChangeTypeCreate:
+			change.ChangeType = coreapi.ResourcePropertyChangeTypeAdded
+		case DryRunPropertyChangeTypeDelete:
+			change.ChangeType = coreapi.ResourcePropertyChangeTypeRemoved
+		default:
+			change.ChangeType = coreapi.ResourcePropertyChangeTypeModified
+		}
+		if delta.Before != nil {
+			if change.Before, err = json.Marshal(delta.Before); err != nil {
+				return nil, fmt.Errorf("failed to marshal %q: %w", delta.Path, err)
+			}
+		}
+		if delta.After != nil {
+			if change.After, err = json.Marshal(delta.After); err != nil {
+				return nil, fmt.Errorf("failed to marshal %q: %w", delta.Path, err)
+			}
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func isResourceChangeIgnoredPath(path string) bool {
+	for _, ignored := range resourceChangeIgnoredPaths {
+		if path == ignored || strings.HasPrefix(path, ignored+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// addResourceChangeToTransaction records an update of targetID in the change
+// history of its cluster. The record is named after the operation started by
+// the update and is written in the same transaction, so it only exists if the
+// update is committed.
+func (f *Frontend) addResourceChangeToTransaction(
+	ctx context.Context,
+	transaction cosmosstorageutils.DBTransaction,
+	targetID *azcorearm.ResourceID,
+	operationName string,
+	systemData *coreapi.SystemData,
+	correlationData *coreapi.CorrelationData,
+	changes []coreapi.ResourcePropertyChange,
+) error {
+	clusterID := targetID
+	for clusterID != nil && !armhelpers.ResourceTypeEqual(clusterID.ResourceType, coreapi.ClusterResourceType) {
+		clusterID = clusterID.Parent
+	}
+	if clusterID == nil {
+		return utils.TrackError(fmt.Errorf("%s is not a cluster or nested under one", targetID))
+	}
+
+	resourceID, err := coreapi.ToResourceChangeResourceID(clusterID.SubscriptionID, clusterID.ResourceGroupName, clusterID.Name, operationName)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+
+	resourceChange := &coreapi.ResourceChange{
+		CosmosMetadata: coreapi.CosmosMetadata{
+			ResourceID: resourceID,
+		},
+		TargetResourceID: targetID,
+		ChangedAt:        metav1.NewTime(f.clock.Now().UTC().Truncate(time.Second)),
+		OperationID:      operationName,
+		SystemData:       systemData.DeepCopy(),
+		CorrelationData:  correlationData.DeepCopy(),
+		Changes:          changes,
+	}
+	_, err = f.resourcesDBClient.HCPClusters(clusterID.SubscriptionID, clusterID.ResourceGroupName).
+		ResourceChanges(clusterID.Name).
+		AddCreateToTransaction(ctx, transaction, resourceChange, nil)
+	if err != nil {
+		return utils.TrackError(err)
+	}
+	return nil
+}
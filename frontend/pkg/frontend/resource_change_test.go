@@ -0,0 +1,115 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	clocktesting "k8s.io/utils/clock/testing"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/apitesting/coreapitesting"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/corecosmosstoragetesting"
+)
+
+func TestDiffResourceProperties(t *testing.T) {
+	type properties struct {
+		ProvisioningState string   `json:"provisioningState,omitempty"`
+		Version           string   `json:"version,omitempty"`
+		Labels            []string `json:"labels,omitempty"`
+		Replicas          *int     `json:"replicas,omitempty"`
+	}
+	type resource struct {
+		Tags       map[string]string `json:"tags,omitempty"`
+		SystemData map[string]string `json:"systemData,omitempty"`
+		Properties properties        `json:"properties"`
+	}
+	replicas := 3
+
+	tests := []struct {
+		name   string
+		before resource
+		after  resource
+		want   []coreapi.ResourcePropertyChange
+	}{
+		{
+			name:   "no change ignores system data and provisioning state",
+			before: resource{SystemData: map[string]string{"lastModifiedAt": "yesterday"}, Properties: properties{ProvisioningState: "Succeeded"}},
+			after:  resource{SystemData: map[string]string{"lastModifiedAt": "today"}, Properties: properties{ProvisioningState: "Accepted"}},
+		},
+		{
+			name:   "added, modified and removed properties",
+			before: resource{Tags: map[string]string{"env": "dev"}, Properties: properties{Version: "4.19", Labels: []string{"a"}}},
+			after:  resource{Tags: map[string]string{"env": "prod"}, Properties: properties{Version: "4.20", Replicas: &replicas}},
+			want: []coreapi.ResourcePropertyChange{
+				{Path: "properties.labels", ChangeType: coreapi.ResourcePropertyChangeTypeRemoved, Before: json.RawMessage(`["a"]`)},
+				{Path: "properties.replicas", ChangeType: coreapi.ResourcePropertyChangeTypeAdded, After: json.RawMessage(`3`)},
+				{Path: "properties.version", ChangeType: coreapi.ResourcePropertyChangeTypeModified, Before: json.RawMessage(`"4.19"`), After: json.RawMessage(`"4.20"`)},
+				{Path: "tags.env", ChangeType: coreapi.ResourcePropertyChangeTypeModified, Before: json.RawMessage(`"dev"`), After: json.RawMessage(`"prod"`)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := diffResourceProperties(tt.before, tt.after)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAddResourceChangeToTransaction(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 12, 30, 45, 500, time.UTC)
+	mockResourcesDBClient := corecosmosstoragetesting.NewMockResourcesDBClient()
+	f := &Frontend{
+		resourcesDBClient: mockResourcesDBClient,
+		clock:             clocktesting.NewFakePassiveClock(now),
+	}
+
+	nodePoolID, err := azcorearm.ParseResourceID(coreapitesting.TestNodePoolResourceID)
+	require.NoError(t, err)
+	systemData := &coreapi.SystemData{LastModifiedBy: "user@example.com", LastModifiedByType: coreapi.CreatedByTypeUser}
+	correlationData := &coreapi.CorrelationData{CorrelationRequestID: "correlation-id"}
+	changes := []coreapi.ResourcePropertyChange{
+		{Path: "properties.replicas", ChangeType: coreapi.ResourcePropertyChangeTypeModified, Before: json.RawMessage(`2`), After: json.RawMessage(`3`)},
+	}
+
+	transaction := mockResourcesDBClient.NewTransaction(nodePoolID.SubscriptionID)
+	err = f.addResourceChangeToTransaction(ctx, transaction, nodePoolID, "operation-name", systemData, correlationData, changes)
+	require.NoError(t, err)
+	_, err = transaction.Execute(ctx, nil)
+	require.NoError(t, err)
+
+	// The record is nested under the cluster of the node pool.
+	resourceChanges := mockResourcesDBClient.HCPClusters(nodePoolID.SubscriptionID, nodePoolID.ResourceGroupName).ResourceChanges(nodePoolID.Parent.Name)
+	resourceChange, err := resourceChanges.Get(ctx, "operation-name")
+	require.NoError(t, err)
+	assert.Equal(t, nodePoolID.String(), resourceChange.TargetResourceID.String())
+	assert.Equal(t, now.Truncate(time.Second), resourceChange.ChangedAt.Time)
+	assert.Equal(t, "operation-name", resourceChange.OperationID)
+	assert.Equal(t, systemData, resourceChange.SystemData)
+	assert.Equal(t, correlationData, resourceChange.CorrelationData)
+	assert.Equal(t, changes, resourceChange.Changes)
+}
@@ -51,6 +51,10 @@ const (
 
 	ActionRequestAdminCredential = "requestadmincredential"
 	ActionRevokeCredentials      = "revokecredentials"
+	ActionStop                   = "stop"
+	ActionStart                  = "start"
+	ActionCancel                 = "cancel"
+	ActionReplace                = "replace"
 
 	// User-visible display names for provider and resource types
 	ProviderDisplay                          = "Azure Red Hat OpenShift"
@@ -127,6 +131,24 @@ var AvailableOperations = []coreapi.NamespaceOperation{
 			Description: "Revoke all unexpired certificates issued for user access to a " + ClusterResourceTypeDisplaySingle,
 		},
 	},
+	{
+		Name: path.Join(coreapi.ClusterResourceType.String(), ActionStop, coreapi.NamespaceOperationAction),
+		Display: coreapi.NamespaceOperationDisplay{
+			Provider:    ProviderDisplay,
+			Resource:    ClusterResourceTypeDisplayPlural,
+			Operation:   "Stop " + ClusterResourceTypeDisplaySingle,
+			Description: "Scale the node pools and control plane of a " + ClusterResourceTypeDisplaySingle + " down to zero",
+		},
+	},
+	{
+		Name: path.Join(coreapi.ClusterResourceType.String(), ActionStart, coreapi.NamespaceOperationAction),
+		Display: coreapi.NamespaceOperationDisplay{
+			Provider:    ProviderDisplay,
+			Resource:    ClusterResourceTypeDisplayPlural,
+			Operation:   "Start " + ClusterResourceTypeDisplaySingle,
+			Description: "Restore the control plane and node pools of a stopped " + ClusterResourceTypeDisplaySingle,
+		},
+	},
 	{
 		Name: path.Join(coreapi.NodePoolResourceType.String(), coreapi.NamespaceOperationRead),
 		Display: coreapi.NamespaceOperationDisplay{
@@ -154,6 +176,15 @@ var AvailableOperations = []coreapi.NamespaceOperation{
 			Description: "Delete any " + NodePoolResourceTypeDisplayPlural,
 		},
 	},
+	{
+		Name: path.Join(coreapi.NodePoolResourceType.String(), ActionReplace, coreapi.NamespaceOperationAction),
+		Display: coreapi.NamespaceOperationDisplay{
+			Provider:    ProviderDisplay,
+			Resource:    NodePoolResourceTypeDisplayPlural,
+			Operation:   "Replace " + NodePoolResourceTypeDisplaySingle,
+			Description: "Replace the virtual machines of a " + NodePoolResourceTypeDisplaySingle + " with a new platform profile",
+		},
+	},
 	{
 		Name: path.Join(coreapi.ExternalAuthResourceType.String(), coreapi.NamespaceOperationRead),
 		Display: coreapi.NamespaceOperationDisplay{
@@ -208,6 +239,15 @@ var AvailableOperations = []coreapi.NamespaceOperation{
 			Description: "Read the status of an ongoing or failed asynchronous operation",
 		},
 	},
+	{
+		Name: path.Join(coreapi.ProviderNamespace, "locations", coreapi.OperationStatusResourceTypeName, ActionCancel, coreapi.NamespaceOperationAction),
+		Display: coreapi.NamespaceOperationDisplay{
+			Provider:    ProviderDisplay,
+			Resource:    OperationStatusResourceTypeDisplayPlural,
+			Operation:   "Cancel Asynchronous Operation",
+			Description: "Cancel an ongoing asynchronous operation",
+		},
+	},
 }
 
 // These operations were copied from the ARO "Classic" service:
@@ -332,6 +372,10 @@ func (f *Frontend) routes(r prometheus.Registerer) http.Handler {
 	// Setup metrics middleware
 	metricsMiddleware := NewMetricsMiddleware(r, f.collector)
 
+	// Setup rate limit middleware, shared by every subscription-scoped route
+	// so all of them draw from the same buckets.
+	rateLimitMiddleware := newMiddlewareRateLimit(r, f.clock, f.limits)
+
 	middlewareMux := NewMiddlewareMux(
 		MiddlewarePanic,
 		MiddlewareReferer,
@@ -355,6 +399,7 @@ func (f *Frontend) routes(r prometheus.Registerer) http.Handler {
 	postMuxMiddleware := NewMiddleware(
 		MiddlewareResourceID,
 		MiddlewareLoggingPostMux,
+		rateLimitMiddleware.handleRequest,
 		newMiddlewareValidatedAPIVersion(f.apiRegistry).handleRequest,
 		newMiddlewareValidateSubscriptionState(f.resourcesDBClient).handleRequest)
 	middlewareMux.Handle(
@@ -378,6 +423,7 @@ func (f *Frontend) routes(r prometheus.Registerer) http.Handler {
 	postMuxMiddleware = NewMiddleware(
 		MiddlewareResourceID,
 		MiddlewareLoggingPostMux,
+		rateLimitMiddleware.handleRequest,
 		newMiddlewareValidatedAPIVersion(f.apiRegistry).handleRequest,
 		newMiddlewareValidateSubscriptionState(f.resourcesDBClient).handleRequest)
 	middlewareMux.Handle(
@@ -398,6 +444,7 @@ func (f *Frontend) routes(r prometheus.Registerer) http.Handler {
 	postMuxMiddleware = NewMiddleware(
 		MiddlewareResourceID,
 		MiddlewareLoggingPostMux,
+		rateLimitMiddleware.handleRequest,
 		newMiddlewareValidatedAPIVersion(f.apiRegistry).handleRequest,
 		newMiddlewareValidateSubscriptionState(f.resourcesDBClient).handleRequest)
 	middlewareMux.Handle(
@@ -415,6 +462,12 @@ func (f *Frontend) routes(r prometheus.Registerer) http.Handler {
 	middlewareMux.Handle(
 		MuxPattern(http.MethodPost, PatternSubscriptions, PatternResourceGroups, PatternProviders, PatternClusters, ActionRevokeCredentials),
 		postMuxMiddleware.HandlerFunc(errorutils.ReportError(f.ArmResourceActionRevokeCredentials)))
+	middlewareMux.Handle(
+		MuxPattern(http.MethodPost, PatternSubscriptions, PatternResourceGroups, PatternProviders, PatternClusters, ActionStop),
+		postMuxMiddleware.HandlerFunc(errorutils.ReportError(f.ArmResourceActionStopCluster)))
+	middlewareMux.Handle(
+		MuxPattern(http.MethodPost, PatternSubscriptions, PatternResourceGroups, PatternProviders, PatternClusters, ActionStart),
+		postMuxMiddleware.HandlerFunc(errorutils.ReportError(f.ArmResourceActionStartCluster)))
 	middlewareMux.Handle(
 		MuxPattern(http.MethodPut, PatternSubscriptions, PatternResourceGroups, PatternProviders, PatternClusters, PatternNodePools),
 		postMuxMiddleware.HandlerFunc(errorutils.ReportError(f.CreateOrUpdateNodePool)))
@@ -424,6 +477,9 @@ func (f *Frontend) routes(r prometheus.Registerer) http.Handler {
 	middlewareMux.Handle(
 		MuxPattern(http.MethodDelete, PatternSubscriptions, PatternResourceGroups, PatternProviders, PatternClusters, PatternNodePools),
 		postMuxMiddleware.HandlerFunc(errorutils.ReportError(f.DeleteNodePool)))
+	middlewareMux.Handle(
+		MuxPattern(http.MethodPost, PatternSubscriptions, PatternResourceGroups, PatternProviders, PatternClusters, PatternNodePools, ActionReplace),
+		postMuxMiddleware.HandlerFunc(errorutils.ReportError(f.ArmResourceActionReplaceNodePool)))
 	middlewareMux.Handle(
 		MuxPattern(http.MethodPut, PatternSubscriptions, PatternResourceGroups, PatternProviders, PatternClusters, PatternExternalAuth),
 		postMuxMiddleware.HandlerFunc(errorutils.ReportError(f.CreateOrUpdateExternalAuth)))
@@ -439,6 +495,7 @@ func (f *Frontend) routes(r prometheus.Registerer) http.Handler {
 	postMuxMiddleware = NewMiddleware(
 		MiddlewareResourceID,
 		MiddlewareLoggingPostMux,
+		rateLimitMiddleware.handleRequest,
 		newMiddlewareValidatedAPIVersion(f.apiRegistry).handleRequest,
 		newMiddlewareValidateSubscriptionState(f.resourcesDBClient).handleRequest)
 	middlewareMux.Handle(
@@ -447,6 +504,9 @@ func (f *Frontend) routes(r prometheus.Registerer) http.Handler {
 	middlewareMux.Handle(
 		MuxPattern(http.MethodGet, PatternSubscriptions, PatternProviders, PatternLocations, PatternOperationStatuses),
 		postMuxMiddleware.HandlerFunc(errorutils.ReportError(f.OperationStatus)))
+	middlewareMux.Handle(
+		MuxPattern(http.MethodPost, PatternSubscriptions, PatternProviders, PatternLocations, PatternOperationStatuses, ActionCancel),
+		postMuxMiddleware.HandlerFunc(errorutils.ReportError(f.OperationCancel)))
 
 	// Exclude ARO-HCP API version validation for the following endpoints defined by ARM.
 
@@ -476,6 +536,7 @@ func (f *Frontend) routes(r prometheus.Registerer) http.Handler {
 		postMuxMiddleware.HandlerFunc(errorutils.ReportError(f.ArmSubscriptionPut)))
 
 	// Deployment preflight endpoint
+	// ARM calls this on behalf of a deployment, so it is not rate limited.
 	postMuxMiddleware = NewMiddleware(
 		MiddlewareLoggingPostMux,
 		newMiddlewareValidateSubscriptionState(f.resourcesDBClient).handleRequest)
@@ -49,6 +49,7 @@ func NewTestFrontend(t *testing.T) *Frontend {
 		newNoopAuditClient(t),
 		coreapitesting.TestLocation,
 		true,
+		Limits{},
 	)
 	return f
 }
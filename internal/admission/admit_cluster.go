@@ -66,6 +66,9 @@ type ClusterAdmissionContext struct {
 	// node pool on CREATE.
 	// The list is empty on UPDATE.
 	SubscriptionNodePools []*coreapi.HCPOpenShiftClusterNodePool
+	// Quota caps the number of clusters in the subscription, checked against
+	// SubscriptionClusters on CREATE.
+	Quota SubscriptionQuota
 }
 
 // ClusterAdmissionNodePool is a single node pool plus its prefetched service
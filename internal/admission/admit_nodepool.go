@@ -50,6 +50,12 @@ type NodePoolAdmissionContext struct {
 	Cluster                 *coreapi.HCPOpenShiftCluster
 	ServiceProviderNodePool *coreapi.ServiceProviderNodePool
 	ServiceProviderCluster  *coreapi.ServiceProviderCluster
+	// Quota caps the number of node pools in the subscription.
+	Quota SubscriptionQuota
+	// SubscriptionNodePoolCount is the number of node pools that already exist
+	// across every cluster in the subscription. It is only populated on CREATE
+	// when Quota.MaxNodePools is set.
+	SubscriptionNodePoolCount int
 }
 
 // MutateNodePool applies admission-time mutations to a node pool (e.g. defaulting
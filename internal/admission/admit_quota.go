@@ -0,0 +1,74 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+)
+
+// SubscriptionQuota caps how many resources a single subscription may hold.
+// A zero limit means the resource count is not capped.
+type SubscriptionQuota struct {
+	MaxClusters  int
+	MaxNodePools int
+}
+
+// AdmitClusterQuota rejects the creation of a cluster that would take the
+// subscription past its cluster quota. Clusters that are being deleted still
+// count until their documents are gone, since they still hold capacity.
+//
+// Quota errors are not field errors: ARM expects a 409 QuotaExceeded response
+// so that clients do not treat the request body as invalid.
+func AdmitClusterQuota(_ context.Context, admissionContext *ClusterAdmissionContext, op operation.Operation, newObj *coreapi.HCPOpenShiftCluster) error {
+	maxClusters := admissionContext.Quota.MaxClusters
+	if op.Type != operation.Create || maxClusters <= 0 {
+		return nil
+	}
+
+	if len(admissionContext.SubscriptionClusters) >= maxClusters {
+		return coreapi.NewCloudError(
+			http.StatusConflict,
+			coreapi.CloudErrorCodeQuotaExceeded,
+			newObj.ID.String(),
+			"Subscription '%s' has reached its limit of %d clusters. Delete an existing cluster before creating a new one.",
+			newObj.ID.SubscriptionID, maxClusters)
+	}
+	return nil
+}
+
+// AdmitNodePoolQuota rejects the creation of a node pool that would take the
+// subscription past its node pool quota. SubscriptionNodePoolCount must be
+// populated on CREATE whenever the quota is set.
+func AdmitNodePoolQuota(_ context.Context, admissionContext *NodePoolAdmissionContext, op operation.Operation, newObj *coreapi.HCPOpenShiftClusterNodePool) error {
+	maxNodePools := admissionContext.Quota.MaxNodePools
+	if op.Type != operation.Create || maxNodePools <= 0 {
+		return nil
+	}
+
+	if admissionContext.SubscriptionNodePoolCount >= maxNodePools {
+		return coreapi.NewCloudError(
+			http.StatusConflict,
+			coreapi.CloudErrorCodeQuotaExceeded,
+			newObj.ID.String(),
+			"Subscription '%s' has reached its limit of %d node pools. Delete an existing node pool before creating a new one.",
+			newObj.ID.SubscriptionID, maxNodePools)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admission
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/api/operation"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/apitesting/coreapitesting"
+)
+
+func TestAdmitClusterQuota(t *testing.T) {
+	existing := []*coreapi.HCPOpenShiftCluster{
+		coreapitesting.MinimumValidClusterTestCase(),
+		coreapitesting.MinimumValidClusterTestCase(),
+	}
+
+	tests := []struct {
+		name        string
+		op          operation.Type
+		quota       SubscriptionQuota
+		existing    []*coreapi.HCPOpenShiftCluster
+		expectQuota bool
+	}{
+		{
+			name:     "no quota",
+			op:       operation.Create,
+			existing: existing,
+		},
+		{
+			name:     "below quota",
+			op:       operation.Create,
+			quota:    SubscriptionQuota{MaxClusters: 3},
+			existing: existing,
+		},
+		{
+			name:        "at quota",
+			op:          operation.Create,
+			quota:       SubscriptionQuota{MaxClusters: 2},
+			existing:    existing,
+			expectQuota: true,
+		},
+		{
+			name:     "update is not counted",
+			op:       operation.Update,
+			quota:    SubscriptionQuota{MaxClusters: 2},
+			existing: existing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admissionContext := &ClusterAdmissionContext{
+				SubscriptionClusters: tt.existing,
+				Quota:                tt.quota,
+			}
+			err := AdmitClusterQuota(context.Background(), admissionContext, operation.Operation{Type: tt.op}, coreapitesting.MinimumValidClusterTestCase())
+			if !tt.expectQuota {
+				require.NoError(t, err)
+				return
+			}
+			var cloudError *coreapi.CloudError
+			require.ErrorAs(t, err, &cloudError)
+			assert.Equal(t, http.StatusConflict, cloudError.StatusCode)
+			assert.Equal(t, coreapi.CloudErrorCodeQuotaExceeded, cloudError.Code)
+		})
+	}
+}
+
+func TestAdmitNodePoolQuota(t *testing.T) {
+	nodePool := coreapi.NewDefaultHCPOpenShiftClusterNodePool(
+		metadataapi.Must(azcorearm.ParseResourceID(coreapitesting.TestNodePoolResourceID)),
+		coreapitesting.TestLocation)
+
+	tests := []struct {
+		name        string
+		op          operation.Type
+		quota       SubscriptionQuota
+		count       int
+		expectQuota bool
+	}{
+		{
+			name:  "no quota",
+			op:    operation.Create,
+			count: 100,
+		},
+		{
+			name:  "below quota",
+			op:    operation.Create,
+			quota: SubscriptionQuota{MaxNodePools: 5},
+			count: 4,
+		},
+		{
+			name:        "at quota",
+			op:          operation.Create,
+			quota:       SubscriptionQuota{MaxNodePools: 5},
+			count:       5,
+			expectQuota: true,
+		},
+		{
+			name:  "update is not counted",
+			op:    operation.Update,
+			quota: SubscriptionQuota{MaxNodePools: 5},
+			count: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			admissionContext := &NodePoolAdmissionContext{
+				Quota:                     tt.quota,
+				SubscriptionNodePoolCount: tt.count,
+			}
+			err := AdmitNodePoolQuota(context.Background(), admissionContext, operation.Operation{Type: tt.op}, nodePool)
+			if !tt.expectQuota {
+				require.NoError(t, err)
+				return
+			}
+			var cloudError *coreapi.CloudError
+			require.ErrorAs(t, err, &cloudError)
+			assert.Equal(t, http.StatusConflict, cloudError.StatusCode)
+			assert.Equal(t, coreapi.CloudErrorCodeQuotaExceeded, cloudError.Code)
+		})
+	}
+}
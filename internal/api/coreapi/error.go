@@ -34,6 +34,7 @@ const (
 	CloudErrorCodeInvalidRequestContent    = "InvalidRequestContent"
 	CloudErrorCodeInvalidResource          = "InvalidResource"
 	CloudErrorCodeInvalidResourceType      = "InvalidResourceType"
+	CloudErrorCodeInvalidFilter            = "InvalidFilter"
 	CloudErrorCodeMultipleErrorsOccurred   = "MultipleErrorsOccurred"
 	CloudErrorCodeUnsupportedMediaType     = "UnsupportedMediaType"
 	CloudErrorCodeCanceled                 = "Canceled"
@@ -47,6 +48,8 @@ const (
 	CloudErrorCodeInvalidResourceName      = "InvalidResourceName"
 	CloudErrorCodeInvalidResourceGroupName = "InvalidResourceGroupName"
 	CloudErrorCodeLockContention           = "LockContention"
+	CloudErrorCodeTooManyRequests          = "TooManyRequests"
+	CloudErrorCodeQuotaExceeded            = "QuotaExceeded"
 )
 
 // CloudError represents a complete resource provider error.
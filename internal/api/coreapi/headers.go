@@ -31,4 +31,9 @@ const (
 	HeaderNameARMResourceSystemData = "X-Ms-Arm-Resource-System-Data"
 	HeaderNameIdentityURL           = "X-Ms-Identity-Url"
 	HeaderClientPrincipalName       = "X-Ms-Client-Principal-Name"
+
+	// Remaining request budget of the subscription, returned on every
+	// subscription-scoped response so clients can pace themselves.
+	HeaderNameRateLimitRemainingSubscriptionReads  = "X-Ms-Ratelimit-Remaining-Subscription-Reads"
+	HeaderNameRateLimitRemainingSubscriptionWrites = "X-Ms-Ratelimit-Remaining-Subscription-Writes"
 )
@@ -0,0 +1,131 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coreapi
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	// The distroless backend and frontend images do not ship a time zone
+	// database, so embed one to resolve MaintenanceWindowProfile.TimeZone.
+	_ "time/tzdata"
+
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+)
+
+const (
+	// MaintenanceWindowStartTimeLayout is the time.Parse layout of MaintenanceWindowProfile.StartTime.
+	MaintenanceWindowStartTimeLayout = "15:04"
+	// MaintenanceWindowExclusionDateLayout is the time.Parse layout of MaintenanceWindowProfile.ExclusionDates.
+	MaintenanceWindowExclusionDateLayout = time.DateOnly
+
+	// MaxMaintenanceWindowDurationHours bounds DurationHours so that at most
+	// one occurrence that started on an earlier day can still be open.
+	MaxMaintenanceWindowDurationHours = 24
+	// MaxMaintenanceWindowExclusionDates bounds ExclusionDates, which in turn
+	// bounds how far ahead NextOpening has to look.
+	MaxMaintenanceWindowExclusionDates = 100
+)
+
+// Location returns the time zone the window is defined in.
+func (w *MaintenanceWindowProfile) Location() (*time.Location, error) {
+	if len(w.TimeZone) == 0 {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance window time zone %q: %w", w.TimeZone, err)
+	}
+	return loc, nil
+}
+
+// UpgradeAllowed returns true if an upgrade may start at now. A nil window
+// allows upgrades at any time.
+func (w *MaintenanceWindowProfile) UpgradeAllowed(now time.Time) (bool, error) {
+	if w == nil {
+		return true, nil
+	}
+	return w.IsOpen(now)
+}
+
+// IsOpen returns true if now falls inside an occurrence of the window.
+func (w *MaintenanceWindowProfile) IsOpen(now time.Time) (bool, error) {
+	loc, startTime, err := w.parse()
+	if err != nil {
+		return false, err
+	}
+
+	duration := time.Duration(w.DurationHours) * time.Hour
+	localNow := now.In(loc)
+	// An occurrence lasts at most a day, so only the occurrences opening
+	// today or yesterday can contain now.
+	for offset := -1; offset <= 0; offset++ {
+		opening, ok := w.openingOn(localNow.Year(), localNow.Month(), localNow.Day()+offset, startTime, loc)
+		if ok && !now.Before(opening) && now.Before(opening.Add(duration)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// NextOpening returns the start of the first occurrence of the window that
+// opens after now. The boolean is false if the window never opens, which
+// can only happen if every remaining day is excluded.
+func (w *MaintenanceWindowProfile) NextOpening(now time.Time) (time.Time, bool, error) {
+	loc, startTime, err := w.parse()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	// Every excluded date can push the next opening back by at most a week.
+	const searchDays = 7 * (MaxMaintenanceWindowExclusionDates + 1)
+
+	localNow := now.In(loc)
+	for offset := 0; offset <= searchDays; offset++ {
+		opening, ok := w.openingOn(localNow.Year(), localNow.Month(), localNow.Day()+offset, startTime, loc)
+		if ok && opening.After(now) {
+			return opening, true, nil
+		}
+	}
+
+	return time.Time{}, false, nil
+}
+
+func (w *MaintenanceWindowProfile) parse() (*time.Location, time.Time, error) {
+	loc, err := w.Location()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	startTime, err := time.Parse(MaintenanceWindowStartTimeLayout, w.StartTime)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("invalid maintenance window start time %q: %w", w.StartTime, err)
+	}
+	return loc, startTime, nil
+}
+
+// openingOn returns when the window opens on the given local date, if it
+// opens on that date at all. The date is normalized by time.Date.
+func (w *MaintenanceWindowProfile) openingOn(year int, month time.Month, day int, startTime time.Time, loc *time.Location) (time.Time, bool) {
+	opening := time.Date(year, month, day, startTime.Hour(), startTime.Minute(), 0, 0, loc)
+	if !slices.Contains(w.DaysOfWeek, metadataapi.DayOfWeek(opening.Weekday().String())) {
+		return time.Time{}, false
+	}
+	if slices.Contains(w.ExclusionDates, opening.Format(MaintenanceWindowExclusionDateLayout)) {
+		return time.Time{}, false
+	}
+	return opening, true
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coreapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+)
+
+func TestMaintenanceWindowIsOpen(t *testing.T) {
+	// 2026-10-17 is a Saturday.
+	saturdayNight := &MaintenanceWindowProfile{
+		DaysOfWeek:    []metadataapi.DayOfWeek{metadataapi.DayOfWeekSaturday},
+		StartTime:     "22:00",
+		DurationHours: 4,
+	}
+
+	tests := []struct {
+		name    string
+		window  *MaintenanceWindowProfile
+		now     time.Time
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:   "before opening",
+			window: saturdayNight,
+			now:    time.Date(2026, 10, 17, 21, 59, 0, 0, time.UTC),
+			want:   false,
+		},
+		{
+			name:   "at opening",
+			window: saturdayNight,
+			now:    time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC),
+			want:   true,
+		},
+		{
+			name:   "spills into the next day",
+			window: saturdayNight,
+			now:    time.Date(2026, 10, 18, 1, 30, 0, 0, time.UTC),
+			want:   true,
+		},
+		{
+			name:   "at closing",
+			window: saturdayNight,
+			now:    time.Date(2026, 10, 18, 2, 0, 0, 0, time.UTC),
+			want:   false,
+		},
+		{
+			name:   "wrong day",
+			window: saturdayNight,
+			now:    time.Date(2026, 10, 16, 23, 0, 0, 0, time.UTC),
+			want:   false,
+		},
+		{
+			name: "excluded date",
+			window: &MaintenanceWindowProfile{
+				DaysOfWeek:     []metadataapi.DayOfWeek{metadataapi.DayOfWeekSaturday},
+				StartTime:      "22:00",
+				DurationHours:  4,
+				ExclusionDates: []string{"2026-10-17"},
+			},
+			now:  time.Date(2026, 10, 18, 1, 0, 0, 0, time.UTC),
+			want: false,
+		},
+		{
+			name: "time zone",
+			window: &MaintenanceWindowProfile{
+				DaysOfWeek:    []metadataapi.DayOfWeek{metadataapi.DayOfWeekSaturday},
+				StartTime:     "22:00",
+				DurationHours: 1,
+				TimeZone:      "Asia/Tokyo",
+			},
+			// 22:30 Saturday in Tokyo
+			now:  time.Date(2026, 10, 17, 13, 30, 0, 0, time.UTC),
+			want: true,
+		},
+		{
+			name: "invalid time zone",
+			window: &MaintenanceWindowProfile{
+				DaysOfWeek:    []metadataapi.DayOfWeek{metadataapi.DayOfWeekSaturday},
+				StartTime:     "22:00",
+				DurationHours: 1,
+				TimeZone:      "Not/AZone",
+			},
+			now:     time.Date(2026, 10, 17, 22, 30, 0, 0, time.UTC),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.window.IsOpen(tt.now)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMaintenanceWindowNextOpening(t *testing.T) {
+	window := &MaintenanceWindowProfile{
+		DaysOfWeek:     []metadataapi.DayOfWeek{metadataapi.DayOfWeekSaturday},
+		StartTime:      "22:00",
+		DurationHours:  4,
+		ExclusionDates: []string{"2026-10-24"},
+	}
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		want     time.Time
+		wantOpen bool
+	}{
+		{
+			name:     "later the same day",
+			now:      time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC),
+			want:     time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC),
+			wantOpen: true,
+		},
+		{
+			name:     "skips excluded date",
+			now:      time.Date(2026, 10, 17, 23, 0, 0, 0, time.UTC),
+			want:     time.Date(2026, 10, 31, 22, 0, 0, 0, time.UTC),
+			wantOpen: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := window.NextOpening(tt.now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOpen, ok)
+			assert.True(t, tt.want.Equal(got), "want %v, got %v", tt.want, got)
+		})
+	}
+}
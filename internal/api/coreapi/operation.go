@@ -33,3 +33,24 @@ type OperationStatus struct {
 	Error           *CloudErrorBody       `json:"error,omitempty"`
 	Operations      []OperationStatus     `json:"operations,omitempty"`
 }
+
+// OperationStatusProperties is the service-specific content of OperationStatus.Properties.
+type OperationStatusProperties struct {
+	// StatusMessage describes what a non-terminal operation is waiting on.
+	StatusMessage string `json:"statusMessage,omitempty"`
+	// Phases lists the steps of the operation in the order they complete.
+	Phases []OperationPhase `json:"phases,omitempty"`
+}
+
+type OperationPhaseStatus string
+
+const (
+	OperationPhaseStatusPending   OperationPhaseStatus = "Pending"
+	OperationPhaseStatusCompleted OperationPhaseStatus = "Completed"
+)
+
+// OperationPhase is a customer-visible step of an asynchronous operation.
+type OperationPhase struct {
+	Name   string               `json:"name"`
+	Status OperationPhaseStatus `json:"status"`
+}
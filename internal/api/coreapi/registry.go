@@ -74,16 +74,19 @@ const (
 	ExternalAuthResourceTypeName                    = "externalAuths"
 	OperationResultResourceTypeName                 = "hcpOperationResults"
 	OperationStatusResourceTypeName                 = "hcpOperationStatuses"
+	OperationNotificationResourceTypeName           = "hcpOperationNotifications"
 	ControllerResourceTypeName                      = "hcpOpenShiftControllers"
 	RequestAdminCredentialActionTypeName            = "requestadmincredential"
 	RevokeAdminCredentialsActionTypeName            = "revokecredentials"
 	ManagementClusterContentResourceTypeName        = "managementClusterContents"
 	SystemAdminCredentialRequestResourceTypeName    = "systemAdminCredentialRequests"
 	SystemAdminCredentialRevocationResourceTypeName = "systemAdminCredentialRevocations"
+	ResourceChangeResourceTypeName                  = "hcpResourceChanges"
 )
 
 var (
 	OperationStatusResourceType         = azcorearm.NewResourceType(ProviderNamespace, OperationStatusResourceTypeName)
+	OperationNotificationResourceType   = azcorearm.NewResourceType(ProviderNamespace, OperationNotificationResourceTypeName)
 	ClusterResourceType                 = azcorearm.NewResourceType(ProviderNamespace, ClusterResourceTypeName)
 	ServiceProviderClusterResourceType  = azcorearm.NewResourceType(ProviderNamespace, ClusterResourceTypeName+"/"+ServiceProviderClusterResourceTypeName)
 	NodePoolResourceType                = azcorearm.NewResourceType(ProviderNamespace, ClusterResourceTypeName+"/"+NodePoolResourceTypeName)
@@ -108,6 +111,8 @@ var (
 	SystemAdminCredentialRevocationResourceType = azcorearm.NewResourceType(ProviderNamespace, ClusterResourceTypeName+"/"+SystemAdminCredentialRevocationResourceTypeName)
 	// SystemAdminCredentialRevocationControllerResourceType is controllers nested under systemAdminCredentialRevocations
 	SystemAdminCredentialRevocationControllerResourceType = azcorearm.NewResourceType(ProviderNamespace, filepath.Join(ClusterResourceTypeName, SystemAdminCredentialRevocationResourceTypeName, ControllerResourceTypeName))
+	// ResourceChangeResourceType is hcpResourceChanges nested directly under a Cluster
+	ResourceChangeResourceType = azcorearm.NewResourceType(ProviderNamespace, ClusterResourceTypeName+"/"+ResourceChangeResourceTypeName)
 )
 
 type VersionedResource interface {
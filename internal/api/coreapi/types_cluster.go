@@ -99,6 +99,8 @@ type HCPOpenShiftClusterCustomerProperties struct {
 	ImageDigestMirrors []ImageDigestMirror `json:"imageDigestMirrors,omitempty"`
 	// Written by: Frontend PUT Cluster
 	CryptoRestrictions metadataapi.CryptoRestrictions `json:"cryptoRestrictions,omitempty"`
+	// Written by: Frontend PUT/PATCH Cluster
+	MaintenanceWindow *MaintenanceWindowProfile `json:"maintenanceWindow,omitempty"`
 }
 
 // HCPOpenShiftClusterServiceProviderProperties represents the service-provider-managed property bag of a HCPOpenShiftCluster resource.
@@ -113,6 +115,10 @@ type HCPOpenShiftClusterServiceProviderProperties struct {
 	ActiveOperationID string `json:"activeOperationId,omitempty"`
 	// Written by: Frontend POST RevokeCredentials, SystemAdminCredentialOperationRevokeCredentialsPoll
 	RevokeCredentialsOperationID string `json:"revokeCredentialsOperationId,omitempty"`
+	// PowerState reports whether the cluster is running or hibernated. An
+	// empty value means the cluster has never been stopped and is running.
+	// Written by: Frontend POST stop/start Cluster, OperationClusterStop, OperationClusterStart
+	PowerState PowerState `json:"powerState,omitempty"`
 	// Written by: ClusterPropertiesSync
 	DNS ServiceProviderDNSProfile `json:"dns,omitempty"`
 	// Written by: ClusterPropertiesSync
@@ -182,6 +188,23 @@ type HCPOpenShiftClusterServiceProviderProperties struct {
 	DeleteOperationCompletionDeadline *metav1.Time `json:"deleteOperationCompletionDeadline,omitempty"`
 }
 
+// PowerState is whether a cluster's control plane and node pools are
+// running or scaled down by the stop action.
+type PowerState string
+
+const (
+	PowerStateRunning  PowerState = "Running"
+	PowerStateStopping PowerState = "Stopping"
+	PowerStateStopped  PowerState = "Stopped"
+	PowerStateStarting PowerState = "Starting"
+)
+
+// IsRunning returns true if the cluster has not been stopped, or has been
+// fully started again.
+func (s PowerState) IsRunning() bool {
+	return len(s) == 0 || s == PowerStateRunning
+}
+
 // VersionProfile represents the cluster control plane version.
 type VersionProfile struct {
 	ID           string `json:"id,omitempty"`
@@ -329,6 +352,21 @@ type ImageDigestMirror struct {
 	MirrorSourcePolicy metadataapi.MirrorSourcePolicy `json:"mirrorSourcePolicy,omitempty"`
 }
 
+// MaintenanceWindowProfile is a recurring weekly window during which
+// control plane and node pool upgrades may start. Upgrades that start inside
+// the window are allowed to run past its end.
+type MaintenanceWindowProfile struct {
+	DaysOfWeek []metadataapi.DayOfWeek `json:"daysOfWeek,omitempty"`
+	// StartTime is the time of day the window opens in 24-hour "HH:MM" format.
+	StartTime     string `json:"startTime,omitempty"`
+	DurationHours int32  `json:"durationHours,omitempty"`
+	// TimeZone is an IANA time zone name. An empty value means UTC.
+	TimeZone string `json:"timeZone,omitempty"`
+	// ExclusionDates are "YYYY-MM-DD" dates, in TimeZone, on which the
+	// window does not open.
+	ExclusionDates []string `json:"exclusionDates,omitempty"`
+}
+
 // Creates an HCPOpenShiftCluster with any non-zero default values.
 func NewDefaultHCPOpenShiftCluster(resourceID *azcorearm.ResourceID, azureLocation string) *HCPOpenShiftCluster {
 	return &HCPOpenShiftCluster{
@@ -83,6 +83,17 @@ func ToOperationResourceIDString(subscriptionName, operationName string) string
 	))
 }
 
+func ToOperationNotificationResourceIDString(subscriptionName, operationName string) string {
+	return strings.ToLower(path.Join(
+		"/subscriptions", subscriptionName,
+		"providers", OperationNotificationResourceType.String(), operationName,
+	))
+}
+
+func ToOperationNotificationResourceID(subscriptionName, operationName string) (*azcorearm.ResourceID, error) {
+	return azcorearm.ParseResourceID(ToOperationNotificationResourceIDString(subscriptionName, operationName))
+}
+
 func ToManagementClusterContentResourceIDString(subscriptionName, resourceGroupName, clusterName, managementClusterContentName string) string {
 	return strings.ToLower(path.Join(
 		ToClusterResourceIDString(subscriptionName, resourceGroupName, clusterName),
@@ -116,6 +127,19 @@ func ToSystemAdminCredentialRevocationResourceID(subscriptionName, resourceGroup
 	return azcorearm.ParseResourceID(ToSystemAdminCredentialRevocationResourceIDString(subscriptionName, resourceGroupName, clusterName, revocationName))
 }
 
+func ToResourceChangeResourceIDString(subscriptionName, resourceGroupName, clusterName, changeName string) string {
+	return strings.ToLower(path.Join(
+		"/subscriptions", subscriptionName,
+		"resourceGroups", resourceGroupName,
+		"providers", ClusterResourceType.String(), clusterName,
+		ResourceChangeResourceTypeName, changeName,
+	))
+}
+
+func ToResourceChangeResourceID(subscriptionName, resourceGroupName, clusterName, changeName string) (*azcorearm.ResourceID, error) {
+	return azcorearm.ParseResourceID(ToResourceChangeResourceIDString(subscriptionName, resourceGroupName, clusterName, changeName))
+}
+
 func ToServiceProviderNodePoolResourceIDString(subscriptionName, resourceGroupName, clusterName, nodePoolName string) string {
 	return strings.ToLower(path.Join(
 		ToNodePoolResourceIDString(subscriptionName, resourceGroupName, clusterName, nodePoolName),
@@ -112,6 +112,18 @@ type HCPOpenShiftClusterNodePoolServiceProviderProperties struct {
 	// pool has been handled.
 	// Written by: NodePoolClusterServiceDeleteDispatch
 	ClusterServiceDeletionTimestamp *metav1.Time `json:"clusterServiceDeletionTimestamp,omitempty"`
+	// UpdateRollback holds the properties the most recent update operation
+	// replaced, so canceling that update can restore them.
+	// Written by: Frontend PUT/PATCH NodePool
+	UpdateRollback *NodePoolUpdateRollback `json:"updateRollback,omitempty"`
+	// Replacement tracks the most recent replace action on the node pool.
+	// Written by: Frontend POST replace NodePool, NodePoolReplace
+	Replacement *NodePoolReplacement `json:"replacement,omitempty"`
+	// StoppedScaling holds the node pool's replicas and autoscaling bounds
+	// from before the cluster was stopped. They are restored, and this field
+	// cleared, when the cluster is started again.
+	// Written by: OperationClusterStop, OperationClusterStart
+	StoppedScaling *NodePoolStoppedScaling `json:"stoppedScaling,omitempty"`
 
 	// Written by: Frontend DELETE NodePool
 	UsesNewNodePoolDeletionApproach bool `json:"usesNewNodePoolDeletionApproach"`
@@ -126,6 +138,63 @@ type HCPOpenShiftClusterNodePoolServiceProviderProperties struct {
 	CreateOperationCompletionDeadline *metav1.Time `json:"createOperationCompletionDeadline,omitempty"`
 }
 
+// NodePoolReplacementPhase is the step a node pool replacement is in.
+type NodePoolReplacementPhase string
+
+const (
+	// NodePoolReplacementPhaseCreatingSurgeNodePool means a Cluster Service
+	// node pool with the new platform is being created alongside the
+	// current one and has not reported ready yet.
+	NodePoolReplacementPhaseCreatingSurgeNodePool NodePoolReplacementPhase = "CreatingSurgeNodePool"
+	// NodePoolReplacementPhaseRemovingPreviousNodePool means the node pool now
+	// points at the surge node pool and the previous Cluster Service node pool
+	// is being drained and deleted.
+	NodePoolReplacementPhaseRemovingPreviousNodePool NodePoolReplacementPhase = "RemovingPreviousNodePool"
+	NodePoolReplacementPhaseSucceeded                NodePoolReplacementPhase = "Succeeded"
+	NodePoolReplacementPhaseFailed                   NodePoolReplacementPhase = "Failed"
+)
+
+// IsTerminal returns true if no further replacement work remains.
+func (p NodePoolReplacementPhase) IsTerminal() bool {
+	return p == NodePoolReplacementPhaseSucceeded || p == NodePoolReplacementPhaseFailed
+}
+
+// NodePoolUpdateRollback records the node pool properties from before an
+// update operation was accepted.
+type NodePoolUpdateRollback struct {
+	// OperationID is the name of the update operation that replaced Properties.
+	OperationID string `json:"operationId"`
+	// Properties are the node pool properties from before the update.
+	Properties HCPOpenShiftClusterNodePoolProperties `json:"properties"`
+}
+
+// NodePoolReplacement records a request to move a node pool onto new
+// immutable platform properties by creating a surge Cluster Service node pool,
+// waiting for it to be ready, and then draining and deleting the previous one.
+type NodePoolReplacement struct {
+	// OperationID is the name of the replace operation.
+	OperationID string `json:"operationId"`
+	// Platform is the platform profile of the surge node pool. It becomes the
+	// node pool's platform once the surge node pool is ready.
+	Platform NodePoolPlatformProfile  `json:"platform"`
+	Phase    NodePoolReplacementPhase `json:"phase"`
+	// Message explains a failed replacement.
+	Message string `json:"message,omitempty"`
+	// SurgeClusterServiceID is the Cluster Service ID of the surge node pool
+	// once it has been requested.
+	SurgeClusterServiceID *metadataapi.InternalID `json:"surgeClusterServiceId,omitempty"`
+	// PreviousClusterServiceID is the Cluster Service ID the node pool pointed
+	// at before the surge node pool took over.
+	PreviousClusterServiceID *metadataapi.InternalID `json:"previousClusterServiceId,omitempty"`
+}
+
+// NodePoolStoppedScaling is the scaling configuration a node pool had before
+// its cluster was stopped.
+type NodePoolStoppedScaling struct {
+	Replicas    int32                `json:"replicas,omitempty"`
+	AutoScaling *NodePoolAutoScaling `json:"autoScaling,omitempty"`
+}
+
 // NodePoolVersionProfile represents the worker node pool version.
 // Visbility for the entire struct is "read create update".
 type NodePoolVersionProfile struct {
@@ -34,6 +34,9 @@ const (
 	// These are for POST actions on resources.
 	OperationRequestSystemAdminCredentialRequest    OperationRequest = "RequestCredential"
 	OperationRequestSystemAdminCredentialRevocation OperationRequest = "RevokeCredentials"
+	OperationRequestNodePoolReplace                 OperationRequest = "Replace"
+	OperationRequestClusterStop                     OperationRequest = "Stop"
+	OperationRequestClusterStart                    OperationRequest = "Start"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -71,6 +74,16 @@ type Operation struct {
 	Status ProvisioningState `json:"status,omitempty"`
 	// Error is an OData error, present when Status is "Failed" or "Canceled"
 	Error *CloudErrorBody `json:"error,omitempty"`
+	// StatusMessage describes what a non-terminal operation is waiting on, such
+	// as a closed maintenance window. It is cleared when the operation becomes terminal.
+	// Written by: backend operation controllers
+	StatusMessage string `json:"statusMessage,omitempty"`
+	// PercentComplete estimates how far the operation has progressed, from 0 to 100.
+	// Written by: backend operation controllers
+	PercentComplete float64 `json:"percentComplete,omitempty"`
+	// Phases lists the steps of the operation and whether each has completed.
+	// Written by: backend operation controllers
+	Phases []OperationPhase `json:"phases,omitempty"`
 
 	// Temporary field to track whether the node pool operation is using the new deletion approach.
 	// We are migrating from the node pool cs deletion synchronous in frontend to the backend, to be fully asynchronous
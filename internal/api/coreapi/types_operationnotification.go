@@ -0,0 +1,87 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coreapi
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+)
+
+// OperationNotification is a durable outbox record for a single ARM async
+// operation notification, tracked in Cosmos and nested directly under the
+// subscription alongside the operation it belongs to. When an operation with a
+// NotificationURI reaches a terminal status, the backend (or the frontend, when
+// the customer cancels the operation) creates one of these documents in the
+// same transactional batch that writes the terminal operation and clears the
+// operation's NotificationURI, so the obligation to notify ARM is never lost
+// between the status change and the POST.
+//
+// The operation-notification delivery controller then POSTs the captured
+// payload to the notification URI, retrying with exponential backoff until it
+// succeeds or the delivery deadline passes, at which point the document is
+// dead-lettered. Delivered and dead-lettered documents are kept until their
+// Cosmos TTL expires so failed deliveries can be audited.
+//
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type OperationNotification struct {
+	// PartitionKey holds the lowercased subscriptionID.
+	CosmosMetadata `json:"cosmosMetadata"`
+
+	Spec   OperationNotificationSpec   `json:"spec"`
+	Status OperationNotificationStatus `json:"status"`
+}
+
+// OperationNotificationSpec contains the notification to deliver.
+// Written by: backend operation controllers, Frontend POST cancel operation (all fields set at creation)
+type OperationNotificationSpec struct {
+	// OperationID is the Azure resource ID of the operation status being reported.
+	OperationID *azcorearm.ResourceID `json:"operationId,omitempty"`
+	// NotificationURI is the Azure-AsyncNotificationUri supplied by ARM when the
+	// operation was created.
+	NotificationURI string `json:"notificationUri"`
+	// Payload is the serialized ARM operation status captured when the operation
+	// reached its terminal state. It is POSTed verbatim on every attempt.
+	Payload json.RawMessage `json:"payload"`
+	// CreationTime is when the operation reached its terminal state. The delivery
+	// deadline is measured from here.
+	CreationTime metav1.Time `json:"creationTime"`
+}
+
+// OperationNotificationStatus contains the observed delivery state.
+// Written by: backend operation controllers (fast-path attempt), OperationNotificationDelivery
+type OperationNotificationStatus struct {
+	// Attempts is the number of delivery attempts made so far.
+	Attempts int32 `json:"attempts,omitempty"`
+	// LastAttemptTime is when the most recent delivery attempt was made.
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
+	// NextAttemptTime is the earliest time the next delivery attempt may be made.
+	NextAttemptTime *metav1.Time `json:"nextAttemptTime,omitempty"`
+	// LastError is the error returned by the most recent failed delivery attempt.
+	LastError string `json:"lastError,omitempty"`
+	// DeliveredTime is set once ARM accepted the notification.
+	DeliveredTime *metav1.Time `json:"deliveredTime,omitempty"`
+	// DeadLetteredTime is set once the delivery deadline passed without a
+	// successful attempt. No further attempts are made.
+	DeadLetteredTime *metav1.Time `json:"deadLetteredTime,omitempty"`
+}
+
+// IsPending returns true if the notification has neither been delivered nor dead-lettered.
+func (n *OperationNotification) IsPending() bool {
+	return n.Status.DeliveredTime == nil && n.Status.DeadLetteredTime == nil
+}
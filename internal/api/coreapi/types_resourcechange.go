@@ -0,0 +1,75 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coreapi
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+)
+
+// ResourcePropertyChangeType describes how a single property changed.
+type ResourcePropertyChangeType string
+
+const (
+	ResourcePropertyChangeTypeAdded    ResourcePropertyChangeType = "Added"
+	ResourcePropertyChangeTypeModified ResourcePropertyChangeType = "Modified"
+	ResourcePropertyChangeTypeRemoved  ResourcePropertyChangeType = "Removed"
+)
+
+// ResourceChange is an append-only record of a single successful customer
+// update of a cluster, node pool or external auth. Records are nested directly
+// under the cluster they belong to, so the history of a cluster and all of its
+// child resources can be listed from a single parent. The frontend writes one
+// in the same transaction as the resource it describes, so a committed write
+// always has a matching record. Records are never updated and are removed by
+// their Cosmos TTL.
+//
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ResourceChange struct {
+	// PartitionKey holds the lowercased subscriptionID.
+	CosmosMetadata `json:"cosmosMetadata"`
+
+	// TargetResourceID is the resource that was written.
+	TargetResourceID *azcorearm.ResourceID `json:"targetResourceId"`
+	// ChangedAt is when the write was accepted. It is serialized with second
+	// precision in RFC 3339 form so records sort chronologically as strings.
+	ChangedAt metav1.Time `json:"changedAt"`
+	// OperationID is the name of the asynchronous operation started by the
+	// write. The record is named after it as well.
+	OperationID string `json:"operationId,omitempty"`
+	// SystemData identifies who made the change, as reported by ARM.
+	SystemData *SystemData `json:"systemData,omitempty"`
+	// CorrelationData ties the change to the request logs of the write.
+	CorrelationData *CorrelationData `json:"correlationData,omitempty"`
+	// Changes holds the customer-visible properties that differ between the
+	// previous and the new state of the resource.
+	Changes []ResourcePropertyChange `json:"changes,omitempty"`
+}
+
+// ResourcePropertyChange is a single property difference of a ResourceChange.
+type ResourcePropertyChange struct {
+	// Path is the dotted JSON path of the property, for example
+	// "properties.autoScaling.max" or "tags.env".
+	Path       string                     `json:"path"`
+	ChangeType ResourcePropertyChangeType `json:"changeType"`
+	// Before is the JSON value before the change. Unset for added properties.
+	Before json.RawMessage `json:"before,omitempty"`
+	// After is the JSON value after the change. Unset for removed properties.
+	After json.RawMessage `json:"after,omitempty"`
+}
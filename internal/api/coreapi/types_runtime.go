@@ -357,3 +357,71 @@ var _ runtime.Object = &SystemAdminCredentialRevocationList{}
 func (l *SystemAdminCredentialRevocationList) GetObjectKind() schema.ObjectKind {
 	return &l.TypeMeta
 }
+
+var (
+	_ runtime.Object            = &ResourceChange{}
+	_ metav1.ObjectMetaAccessor = &ResourceChange{}
+)
+
+func (o *ResourceChange) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+func (o *ResourceChange) GetObjectMeta() metav1.Object {
+	om := &metav1.ObjectMeta{}
+	if o.GetResourceID() != nil {
+		om.Name = strings.ToLower(o.GetResourceID().String())
+	}
+	// shared_informer uses ResourceVersion to determine if an event is a sync
+	om.ResourceVersion = strconv.FormatInt(o.InstanceVersion, 10)
+	return om
+}
+
+// ResourceChangeList is a list of ResourceChange resources compatible with
+// runtime.Object for use with Kubernetes informer machinery.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ResourceChangeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceChange `json:"items"`
+}
+
+var _ runtime.Object = &ResourceChangeList{}
+
+func (l *ResourceChangeList) GetObjectKind() schema.ObjectKind {
+	return &l.TypeMeta
+}
+
+var (
+	_ runtime.Object            = &OperationNotification{}
+	_ metav1.ObjectMetaAccessor = &OperationNotification{}
+)
+
+func (o *OperationNotification) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+func (o *OperationNotification) GetObjectMeta() metav1.Object {
+	om := &metav1.ObjectMeta{}
+	if o.GetResourceID() != nil {
+		om.Name = strings.ToLower(o.GetResourceID().String())
+	}
+	// shared_informer uses ResourceVersion to determine if an event is a sync
+	om.ResourceVersion = strconv.FormatInt(o.InstanceVersion, 10)
+	return om
+}
+
+// OperationNotificationList is a list of OperationNotification resources
+// compatible with runtime.Object for use with Kubernetes informer machinery.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type OperationNotificationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OperationNotification `json:"items"`
+}
+
+var _ runtime.Object = &OperationNotificationList{}
+
+func (l *OperationNotificationList) GetObjectKind() schema.ObjectKind {
+	return &l.TypeMeta
+}
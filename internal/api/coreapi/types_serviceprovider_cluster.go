@@ -101,6 +101,14 @@ type ServiceProviderClusterSpec struct {
 	// from any explicit choice; nil means no tier has been requested. Valid
 	// values are the HostedClusterControlPlaneSize* constants above.
 	DesiredHostedClusterControlPlaneSize *string `json:"desiredHostedClusterControlPlaneSize,omitempty"`
+
+	// DesiredHostedClusterControlPlaneSizeUnpinned is set when the control
+	// plane right-sizing controller may change
+	// DesiredHostedClusterControlPlaneSize: either it applied the size itself
+	// or SRE opted in through the admin API. A size without it is pinned, so
+	// sizes set before right-sizing existed are never overwritten. The
+	// controller keeps recording recommendations in Status either way.
+	DesiredHostedClusterControlPlaneSizeUnpinned bool `json:"desiredHostedClusterControlPlaneSizeUnpinned,omitempty"`
 }
 
 // ServiceProviderClusterSpecVersion contains the desired version information.
@@ -161,7 +169,8 @@ type ServiceProviderClusterStatus struct {
 	MaestroReadonlyBundles MaestroBundleReferenceList `json:"maestroReadonlyBundles,omitempty"`
 	// ManagementClusterResourceID is the resource ID of the management cluster
 	// this HCP is placed on. Nil means placement has not been resolved yet.
-	// Once set, this field is immutable.
+	// Once set, it only changes when the HCP is migrated off a draining
+	// management cluster.
 	ManagementClusterResourceID *azcorearm.ResourceID `json:"managementClusterResourceID,omitempty"`
 
 	// DesiredHostedClusterControlPlaneSize mirrors the value of
@@ -173,6 +182,12 @@ type ServiceProviderClusterStatus struct {
 	// property.
 	DesiredHostedClusterControlPlaneSize *string `json:"desiredHostedClusterControlPlaneSize,omitempty"`
 
+	// ControlPlaneSizeRecommendation is the control plane tier the right-sizing
+	// controller recommends from the observed node pool and control plane
+	// signals. It is informational unless auto-apply is enabled on the backend.
+	// Written by: ControlPlaneRightSizing
+	ControlPlaneSizeRecommendation *HostedClusterControlPlaneSizeRecommendation `json:"controlPlaneSizeRecommendation,omitempty"`
+
 	// HostedClusterNamespace is the namespace of the actual HostedCluster.  It contains things like
 	//  - HostedCluster CR — the primary user-facing API object
 	//  - NodePool CRs — user creates these here
@@ -249,6 +264,35 @@ type AzureReference struct {
 	EarliestRecheckTime *metav1.Time `json:"earliestRecheckTime,omitempty"`
 }
 
+// HostedClusterControlPlaneSizeRecommendation records a control plane sizing
+// recommendation together with the signals it was computed from, so SRE can
+// review it before any change is applied.
+type HostedClusterControlPlaneSizeRecommendation struct {
+	// Size is the recommended tier, one of the HostedClusterControlPlaneSize* constants.
+	Size string `json:"size"`
+	// Reason is a short explanation of the signal that determined Size.
+	Reason string `json:"reason,omitempty"`
+	// NodeCount is the number of worker nodes observed across all node pools.
+	NodeCount int32 `json:"nodeCount"`
+	// MaxNodeCount is the number of worker nodes the node pools can scale up
+	// to: the autoscaling maximum of autoscaled pools plus the replicas of the
+	// others.
+	MaxNodeCount int32 `json:"maxNodeCount"`
+	// ControlPlaneAutoscalerSize is the size recommended by the HyperShift
+	// control plane autoscaler from kube-apiserver load, when it publishes one
+	// on the HostedCluster.
+	ControlPlaneAutoscalerSize string `json:"controlPlaneAutoscalerSize,omitempty"`
+	// APIRequestsPerSecond is the request rate served by the hosted
+	// kube-apiserver, as reported by its HorizontalPodAutoscaler. Zero means
+	// no rate was observed.
+	APIRequestsPerSecond int64 `json:"apiRequestsPerSecond,omitempty"`
+	// RecommendedTime is when Size last changed.
+	RecommendedTime metav1.Time `json:"recommendedTime"`
+	// AppliedTime is when the controller last copied Size into
+	// Spec.DesiredHostedClusterControlPlaneSize. Nil means it never did.
+	AppliedTime *metav1.Time `json:"appliedTime,omitempty"`
+}
+
 // ServiceProviderClusterStatusVersion contains the actual version information.
 type ServiceProviderClusterStatusVersion struct {
 	// ActiveVersions is an array of versions currently active in the control plane, ordered with the most recent first.
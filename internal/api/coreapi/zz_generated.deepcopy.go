@@ -846,6 +846,11 @@ func (in *HCPOpenShiftClusterCustomerProperties) DeepCopyInto(out *HCPOpenShiftC
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(MaintenanceWindowProfile)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1161,6 +1166,21 @@ func (in *HCPOpenShiftClusterNodePoolServiceProviderProperties) DeepCopyInto(out
 		in, out := &in.ClusterServiceDeletionTimestamp, &out.ClusterServiceDeletionTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.UpdateRollback != nil {
+		in, out := &in.UpdateRollback, &out.UpdateRollback
+		*out = new(NodePoolUpdateRollback)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(NodePoolReplacement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StoppedScaling != nil {
+		in, out := &in.StoppedScaling, &out.StoppedScaling
+		*out = new(NodePoolStoppedScaling)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CreateOperationCompletionDeadline != nil {
 		in, out := &in.CreateOperationCompletionDeadline, &out.CreateOperationCompletionDeadline
 		*out = (*in).DeepCopy()
@@ -1325,6 +1345,27 @@ func (in *HCPOpenShiftVersionProperties) DeepCopy() *HCPOpenShiftVersionProperti
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostedClusterControlPlaneSizeRecommendation) DeepCopyInto(out *HostedClusterControlPlaneSizeRecommendation) {
+	*out = *in
+	in.RecommendedTime.DeepCopyInto(&out.RecommendedTime)
+	if in.AppliedTime != nil {
+		in, out := &in.AppliedTime, &out.AppliedTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostedClusterControlPlaneSizeRecommendation.
+func (in *HostedClusterControlPlaneSizeRecommendation) DeepCopy() *HostedClusterControlPlaneSizeRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(HostedClusterControlPlaneSizeRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageDigestMirror) DeepCopyInto(out *ImageDigestMirror) {
 	*out = *in
@@ -1442,6 +1483,32 @@ func (in *MaestroBundleResourceIdentifier) DeepCopy() *MaestroBundleResourceIden
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowProfile) DeepCopyInto(out *MaintenanceWindowProfile) {
+	*out = *in
+	if in.DaysOfWeek != nil {
+		in, out := &in.DaysOfWeek, &out.DaysOfWeek
+		*out = make([]metadataapi.DayOfWeek, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExclusionDates != nil {
+		in, out := &in.ExclusionDates, &out.ExclusionDates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowProfile.
+func (in *MaintenanceWindowProfile) DeepCopy() *MaintenanceWindowProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedServiceIdentity) DeepCopyInto(out *ManagedServiceIdentity) {
 	*out = *in
@@ -1646,6 +1713,71 @@ func (in *NodePoolPlatformProfile) DeepCopy() *NodePoolPlatformProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolReplacement) DeepCopyInto(out *NodePoolReplacement) {
+	*out = *in
+	in.Platform.DeepCopyInto(&out.Platform)
+	if in.SurgeClusterServiceID != nil {
+		in, out := &in.SurgeClusterServiceID, &out.SurgeClusterServiceID
+		*out = new(metadataapi.InternalID)
+		**out = **in
+	}
+	if in.PreviousClusterServiceID != nil {
+		in, out := &in.PreviousClusterServiceID, &out.PreviousClusterServiceID
+		*out = new(metadataapi.InternalID)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolReplacement.
+func (in *NodePoolReplacement) DeepCopy() *NodePoolReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolStoppedScaling) DeepCopyInto(out *NodePoolStoppedScaling) {
+	*out = *in
+	if in.AutoScaling != nil {
+		in, out := &in.AutoScaling, &out.AutoScaling
+		*out = new(NodePoolAutoScaling)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolStoppedScaling.
+func (in *NodePoolStoppedScaling) DeepCopy() *NodePoolStoppedScaling {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolStoppedScaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePoolUpdateRollback) DeepCopyInto(out *NodePoolUpdateRollback) {
+	*out = *in
+	in.Properties.DeepCopyInto(&out.Properties)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolUpdateRollback.
+func (in *NodePoolUpdateRollback) DeepCopy() *NodePoolUpdateRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePoolUpdateRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodePoolVersionProfile) DeepCopyInto(out *NodePoolVersionProfile) {
 	*out = *in
@@ -1707,6 +1839,11 @@ func (in *Operation) DeepCopyInto(out *Operation) {
 		*out = new(CloudErrorBody)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]OperationPhase, len(*in))
+		copy(*out, *in)
+	}
 	if in.SystemAdminCredentialRequest != nil {
 		in, out := &in.SystemAdminCredentialRequest, &out.SystemAdminCredentialRequest
 		*out = new(OperationSystemAdminCredentialRequest)
@@ -1771,6 +1908,140 @@ func (in *OperationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationNotification) DeepCopyInto(out *OperationNotification) {
+	*out = *in
+	in.CosmosMetadata.DeepCopyInto(&out.CosmosMetadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationNotification.
+func (in *OperationNotification) DeepCopy() *OperationNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperationNotification) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationNotificationList) DeepCopyInto(out *OperationNotificationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OperationNotification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationNotificationList.
+func (in *OperationNotificationList) DeepCopy() *OperationNotificationList {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationNotificationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperationNotificationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationNotificationSpec) DeepCopyInto(out *OperationNotificationSpec) {
+	*out = *in
+	if in.OperationID != nil {
+		in, out := &in.OperationID, &out.OperationID
+		*out = DeepCopyResourceID(*in)
+	}
+	if in.Payload != nil {
+		in, out := &in.Payload, &out.Payload
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	in.CreationTime.DeepCopyInto(&out.CreationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationNotificationSpec.
+func (in *OperationNotificationSpec) DeepCopy() *OperationNotificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationNotificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationNotificationStatus) DeepCopyInto(out *OperationNotificationStatus) {
+	*out = *in
+	if in.LastAttemptTime != nil {
+		in, out := &in.LastAttemptTime, &out.LastAttemptTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextAttemptTime != nil {
+		in, out := &in.NextAttemptTime, &out.NextAttemptTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DeliveredTime != nil {
+		in, out := &in.DeliveredTime, &out.DeliveredTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DeadLetteredTime != nil {
+		in, out := &in.DeadLetteredTime, &out.DeadLetteredTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationNotificationStatus.
+func (in *OperationNotificationStatus) DeepCopy() *OperationNotificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationNotificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationPhase) DeepCopyInto(out *OperationPhase) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationPhase.
+func (in *OperationPhase) DeepCopy() *OperationPhase {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationPhase)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationStatus) DeepCopyInto(out *OperationStatus) {
 	*out = *in
@@ -1818,6 +2089,27 @@ func (in *OperationStatus) DeepCopy() *OperationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationStatusProperties) DeepCopyInto(out *OperationStatusProperties) {
+	*out = *in
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]OperationPhase, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationStatusProperties.
+func (in *OperationStatusProperties) DeepCopy() *OperationStatusProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationStatusProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationSystemAdminCredentialRequest) DeepCopyInto(out *OperationSystemAdminCredentialRequest) {
 	*out = *in
@@ -1944,6 +2236,112 @@ func (in *Resource) DeepCopy() *Resource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceChange) DeepCopyInto(out *ResourceChange) {
+	*out = *in
+	in.CosmosMetadata.DeepCopyInto(&out.CosmosMetadata)
+	if in.TargetResourceID != nil {
+		in, out := &in.TargetResourceID, &out.TargetResourceID
+		*out = DeepCopyResourceID(*in)
+	}
+	in.ChangedAt.DeepCopyInto(&out.ChangedAt)
+	if in.SystemData != nil {
+		in, out := &in.SystemData, &out.SystemData
+		*out = new(SystemData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CorrelationData != nil {
+		in, out := &in.CorrelationData, &out.CorrelationData
+		*out = new(CorrelationData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]ResourcePropertyChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceChange.
+func (in *ResourceChange) DeepCopy() *ResourceChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceChange) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceChangeList) DeepCopyInto(out *ResourceChangeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceChangeList.
+func (in *ResourceChangeList) DeepCopy() *ResourceChangeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceChangeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceChangeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePropertyChange) DeepCopyInto(out *ResourcePropertyChange) {
+	*out = *in
+	if in.Before != nil {
+		in, out := &in.Before, &out.Before
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	if in.After != nil {
+		in, out := &in.After, &out.After
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePropertyChange.
+func (in *ResourcePropertyChange) DeepCopy() *ResourcePropertyChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePropertyChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceProviderAPIProfile) DeepCopyInto(out *ServiceProviderAPIProfile) {
 	*out = *in
@@ -2110,6 +2508,11 @@ func (in *ServiceProviderClusterStatus) DeepCopyInto(out *ServiceProviderCluster
 		*out = new(string)
 		**out = **in
 	}
+	if in.ControlPlaneSizeRecommendation != nil {
+		in, out := &in.ControlPlaneSizeRecommendation, &out.ControlPlaneSizeRecommendation
+		*out = new(HostedClusterControlPlaneSizeRecommendation)
+		(*in).DeepCopyInto(*out)
+	}
 	in.AzureResources.DeepCopyInto(&out.AzureResources)
 	return
 }
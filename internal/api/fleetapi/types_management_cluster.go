@@ -52,6 +52,19 @@ const (
 	// Owner: MaestroRegistrationController.
 	ManagementClusterConditionMaestroRegistered ManagementClusterConditionType = "MaestroRegistered"
 
+	// ManagementClusterConditionDrained indicates whether every hosted control
+	// plane has been migrated off a management cluster with a drain requested.
+	// The migrations in flight are reported in ManagementClusterStatus.Drain.
+	// Owner: ManagementClusterDrainController.
+	ManagementClusterConditionDrained ManagementClusterConditionType = "Drained"
+
+	// ManagementClusterConditionCapacityAvailable indicates whether the
+	// capacity score of the management cluster leaves room for new hosted
+	// control planes. It is False while the capacity controller holds the
+	// management cluster cordoned. See ManagementClusterStatus.Capacity.
+	// Owner: ManagementClusterCapacityController.
+	ManagementClusterConditionCapacityAvailable ManagementClusterConditionType = "CapacityAvailable"
+
 	// ManagementClusterConditionReasonProvisionShardActive indicates the CS provision
 	// shard is active and the management cluster is ready for scheduling.
 	ManagementClusterConditionReasonProvisionShardActive ManagementClusterConditionReason = "ProvisionShardActive"
@@ -88,6 +101,40 @@ const (
 	// ManagementClusterConditionReasonRegistrationIncomplete indicates one or more
 	// sub-conditions are not True.
 	ManagementClusterConditionReasonRegistrationIncomplete ManagementClusterConditionReason = "RegistrationIncomplete"
+
+	// ManagementClusterConditionReasonDrainNotRequested indicates a previous
+	// drain request was withdrawn through undrain.
+	ManagementClusterConditionReasonDrainNotRequested ManagementClusterConditionReason = "DrainNotRequested"
+
+	// ManagementClusterConditionReasonDrainInProgress indicates hosted control
+	// planes are still being migrated off the management cluster.
+	ManagementClusterConditionReasonDrainInProgress ManagementClusterConditionReason = "DrainInProgress"
+
+	// ManagementClusterConditionReasonDrainComplete indicates no hosted control
+	// planes remain on the management cluster.
+	ManagementClusterConditionReasonDrainComplete ManagementClusterConditionReason = "DrainComplete"
+
+	// ManagementClusterConditionReasonNoDrainTarget indicates no other
+	// management cluster is Ready and Schedulable to receive hosted control planes.
+	ManagementClusterConditionReasonNoDrainTarget ManagementClusterConditionReason = "NoDrainTarget"
+
+	// ManagementClusterConditionReasonMigrationUnavailable indicates hosted
+	// control planes remain and Cluster Service cannot migrate them, so the
+	// drain completes only once they are deleted.
+	ManagementClusterConditionReasonMigrationUnavailable ManagementClusterConditionReason = "MigrationUnavailable"
+
+	// ManagementClusterConditionReasonBelowHighWaterMark indicates the capacity
+	// score is below the high-water mark.
+	ManagementClusterConditionReasonBelowHighWaterMark ManagementClusterConditionReason = "BelowHighWaterMark"
+
+	// ManagementClusterConditionReasonAboveHighWaterMark indicates the capacity
+	// score reached the high-water mark and the management cluster stays
+	// cordoned until the score falls to the low-water mark.
+	ManagementClusterConditionReasonAboveHighWaterMark ManagementClusterConditionReason = "AboveHighWaterMark"
+
+	// ManagementClusterConditionReasonCapacityUnknown indicates the usage of
+	// the management cluster could not be read.
+	ManagementClusterConditionReasonCapacityUnknown ManagementClusterConditionReason = "CapacityUnknown"
 )
 
 // ManagementClusterSchedulingPolicy controls whether new hosted control planes
@@ -153,14 +200,70 @@ type ManagementClusterSpec struct {
 	// by ManagementClusterMigrationController (temporary, during CS-to-Cosmos migration).
 	// Will transition to being owned by the admin API via a Geneva Action for
 	// SRE-initiated cordon/uncordon operations.
+	// ManagementClusterCapacityController also sets it to Unschedulable when
+	// the capacity score reaches the high-water mark, and back to Schedulable
+	// once the score falls to the low-water mark (see Status.Capacity.Cordoned).
 	SchedulingPolicy ManagementClusterSchedulingPolicy `json:"schedulingPolicy"`
+
+	// Drain requests that every hosted control plane is migrated off this
+	// management cluster, e.g. for decommissioning or incident response.
+	// Analogous to kubectl drain: it is set together with an Unschedulable
+	// SchedulingPolicy so no new HCPs land here while the drain runs.
+	//
+	// Ownership: set by the admin API, acted on by ManagementClusterDrainController.
+	//
+	// +optional
+	Drain *ManagementClusterDrain `json:"drain,omitempty"`
+}
+
+// ManagementClusterDrain describes a request to migrate all hosted control
+// planes off a management cluster.
+type ManagementClusterDrain struct {
+	// Reason explains why the management cluster is drained, e.g. an incident
+	// or a decommissioning ticket.
+	//
+	// +required
+	Reason string `json:"reason"`
+
+	// RequestedBy is the client principal that requested the drain.
+	//
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+
+	// RequestedTime is when the drain was first requested.
+	//
+	// +required
+	RequestedTime metav1.Time `json:"requestedTime"`
+
+	// MaxConcurrentMigrations bounds how many hosted control planes are
+	// migrated at the same time.
+	//
+	// +required, between 1 and MaxManagementClusterDrainConcurrentMigrations.
+	MaxConcurrentMigrations int32 `json:"maxConcurrentMigrations"`
+
+	// PreviousSchedulingPolicy is the SchedulingPolicy the management cluster
+	// had before the drain cordoned it. Undrain restores it.
+	//
+	// +optional, one of ValidManagementClusterSchedulingPolicies.
+	PreviousSchedulingPolicy ManagementClusterSchedulingPolicy `json:"previousSchedulingPolicy,omitempty"`
 }
 
+const (
+	// DefaultManagementClusterDrainConcurrentMigrations is used when a drain
+	// request does not specify MaxConcurrentMigrations.
+	DefaultManagementClusterDrainConcurrentMigrations int32 = 5
+
+	// MaxManagementClusterDrainConcurrentMigrations is the upper bound of
+	// ManagementClusterDrain.MaxConcurrentMigrations.
+	MaxManagementClusterDrainConcurrentMigrations int32 = 50
+)
+
 // ManagementClusterStatus contains the observed state of a management cluster.
 type ManagementClusterStatus struct {
 	// Conditions is a list of conditions tracking the lifecycle of the management cluster.
 	// Known condition types are defined as ManagementClusterConditionType constants:
-	// Ready, ClustersServiceRegistered, MaestroRegistered.
+	// Ready, ClustersServiceRegistered, MaestroRegistered, CapacityAvailable
+	// and Drained.
 	//
 	// Conditions are added on first evaluation and never removed. Status is toggled
 	// between True/False/Unknown. Absence of a condition means "not yet evaluated."
@@ -230,4 +333,136 @@ type ManagementClusterStatus struct {
 	//
 	// +required, immutable once set.
 	KubeApplierCosmosContainerName string `json:"kubeApplierCosmosContainerName,omitempty"`
+
+	// Capacity is the most recent capacity score of the management cluster.
+	//
+	// Ownership: ManagementClusterCapacityController.
+	//
+	// +optional
+	Capacity *ManagementClusterCapacity `json:"capacity,omitempty"`
+
+	// Drain reports the progress of the drain requested in Spec.Drain. It is
+	// removed when the drain is withdrawn.
+	//
+	// Ownership: ManagementClusterDrainController.
+	//
+	// +optional
+	Drain *ManagementClusterDrainStatus `json:"drain,omitempty"`
+}
+
+// ManagementClusterDrainStatus is the progress of a drain.
+type ManagementClusterDrainStatus struct {
+	// RequestedTime is the Spec.Drain.RequestedTime of the drain this status
+	// belongs to, so a new drain request starts from a fresh status.
+	RequestedTime metav1.Time `json:"requestedTime"`
+
+	// Migrations are the hosted control plane migrations in flight. An entry
+	// is removed once Cluster Service places the hosted control plane on its
+	// target provision shard, so there are never more than
+	// Spec.Drain.MaxConcurrentMigrations.
+	//
+	// +optional
+	Migrations []HostedControlPlaneMigration `json:"migrations,omitempty"`
+
+	// FailedMigrations are the migrations that did not complete within the
+	// migration timeout. They no longer count against
+	// Spec.Drain.MaxConcurrentMigrations and their hosted control planes are
+	// not migrated again by this drain.
+	//
+	// +optional
+	FailedMigrations []HostedControlPlaneMigration `json:"failedMigrations,omitempty"`
+
+	// MigratedCount is the number of hosted control planes this drain moved
+	// off the management cluster.
+	MigratedCount int32 `json:"migratedCount"`
+
+	// CompletedTime is when the last hosted control plane left the management
+	// cluster. No further migrations are started once it is set.
+	//
+	// +optional
+	CompletedTime *metav1.Time `json:"completedTime,omitempty"`
+}
+
+// HostedControlPlaneMigration is a hosted control plane that Cluster Service
+// was asked to move to another management cluster.
+type HostedControlPlaneMigration struct {
+	// ClusterServiceID is the Cluster Service ID of the cluster.
+	ClusterServiceID string `json:"clusterServiceID"`
+
+	// TargetProvisionShardID is the ID of the provision shard the cluster is
+	// migrating to.
+	TargetProvisionShardID string `json:"targetProvisionShardID"`
+
+	// StartedTime is when the migration was requested.
+	StartedTime metav1.Time `json:"startedTime"`
+
+	// FailedTime is when the migration was given up on.
+	//
+	// +optional
+	FailedTime *metav1.Time `json:"failedTime,omitempty"`
+}
+
+// ManagementClusterCapacity describes how much of a management cluster is in
+// use. Every dimension is a utilization percentage from 0 (empty) to 100
+// (full); dimensions that could not be measured are reported as 0.
+type ManagementClusterCapacity struct {
+	// Score is the utilization of the most constrained dimension. When
+	// automatic cordoning is enabled, the management cluster is cordoned once
+	// it reaches the high-water mark.
+	Score int32 `json:"score"`
+
+	// HostedControlPlanes is the number of hosted control planes placed on
+	// the management cluster.
+	HostedControlPlanes int32 `json:"hostedControlPlanes"`
+
+	// HostedControlPlanesPercent is HostedControlPlanes relative to the
+	// configured maximum per management cluster.
+	HostedControlPlanesPercent int32 `json:"hostedControlPlanesPercent"`
+
+	// CPURequestedPercent is the CPU requested by pods relative to the CPU
+	// allocatable on the nodes.
+	CPURequestedPercent int32 `json:"cpuRequestedPercent"`
+
+	// MemoryRequestedPercent is the memory requested by pods relative to the
+	// memory allocatable on the nodes.
+	MemoryRequestedPercent int32 `json:"memoryRequestedPercent"`
+
+	// Zones breaks down the requested CPU and memory per availability zone.
+	// Hosted control planes spread across zones, so the fullest zone bounds
+	// the capacity of the management cluster.
+	//
+	// +optional
+	Zones []ManagementClusterZoneCapacity `json:"zones,omitempty"`
+
+	// AMWActiveTimeSeries is the number of active time series the management
+	// cluster sends to its Azure Monitor Workspace.
+	//
+	// +optional
+	AMWActiveTimeSeries int64 `json:"amwActiveTimeSeries,omitempty"`
+
+	// AMWIngestionPercent is AMWActiveTimeSeries relative to the share of the
+	// workspace ingestion limit reserved for each management cluster.
+	AMWIngestionPercent int32 `json:"amwIngestionPercent"`
+
+	// Cordoned is true when the capacity controller set SchedulingPolicy to
+	// Unschedulable. Only a cordon it placed is lifted by the controller.
+	//
+	// +optional
+	Cordoned bool `json:"cordoned,omitempty"`
+
+	// LastUpdateTime is when the score or the cordon state last changed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// ManagementClusterZoneCapacity is the requested CPU and memory of the nodes
+// in one availability zone of a management cluster.
+type ManagementClusterZoneCapacity struct {
+	// Zone is the topology.kubernetes.io/zone label of the nodes.
+	Zone string `json:"zone"`
+
+	// CPURequestedPercent is the CPU requested relative to the CPU allocatable in the zone.
+	CPURequestedPercent int32 `json:"cpuRequestedPercent"`
+
+	// MemoryRequestedPercent is the memory requested relative to the memory allocatable in the zone.
+	MemoryRequestedPercent int32 `json:"memoryRequestedPercent"`
 }
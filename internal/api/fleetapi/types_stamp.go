@@ -15,6 +15,8 @@
 package fleetapi
 
 import (
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -44,6 +46,37 @@ const (
 	// StampConditionReasonApprovalRevoked indicates approval was revoked
 	// via the admin API.
 	StampConditionReasonApprovalRevoked StampConditionReason = "ApprovalRevoked"
+
+	// StampConditionReasonApprovalsReceived indicates the stamp collected the
+	// number of distinct approvals its approval policy requires.
+	StampConditionReasonApprovalsReceived StampConditionReason = "ApprovalsReceived"
+
+	// StampConditionReasonAwaitingApprovals indicates the stamp has fewer
+	// distinct approvals than its approval policy requires.
+	StampConditionReasonAwaitingApprovals StampConditionReason = "AwaitingApprovals"
+)
+
+const (
+	// StampConditionProductionReady indicates whether an approved stamp has
+	// passed the rollout gates of its approval policy and may receive
+	// customer hosted control planes. Only set for stamps with an approval
+	// policy.
+	StampConditionProductionReady StampConditionType = "ProductionReady"
+
+	// StampConditionReasonNotApproved indicates the stamp is not approved yet.
+	StampConditionReasonNotApproved StampConditionReason = "NotApproved"
+
+	// StampConditionReasonSoaking indicates the management cluster is
+	// registered but has not been Ready for the soak duration yet.
+	StampConditionReasonSoaking StampConditionReason = "Soaking"
+
+	// StampConditionReasonHealthCheckFailing indicates one of the management
+	// cluster conditions required by the approval policy is not True.
+	StampConditionReasonHealthCheckFailing StampConditionReason = "HealthCheckFailing"
+
+	// StampConditionReasonRolloutGatesPassed indicates the stamp passed every
+	// rollout gate. Once set it is only cleared by revoking approval.
+	StampConditionReasonRolloutGatesPassed StampConditionReason = "RolloutGatesPassed"
 )
 
 // Stamp is the parent scope for management cluster resources, analogous to
@@ -69,12 +102,83 @@ type Stamp struct {
 }
 
 // StampSpec contains the desired state of a stamp.
-// Reserved for future provisioning intent (constraints, features, sizing).
-type StampSpec struct{}
+type StampSpec struct {
+	// ApprovalPolicy requires a number of distinct approvers and staged
+	// rollout gates before the stamp takes customer workloads. When nil, a
+	// single approval via the admin API (or auto-approval) is sufficient.
+	//
+	// Owner: stamp registration.
+	// +optional
+	ApprovalPolicy *StampApprovalPolicy `json:"approvalPolicy,omitempty"`
+}
+
+// StampApprovalPolicy describes the two-person control and rollout gates a
+// stamp must pass before it is production ready.
+type StampApprovalPolicy struct {
+	// RequiredApprovals is the number of distinct approvers required before
+	// the stamp is Approved and registered with Cluster Service and Maestro.
+	// +required, at least 1.
+	RequiredApprovals int32 `json:"requiredApprovals"`
+
+	// RequestedBy is the identity that requested the stamp. It cannot
+	// approve its own stamp.
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+
+	// SoakDuration is how long the management cluster must have been Ready
+	// after approval before the stamp is ProductionReady. While soaking the
+	// provision shard stays in maintenance so Cluster Service does not
+	// place customer hosted control planes on it.
+	// +optional
+	SoakDuration metav1.Duration `json:"soakDuration,omitempty"`
+
+	// RequiredManagementClusterConditions lists management cluster condition
+	// types that must be True before the stamp is ProductionReady, for
+	// example ClustersServiceRegistered and MaestroRegistered.
+	// +optional
+	RequiredManagementClusterConditions []string `json:"requiredManagementClusterConditions,omitempty"`
+}
 
 // StampStatus contains the observed state of a stamp.
 type StampStatus struct {
 	// Conditions tracks the stamp's lifecycle progression.
-	// Known condition types: Approved.
+	// Known condition types: Approved, ProductionReady.
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Approvals records who approved the stamp, at most one entry per
+	// approver. Cleared when approval is revoked.
+	//
+	// Owner: admin API.
+	// +optional
+	Approvals []StampApproval `json:"approvals,omitempty"`
+}
+
+// StampApproval is one approver's sign-off on a stamp.
+type StampApproval struct {
+	// Approver is the identity of the principal that approved the stamp.
+	Approver string `json:"approver"`
+
+	// Reason and Message are the justification given with the approval.
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+
+	// ApprovedTime is when the approval was recorded.
+	ApprovedTime metav1.Time `json:"approvedTime"`
+}
+
+// DistinctApprovers returns the number of distinct approvers recorded on the
+// stamp, not counting the identity that requested it.
+func (s *Stamp) DistinctApprovers() int32 {
+	requestedBy := ""
+	if s.Spec.ApprovalPolicy != nil {
+		requestedBy = s.Spec.ApprovalPolicy.RequestedBy
+	}
+	seen := map[string]bool{}
+	for _, approval := range s.Status.Approvals {
+		if len(approval.Approver) == 0 || strings.EqualFold(approval.Approver, requestedBy) {
+			continue
+		}
+		seen[strings.ToLower(approval.Approver)] = true
+	}
+	return int32(len(seen))
 }
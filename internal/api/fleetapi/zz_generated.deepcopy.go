@@ -27,6 +27,27 @@ import (
 	metadataapi "github.com/Azure/ARO-HCP/internal/api/metadataapi"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostedControlPlaneMigration) DeepCopyInto(out *HostedControlPlaneMigration) {
+	*out = *in
+	in.StartedTime.DeepCopyInto(&out.StartedTime)
+	if in.FailedTime != nil {
+		in, out := &in.FailedTime, &out.FailedTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostedControlPlaneMigration.
+func (in *HostedControlPlaneMigration) DeepCopy() *HostedControlPlaneMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(HostedControlPlaneMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagementCluster) DeepCopyInto(out *ManagementCluster) {
 	*out = *in
@@ -35,7 +56,7 @@ func (in *ManagementCluster) DeepCopyInto(out *ManagementCluster) {
 		in, out := &in.ResourceID, &out.ResourceID
 		*out = coreapi.DeepCopyResourceID(*in)
 	}
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -58,6 +79,80 @@ func (in *ManagementCluster) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagementClusterCapacity) DeepCopyInto(out *ManagementClusterCapacity) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]ManagementClusterZoneCapacity, len(*in))
+		copy(*out, *in)
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterCapacity.
+func (in *ManagementClusterCapacity) DeepCopy() *ManagementClusterCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagementClusterCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagementClusterDrain) DeepCopyInto(out *ManagementClusterDrain) {
+	*out = *in
+	in.RequestedTime.DeepCopyInto(&out.RequestedTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterDrain.
+func (in *ManagementClusterDrain) DeepCopy() *ManagementClusterDrain {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagementClusterDrain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagementClusterDrainStatus) DeepCopyInto(out *ManagementClusterDrainStatus) {
+	*out = *in
+	in.RequestedTime.DeepCopyInto(&out.RequestedTime)
+	if in.Migrations != nil {
+		in, out := &in.Migrations, &out.Migrations
+		*out = make([]HostedControlPlaneMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailedMigrations != nil {
+		in, out := &in.FailedMigrations, &out.FailedMigrations
+		*out = make([]HostedControlPlaneMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CompletedTime != nil {
+		in, out := &in.CompletedTime, &out.CompletedTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterDrainStatus.
+func (in *ManagementClusterDrainStatus) DeepCopy() *ManagementClusterDrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagementClusterDrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagementClusterList) DeepCopyInto(out *ManagementClusterList) {
 	*out = *in
@@ -94,6 +189,11 @@ func (in *ManagementClusterList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagementClusterSpec) DeepCopyInto(out *ManagementClusterSpec) {
 	*out = *in
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(ManagementClusterDrain)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -130,6 +230,16 @@ func (in *ManagementClusterStatus) DeepCopyInto(out *ManagementClusterStatus) {
 		*out = new(metadataapi.InternalID)
 		**out = **in
 	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(ManagementClusterCapacity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(ManagementClusterDrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -143,6 +253,22 @@ func (in *ManagementClusterStatus) DeepCopy() *ManagementClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagementClusterZoneCapacity) DeepCopyInto(out *ManagementClusterZoneCapacity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagementClusterZoneCapacity.
+func (in *ManagementClusterZoneCapacity) DeepCopy() *ManagementClusterZoneCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagementClusterZoneCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Stamp) DeepCopyInto(out *Stamp) {
 	*out = *in
@@ -151,7 +277,7 @@ func (in *Stamp) DeepCopyInto(out *Stamp) {
 		in, out := &in.ResourceID, &out.ResourceID
 		*out = coreapi.DeepCopyResourceID(*in)
 	}
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -174,6 +300,45 @@ func (in *Stamp) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StampApproval) DeepCopyInto(out *StampApproval) {
+	*out = *in
+	in.ApprovedTime.DeepCopyInto(&out.ApprovedTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StampApproval.
+func (in *StampApproval) DeepCopy() *StampApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(StampApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StampApprovalPolicy) DeepCopyInto(out *StampApprovalPolicy) {
+	*out = *in
+	out.SoakDuration = in.SoakDuration
+	if in.RequiredManagementClusterConditions != nil {
+		in, out := &in.RequiredManagementClusterConditions, &out.RequiredManagementClusterConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StampApprovalPolicy.
+func (in *StampApprovalPolicy) DeepCopy() *StampApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(StampApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StampList) DeepCopyInto(out *StampList) {
 	*out = *in
@@ -210,6 +375,11 @@ func (in *StampList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StampSpec) DeepCopyInto(out *StampSpec) {
 	*out = *in
+	if in.ApprovalPolicy != nil {
+		in, out := &in.ApprovalPolicy, &out.ApprovalPolicy
+		*out = new(StampApprovalPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -233,6 +403,13 @@ func (in *StampStatus) DeepCopyInto(out *StampStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]StampApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -24,6 +24,16 @@ const (
 	// True means the controller failed in a way unrelated to the kube-apiserver
 	// rejecting our request.
 	ConditionTypeDegraded = "Degraded"
+
+	// ConditionTypeWaitingForDependencies is reported on ApplyDesires with
+	// .spec.dependsOn. True means at least one dependency has not succeeded
+	// or is not ready yet, so the desire has not been reconciled.
+	ConditionTypeWaitingForDependencies = "WaitingForDependencies"
+
+	// ConditionTypeDrifted is reported on ApplyDesires with Type=ServerSideApply.
+	// True means the last sync found the live object differing from the
+	// desired state in fields the kube-applier manages.
+	ConditionTypeDrifted = "Drifted"
 )
 
 // Condition reasons.
@@ -47,4 +57,25 @@ const (
 	// ConditionReasonFailed is the failure reason matching the existing controller
 	// convention (see backend's controllerutils.ReportSyncError).
 	ConditionReasonFailed = "Failed"
+
+	// ConditionReasonDependenciesNotReady is set on WaitingForDependencies when at least
+	// one dependency has not succeeded or is not ready yet.
+	ConditionReasonDependenciesNotReady = "DependenciesNotReady"
+
+	// ConditionReasonDependenciesReady is set on WaitingForDependencies when every
+	// dependency succeeded and is ready.
+	ConditionReasonDependenciesReady = "DependenciesReady"
+
+	// ConditionReasonNoDrift is set on Drifted when the live object matches the
+	// desired state.
+	ConditionReasonNoDrift = "NoDrift"
+
+	// ConditionReasonDriftCorrected is set on Drifted when drift was found and
+	// the desired state was re-applied over it.
+	ConditionReasonDriftCorrected = "DriftCorrected"
+
+	// ConditionReasonDriftDetected is set on Drifted when drift was found and
+	// left in place, either because the desire's drift policy is ReportOnly or
+	// because re-applying failed.
+	ConditionReasonDriftDetected = "DriftDetected"
 )
@@ -100,8 +100,102 @@ type ApplyDesireSpec struct {
 	// Must be non-nil when Type=ServerSideApply; must be nil when Type=Delete.
 	// +k8s:unionMember=ServerSideApply
 	ServerSideApply *ServerSideApplyConfig `json:"serverSideApply,omitempty"`
+
+	// DependsOn lists the ApplyDesires that must have succeeded, and pass
+	// their readiness check if one is given, before this desire is
+	// reconciled. Until then the controller reports the
+	// "WaitingForDependencies" condition and issues no kube-apiserver calls
+	// for this desire. Dependencies must target the same management cluster;
+	// cycles are not detected and leave every desire in the cycle waiting.
+	DependsOn []ApplyDesireDependency `json:"dependsOn,omitempty"`
+}
+
+// ApplyDesireDependency references an ApplyDesire that another ApplyDesire
+// depends on.
+type ApplyDesireDependency struct {
+	// ApplyDesire is the resource ID of the ApplyDesire depended on. It may
+	// be nested under a different parent (cluster or node pool) than the
+	// dependent desire.
+	ApplyDesire *azcorearm.ResourceID `json:"applyDesire"`
+
+	// Readiness optionally checks the live object of the dependency's
+	// .spec.targetItem on top of the dependency having succeeded, e.g. that a
+	// CustomResourceDefinition is Established or a Deployment is Available.
+	// Only meaningful for dependencies with Type=ServerSideApply.
+	Readiness *ReadinessCheck `json:"readiness,omitempty"`
+}
+
+// ReadinessCheckType is the discriminator for the ReadinessCheck union.
+// +k8s:union
+type ReadinessCheckType string
+
+const (
+	// ReadinessCheckTypeCondition checks a condition in .status.conditions of
+	// the target object.
+	// +k8s:unionMember
+	ReadinessCheckTypeCondition ReadinessCheckType = "Condition"
+
+	// ReadinessCheckTypeJSONPath compares the value a JSONPath expression
+	// yields on the target object.
+	// +k8s:unionMember
+	ReadinessCheckTypeJSONPath ReadinessCheckType = "JSONPath"
+)
+
+// ReadinessCheck decides whether a live Kubernetes object is ready.
+//
+// +k8s:discriminator=Type
+type ReadinessCheck struct {
+	// Type discriminates the check: Condition or JSONPath.
+	// +k8s:union
+	Type ReadinessCheckType `json:"type"`
+
+	// Condition must be non-nil when Type=Condition.
+	// +k8s:unionMember=Condition
+	Condition *ConditionReadinessCheck `json:"condition,omitempty"`
+
+	// JSONPath must be non-nil when Type=JSONPath.
+	// +k8s:unionMember=JSONPath
+	JSONPath *JSONPathReadinessCheck `json:"jsonPath,omitempty"`
+}
+
+// ConditionReadinessCheck passes when the target object carries a condition
+// of the given type and status in .status.conditions.
+type ConditionReadinessCheck struct {
+	// Type is the condition type, e.g. "Established" for a
+	// CustomResourceDefinition or "Available" for a Deployment.
+	Type string `json:"type"`
+	// Status is the expected condition status. Defaults to "True".
+	Status metav1.ConditionStatus `json:"status,omitempty"`
+}
+
+// JSONPathReadinessCheck passes when the JSONPath expression yields the
+// expected value on the target object.
+type JSONPathReadinessCheck struct {
+	// Path is a kubectl-style JSONPath expression, e.g. "{.status.phase}".
+	Path string `json:"path"`
+	// Value is the expected output of Path, compared verbatim.
+	Value string `json:"value"`
 }
 
+// DriftPolicy selects what the kube-applier does when the live object of a
+// ServerSideApply desire no longer matches what it last applied, e.g.
+// because someone edited it by hand on the management cluster.
+type DriftPolicy string
+
+const (
+	// DriftPolicyCorrect re-applies the desired state over the drift and
+	// reports the correction. It is the default.
+	DriftPolicyCorrect DriftPolicy = "Correct"
+
+	// DriftPolicyReportOnly reports the drift and leaves the live object
+	// alone. Changes to the desire itself are still applied.
+	DriftPolicyReportOnly DriftPolicy = "ReportOnly"
+)
+
+// MaxDriftedFields caps the number of field paths recorded in
+// .status.driftedFields.
+const MaxDriftedFields = 20
+
 // ServerSideApplyConfig holds fields specific to the ServerSideApply variant
 // of ApplyDesire.
 type ServerSideApplyConfig struct {
@@ -118,6 +212,12 @@ type ServerSideApplyConfig struct {
 	// so an operator inspecting fieldsV1 metadata can attribute ownership at
 	// a glance.
 	KubeContent *runtime.RawExtension `json:"kubeContent,omitempty"`
+
+	// DriftPolicy selects how drift of the live object from KubeContent is
+	// handled: Correct (the default when empty) or ReportOnly. Drift is
+	// detected with a dry-run server-side apply compared against the live
+	// object, so only fields the kube-applier manages are considered.
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 type ApplyDesireStatus struct {
@@ -127,6 +227,13 @@ type ApplyDesireStatus struct {
 	//     are running, Successful stays False with reason "WaitingForDeletion".
 	//   - "Degraded":   the controller is not making progress for an
 	//                   out-of-band reason.
+	//   - "WaitingForDependencies": only set when .spec.dependsOn is
+	//     non-empty; true while at least one dependency is not ready.
+	//   - "Drifted": only set for Type=ServerSideApply; true when the last
+	//     sync found the live object differing from the desired state, with
+	//     reason "DriftCorrected" or "DriftDetected" depending on whether it
+	//     was re-applied. Under ReportOnly, Successful and
+	//     AppliedKubeGeneration keep describing the last apply.
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
 	// AppliedKubeGeneration records the metadata.generation of the
@@ -135,4 +242,18 @@ type ApplyDesireStatus struct {
 	// nil so that consumers can distinguish "last apply succeeded and the
 	// kube object is at generation N" from "last apply failed".
 	AppliedKubeGeneration *int64 `json:"appliedKubeGeneration,omitempty"`
+
+	// AppliedKubeContentHash is the hex SHA-256 of
+	// .spec.serverSideApply.kubeContent as of the most recent successful
+	// server-side apply. Drift is only checked while it matches the current
+	// kubeContent: a difference caused by an updated desire is a rollout,
+	// not drift. Unlike AppliedKubeGeneration it survives failed applies.
+	AppliedKubeContentHash string `json:"appliedKubeContentHash,omitempty"`
+
+	// DriftedFields lists the paths of the fields, sorted and capped at
+	// MaxDriftedFields, in which the live object differed from the desired
+	// state at the last sync. Values are deliberately not recorded so that
+	// drift on a Secret does not copy its data into Cosmos. Empty unless
+	// the Drifted condition is True.
+	DriftedFields []string `json:"driftedFields,omitempty"`
 }
@@ -23,7 +23,8 @@ import (
 	"github.com/Azure/ARO-HCP/internal/api/coreapi"
 )
 
-// ReadDesire indicates a kube item in .spec.targetItem to issue a list/watch+informer for.
+// ReadDesire indicates a kube item in .spec.targetItem, or a collection of kube
+// items in .spec.targetCollection, to issue a list/watch+informer for.
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 type ReadDesire struct {
 	// CosmosMetadata.ResourceID is nested under an HCPOpenShiftCluster (and
@@ -55,6 +56,13 @@ type ReadDesireSpec struct {
 	// mirrors the live object into .status.kubeContent. Refresh frequency is
 	// not contractual, and .status carries no explicit observed-at timestamp.
 	TargetItem ResourceReference `json:"targetItem,omitempty"`
+
+	// TargetCollection identifies a set of kube objects to read instead of a
+	// single one, so consumers can discover child objects rather than guess
+	// their names. When set, TargetItem must be empty (a desire naming both
+	// fails with PreCheckFailed), and .status.kubeContent holds a trimmed
+	// kind=List of the matching objects.
+	TargetCollection *CollectionReference `json:"targetCollection,omitempty"`
 }
 
 type ReadDesireStatus struct {
@@ -70,7 +78,15 @@ type ReadDesireStatus struct {
 	// from the cluster — distinguish those two via the "Successful"
 	// condition (Unknown vs. True).
 	//
-	// When TargetItem is a core/v1 Secret, KubeContent is redacted before
+	// For a TargetCollection, KubeContent is a v1 List of the matching
+	// objects sorted by namespace and name, with metadata.managedFields
+	// removed from every item. At most TargetCollection.MaxItems items, and
+	// at most MaxCollectionContentBytes of them, are kept;
+	// .metadata.remainingItemCount reports how many were left out. An
+	// empty collection is an empty List, not nil.
+	//
+	// When the target is core/v1 Secrets, KubeContent (every list item for a
+	// TargetCollection) is redacted before
 	// storage: structural fields (apiVersion, kind, type) and metadata are
 	// preserved, but annotations that can embed the full Secret (e.g.
 	// kubectl.kubernetes.io/last-applied-configuration) are stripped. In the
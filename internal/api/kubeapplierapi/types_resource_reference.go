@@ -37,3 +37,45 @@ type ResourceReference struct {
 	// Name is the name of the target resource.
 	Name string `json:"name"`
 }
+
+// DefaultCollectionMaxItems is the number of items a ReadDesire with a
+// TargetCollection mirrors when MaxItems is unset.
+const DefaultCollectionMaxItems = 100
+
+// MaxCollectionMaxItems bounds CollectionReference.MaxItems. The mirrored
+// list is stored inline in one Cosmos document, which is limited to 2MB.
+const MaxCollectionMaxItems = 500
+
+// MaxCollectionContentBytes bounds the serialized items of a mirrored
+// collection, whatever MaxItems allows, leaving room in the 2MB Cosmos
+// document for the rest of the ReadDesire.
+const MaxCollectionContentBytes = 1 << 20
+
+// CollectionReference identifies a set of Kubernetes objects of one GVR on
+// the management cluster, optionally narrowed by namespace and selectors.
+// It is the ReadDesire spec.targetCollection counterpart of
+// ResourceReference.
+type CollectionReference struct {
+	// Group is the API group of the target resources. Empty for the core API.
+	Group string `json:"group"`
+	// Version is the API version of the target resources (e.g. "v1", "v1beta1").
+	Version string `json:"version"`
+	// Resource is the lower-cased plural resource name (e.g. "nodepools").
+	Resource string `json:"resource"`
+	// Namespace restricts the collection to one namespace. Leave empty for
+	// cluster-scoped resources, or to span every namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// LabelSelector is a Kubernetes label selector in its string form, e.g.
+	// "hypershift.openshift.io/nodePool=np-1". Empty selects everything.
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// FieldSelector is a Kubernetes field selector in its string form, e.g.
+	// "status.phase=Running". It is evaluated by the kube-apiserver, so only
+	// the fields the resource supports for selection may be used. Empty
+	// selects everything.
+	FieldSelector string `json:"fieldSelector,omitempty"`
+	// MaxItems caps the number of items mirrored into
+	// .status.kubeContent. Defaults to DefaultCollectionMaxItems and may not
+	// exceed MaxCollectionMaxItems. Fewer items are mirrored when they would
+	// not fit in MaxCollectionContentBytes.
+	MaxItems int32 `json:"maxItems,omitempty"`
+}
@@ -53,6 +53,31 @@ func (in *ApplyDesire) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplyDesireDependency) DeepCopyInto(out *ApplyDesireDependency) {
+	*out = *in
+	if in.ApplyDesire != nil {
+		in, out := &in.ApplyDesire, &out.ApplyDesire
+		*out = coreapi.DeepCopyResourceID(*in)
+	}
+	if in.Readiness != nil {
+		in, out := &in.Readiness, &out.Readiness
+		*out = new(ReadinessCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyDesireDependency.
+func (in *ApplyDesireDependency) DeepCopy() *ApplyDesireDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplyDesireDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplyDesireList) DeepCopyInto(out *ApplyDesireList) {
 	*out = *in
@@ -99,6 +124,13 @@ func (in *ApplyDesireSpec) DeepCopyInto(out *ApplyDesireSpec) {
 		*out = new(ServerSideApplyConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplyDesireDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -127,6 +159,11 @@ func (in *ApplyDesireStatus) DeepCopyInto(out *ApplyDesireStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.DriftedFields != nil {
+		in, out := &in.DriftedFields, &out.DriftedFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -140,6 +177,54 @@ func (in *ApplyDesireStatus) DeepCopy() *ApplyDesireStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectionReference) DeepCopyInto(out *CollectionReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectionReference.
+func (in *CollectionReference) DeepCopy() *CollectionReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectionReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionReadinessCheck) DeepCopyInto(out *ConditionReadinessCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionReadinessCheck.
+func (in *ConditionReadinessCheck) DeepCopy() *ConditionReadinessCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionReadinessCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONPathReadinessCheck) DeepCopyInto(out *JSONPathReadinessCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONPathReadinessCheck.
+func (in *JSONPathReadinessCheck) DeepCopy() *JSONPathReadinessCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONPathReadinessCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReadDesire) DeepCopyInto(out *ReadDesire) {
 	*out = *in
@@ -208,6 +293,11 @@ func (in *ReadDesireSpec) DeepCopyInto(out *ReadDesireSpec) {
 		*out = coreapi.DeepCopyResourceID(*in)
 	}
 	out.TargetItem = in.TargetItem
+	if in.TargetCollection != nil {
+		in, out := &in.TargetCollection, &out.TargetCollection
+		*out = new(CollectionReference)
+		**out = **in
+	}
 	return
 }
 
@@ -249,6 +339,32 @@ func (in *ReadDesireStatus) DeepCopy() *ReadDesireStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReadinessCheck) DeepCopyInto(out *ReadinessCheck) {
+	*out = *in
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(ConditionReadinessCheck)
+		**out = **in
+	}
+	if in.JSONPath != nil {
+		in, out := &in.JSONPath, &out.JSONPath
+		*out = new(JSONPathReadinessCheck)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadinessCheck.
+func (in *ReadinessCheck) DeepCopy() *ReadinessCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ReadinessCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
 	*out = *in
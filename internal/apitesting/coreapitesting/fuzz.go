@@ -181,6 +181,8 @@ func CommonRoundTripFuzzFuncs() []interface{} {
 			c.FillNoCustom(j)
 			j.ActiveOperationID = ""
 			j.RevokeCredentialsOperationID = ""
+			// PowerState is read-only and never converted back from an external request.
+			j.PowerState = ""
 			j.PendingClusterServiceID = nil
 			j.ClusterServiceID = nil
 			j.ExperimentalFeatures = coreapi.ExperimentalFeatures{}
@@ -195,6 +197,9 @@ func CommonRoundTripFuzzFuncs() []interface{} {
 			c.FillNoCustom(j)
 			j.ActiveOperationID = ""
 			j.ClusterServiceID = nil
+			j.UpdateRollback = nil
+			j.Replacement = nil
+			j.StoppedScaling = nil
 			j.UsesNewNodePoolDeletionApproach = false
 		},
 		func(j *coreapi.HCPOpenShiftClusterExternalAuthServiceProviderProperties, c randfill.Continue) {
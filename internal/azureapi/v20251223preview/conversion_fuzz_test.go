@@ -36,11 +36,13 @@ func TestRoundTripInternalExternalInternal(t *testing.T) {
 	t.Logf("seed: %d", seed)
 
 	fuzzer := coreapitesting.FuzzerFor(append(coreapitesting.CommonRoundTripFuzzFuncs(),
-		// Ingress and CryptoRestrictions were added in v20260630preview and do not exist in v20251223preview.
+		// Ingress and CryptoRestrictions were added in v20260630preview and MaintenanceWindow was added in
+		// v20260901preview; none of them exist in v20251223preview.
 		func(j *coreapi.HCPOpenShiftClusterCustomerProperties, c randfill.Continue) {
 			c.FillNoCustom(j)
 			j.Ingress = coreapi.CustomerIngressProfile{}
 			j.CryptoRestrictions = metadataapi.CryptoRestrictionsNone
+			j.MaintenanceWindow = nil
 		},
 	), rand.NewSource(seed))
 
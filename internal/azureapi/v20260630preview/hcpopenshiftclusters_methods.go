@@ -544,9 +544,10 @@ func (c *HcpOpenShiftCluster) ConvertToInternal(existing *coreapi.HCPOpenShiftCl
 }
 
 // preserveUnknownClusterFields copies customer-facing fields from existing that
-// this API version doesn't know about. Currently empty — no cross-version
-// customer fields exist yet between v20240610preview and v20260630preview.
+// this API version doesn't know about.
 func preserveUnknownClusterFields(from, to *coreapi.HCPOpenShiftCluster) {
+	// MaintenanceWindow was added in v2026_09_01_preview.
+	to.CustomerProperties.MaintenanceWindow = from.CustomerProperties.MaintenanceWindow.DeepCopy()
 }
 
 func normalizeManagedIdentity(identity *generated.ManagedServiceIdentity) *coreapi.ManagedServiceIdentity {
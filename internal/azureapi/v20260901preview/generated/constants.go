@@ -113,6 +113,39 @@ func PossibleCustomerManagedEncryptionTypeValues() []CustomerManagedEncryptionTy
 	}
 }
 
+// DayOfWeek - Day of the week
+type DayOfWeek string
+
+const (
+	// DayOfWeekFriday - Friday
+	DayOfWeekFriday DayOfWeek = "Friday"
+	// DayOfWeekMonday - Monday
+	DayOfWeekMonday DayOfWeek = "Monday"
+	// DayOfWeekSaturday - Saturday
+	DayOfWeekSaturday DayOfWeek = "Saturday"
+	// DayOfWeekSunday - Sunday
+	DayOfWeekSunday DayOfWeek = "Sunday"
+	// DayOfWeekThursday - Thursday
+	DayOfWeekThursday DayOfWeek = "Thursday"
+	// DayOfWeekTuesday - Tuesday
+	DayOfWeekTuesday DayOfWeek = "Tuesday"
+	// DayOfWeekWednesday - Wednesday
+	DayOfWeekWednesday DayOfWeek = "Wednesday"
+)
+
+// PossibleDayOfWeekValues returns the possible values for the DayOfWeek const type.
+func PossibleDayOfWeekValues() []DayOfWeek {
+	return []DayOfWeek{
+		DayOfWeekFriday,
+		DayOfWeekMonday,
+		DayOfWeekSaturday,
+		DayOfWeekSunday,
+		DayOfWeekThursday,
+		DayOfWeekTuesday,
+		DayOfWeekWednesday,
+	}
+}
+
 // DiskStorageAccountType - The type of the disk storage account
 // * https://learn.microsoft.com/en-us/azure/virtual-machines/disks-types
 type DiskStorageAccountType string
@@ -380,6 +413,30 @@ func PossibleOutboundTypeValues() []OutboundType {
 	}
 }
 
+// PowerState - The power state of a cluster
+type PowerState string
+
+const (
+	// PowerStateRunning - The control plane and node pools are running
+	PowerStateRunning PowerState = "Running"
+	// PowerStateStarting - The cluster is restoring its control plane and node pools
+	PowerStateStarting PowerState = "Starting"
+	// PowerStateStopped - The node pools and control plane are scaled down to zero
+	PowerStateStopped PowerState = "Stopped"
+	// PowerStateStopping - The cluster is scaling its node pools and control plane down
+	PowerStateStopping PowerState = "Stopping"
+)
+
+// PossiblePowerStateValues returns the possible values for the PowerState const type.
+func PossiblePowerStateValues() []PowerState {
+	return []PowerState{
+		PowerStateRunning,
+		PowerStateStarting,
+		PowerStateStopped,
+		PowerStateStopping,
+	}
+}
+
 // ProvisioningState - The resource provisioning state.
 type ProvisioningState string
 
@@ -379,6 +379,10 @@ type HcpOpenShiftClusterProperties struct {
 	// The cluster ingress configuration
 	Ingress *IngressProfile
 
+	// maintenanceWindow restricts when control plane and node pool upgrades
+	// may start. When omitted, upgrades start as soon as they are requested.
+	MaintenanceWindow *MaintenanceWindowProfile
+
 	// Cluster network configuration
 	Network *NetworkProfile
 
@@ -395,6 +399,9 @@ type HcpOpenShiftClusterProperties struct {
 	// READ-ONLY; Shows the cluster web console information
 	Console *ConsoleProfile
 
+	// READ-ONLY; Whether the cluster is running or stopped by the stop action
+	PowerState *PowerState
+
 	// READ-ONLY; The status of the last operation.
 	ProvisioningState *ProvisioningState
 
@@ -414,6 +421,10 @@ type HcpOpenShiftClusterPropertiesUpdate struct {
 	// WARNING: Updating this array will redeploy all node pools in the cluster.
 	ImageDigestMirrors []*ImageDigestMirror
 
+	// maintenanceWindow restricts when control plane and node pool upgrades
+	// may start. When omitted, upgrades start as soon as they are requested.
+	MaintenanceWindow *MaintenanceWindowProfile
+
 	// nodeDrainTimeoutMinutes is the grace period for how long Pod Disruption Budget-protected workloads will be respected during
 	// any node draining operation. After this grace period, any workloads
 	// protected by Pod Disruption Budgets that have not been successfully drained from a node will be forcibly evicted. This
@@ -616,6 +627,29 @@ type Label struct {
 	Value *string
 }
 
+// MaintenanceWindowProfile is a recurring weekly window during which
+// upgrades of the control plane and node pools may start. An upgrade that
+// starts inside the window is allowed to run past its end.
+type MaintenanceWindowProfile struct {
+	// REQUIRED; daysOfWeek are the days on which the window opens.
+	DaysOfWeek []*DayOfWeek
+
+	// REQUIRED; durationHours is how long the window stays open.
+	DurationHours *int32
+
+	// REQUIRED; startTime is the time of day at which the window opens, in 24-hour
+	// HH:MM format, interpreted in timeZone.
+	StartTime *string
+
+	// exclusionDates are dates, in YYYY-MM-DD format, on which the window
+	// does not open even if they fall on one of daysOfWeek.
+	ExclusionDates []*string
+
+	// timeZone is the IANA time zone name, such as "Europe/Berlin", in which
+	// startTime and exclusionDates are interpreted. The default is UTC.
+	TimeZone *string
+}
+
 // ManagedServiceIdentity - Managed service identity (system assigned and/or user assigned identities)
 type ManagedServiceIdentity struct {
 	// REQUIRED; Type of managed service identity (where both SystemAssigned and UserAssigned types are allowed).
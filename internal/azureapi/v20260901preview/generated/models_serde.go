@@ -1016,9 +1016,11 @@ func (h HcpOpenShiftClusterProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "etcd", h.Etcd)
 	populate(objectMap, "imageDigestMirrors", h.ImageDigestMirrors)
 	populate(objectMap, "ingress", h.Ingress)
+	populate(objectMap, "maintenanceWindow", h.MaintenanceWindow)
 	populate(objectMap, "network", h.Network)
 	populate(objectMap, "nodeDrainTimeoutMinutes", h.NodeDrainTimeoutMinutes)
 	populate(objectMap, "platform", h.Platform)
+	populate(objectMap, "powerState", h.PowerState)
 	populate(objectMap, "provisioningState", h.ProvisioningState)
 	populate(objectMap, "status", h.Status)
 	populate(objectMap, "version", h.Version)
@@ -1061,6 +1063,9 @@ func (h *HcpOpenShiftClusterProperties) UnmarshalJSON(data []byte) error {
 		case "ingress":
 			err = unpopulate(val, "Ingress", &h.Ingress)
 			delete(rawMsg, key)
+		case "maintenanceWindow":
+			err = unpopulate(val, "MaintenanceWindow", &h.MaintenanceWindow)
+			delete(rawMsg, key)
 		case "network":
 			err = unpopulate(val, "Network", &h.Network)
 			delete(rawMsg, key)
@@ -1070,6 +1075,9 @@ func (h *HcpOpenShiftClusterProperties) UnmarshalJSON(data []byte) error {
 		case "platform":
 			err = unpopulate(val, "Platform", &h.Platform)
 			delete(rawMsg, key)
+		case "powerState":
+			err = unpopulate(val, "PowerState", &h.PowerState)
+			delete(rawMsg, key)
 		case "provisioningState":
 			err = unpopulate(val, "ProvisioningState", &h.ProvisioningState)
 			delete(rawMsg, key)
@@ -1095,6 +1103,7 @@ func (h HcpOpenShiftClusterPropertiesUpdate) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "autoscaling", h.Autoscaling)
 	populate(objectMap, "etcd", h.Etcd)
 	populate(objectMap, "imageDigestMirrors", h.ImageDigestMirrors)
+	populate(objectMap, "maintenanceWindow", h.MaintenanceWindow)
 	populate(objectMap, "nodeDrainTimeoutMinutes", h.NodeDrainTimeoutMinutes)
 	populate(objectMap, "platform", h.Platform)
 	populate(objectMap, "version", h.Version)
@@ -1119,6 +1128,9 @@ func (h *HcpOpenShiftClusterPropertiesUpdate) UnmarshalJSON(data []byte) error {
 		case "imageDigestMirrors":
 			err = unpopulate(val, "ImageDigestMirrors", &h.ImageDigestMirrors)
 			delete(rawMsg, key)
+		case "maintenanceWindow":
+			err = unpopulate(val, "MaintenanceWindow", &h.MaintenanceWindow)
+			delete(rawMsg, key)
 		case "nodeDrainTimeoutMinutes":
 			err = unpopulate(val, "NodeDrainTimeoutMinutes", &h.NodeDrainTimeoutMinutes)
 			delete(rawMsg, key)
@@ -1640,6 +1652,51 @@ func (l *Label) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type MaintenanceWindowProfile.
+func (m MaintenanceWindowProfile) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "daysOfWeek", m.DaysOfWeek)
+	populate(objectMap, "durationHours", m.DurationHours)
+	populate(objectMap, "exclusionDates", m.ExclusionDates)
+	populate(objectMap, "startTime", m.StartTime)
+	populate(objectMap, "timeZone", m.TimeZone)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type MaintenanceWindowProfile.
+func (m *MaintenanceWindowProfile) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", m, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "daysOfWeek":
+			err = unpopulate(val, "DaysOfWeek", &m.DaysOfWeek)
+			delete(rawMsg, key)
+		case "durationHours":
+			err = unpopulate(val, "DurationHours", &m.DurationHours)
+			delete(rawMsg, key)
+		case "exclusionDates":
+			err = unpopulate(val, "ExclusionDates", &m.ExclusionDates)
+			delete(rawMsg, key)
+		case "startTime":
+			err = unpopulate(val, "StartTime", &m.StartTime)
+			delete(rawMsg, key)
+		case "timeZone":
+			err = unpopulate(val, "TimeZone", &m.TimeZone)
+			delete(rawMsg, key)
+		default:
+			err = fmt.Errorf("unmarshalling type %T, unknown field %q", m, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", m, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type ManagedServiceIdentity.
 func (m ManagedServiceIdentity) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
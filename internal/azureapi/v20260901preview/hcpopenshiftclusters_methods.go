@@ -295,6 +295,34 @@ func newImageDigestMirrors(from []coreapi.ImageDigestMirror) []*generated.ImageD
 	return out
 }
 
+func newMaintenanceWindowProfile(from *coreapi.MaintenanceWindowProfile) *generated.MaintenanceWindowProfile {
+	if from == nil {
+		return nil
+	}
+	var daysOfWeek []*generated.DayOfWeek
+	if from.DaysOfWeek != nil {
+		daysOfWeek = make([]*generated.DayOfWeek, 0, len(from.DaysOfWeek))
+		for _, day := range from.DaysOfWeek {
+			daysOfWeek = append(daysOfWeek, metadataapi.Ptr(generated.DayOfWeek(day)))
+		}
+	}
+	return &generated.MaintenanceWindowProfile{
+		DaysOfWeek:     daysOfWeek,
+		StartTime:      metadataapi.PtrOrNil(from.StartTime),
+		DurationHours:  metadataapi.PtrOrNil(from.DurationHours),
+		TimeZone:       metadataapi.PtrOrNil(from.TimeZone),
+		ExclusionDates: metadataapi.StringSliceToStringPtrSlice(from.ExclusionDates),
+	}
+}
+
+// newPowerState reports clusters that have never been stopped as running.
+func newPowerState(from coreapi.PowerState) generated.PowerState {
+	if from.IsRunning() {
+		return generated.PowerStateRunning
+	}
+	return generated.PowerState(from)
+}
+
 func newOperatorsAuthenticationProfile(from *coreapi.OperatorsAuthenticationProfile) generated.OperatorsAuthenticationProfile {
 	if from == nil {
 		return generated.OperatorsAuthenticationProfile{}
@@ -366,6 +394,7 @@ func (v version) NewHCPOpenShiftCluster(from *coreapi.HCPOpenShiftCluster) corea
 			Tags:       metadataapi.StringMapToStringPtrMap(from.Tags),
 			Properties: &generated.HcpOpenShiftClusterProperties{
 				ProvisioningState: metadataapi.PtrOrNil(generated.ProvisioningState(from.ServiceProviderProperties.ProvisioningState)),
+				PowerState:        metadataapi.Ptr(newPowerState(from.ServiceProviderProperties.PowerState)),
 				Version:           metadataapi.PtrOrNil(newVersionProfile(&from.CustomerProperties.Version)),
 				DNS:               metadataapi.PtrOrNil(newDNSProfile(&from.CustomerProperties.DNS, &from.ServiceProviderProperties.DNS)),
 				Network:           metadataapi.PtrOrNil(newNetworkProfile(&from.CustomerProperties.Network)),
@@ -381,6 +410,7 @@ func (v version) NewHCPOpenShiftCluster(from *coreapi.HCPOpenShiftCluster) corea
 				ImageDigestMirrors:      newImageDigestMirrors(from.CustomerProperties.ImageDigestMirrors),
 				Status:                  newResourceStatus(from.Status.Conditions),
 				CryptoRestrictions:      metadataapi.PtrOrNil(generated.CryptoRestrictions(from.CustomerProperties.CryptoRestrictions)),
+				MaintenanceWindow:       newMaintenanceWindowProfile(from.CustomerProperties.MaintenanceWindow),
 			},
 			Identity: newManagedServiceIdentity(from.Identity),
 		},
@@ -513,6 +543,10 @@ func (c *HcpOpenShiftCluster) ConvertToInternal(existing *coreapi.HCPOpenShiftCl
 		if c.Properties.CryptoRestrictions != nil {
 			normalizeCryptoRestrictions(c.Properties.CryptoRestrictions, &out.CustomerProperties.CryptoRestrictions)
 		}
+		if c.Properties.MaintenanceWindow != nil {
+			out.CustomerProperties.MaintenanceWindow = &coreapi.MaintenanceWindowProfile{}
+			normalizeMaintenanceWindow(c.Properties.MaintenanceWindow, out.CustomerProperties.MaintenanceWindow)
+		}
 	}
 
 	if existing != nil {
@@ -698,6 +732,21 @@ func normalizeImageDigestMirrors(p []*generated.ImageDigestMirror, out *[]coreap
 	*out = slice
 }
 
+func normalizeMaintenanceWindow(p *generated.MaintenanceWindowProfile, out *coreapi.MaintenanceWindowProfile) {
+	if p.DaysOfWeek != nil {
+		out.DaysOfWeek = make([]metadataapi.DayOfWeek, 0, len(p.DaysOfWeek))
+		for _, day := range p.DaysOfWeek {
+			if day != nil {
+				out.DaysOfWeek = append(out.DaysOfWeek, metadataapi.DayOfWeek(*day))
+			}
+		}
+	}
+	out.StartTime = metadataapi.Deref(p.StartTime)
+	out.DurationHours = metadataapi.Deref(p.DurationHours)
+	out.TimeZone = metadataapi.Deref(p.TimeZone)
+	out.ExclusionDates = metadataapi.StringPtrSliceToStringSlice(p.ExclusionDates)
+}
+
 func normalizeOperatorsAuthentication(fldPath *field.Path, p *generated.OperatorsAuthenticationProfile, out *coreapi.OperatorsAuthenticationProfile) field.ErrorList {
 	errs := field.ErrorList{}
 
@@ -21,9 +21,10 @@ import (
 	"github.com/Azure/ARO-HCP/internal/utils"
 )
 
-// BillingDBClient provides access to the Cosmos DB Billing container (separate from ARM resource documents).
+// BillingDBClient provides access to the Cosmos DB Billing and BillingUsage containers (separate from ARM resource documents).
 type BillingDBClient interface {
 	BillingDocs(subscriptionID string) BillingDocCRUD
+	NodePoolUsage(subscriptionID string) NodePoolUsageCRUD
 	BillingGlobalListers() BillingGlobalListers
 }
 
@@ -34,23 +35,32 @@ type BillingGlobalListers interface {
 
 type billingCosmosDBClient struct {
 	billing *azcosmos.ContainerClient
+	usage   *azcosmos.ContainerClient
 }
 
 var _ BillingDBClient = &billingCosmosDBClient{}
 
-// NewBillingDBClient opens the Billing container on the given async database client.
+// NewBillingDBClient opens the Billing and BillingUsage containers on the given async database client.
 func NewBillingDBClient(database *azcosmos.DatabaseClient) (BillingDBClient, error) {
 	billing, err := database.NewContainer(billingContainer)
 	if err != nil {
 		return nil, utils.TrackError(err)
 	}
-	return &billingCosmosDBClient{billing: billing}, nil
+	usage, err := database.NewContainer(billingUsageContainer)
+	if err != nil {
+		return nil, utils.TrackError(err)
+	}
+	return &billingCosmosDBClient{billing: billing, usage: usage}, nil
 }
 
 func (d *billingCosmosDBClient) BillingDocs(subscriptionID string) BillingDocCRUD {
 	return NewBillingDocCRUD(d.billing, subscriptionID)
 }
 
+func (d *billingCosmosDBClient) NodePoolUsage(subscriptionID string) NodePoolUsageCRUD {
+	return NewNodePoolUsageCRUD(d.usage, subscriptionID)
+}
+
 func (d *billingCosmosDBClient) BillingGlobalListers() BillingGlobalListers {
 	return NewCosmosBillingGlobalListers(d.billing)
 }
@@ -70,4 +80,7 @@ func (g *cosmosBillingGlobalListers) BillingDocs() cosmosstorageutils.GlobalList
 	return &cosmosBillingGlobalLister{containerClient: g.billing}
 }
 
-const billingContainer = "Billing"
+const (
+	billingContainer      = "Billing"
+	billingUsageContainer = "BillingUsage"
+)
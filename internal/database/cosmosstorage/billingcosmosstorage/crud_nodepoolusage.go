@@ -0,0 +1,255 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package billingcosmosstorage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+)
+
+// NodePoolUsageCRUD provides access to node pool scale events and hourly
+// usage records within a subscription partition of the BillingUsage container.
+type NodePoolUsageCRUD interface {
+	// CreateScaleEvent stores a new scale event
+	CreateScaleEvent(ctx context.Context, event *NodePoolScaleEvent) error
+
+	// GetLatestScaleEvent returns the most recent scale event of the node pool,
+	// or nil if none was recorded
+	GetLatestScaleEvent(ctx context.Context, nodePoolResourceID *azcorearm.ResourceID) (*NodePoolScaleEvent, error)
+
+	// ListScaleEvents lists the scale events of the subscription observed at or
+	// after since, plus the latest event of every node pool observed before
+	// since, which holds the node count the node pool had at since. Events are
+	// returned oldest first.
+	ListScaleEvents(ctx context.Context, since time.Time) ([]*NodePoolScaleEvent, error)
+
+	// UpsertUsageRecord creates or replaces an hourly usage record
+	UpsertUsageRecord(ctx context.Context, record *NodePoolUsageRecord) error
+
+	// ListUsageRecords lists the usage records of the subscription for hours
+	// starting in [start, end)
+	ListUsageRecords(ctx context.Context, start, end time.Time) ([]*NodePoolUsageRecord, error)
+}
+
+type nodePoolUsageCRUD struct {
+	containerClient *azcosmos.ContainerClient
+	subscriptionID  string
+}
+
+// NewNodePoolUsageCRUD creates a new NodePoolUsageCRUD instance for a subscription
+func NewNodePoolUsageCRUD(containerClient *azcosmos.ContainerClient, subscriptionID string) NodePoolUsageCRUD {
+	return &nodePoolUsageCRUD{
+		containerClient: containerClient,
+		subscriptionID:  subscriptionID,
+	}
+}
+
+var _ NodePoolUsageCRUD = &nodePoolUsageCRUD{}
+
+func (u *nodePoolUsageCRUD) CreateScaleEvent(ctx context.Context, event *NodePoolScaleEvent) error {
+	if event.NodePoolResourceID == nil {
+		return errors.New("NodePoolScaleEvent is missing a NodePoolResourceID")
+	}
+
+	if event.ID == "" {
+		return errors.New("NodePoolScaleEvent is missing an ID")
+	}
+
+	if event.ObservedTime.IsZero() {
+		return errors.New("NodePoolScaleEvent is missing an ObservedTime")
+	}
+
+	pk := cosmosstorageutils.NewPartitionKey(u.subscriptionID)
+	marshalled, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal NodePoolScaleEvent: %w", err)
+	}
+
+	_, err = u.containerClient.CreateItem(ctx, pk, marshalled, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create node pool scale event: %w", err)
+	}
+
+	return nil
+}
+
+func (u *nodePoolUsageCRUD) GetLatestScaleEvent(ctx context.Context, nodePoolResourceID *azcorearm.ResourceID) (*NodePoolScaleEvent, error) {
+	const query = "SELECT TOP 1 * FROM c WHERE c.documentType = @documentType AND STRINGEQUALS(c.nodePoolResourceId, @nodePoolResourceId, true) ORDER BY c.observedTime DESC"
+	opt := azcosmos.QueryOptions{
+		QueryParameters: []azcosmos.QueryParameter{
+			{
+				Name:  "@documentType",
+				Value: string(UsageDocumentTypeNodePoolScaleEvent),
+			},
+			{
+				Name:  "@nodePoolResourceId",
+				Value: nodePoolResourceID.String(),
+			},
+		},
+	}
+
+	events, err := queryUsageItems[NodePoolScaleEvent](ctx, u.containerClient, u.subscriptionID, query, &opt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scale events for '%s': %w", nodePoolResourceID, err)
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	return events[0], nil
+}
+
+func (u *nodePoolUsageCRUD) ListScaleEvents(ctx context.Context, since time.Time) ([]*NodePoolScaleEvent, error) {
+	const nodePoolsQuery = "SELECT DISTINCT VALUE c.nodePoolResourceId FROM c WHERE c.documentType = @documentType AND c.observedTime < @since"
+	const previousEventQuery = "SELECT TOP 1 * FROM c WHERE c.documentType = @documentType AND STRINGEQUALS(c.nodePoolResourceId, @nodePoolResourceId, true) AND c.observedTime < @since ORDER BY c.observedTime DESC"
+	const eventsQuery = "SELECT * FROM c WHERE c.documentType = @documentType AND c.observedTime >= @since ORDER BY c.observedTime ASC"
+
+	documentTypeParameter := azcosmos.QueryParameter{
+		Name:  "@documentType",
+		Value: string(UsageDocumentTypeNodePoolScaleEvent),
+	}
+	sinceParameter := azcosmos.QueryParameter{
+		Name:  "@since",
+		Value: since.UTC().Format(time.RFC3339Nano),
+	}
+
+	opt := azcosmos.QueryOptions{
+		QueryParameters: []azcosmos.QueryParameter{documentTypeParameter, sinceParameter},
+	}
+	nodePoolResourceIDs, err := queryUsageItems[string](ctx, u.containerClient, u.subscriptionID, nodePoolsQuery, &opt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query node pools with scale events: %w", err)
+	}
+
+	var events []*NodePoolScaleEvent
+	seenNodePools := map[string]bool{}
+	for _, nodePoolResourceID := range nodePoolResourceIDs {
+		key := strings.ToLower(*nodePoolResourceID)
+		if seenNodePools[key] {
+			continue
+		}
+		seenNodePools[key] = true
+
+		previousEventOpt := azcosmos.QueryOptions{
+			QueryParameters: []azcosmos.QueryParameter{
+				documentTypeParameter,
+				sinceParameter,
+				{
+					Name:  "@nodePoolResourceId",
+					Value: *nodePoolResourceID,
+				},
+			},
+		}
+		previousEvents, err := queryUsageItems[NodePoolScaleEvent](ctx, u.containerClient, u.subscriptionID, previousEventQuery, &previousEventOpt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query scale events for '%s': %w", *nodePoolResourceID, err)
+		}
+		events = append(events, previousEvents...)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].ObservedTime.Before(events[j].ObservedTime)
+	})
+
+	recentEvents, err := queryUsageItems[NodePoolScaleEvent](ctx, u.containerClient, u.subscriptionID, eventsQuery, &opt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scale events: %w", err)
+	}
+
+	return append(events, recentEvents...), nil
+}
+
+func (u *nodePoolUsageCRUD) UpsertUsageRecord(ctx context.Context, record *NodePoolUsageRecord) error {
+	if record.NodePoolResourceID == nil {
+		return errors.New("NodePoolUsageRecord is missing a NodePoolResourceID")
+	}
+
+	if record.ID == "" {
+		return errors.New("NodePoolUsageRecord is missing an ID")
+	}
+
+	pk := cosmosstorageutils.NewPartitionKey(u.subscriptionID)
+	marshalled, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal NodePoolUsageRecord: %w", err)
+	}
+
+	_, err = u.containerClient.UpsertItem(ctx, pk, marshalled, nil)
+	if err != nil {
+		return fmt.Errorf("failed to upsert node pool usage record: %w", err)
+	}
+
+	return nil
+}
+
+func (u *nodePoolUsageCRUD) ListUsageRecords(ctx context.Context, start, end time.Time) ([]*NodePoolUsageRecord, error) {
+	const query = "SELECT * FROM c WHERE c.documentType = @documentType AND c.hourStart >= @start AND c.hourStart < @end"
+	opt := azcosmos.QueryOptions{
+		QueryParameters: []azcosmos.QueryParameter{
+			{
+				Name:  "@documentType",
+				Value: string(UsageDocumentTypeNodePoolUsageRecord),
+			},
+			{
+				Name:  "@start",
+				Value: start.UTC().Format(time.RFC3339),
+			},
+			{
+				Name:  "@end",
+				Value: end.UTC().Format(time.RFC3339),
+			},
+		},
+	}
+
+	records, err := queryUsageItems[NodePoolUsageRecord](ctx, u.containerClient, u.subscriptionID, query, &opt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query usage records: %w", err)
+	}
+
+	return records, nil
+}
+
+func queryUsageItems[T any](ctx context.Context, containerClient *azcosmos.ContainerClient, subscriptionID, query string, opt *azcosmos.QueryOptions) ([]*T, error) {
+	pk := cosmosstorageutils.NewPartitionKey(subscriptionID)
+	queryPager := containerClient.NewQueryItemsPager(query, pk, opt)
+
+	var items []*T
+	for queryPager.More() {
+		queryResponse, err := queryPager.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to advance page while querying BillingUsage container for subscription '%s': %w", subscriptionID, err)
+		}
+
+		for _, item := range queryResponse.Items {
+			var doc T
+			err = json.Unmarshal(item, &doc)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal BillingUsage container item for subscription '%s': %w", subscriptionID, err)
+			}
+			items = append(items, &doc)
+		}
+	}
+
+	return items, nil
+}
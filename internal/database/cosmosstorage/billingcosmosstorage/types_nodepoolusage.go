@@ -0,0 +1,221 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package billingcosmosstorage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+)
+
+// UsageDocumentType discriminates the documents stored in the BillingUsage
+// container.
+type UsageDocumentType string
+
+const (
+	UsageDocumentTypeNodePoolScaleEvent  UsageDocumentType = "NodePoolScaleEvent"
+	UsageDocumentTypeNodePoolUsageRecord UsageDocumentType = "NodePoolUsageRecord"
+)
+
+// NodePoolScaleEventReason describes what changed in a NodePoolScaleEvent
+// compared to the previous event of the same node pool.
+type NodePoolScaleEventReason string
+
+const (
+	// NodePoolScaleEventReasonCreated is the first event recorded for a node pool.
+	NodePoolScaleEventReasonCreated NodePoolScaleEventReason = "Created"
+	// NodePoolScaleEventReasonVMSizeChanged means the node pool moved to a different
+	// VM size, e.g. through a node pool replacement.
+	NodePoolScaleEventReasonVMSizeChanged NodePoolScaleEventReason = "VMSizeChanged"
+	// NodePoolScaleEventReasonScalingChanged means the requested replicas or the
+	// autoscaling bounds changed.
+	NodePoolScaleEventReasonScalingChanged NodePoolScaleEventReason = "ScalingChanged"
+	// NodePoolScaleEventReasonNodeCountChanged means only the number of nodes
+	// Cluster Service reports changed, either converging on the requested
+	// replicas or through autoscaler activity.
+	NodePoolScaleEventReasonNodeCountChanged NodePoolScaleEventReason = "NodeCountChanged"
+	// NodePoolScaleEventReasonDeleted closes the metering of a node pool that
+	// is gone from Cluster Service or from Cosmos.
+	NodePoolScaleEventReasonDeleted NodePoolScaleEventReason = "Deleted"
+	// NodePoolScaleEventReasonRefreshed re-records an unchanged scale before
+	// the previous event expires.
+	NodePoolScaleEventReasonRefreshed NodePoolScaleEventReason = "Refreshed"
+)
+
+// NodePoolScaleEventRefreshInterval is the age at which the unchanged scale of
+// a node pool is recorded again. Scale events expire after
+// nodePoolScaleEventTimeToLive, so a node pool that never scales must get a
+// new event well before its latest one expires to keep being metered.
+const NodePoolScaleEventRefreshInterval = 30 * 24 * time.Hour
+
+// nodePoolScaleEventTimeToLive is the time to live, in seconds, of a
+// NodePoolScaleEvent. The BillingUsage container has no default expiration,
+// so NodePoolUsageRecords are kept indefinitely.
+const nodePoolScaleEventTimeToLive = 7776000 // 90 days
+
+// NodePoolScaleEvent records the compute a node pool provided from
+// ObservedTime until the next event of the same node pool. Usage is metered
+// from CurrentReplicas, the node count Cluster Service reports; Replicas and
+// AutoScaling are the requested scaling and are kept for auditing.
+type NodePoolScaleEvent struct {
+	cosmosstorageutils.BaseDocument
+
+	DocumentType UsageDocumentType `json:"documentType"`
+
+	// The subscription ID of the HCP cluster (also the partition key)
+	SubscriptionID string `json:"subscriptionId,omitempty"`
+	// The ClusterUID of the HCP cluster, which is also the ID of its BillingDocument
+	ClusterUID string `json:"clusterUid,omitempty"`
+	// The HCP cluster ARM resource ID
+	ClusterResourceID *azcorearm.ResourceID `json:"clusterResourceId,omitempty"`
+	// The node pool ARM resource ID
+	NodePoolResourceID *azcorearm.ResourceID `json:"nodePoolResourceId,omitempty"`
+
+	// The time the change was observed
+	ObservedTime time.Time `json:"observedTime"`
+	// Reason describes what changed since the previous event
+	Reason NodePoolScaleEventReason `json:"reason"`
+	// The VM size of the node pool's nodes
+	VMSize string `json:"vmSize,omitempty"`
+	// The requested number of replicas when autoscaling is off
+	Replicas int32 `json:"replicas"`
+	// The requested autoscaling bounds, nil when autoscaling is off
+	AutoScaling *NodePoolScaleEventAutoScaling `json:"autoScaling,omitempty"`
+	// The number of nodes Cluster Service reports
+	CurrentReplicas int32 `json:"currentReplicas"`
+}
+
+// NodePoolScaleEventAutoScaling holds the autoscaling bounds of a node pool.
+type NodePoolScaleEventAutoScaling struct {
+	Min int32 `json:"min"`
+	Max int32 `json:"max"`
+}
+
+// NewNodePoolScaleEvent returns a NodePoolScaleEvent for the node pool, keyed
+// by id and partitioned by the node pool's subscription.
+func NewNodePoolScaleEvent(id string, nodePoolResourceID *azcorearm.ResourceID) *NodePoolScaleEvent {
+	return &NodePoolScaleEvent{
+		BaseDocument: cosmosstorageutils.BaseDocument{
+			ID:         id,
+			TimeToLive: nodePoolScaleEventTimeToLive,
+		},
+		DocumentType:       UsageDocumentTypeNodePoolScaleEvent,
+		SubscriptionID:     nodePoolResourceID.SubscriptionID,
+		ClusterResourceID:  nodePoolResourceID.Parent,
+		NodePoolResourceID: nodePoolResourceID,
+	}
+}
+
+// SameScale reports whether two events describe the same VM size, requested
+// scaling, and node count.
+func (e *NodePoolScaleEvent) SameScale(other *NodePoolScaleEvent) bool {
+	if e.VMSize != other.VMSize || e.Replicas != other.Replicas || e.CurrentReplicas != other.CurrentReplicas {
+		return false
+	}
+	if (e.AutoScaling == nil) != (other.AutoScaling == nil) {
+		return false
+	}
+	return e.AutoScaling == nil || *e.AutoScaling == *other.AutoScaling
+}
+
+// DeepCopy creates a deep copy of the NodePoolScaleEvent.
+func (e *NodePoolScaleEvent) DeepCopy() *NodePoolScaleEvent {
+	if e == nil {
+		return nil
+	}
+	out := new(NodePoolScaleEvent)
+	*out = *e
+
+	if e.ClusterResourceID != nil {
+		out.ClusterResourceID = new(azcorearm.ResourceID)
+		*out.ClusterResourceID = *e.ClusterResourceID
+	}
+	if e.NodePoolResourceID != nil {
+		out.NodePoolResourceID = new(azcorearm.ResourceID)
+		*out.NodePoolResourceID = *e.NodePoolResourceID
+	}
+	if e.AutoScaling != nil {
+		out.AutoScaling = new(NodePoolScaleEventAutoScaling)
+		*out.AutoScaling = *e.AutoScaling
+	}
+
+	return out
+}
+
+// NodePoolUsageRecord is the metered usage of one node pool, on one VM size,
+// during one clock hour. Records are derived from NodePoolScaleEvents and
+// can be recomputed at any time; their ID is deterministic so that
+// recomputing an hour replaces its records instead of duplicating them.
+type NodePoolUsageRecord struct {
+	cosmosstorageutils.BaseDocument
+
+	DocumentType UsageDocumentType `json:"documentType"`
+
+	// The subscription ID of the HCP cluster (also the partition key)
+	SubscriptionID string `json:"subscriptionId,omitempty"`
+	// The ClusterUID of the HCP cluster, which is also the ID of its BillingDocument
+	ClusterUID string `json:"clusterUid,omitempty"`
+	// The HCP cluster ARM resource ID
+	ClusterResourceID *azcorearm.ResourceID `json:"clusterResourceId,omitempty"`
+	// The node pool ARM resource ID
+	NodePoolResourceID *azcorearm.ResourceID `json:"nodePoolResourceId,omitempty"`
+
+	// The start of the clock hour, in UTC
+	HourStart time.Time `json:"hourStart"`
+	// The VM size of the metered nodes
+	VMSize string `json:"vmSize,omitempty"`
+	// The number of vCPUs of one node of VMSize, zero when unknown
+	VCPUsPerNode int32 `json:"vcpusPerNode"`
+	// The node-hours used during the hour
+	NodeHours float64 `json:"nodeHours"`
+	// The vCPU-hours used during the hour, NodeHours times VCPUsPerNode
+	VCPUHours float64 `json:"vcpuHours"`
+	// The time the record was computed
+	AggregatedTime time.Time `json:"aggregatedTime"`
+}
+
+// NewNodePoolUsageRecord returns the NodePoolUsageRecord for the node pool,
+// hour, and VM size, with its deterministic ID set.
+func NewNodePoolUsageRecord(nodePoolResourceID *azcorearm.ResourceID, hourStart time.Time, vmSize string) *NodePoolUsageRecord {
+	hourStart = hourStart.UTC()
+	return &NodePoolUsageRecord{
+		BaseDocument: cosmosstorageutils.BaseDocument{
+			ID: NodePoolUsageRecordID(nodePoolResourceID, hourStart, vmSize),
+		},
+		DocumentType:       UsageDocumentTypeNodePoolUsageRecord,
+		SubscriptionID:     nodePoolResourceID.SubscriptionID,
+		ClusterResourceID:  nodePoolResourceID.Parent,
+		NodePoolResourceID: nodePoolResourceID,
+		HourStart:          hourStart,
+		VMSize:             vmSize,
+	}
+}
+
+// NodePoolUsageRecordID returns the ID of the usage record for the node pool,
+// hour, and VM size. Resource IDs contain characters Cosmos does not allow
+// in IDs, so the ID is a hash.
+func NodePoolUsageRecordID(nodePoolResourceID *azcorearm.ResourceID, hourStart time.Time, vmSize string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		strings.ToLower(nodePoolResourceID.String()),
+		hourStart.UTC().Format(time.RFC3339),
+		strings.ToLower(vmSize),
+	}, "|")))
+	return hex.EncodeToString(sum[:])
+}
@@ -117,6 +117,8 @@ type HCPClusterCRUD interface {
 	NodePools(hcpClusterID string) NodePoolsCRUD
 	SystemAdminCredentialRequests(hcpClusterName string) SystemAdminCredentialRequestsCRUD
 	SystemAdminCredentialRevocations(hcpClusterName string) SystemAdminCredentialRevocationsCRUD
+	// ResourceChanges holds the change history of the cluster and its node pools and external auths.
+	ResourceChanges(hcpClusterName string) cosmosstorageutils.ResourceCRUD[coreapi.ResourceChange, *coreapi.ResourceChange]
 }
 
 func NewHCPClusterCRUD(containerClient *azcosmos.ContainerClient, subscriptionID, resourceGroupName string) HCPClusterCRUD {
@@ -218,6 +220,21 @@ func (h *hcpClusterCRUD) SystemAdminCredentialRevocations(hcpClusterName string)
 	}
 }
 
+func (h *hcpClusterCRUD) ResourceChanges(hcpClusterName string) cosmosstorageutils.ResourceCRUD[coreapi.ResourceChange, *coreapi.ResourceChange] {
+	clusterResourceID := metadataapi.Must(azcorearm.ParseResourceID(
+		path.Join(
+			h.ParentResourceID.String(),
+			"providers",
+			h.ResourceType.Namespace,
+			h.ResourceType.Type,
+			hcpClusterName)))
+
+	return cosmosstorageutils.NewCosmosResourceCRUD[coreapi.ResourceChange, *coreapi.ResourceChange, cosmosstorageutils.GenericDocument[coreapi.ResourceChange]](
+		h.ContainerClient,
+		clusterResourceID,
+		coreapi.ResourceChangeResourceType)
+}
+
 func (h *hcpClusterCRUD) Controllers(hcpClusterName string) cosmosstorageutils.ResourceCRUD[coreapi.Controller, *coreapi.Controller] {
 	parentResourceID := metadataapi.Must(azcorearm.ParseResourceID(
 		path.Join(
@@ -16,6 +16,8 @@ package corecosmosstorage
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
 	"k8s.io/utils/ptr"
 
@@ -58,6 +60,11 @@ type ResourcesDBClient interface {
 	// to end users via ARM.  They must also survive the thing they are deleting, so they live under a subscription directly.
 	Operations(subscriptionID string) OperationCRUD
 
+	// OperationNotifications retrieves a CRUD interface for managing the durable outbox of ARM async operation
+	// notifications.  Like operations, they live under a subscription directly so they share a partition with the
+	// operation they report on and can be written in the same transaction.
+	OperationNotifications(subscriptionID string) cosmosstorageutils.ResourceCRUD[coreapi.OperationNotification, *coreapi.OperationNotification]
+
 	Subscriptions() cosmosstorageutils.ResourceCRUD[coreapi.Subscription, *coreapi.Subscription]
 
 	ServiceProviderClusters(subscriptionID, resourceGroupName, clusterName string) cosmosstorageutils.ResourceCRUD[coreapi.ServiceProviderCluster, *coreapi.ServiceProviderCluster]
@@ -73,6 +80,11 @@ type ResourcesDBClient interface {
 
 	ServiceProviderNodePools(subscriptionID, resourceGroupName, clusterName, nodePoolName string) cosmosstorageutils.ResourceCRUD[coreapi.ServiceProviderNodePool, *coreapi.ServiceProviderNodePool]
 
+	// CountNodePools returns the number of node pools across every cluster in the subscription
+	// with a single query over the subscription partition.  Node pools whose deletion has been
+	// requested are not counted.
+	CountNodePools(ctx context.Context, subscriptionID string) (int, error)
+
 	cosmosstorageutils.ChangeFeedClient
 }
 
@@ -110,6 +122,12 @@ func (d *resourcesCosmosDBClient) Operations(subscriptionID string) OperationCRU
 	return NewOperationCRUD(d.resources, subscriptionID)
 }
 
+func (d *resourcesCosmosDBClient) OperationNotifications(subscriptionID string) cosmosstorageutils.ResourceCRUD[coreapi.OperationNotification, *coreapi.OperationNotification] {
+	subscriptionResourceID := metadataapi.Must(coreapi.ToSubscriptionResourceID(subscriptionID))
+	return cosmosstorageutils.NewCosmosResourceCRUD[coreapi.OperationNotification, *coreapi.OperationNotification, cosmosstorageutils.GenericDocument[coreapi.OperationNotification]](
+		d.resources, subscriptionResourceID, coreapi.OperationNotificationResourceType)
+}
+
 func (d *resourcesCosmosDBClient) Subscriptions() cosmosstorageutils.ResourceCRUD[coreapi.Subscription, *coreapi.Subscription] {
 	return cosmosstorageutils.NewCosmosResourceCRUD[coreapi.Subscription, *coreapi.Subscription, cosmosstorageutils.GenericDocument[coreapi.Subscription]](
 		d.resources, nil, azcorearm.SubscriptionResourceType)
@@ -153,6 +171,43 @@ func (d *resourcesCosmosDBClient) ListMissingResourceID(ctx context.Context, opt
 	return cosmosstorageutils.NewQueryTypedDocumentIterator(pager), nil
 }
 
+func (d *resourcesCosmosDBClient) CountNodePools(ctx context.Context, subscriptionID string) (int, error) {
+	query := "SELECT VALUE COUNT(1) FROM c " +
+		"WHERE STRINGEQUALS(c.resourceType, @resourceType, true) " +
+		"AND (NOT IS_DEFINED(c.deletionTimestamp)) " +
+		"AND (NOT IS_DEFINED(c.properties.serviceProviderProperties.deletionTimestamp) " +
+		"OR IS_NULL(c.properties.serviceProviderProperties.deletionTimestamp))"
+
+	queryOptions := azcosmos.QueryOptions{
+		QueryParameters: []azcosmos.QueryParameter{
+			{
+				Name:  "@resourceType",
+				Value: coreapi.NodePoolResourceType.String(),
+			},
+		},
+	}
+
+	partitionKey := cosmosstorageutils.NewPartitionKey(subscriptionID)
+	pager := d.resources.NewQueryItemsPager(query, partitionKey, &queryOptions)
+
+	count := 0
+	for pager.More() {
+		response, err := pager.NextPage(ctx)
+		if err != nil {
+			return 0, utils.TrackError(err)
+		}
+		for _, item := range response.Items {
+			var pageCount int
+			if err := json.Unmarshal(item, &pageCount); err != nil {
+				return 0, utils.TrackError(fmt.Errorf("failed to unmarshal node pool count: %w", err))
+			}
+			count += pageCount
+		}
+	}
+
+	return count, nil
+}
+
 func (d *resourcesCosmosDBClient) ResourcesGlobalListers() ResourcesGlobalListers {
 	return NewCosmosResourcesGlobalListers(d.resources)
 }
@@ -45,6 +45,7 @@ type ResourcesGlobalListers interface {
 	SystemAdminCredentialRevocations() cosmosstorageutils.GlobalLister[coreapi.SystemAdminCredentialRevocation]
 	Operations() cosmosstorageutils.GlobalLister[coreapi.Operation]
 	ActiveOperations() cosmosstorageutils.GlobalLister[coreapi.Operation]
+	OperationNotifications() cosmosstorageutils.GlobalLister[coreapi.OperationNotification]
 }
 
 // cosmosResourcesGlobalListers implements ResourcesGlobalListers using the Resources Cosmos container.
@@ -152,6 +153,13 @@ func (g *cosmosResourcesGlobalListers) ActiveOperations() cosmosstorageutils.Glo
 	}
 }
 
+func (g *cosmosResourcesGlobalListers) OperationNotifications() cosmosstorageutils.GlobalLister[coreapi.OperationNotification] {
+	return &cosmosstorageutils.CosmosGlobalLister[coreapi.OperationNotification, cosmosstorageutils.GenericDocument[coreapi.OperationNotification]]{
+		ContainerClient: g.resources,
+		ResourceTypes:   []azcorearm.ResourceType{coreapi.OperationNotificationResourceType},
+	}
+}
+
 // cosmosActiveOperationsGlobalLister lists operations with non-terminal status
 // across all partitions.
 type cosmosActiveOperationsGlobalLister struct {
@@ -17,9 +17,12 @@ package corecosmosstorage
 import (
 	"context"
 	"errors"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
 	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
 	"github.com/Azure/ARO-HCP/internal/utils"
 	"github.com/Azure/ARO-HCP/internal/utils/apihelpers"
@@ -55,3 +58,52 @@ func CancelActiveOperations(ctx context.Context, resourcesDBClient ResourcesDBCl
 
 	return operationsToCancel, errors.Join(errs...)
 }
+
+// NewOperationNotification builds the outbox document for a terminal operation. The payload is the
+// ARM operation status as of now so that retries deliver exactly what the first attempt would have.
+func NewOperationNotification(now time.Time, operation *coreapi.Operation) (*coreapi.OperationNotification, error) {
+	payload, err := coreapi.MarshalJSON(cosmosstorageutils.ToStatus(operation))
+	if err != nil {
+		return nil, utils.TrackError(err)
+	}
+	resourceID, err := coreapi.ToOperationNotificationResourceID(operation.OperationID.SubscriptionID, operation.OperationID.Name)
+	if err != nil {
+		return nil, utils.TrackError(err)
+	}
+
+	notification := &coreapi.OperationNotification{
+		CosmosMetadata: coreapi.CosmosMetadata{
+			ResourceID: resourceID,
+		},
+		Spec: coreapi.OperationNotificationSpec{
+			OperationID:     operation.OperationID,
+			NotificationURI: operation.NotificationURI,
+			Payload:         payload,
+			CreationTime:    metav1.NewTime(now),
+		},
+	}
+	notification.SetPartitionKey(operation.OperationID.SubscriptionID)
+
+	return notification, nil
+}
+
+// AddOperationNotificationToTransaction hands the obligation to notify ARM from the operation document
+// to a new OperationNotification document when the operation is terminal and has a NotificationURI.
+// The operation's NotificationURI is cleared so the caller must add the operation to the transaction
+// after calling this. Returns nil when no notification is needed.
+func AddOperationNotificationToTransaction(ctx context.Context, resourcesDBClient ResourcesDBClient, transaction cosmosstorageutils.DBTransaction, operation *coreapi.Operation, now time.Time) (*coreapi.OperationNotification, error) {
+	if !operation.Status.IsTerminal() || len(operation.NotificationURI) == 0 {
+		return nil, nil
+	}
+
+	notification, err := NewOperationNotification(now, operation)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := resourcesDBClient.OperationNotifications(operation.OperationID.SubscriptionID).AddCreateToTransaction(ctx, transaction, notification, nil); err != nil {
+		return nil, utils.TrackError(err)
+	}
+	operation.NotificationURI = ""
+
+	return notification, nil
+}
@@ -21,7 +21,10 @@ import (
 	"github.com/Azure/ARO-HCP/internal/api/coreapi"
 )
 
-const operationTimeToLive = 604800 // 7 days
+const (
+	operationTimeToLive      = 604800  // 7 days
+	resourceChangeTimeToLive = 7776000 // 90 days
+)
 
 func InternalToCosmosGeneric[InternalAPIType any](internalObj *InternalAPIType) (*GenericDocument[InternalAPIType], error) {
 	if internalObj == nil {
@@ -63,6 +66,14 @@ func InternalToCosmosGeneric[InternalAPIType any](internalObj *InternalAPIType)
 	case *coreapi.Operation:
 		// TODO Add TTL to cosmosMetadata
 		cosmosObj.TimeToLive = operationTimeToLive
+	case *coreapi.OperationNotification:
+		// Keep delivered and dead-lettered notifications around as long as the
+		// operation they report on so failed deliveries can be audited.
+		cosmosObj.TimeToLive = operationTimeToLive
+	case *coreapi.ResourceChange:
+		// Change history is only kept long enough to investigate recent
+		// customer reports, which bounds its growth per cluster.
+		cosmosObj.TimeToLive = resourceChangeTimeToLive
 	}
 
 	return cosmosObj, nil
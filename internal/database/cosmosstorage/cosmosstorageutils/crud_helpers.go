@@ -155,6 +155,19 @@ func list[InternalAPIType, CosmosAPIType any](ctx context.Context, containerClie
 	}
 
 	if options != nil {
+		filterQuery, filterParameters, err := listFilterQuery(options.Filters)
+		if err != nil {
+			return nil, utils.TrackError(err)
+		}
+		query += filterQuery
+		queryOptions.QueryParameters = append(queryOptions.QueryParameters, filterParameters...)
+
+		orderByQuery, err := listOrderByQuery(options.OrderBy)
+		if err != nil {
+			return nil, utils.TrackError(err)
+		}
+		query += orderByQuery
+
 		// XXX The Cosmos DB REST API gives special meaning to -1 for "x-ms-max-item-count"
 		//     but it's not clear if it treats all negative values equivalently. The Go SDK
 		//     passes the PageSizeHint value as provided so normalize negative values to -1
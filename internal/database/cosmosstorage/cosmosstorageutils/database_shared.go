@@ -89,6 +89,12 @@ type DBClientListResourceDocsOptions struct {
 	// ContinuationToken can be supplied when limiting the number of items returned at once
 	// through PageSizeHint.
 	ContinuationToken *string
+
+	// Filters restricts the results to resource documents matching every filter.
+	Filters []ListFilter
+
+	// OrderBy sorts the results. If unspecified, the order is undefined.
+	OrderBy *ListOrderBy
 }
 
 // ChangeFeedClient is the narrow interface consumed by ChangeFeedListWatcher.
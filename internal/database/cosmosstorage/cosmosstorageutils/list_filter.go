@@ -0,0 +1,206 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cosmosstorageutils
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+)
+
+// ListFilterOperator is the comparison applied by a ListFilter.
+type ListFilterOperator string
+
+const (
+	// ListFilterOperatorEquals matches documents whose value equals the filter value.
+	ListFilterOperatorEquals ListFilterOperator = "eq"
+	// ListFilterOperatorNotEquals matches documents whose value does not equal the
+	// filter value, including documents where the value is not set.
+	ListFilterOperatorNotEquals ListFilterOperator = "ne"
+	// ListFilterOperatorStartsWith matches documents whose value starts with the filter value.
+	ListFilterOperatorStartsWith ListFilterOperator = "startswith"
+)
+
+// ListFilter restricts a list to documents whose string value at Path satisfies
+// Operator against Value. Comparisons are case-insensitive, matching how ARM
+// treats names, locations and enumerated values.
+type ListFilter struct {
+	// Path is the JSON path of the value inside the internal API type, which is
+	// stored under the "properties" key of the Cosmos document.
+	Path     []string
+	Operator ListFilterOperator
+	Value    string
+}
+
+// ListOrderBy sorts a list by the string value at Path.
+type ListOrderBy struct {
+	// Path is the JSON path of the value inside the internal API type, which is
+	// stored under the "properties" key of the Cosmos document.
+	Path       []string
+	Descending bool
+}
+
+// cosmosPropertyPath returns the Cosmos SQL expression for path. Every segment
+// is quoted so that customer-controlled segments such as tag keys cannot alter
+// the query.
+func cosmosPropertyPath(path []string) (string, error) {
+	if len(path) == 0 {
+		return "", fmt.Errorf("path is required")
+	}
+	expression := "c.properties"
+	for _, segment := range path {
+		quoted, err := json.Marshal(segment)
+		if err != nil {
+			return "", err
+		}
+		expression += "[" + string(quoted) + "]"
+	}
+	return expression, nil
+}
+
+// listFilterQuery returns the conditions to append to a list query for filters
+// along with the query parameters they reference.
+func listFilterQuery(filters []ListFilter) (string, []azcosmos.QueryParameter, error) {
+	query := ""
+	var queryParameters []azcosmos.QueryParameter
+	for i, filter := range filters {
+		property, err := cosmosPropertyPath(filter.Path)
+		if err != nil {
+			return "", nil, err
+		}
+		parameterName := fmt.Sprintf("@filter%d", i)
+
+		switch filter.Operator {
+		case ListFilterOperatorEquals:
+			query += fmt.Sprintf(" AND STRINGEQUALS(%s, %s, true)", property, parameterName)
+		case ListFilterOperatorNotEquals:
+			query += fmt.Sprintf(" AND (NOT IS_DEFINED(%s) OR NOT STRINGEQUALS(%s, %s, true))", property, property, parameterName)
+		case ListFilterOperatorStartsWith:
+			query += fmt.Sprintf(" AND STARTSWITH(%s, %s, true)", property, parameterName)
+		default:
+			return "", nil, fmt.Errorf("unsupported list filter operator %q", filter.Operator)
+		}
+
+		queryParameters = append(queryParameters, azcosmos.QueryParameter{
+			Name:  parameterName,
+			Value: filter.Value,
+		})
+	}
+	return query, queryParameters, nil
+}
+
+// listOrderByQuery returns the ORDER BY clause to append to a list query.
+func listOrderByQuery(orderBy *ListOrderBy) (string, error) {
+	if orderBy == nil {
+		return "", nil
+	}
+	property, err := cosmosPropertyPath(orderBy.Path)
+	if err != nil {
+		return "", err
+	}
+	if orderBy.Descending {
+		return " ORDER BY " + property + " DESC", nil
+	}
+	return " ORDER BY " + property + " ASC", nil
+}
+
+// lookupDocumentString returns the string value at path inside the "properties"
+// key of a raw Cosmos document.
+func lookupDocumentString(document map[string]any, path []string) (string, bool) {
+	var current any = document["properties"]
+	for _, segment := range path {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return "", false
+		}
+		current, ok = object[segment]
+		if !ok {
+			return "", false
+		}
+	}
+	value, ok := current.(string)
+	return value, ok
+}
+
+// MatchesListFilters evaluates filters against a raw Cosmos document the same
+// way the query built by list does. It exists so that in-memory database
+// implementations used in tests can honor DBClientListResourceDocsOptions.Filters.
+func MatchesListFilters(rawDocument []byte, filters []ListFilter) (bool, error) {
+	if len(filters) == 0 {
+		return true, nil
+	}
+	document := map[string]any{}
+	if err := json.Unmarshal(rawDocument, &document); err != nil {
+		return false, err
+	}
+
+	for _, filter := range filters {
+		value, ok := lookupDocumentString(document, filter.Path)
+		var matches bool
+		switch filter.Operator {
+		case ListFilterOperatorEquals:
+			matches = ok && strings.EqualFold(value, filter.Value)
+		case ListFilterOperatorNotEquals:
+			matches = !ok || !strings.EqualFold(value, filter.Value)
+		case ListFilterOperatorStartsWith:
+			matches = ok && strings.HasPrefix(strings.ToLower(value), strings.ToLower(filter.Value))
+		default:
+			return false, fmt.Errorf("unsupported list filter operator %q", filter.Operator)
+		}
+		if !matches {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// CompareListOrderBy compares two raw Cosmos documents by orderBy the same way
+// the query built by list does. Documents without a value sort first. It exists
+// so that in-memory database implementations used in tests can honor
+// DBClientListResourceDocsOptions.OrderBy.
+func CompareListOrderBy(a, b []byte, orderBy *ListOrderBy) (int, error) {
+	if orderBy == nil {
+		return 0, nil
+	}
+	documentA := map[string]any{}
+	if err := json.Unmarshal(a, &documentA); err != nil {
+		return 0, err
+	}
+	documentB := map[string]any{}
+	if err := json.Unmarshal(b, &documentB); err != nil {
+		return 0, err
+	}
+
+	valueA, okA := lookupDocumentString(documentA, orderBy.Path)
+	valueB, okB := lookupDocumentString(documentB, orderBy.Path)
+	var result int
+	switch {
+	case !okA && !okB:
+		result = 0
+	case !okA:
+		result = -1
+	case !okB:
+		result = 1
+	default:
+		result = cmp.Compare(valueA, valueB)
+	}
+	if orderBy.Descending {
+		return -result, nil
+	}
+	return result, nil
+}
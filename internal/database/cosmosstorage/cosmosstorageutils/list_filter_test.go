@@ -0,0 +1,218 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cosmosstorageutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+)
+
+func TestListFilterQuery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		filters        []ListFilter
+		wantQuery      string
+		wantParameters []azcosmos.QueryParameter
+		wantErr        bool
+	}{
+		{
+			name:    "no filters",
+			filters: nil,
+		},
+		{
+			name: "all operators",
+			filters: []ListFilter{
+				{Path: []string{"serviceProviderProperties", "provisioningState"}, Operator: ListFilterOperatorEquals, Value: "Failed"},
+				{Path: []string{"location"}, Operator: ListFilterOperatorNotEquals, Value: "eastus"},
+				{Path: []string{"name"}, Operator: ListFilterOperatorStartsWith, Value: "prod-"},
+			},
+			wantQuery: ` AND STRINGEQUALS(c.properties["serviceProviderProperties"]["provisioningState"], @filter0, true)` +
+				` AND (NOT IS_DEFINED(c.properties["location"]) OR NOT STRINGEQUALS(c.properties["location"], @filter1, true))` +
+				` AND STARTSWITH(c.properties["name"], @filter2, true)`,
+			wantParameters: []azcosmos.QueryParameter{
+				{Name: "@filter0", Value: "Failed"},
+				{Name: "@filter1", Value: "eastus"},
+				{Name: "@filter2", Value: "prod-"},
+			},
+		},
+		{
+			name: "path segments are quoted",
+			filters: []ListFilter{
+				{Path: []string{"tags", `team"] OR 1=1 --`}, Operator: ListFilterOperatorEquals, Value: "x"},
+			},
+			wantQuery: ` AND STRINGEQUALS(c.properties["tags"]["team\"] OR 1=1 --"], @filter0, true)`,
+			wantParameters: []azcosmos.QueryParameter{
+				{Name: "@filter0", Value: "x"},
+			},
+		},
+		{
+			name: "empty path",
+			filters: []ListFilter{
+				{Operator: ListFilterOperatorEquals, Value: "x"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown operator",
+			filters: []ListFilter{
+				{Path: []string{"name"}, Operator: "gt", Value: "x"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			query, parameters, err := listFilterQuery(tt.filters)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, query)
+			assert.Equal(t, tt.wantParameters, parameters)
+		})
+	}
+}
+
+func TestListOrderByQuery(t *testing.T) {
+	t.Parallel()
+
+	query, err := listOrderByQuery(nil)
+	require.NoError(t, err)
+	assert.Empty(t, query)
+
+	query, err = listOrderByQuery(&ListOrderBy{Path: []string{"name"}})
+	require.NoError(t, err)
+	assert.Equal(t, ` ORDER BY c.properties["name"] ASC`, query)
+
+	query, err = listOrderByQuery(&ListOrderBy{Path: []string{"customerProperties", "version", "id"}, Descending: true})
+	require.NoError(t, err)
+	assert.Equal(t, ` ORDER BY c.properties["customerProperties"]["version"]["id"] DESC`, query)
+}
+
+func TestMatchesListFilters(t *testing.T) {
+	t.Parallel()
+
+	document := []byte(`{
+		"id": "00000000-0000-0000-0000-000000000000",
+		"properties": {
+			"name": "prod-cluster",
+			"location": "eastus",
+			"tags": {"team": "Payments"},
+			"serviceProviderProperties": {"provisioningState": "Failed"}
+		}
+	}`)
+
+	tests := []struct {
+		name    string
+		filters []ListFilter
+		want    bool
+	}{
+		{
+			name: "no filters",
+			want: true,
+		},
+		{
+			name: "equals is case-insensitive",
+			filters: []ListFilter{
+				{Path: []string{"serviceProviderProperties", "provisioningState"}, Operator: ListFilterOperatorEquals, Value: "failed"},
+			},
+			want: true,
+		},
+		{
+			name: "equals on missing value",
+			filters: []ListFilter{
+				{Path: []string{"tags", "env"}, Operator: ListFilterOperatorEquals, Value: "prod"},
+			},
+			want: false,
+		},
+		{
+			name: "not equals on missing value",
+			filters: []ListFilter{
+				{Path: []string{"tags", "env"}, Operator: ListFilterOperatorNotEquals, Value: "prod"},
+			},
+			want: true,
+		},
+		{
+			name: "starts with",
+			filters: []ListFilter{
+				{Path: []string{"name"}, Operator: ListFilterOperatorStartsWith, Value: "PROD-"},
+			},
+			want: true,
+		},
+		{
+			name: "all filters must match",
+			filters: []ListFilter{
+				{Path: []string{"tags", "team"}, Operator: ListFilterOperatorEquals, Value: "payments"},
+				{Path: []string{"location"}, Operator: ListFilterOperatorEquals, Value: "westus"},
+			},
+			want: false,
+		},
+		{
+			name: "non-string value never matches",
+			filters: []ListFilter{
+				{Path: []string{"tags"}, Operator: ListFilterOperatorEquals, Value: "payments"},
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := MatchesListFilters(document, tt.filters)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompareListOrderBy(t *testing.T) {
+	t.Parallel()
+
+	alpha := []byte(`{"properties": {"name": "alpha"}}`)
+	beta := []byte(`{"properties": {"name": "beta"}}`)
+	unnamed := []byte(`{"properties": {}}`)
+
+	ascending := &ListOrderBy{Path: []string{"name"}}
+	descending := &ListOrderBy{Path: []string{"name"}, Descending: true}
+
+	for _, tt := range []struct {
+		name    string
+		a, b    []byte
+		orderBy *ListOrderBy
+		want    int
+	}{
+		{name: "no order", a: beta, b: alpha, orderBy: nil, want: 0},
+		{name: "ascending", a: alpha, b: beta, orderBy: ascending, want: -1},
+		{name: "descending", a: alpha, b: beta, orderBy: descending, want: 1},
+		{name: "missing sorts first", a: unnamed, b: alpha, orderBy: ascending, want: -1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := CompareListOrderBy(tt.a, tt.b, tt.orderBy)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
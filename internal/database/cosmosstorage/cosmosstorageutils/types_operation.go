@@ -15,6 +15,7 @@
 package cosmosstorageutils
 
 import (
+	"encoding/json"
 	"path"
 	"time"
 
@@ -37,6 +38,9 @@ const (
 	// These are for POST actions on resources.
 	OperationRequestSystemAdminCredentialRequest    OperationRequest = "RequestCredential"
 	OperationRequestSystemAdminCredentialRevocation OperationRequest = "RevokeCredentials"
+	OperationRequestNodePoolReplace                 OperationRequest = "Replace"
+	OperationRequestClusterStop                     OperationRequest = "Stop"
+	OperationRequestClusterStart                    OperationRequest = "Start"
 )
 
 func NewOperation(
@@ -95,15 +99,29 @@ func NewOperation(
 // ToStatus converts an OperationDocument to the ARM operation status format.
 func ToStatus(doc *coreapi.Operation) *coreapi.OperationStatus {
 	operation := &coreapi.OperationStatus{
-		ID:        doc.OperationID,
-		Name:      doc.OperationID.Name,
-		Status:    doc.Status,
-		StartTime: &doc.StartTime,
-		Error:     doc.Error,
+		ID:              doc.OperationID,
+		Name:            doc.OperationID.Name,
+		Status:          doc.Status,
+		StartTime:       &doc.StartTime,
+		PercentComplete: doc.PercentComplete,
+		Error:           doc.Error,
 	}
 
+	properties := coreapi.OperationStatusProperties{
+		Phases: doc.Phases,
+	}
 	if doc.Status.IsTerminal() {
 		operation.EndTime = &doc.LastTransitionTime
+	} else {
+		properties.StatusMessage = doc.StatusMessage
+	}
+	if doc.Status == coreapi.ProvisioningStateSucceeded {
+		operation.PercentComplete = 100
+	}
+
+	if len(properties.StatusMessage) > 0 || len(properties.Phases) > 0 {
+		// Marshaling a struct of strings cannot fail.
+		operation.Properties, _ = json.Marshal(properties)
 	}
 
 	return operation
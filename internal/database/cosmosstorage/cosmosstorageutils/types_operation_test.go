@@ -0,0 +1,121 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cosmosstorageutils
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+)
+
+func TestToStatus(t *testing.T) {
+	t.Parallel()
+
+	operationID := metadataapi.Must(azcorearm.ParseResourceID("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.RedHatOpenShift/locations/eastus/hcpOperationStatuses/operation"))
+	startTime := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	lastTransitionTime := startTime.Add(10 * time.Minute)
+	phases := []coreapi.OperationPhase{
+		{Name: "ClusterServiceAccepted", Status: coreapi.OperationPhaseStatusCompleted},
+		{Name: "ControlPlaneAvailable", Status: coreapi.OperationPhaseStatusPending},
+	}
+
+	tests := []struct {
+		name                string
+		operation           *coreapi.Operation
+		wantPercentComplete float64
+		wantEndTime         bool
+		wantProperties      *coreapi.OperationStatusProperties
+	}{
+		{
+			name: "no progress",
+			operation: &coreapi.Operation{
+				Status: coreapi.ProvisioningStateAccepted,
+			},
+		},
+		{
+			name: "in progress",
+			operation: &coreapi.Operation{
+				Status:          coreapi.ProvisioningStateProvisioning,
+				StatusMessage:   "waiting",
+				PercentComplete: 50,
+				Phases:          phases,
+			},
+			wantPercentComplete: 50,
+			wantProperties: &coreapi.OperationStatusProperties{
+				StatusMessage: "waiting",
+				Phases:        phases,
+			},
+		},
+		{
+			name: "succeeded is complete",
+			operation: &coreapi.Operation{
+				Status:          coreapi.ProvisioningStateSucceeded,
+				PercentComplete: 50,
+			},
+			wantPercentComplete: 100,
+			wantEndTime:         true,
+		},
+		{
+			name: "failed keeps phases but drops status message",
+			operation: &coreapi.Operation{
+				Status:          coreapi.ProvisioningStateFailed,
+				StatusMessage:   "waiting",
+				PercentComplete: 50,
+				Phases:          phases,
+			},
+			wantPercentComplete: 50,
+			wantEndTime:         true,
+			wantProperties: &coreapi.OperationStatusProperties{
+				Phases: phases,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.operation.OperationID = operationID
+			tt.operation.StartTime = startTime
+			tt.operation.LastTransitionTime = lastTransitionTime
+
+			status := ToStatus(tt.operation)
+			assert.Equal(t, tt.operation.Status, status.Status)
+			assert.Equal(t, tt.wantPercentComplete, status.PercentComplete)
+			if tt.wantEndTime {
+				require.NotNil(t, status.EndTime)
+				assert.Equal(t, lastTransitionTime, *status.EndTime)
+			} else {
+				assert.Nil(t, status.EndTime)
+			}
+
+			if tt.wantProperties == nil {
+				assert.Empty(t, status.Properties)
+				return
+			}
+			properties := &coreapi.OperationStatusProperties{}
+			require.NoError(t, json.Unmarshal(status.Properties, properties))
+			assert.Equal(t, tt.wantProperties, properties)
+		})
+	}
+}
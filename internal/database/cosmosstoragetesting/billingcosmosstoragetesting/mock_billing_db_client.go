@@ -60,14 +60,15 @@ func (s *mockBillingStore) snapshot() map[string]*billingcosmosstorage.BillingDo
 
 // MockBillingDBClient implements billingcosmosstorage.BillingDBClient with an isolated in-memory store.
 type MockBillingDBClient struct {
-	store *mockBillingStore
+	store      *mockBillingStore
+	usageStore *mockNodePoolUsageStore
 }
 
 var _ billingcosmosstorage.BillingDBClient = (*MockBillingDBClient)(nil)
 
 // NewMockBillingDBClient returns a BillingDBClient with its own empty billing document store.
 func NewMockBillingDBClient() *MockBillingDBClient {
-	return &MockBillingDBClient{store: newMockBillingStore()}
+	return &MockBillingDBClient{store: newMockBillingStore(), usageStore: newMockNodePoolUsageStore()}
 }
 
 // GetBillingDocuments returns a copy of all billing documents (for testing).
@@ -75,15 +76,30 @@ func (m *MockBillingDBClient) GetBillingDocuments() map[string]*billingcosmossto
 	return m.store.snapshot()
 }
 
-// Clear removes all billing documents (for testing). It does not affect a corecosmosstoragetesting.MockResourcesDBClient.
+// GetNodePoolScaleEvents returns a copy of all node pool scale events, oldest first (for testing).
+func (m *MockBillingDBClient) GetNodePoolScaleEvents() []*billingcosmosstorage.NodePoolScaleEvent {
+	return m.usageStore.scaleEvents("")
+}
+
+// GetNodePoolUsageRecords returns a copy of all node pool usage records (for testing).
+func (m *MockBillingDBClient) GetNodePoolUsageRecords() map[string]*billingcosmosstorage.NodePoolUsageRecord {
+	return m.usageStore.usageRecords()
+}
+
+// Clear removes all billing documents and node pool usage documents (for testing). It does not affect a corecosmosstoragetesting.MockResourcesDBClient.
 func (m *MockBillingDBClient) Clear() {
 	m.store.clear()
+	m.usageStore.clear()
 }
 
 func (m *MockBillingDBClient) BillingDocs(subscriptionID string) billingcosmosstorage.BillingDocCRUD {
 	return newMockBillingDocCRUD(m.store, subscriptionID)
 }
 
+func (m *MockBillingDBClient) NodePoolUsage(subscriptionID string) billingcosmosstorage.NodePoolUsageCRUD {
+	return newMockNodePoolUsageCRUD(m.usageStore, subscriptionID)
+}
+
 func (m *MockBillingDBClient) BillingGlobalListers() billingcosmosstorage.BillingGlobalListers {
 	return &mockBillingDBGlobalListers{store: m.store}
 }
@@ -0,0 +1,173 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package billingcosmosstoragetesting
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/billingcosmosstorage"
+)
+
+// mockNodePoolUsageStore holds in-memory BillingUsage documents for MockBillingDBClient.
+type mockNodePoolUsageStore struct {
+	mu      sync.RWMutex
+	events  map[string]*billingcosmosstorage.NodePoolScaleEvent
+	records map[string]*billingcosmosstorage.NodePoolUsageRecord
+}
+
+func newMockNodePoolUsageStore() *mockNodePoolUsageStore {
+	return &mockNodePoolUsageStore{
+		events:  make(map[string]*billingcosmosstorage.NodePoolScaleEvent),
+		records: make(map[string]*billingcosmosstorage.NodePoolUsageRecord),
+	}
+}
+
+func (s *mockNodePoolUsageStore) clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = make(map[string]*billingcosmosstorage.NodePoolScaleEvent)
+	s.records = make(map[string]*billingcosmosstorage.NodePoolUsageRecord)
+}
+
+// scaleEvents returns copies of the scale events of the subscription, or of
+// all subscriptions when subscriptionID is empty, oldest first.
+func (s *mockNodePoolUsageStore) scaleEvents(subscriptionID string) []*billingcosmosstorage.NodePoolScaleEvent {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var events []*billingcosmosstorage.NodePoolScaleEvent
+	for _, event := range s.events {
+		if subscriptionID == "" || strings.EqualFold(event.SubscriptionID, subscriptionID) {
+			events = append(events, event.DeepCopy())
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].ObservedTime.Equal(events[j].ObservedTime) {
+			return events[i].ObservedTime.Before(events[j].ObservedTime)
+		}
+		return events[i].ID < events[j].ID
+	})
+	return events
+}
+
+func (s *mockNodePoolUsageStore) usageRecords() map[string]*billingcosmosstorage.NodePoolUsageRecord {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make(map[string]*billingcosmosstorage.NodePoolUsageRecord, len(s.records))
+	for k, v := range s.records {
+		record := *v
+		out[k] = &record
+	}
+	return out
+}
+
+// mockNodePoolUsageCRUD implements billingcosmosstorage.NodePoolUsageCRUD for testing.
+type mockNodePoolUsageCRUD struct {
+	store          *mockNodePoolUsageStore
+	subscriptionID string
+}
+
+func newMockNodePoolUsageCRUD(store *mockNodePoolUsageStore, subscriptionID string) *mockNodePoolUsageCRUD {
+	return &mockNodePoolUsageCRUD{
+		store:          store,
+		subscriptionID: subscriptionID,
+	}
+}
+
+var _ billingcosmosstorage.NodePoolUsageCRUD = &mockNodePoolUsageCRUD{}
+
+func (m *mockNodePoolUsageCRUD) CreateScaleEvent(ctx context.Context, event *billingcosmosstorage.NodePoolScaleEvent) error {
+	if event.NodePoolResourceID == nil {
+		return fmt.Errorf("NodePoolScaleEvent is missing a NodePoolResourceID")
+	}
+
+	m.store.mu.Lock()
+	defer m.store.mu.Unlock()
+
+	if _, exists := m.store.events[event.ID]; exists {
+		return &azcore.ResponseError{StatusCode: http.StatusConflict}
+	}
+
+	m.store.events[event.ID] = event.DeepCopy()
+	return nil
+}
+
+func (m *mockNodePoolUsageCRUD) GetLatestScaleEvent(ctx context.Context, nodePoolResourceID *azcorearm.ResourceID) (*billingcosmosstorage.NodePoolScaleEvent, error) {
+	var latest *billingcosmosstorage.NodePoolScaleEvent
+	for _, event := range m.store.scaleEvents(m.subscriptionID) {
+		if strings.EqualFold(event.NodePoolResourceID.String(), nodePoolResourceID.String()) {
+			latest = event
+		}
+	}
+	return latest, nil
+}
+
+func (m *mockNodePoolUsageCRUD) ListScaleEvents(ctx context.Context, since time.Time) ([]*billingcosmosstorage.NodePoolScaleEvent, error) {
+	var previousEvents, recentEvents []*billingcosmosstorage.NodePoolScaleEvent
+	previousIndex := map[string]int{}
+	for _, event := range m.store.scaleEvents(m.subscriptionID) {
+		if !event.ObservedTime.Before(since) {
+			recentEvents = append(recentEvents, event)
+			continue
+		}
+		key := strings.ToLower(event.NodePoolResourceID.String())
+		if i, exists := previousIndex[key]; exists {
+			previousEvents[i] = event
+			continue
+		}
+		previousIndex[key] = len(previousEvents)
+		previousEvents = append(previousEvents, event)
+	}
+	sort.SliceStable(previousEvents, func(i, j int) bool {
+		return previousEvents[i].ObservedTime.Before(previousEvents[j].ObservedTime)
+	})
+	return append(previousEvents, recentEvents...), nil
+}
+
+func (m *mockNodePoolUsageCRUD) UpsertUsageRecord(ctx context.Context, record *billingcosmosstorage.NodePoolUsageRecord) error {
+	if record.NodePoolResourceID == nil {
+		return fmt.Errorf("NodePoolUsageRecord is missing a NodePoolResourceID")
+	}
+
+	m.store.mu.Lock()
+	defer m.store.mu.Unlock()
+
+	stored := *record
+	m.store.records[record.ID] = &stored
+	return nil
+}
+
+func (m *mockNodePoolUsageCRUD) ListUsageRecords(ctx context.Context, start, end time.Time) ([]*billingcosmosstorage.NodePoolUsageRecord, error) {
+	m.store.mu.RLock()
+	defer m.store.mu.RUnlock()
+
+	var records []*billingcosmosstorage.NodePoolUsageRecord
+	for _, record := range m.store.records {
+		if strings.EqualFold(record.SubscriptionID, m.subscriptionID) && !record.HourStart.Before(start) && record.HourStart.Before(end) {
+			stored := *record
+			records = append(records, &stored)
+		}
+	}
+	return records, nil
+}
@@ -225,6 +225,51 @@ func TestMockResourcesDBClient_CRUD_Cluster(t *testing.T) {
 	}
 }
 
+func TestMockResourcesDBClient_CountNodePools(t *testing.T) {
+	mock := NewMockResourcesDBClient()
+	ctx := context.Background()
+
+	subscriptionID := "6b690bec-0c16-4ecb-8f67-781caf40bba7"
+	otherSubscriptionID := "1d3378d3-5a3f-4712-85a1-2485495dfc4b"
+
+	createNodePool := func(subscriptionID, clusterName, nodePoolName string, deleting bool) {
+		nodePoolResourceID := metadataapi.Must(coreapi.ToNodePoolResourceID(subscriptionID, "test-rg", clusterName, nodePoolName))
+		nodePool := &coreapi.HCPOpenShiftClusterNodePool{
+			CosmosMetadata: coreapi.CosmosMetadata{
+				ResourceID:   nodePoolResourceID,
+				PartitionKey: strings.ToLower(subscriptionID),
+			},
+			TrackedResource: coreapi.TrackedResource{
+				Resource: coreapi.Resource{
+					ID:   nodePoolResourceID,
+					Name: nodePoolName,
+					Type: coreapi.NodePoolResourceType.String(),
+				},
+				Location: "eastus",
+			},
+		}
+		if deleting {
+			nodePool.ServiceProviderProperties.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		}
+		if _, err := mock.HCPClusters(subscriptionID, "test-rg").NodePools(clusterName).Create(ctx, nodePool, nil); err != nil {
+			t.Fatalf("Failed to create node pool: %v", err)
+		}
+	}
+
+	createNodePool(subscriptionID, "cluster-1", "np-1", false)
+	createNodePool(subscriptionID, "cluster-1", "np-2", true)
+	createNodePool(subscriptionID, "cluster-2", "np-1", false)
+	createNodePool(otherSubscriptionID, "cluster-1", "np-1", false)
+
+	count, err := mock.CountNodePools(ctx, subscriptionID)
+	if err != nil {
+		t.Fatalf("Failed to count node pools: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 node pools, got %d", count)
+	}
+}
+
 func TestMockResourcesDBClient_CRUD_Operation(t *testing.T) {
 	mock := NewMockResourcesDBClient()
 	ctx := context.Background()
@@ -68,6 +68,8 @@ func (m *MockResourcesDBClient) addResource(ctx context.Context, resource any) e
 		return m.addController(ctx, r)
 	case *coreapi.ManagementClusterContent:
 		return m.addManagementClusterContent(ctx, r)
+	case *coreapi.ResourceChange:
+		return m.addResourceChange(ctx, r)
 	default:
 		return fmt.Errorf("unsupported resource type: %T", resource)
 	}
@@ -224,3 +226,16 @@ func (m *MockResourcesDBClient) addManagementClusterContent(ctx context.Context,
 		return fmt.Errorf("unsupported parent resource type for management cluster content: %s", parentType)
 	}
 }
+
+func (m *MockResourcesDBClient) addResourceChange(ctx context.Context, resourceChange *coreapi.ResourceChange) error {
+	resourceID := resourceChange.GetResourceID()
+	if resourceID == nil {
+		return fmt.Errorf("resource change is missing resource ID")
+	}
+	if resourceID.Parent == nil {
+		return fmt.Errorf("resource change is missing parent cluster ID")
+	}
+	resourceChangeCRUD := m.HCPClusters(resourceID.SubscriptionID, resourceID.ResourceGroupName).ResourceChanges(resourceID.Parent.Name)
+	_, err := resourceChangeCRUD.Create(ctx, resourceChange, nil)
+	return err
+}
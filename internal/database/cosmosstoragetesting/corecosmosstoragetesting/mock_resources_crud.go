@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"path"
+	"slices"
 	"strings"
 	"time"
 
@@ -208,6 +209,12 @@ func (m *MockResourceCRUD[InternalAPIType, InternalAPITypePointer, CosmosAPIType
 	}
 
 	documents := m.client.ListDocuments(&m.resourceType, prefix)
+	if opts != nil {
+		documents, err = filterAndSortDocuments(documents, opts.Filters, opts.OrderBy)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	var ids []string
 	var items []*InternalAPIType
@@ -236,6 +243,34 @@ func (m *MockResourceCRUD[InternalAPIType, InternalAPITypePointer, CosmosAPIType
 	return NewMockIterator(ids, items), nil
 }
 
+// filterAndSortDocuments applies list filters and ordering to raw documents
+// the way the Cosmos query built by cosmosstorageutils would.
+func filterAndSortDocuments(documents []json.RawMessage, filters []cosmosstorageutils.ListFilter, orderBy *cosmosstorageutils.ListOrderBy) ([]json.RawMessage, error) {
+	var filtered []json.RawMessage
+	for _, data := range documents {
+		matches, err := cosmosstorageutils.MatchesListFilters(data, filters)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			filtered = append(filtered, data)
+		}
+	}
+
+	var sortErr error
+	slices.SortStableFunc(filtered, func(a, b json.RawMessage) int {
+		result, err := cosmosstorageutils.CompareListOrderBy(a, b, orderBy)
+		if err != nil && sortErr == nil {
+			sortErr = err
+		}
+		return result
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+	return filtered, nil
+}
+
 func (m *MockResourceCRUD[InternalAPIType, InternalAPITypePointer, CosmosAPIType]) Create(ctx context.Context, newObj *InternalAPIType, options *azcosmos.ItemOptions) (*InternalAPIType, error) {
 	if err := cosmosstorageutils.PrepareForCreate[InternalAPIType, InternalAPITypePointer](newObj); err != nil {
 		return nil, err
@@ -526,6 +561,18 @@ func (m *mockHCPClusterCRUD) SystemAdminCredentialRevocations(hcpClusterName str
 	}
 }
 
+func (m *mockHCPClusterCRUD) ResourceChanges(hcpClusterName string) cosmosstorageutils.ResourceCRUD[coreapi.ResourceChange, *coreapi.ResourceChange] {
+	clusterResourceID := metadataapi.Must(azcorearm.ParseResourceID(
+		path.Join(
+			m.parentResourceID.String(),
+			"providers",
+			coreapi.ClusterResourceType.Namespace,
+			coreapi.ClusterResourceType.Type,
+			hcpClusterName)))
+
+	return NewMockResourceCRUD[coreapi.ResourceChange, *coreapi.ResourceChange, cosmosstorageutils.GenericDocument[coreapi.ResourceChange]](m.client, clusterResourceID, coreapi.ResourceChangeResourceType)
+}
+
 var _ corecosmosstorage.HCPClusterCRUD = &mockHCPClusterCRUD{}
 
 // mockNodePoolsCRUD implements corecosmosstorage.NodePoolsCRUD.
@@ -93,6 +93,13 @@ func (m *MockResourcesDBClient) Operations(subscriptionID string) corecosmosstor
 	return newMockOperationCRUD(m, parentResourceID)
 }
 
+// OperationNotifications returns a CRUD interface for operation notification resources.
+func (m *MockResourcesDBClient) OperationNotifications(subscriptionID string) cosmosstorageutils.ResourceCRUD[coreapi.OperationNotification, *coreapi.OperationNotification] {
+	parentResourceID := metadataapi.Must(coreapi.ToSubscriptionResourceID(subscriptionID))
+
+	return NewMockResourceCRUD[coreapi.OperationNotification, *coreapi.OperationNotification, cosmosstorageutils.GenericDocument[coreapi.OperationNotification]](m, parentResourceID, coreapi.OperationNotificationResourceType)
+}
+
 // Subscriptions returns a CRUD interface for subscription resources.
 func (m *MockResourcesDBClient) Subscriptions() cosmosstorageutils.ResourceCRUD[coreapi.Subscription, *coreapi.Subscription] {
 	return newMockSubscriptionCRUD(m)
@@ -141,6 +148,23 @@ func (m *MockResourcesDBClient) ServiceProviderNodePools(subscriptionID, resourc
 	return newMockServiceProviderNodePoolCRUD(m, nodePoolResourceID)
 }
 
+// CountNodePools returns the number of node pools in the subscription whose deletion
+// has not been requested, mirroring the production COUNT query.
+func (m *MockResourcesDBClient) CountNodePools(ctx context.Context, subscriptionID string) (int, error) {
+	count := 0
+	for _, data := range m.ListDocuments(&coreapi.NodePoolResourceType, "/subscriptions/"+subscriptionID+"/") {
+		var doc cosmosstorageutils.GenericDocument[coreapi.HCPOpenShiftClusterNodePool]
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return 0, fmt.Errorf("failed to unmarshal node pool document: %w", err)
+		}
+		if doc.Content.ServiceProviderProperties.DeletionTimestamp != nil {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
 // ReadChangeFeed reads the in-memory change-feed log. Each
 // successful StoreDocument call records a snapshot of the document;
 // reads return everything past the position encoded in
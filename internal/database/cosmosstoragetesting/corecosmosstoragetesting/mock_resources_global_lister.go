@@ -118,6 +118,13 @@ func (g *mockResourcesGlobalListers) ActiveOperations() cosmosstorageutils.Globa
 	return &mockActiveOperationsGlobalLister{client: g.client}
 }
 
+func (g *mockResourcesGlobalListers) OperationNotifications() cosmosstorageutils.GlobalLister[coreapi.OperationNotification] {
+	return &MockGlobalLister[coreapi.OperationNotification, cosmosstorageutils.GenericDocument[coreapi.OperationNotification]]{
+		client:        g.client,
+		resourceTypes: []azcorearm.ResourceType{coreapi.OperationNotificationResourceType},
+	}
+}
+
 // mockSubscriptionGlobalLister lists all subscriptions across all partitions.
 type mockSubscriptionGlobalLister struct {
 	client *MockResourcesDBClient
@@ -49,6 +49,7 @@ const (
 	ControllerRelistDuration                      = 30 * time.Minute
 	AllOperationsRelistDuration                   = 30 * time.Minute
 	ActiveOperationsRelistDuration                = 30 * time.Minute
+	OperationNotificationRelistDuration           = 5 * time.Minute
 	ManagementClusterContentRelistDuration        = 30 * time.Second
 	SystemAdminCredentialRequestRelistDuration    = 30 * time.Minute
 	SystemAdminCredentialRevocationRelistDuration = 30 * time.Minute
@@ -464,6 +465,35 @@ func NewOperationInformerWithRelistDuration(lister cosmosstorageutils.GlobalList
 	)
 }
 
+// NewOperationNotificationInformer creates an unstarted SharedIndexInformer for
+// OperationNotifications using the default relist duration. Delivered and
+// dead-lettered notifications are kept so that they remain visible in
+// Prometheus until the Cosmos TTL removes them.
+func NewOperationNotificationInformer(lister cosmosstorageutils.GlobalLister[coreapi.OperationNotification], cosmosClient cosmosstorageutils.ChangeFeedClient) cache.SharedIndexInformer {
+	return NewOperationNotificationInformerWithRelistDuration(lister, cosmosClient, OperationNotificationRelistDuration)
+}
+
+// NewOperationNotificationInformerWithRelistDuration creates an unstarted SharedIndexInformer
+// for OperationNotifications with a configurable relist duration.
+func NewOperationNotificationInformerWithRelistDuration(lister cosmosstorageutils.GlobalLister[coreapi.OperationNotification], cosmosClient cosmosstorageutils.ChangeFeedClient, relistDuration time.Duration) cache.SharedIndexInformer {
+	lw := informerutils.NewChangeFeedListWatcher[coreapi.OperationNotification, *coreapi.OperationNotification, cosmosstorageutils.GenericDocument[coreapi.OperationNotification]](
+		[]azcorearm.ResourceType{coreapi.OperationNotificationResourceType},
+		utilsclock.RealClock{},
+		lister,
+		cosmosClient,
+		relistDuration,
+	)
+
+	return cache.NewSharedIndexInformerWithOptions(
+		&informerutils.ListWatchWithoutWatchListSemantics{ListWatch: lw.ToListWatch()},
+		&coreapi.OperationNotification{},
+		cache.SharedIndexInformerOptions{
+			ResyncPeriod:      1 * time.Hour, // this is only a default.  Shorter resyncs can be added when registering handlers.
+			ObjectDescription: "OperationNotification",
+		},
+	)
+}
+
 // NewActiveOperationInformer creates an unstarted SharedIndexInformer for
 // active (non-terminal) operations with resource group and cluster indexes
 // using the default relist duration.
@@ -35,6 +35,7 @@ type BackendInformers interface {
 	Subscriptions() (cache.SharedIndexInformer, corelisters.SubscriptionLister)
 	ActiveOperations() (cache.SharedIndexInformer, corelisters.ActiveOperationLister)
 	AllOperations() cache.SharedIndexInformer
+	OperationNotifications() (cache.SharedIndexInformer, corelisters.OperationNotificationLister)
 	Clusters() (cache.SharedIndexInformer, corelisters.ClusterLister)
 	NodePools() (cache.SharedIndexInformer, corelisters.NodePoolLister)
 	ExternalAuths() (cache.SharedIndexInformer, corelisters.ExternalAuthLister)
@@ -60,6 +61,9 @@ type backendInformers struct {
 
 	allOperationInformer cache.SharedIndexInformer
 
+	operationNotificationInformer cache.SharedIndexInformer
+	operationNotificationLister   corelisters.OperationNotificationLister
+
 	clusterInformer cache.SharedIndexInformer
 	clusterLister   corelisters.ClusterLister
 
@@ -102,6 +106,10 @@ func (b *backendInformers) AllOperations() cache.SharedIndexInformer {
 	return b.allOperationInformer
 }
 
+func (b *backendInformers) OperationNotifications() (cache.SharedIndexInformer, corelisters.OperationNotificationLister) {
+	return b.operationNotificationInformer, b.operationNotificationLister
+}
+
 func (b *backendInformers) Clusters() (cache.SharedIndexInformer, corelisters.ClusterLister) {
 	return b.clusterInformer, b.clusterLister
 }
@@ -159,6 +167,7 @@ func NewBackendInformersWithRelistDuration(ctx context.Context, resourcesGlobalL
 	systemAdminCredentialRevocationRelistDuration := SystemAdminCredentialRevocationRelistDuration
 	allOperationsRelistDuration := AllOperationsRelistDuration
 	activeOperationsRelistDuration := ActiveOperationsRelistDuration
+	operationNotificationRelistDuration := OperationNotificationRelistDuration
 	billingRelistDuration := BillingRelistDuration
 	if relistDuration != nil {
 		subscriptionRelistDuration = *relistDuration
@@ -173,6 +182,7 @@ func NewBackendInformersWithRelistDuration(ctx context.Context, resourcesGlobalL
 		systemAdminCredentialRevocationRelistDuration = *relistDuration
 		allOperationsRelistDuration = *relistDuration
 		activeOperationsRelistDuration = *relistDuration
+		operationNotificationRelistDuration = *relistDuration
 		billingRelistDuration = *relistDuration
 	}
 
@@ -180,6 +190,7 @@ func NewBackendInformersWithRelistDuration(ctx context.Context, resourcesGlobalL
 	ret.subscriptionInformer = NewSubscriptionInformerWithRelistDuration(resourcesGlobalListers.Subscriptions(), resourcesDBClient, subscriptionRelistDuration)
 	ret.activeOperationInformer = NewActiveOperationInformerWithRelistDuration(resourcesGlobalListers.ActiveOperations(), resourcesDBClient, activeOperationsRelistDuration)
 	ret.allOperationInformer = NewOperationInformerWithRelistDuration(resourcesGlobalListers.Operations(), resourcesDBClient, allOperationsRelistDuration)
+	ret.operationNotificationInformer = NewOperationNotificationInformerWithRelistDuration(resourcesGlobalListers.OperationNotifications(), resourcesDBClient, operationNotificationRelistDuration)
 	ret.clusterInformer = NewClusterInformerWithRelistDuration(resourcesGlobalListers.Clusters(), resourcesDBClient, clusterRelistDuration)
 	ret.nodePoolInformer = NewNodePoolInformerWithRelistDuration(resourcesGlobalListers.NodePools(), resourcesDBClient, nodePoolRelistDuration)
 	ret.externalAuthInformer = NewExternalAuthInformerWithRelistDuration(resourcesGlobalListers.ExternalAuths(), resourcesDBClient, externalAuthRelistDuration)
@@ -193,6 +204,7 @@ func NewBackendInformersWithRelistDuration(ctx context.Context, resourcesGlobalL
 
 	ret.subscriptionLister = corelisters.NewSubscriptionLister(ret.subscriptionInformer.GetIndexer())
 	ret.activeOperationLister = corelisters.NewActiveOperationLister(ret.activeOperationInformer.GetIndexer())
+	ret.operationNotificationLister = corelisters.NewOperationNotificationLister(ret.operationNotificationInformer.GetIndexer())
 	ret.clusterLister = corelisters.NewClusterLister(ret.clusterInformer.GetIndexer())
 	ret.nodePoolLister = corelisters.NewNodePoolLister(ret.nodePoolInformer.GetIndexer())
 	ret.externalAuthLister = corelisters.NewExternalAuthLister(ret.externalAuthInformer.GetIndexer())
@@ -243,6 +255,15 @@ func (b *backendInformers) RunWithContext(ctx context.Context) {
 		b.allOperationInformer.RunWithContext(localCtx)
 	}()
 	wg.Add(1)
+	go func() {
+		defer utilruntime.HandleCrash()
+		defer wg.Done()
+		localLogger := logger.WithValues("type", reflect.TypeOf(&coreapi.OperationNotification{}).String())
+		localCtx := utils.ContextWithLogger(ctx, localLogger)
+
+		b.operationNotificationInformer.RunWithContext(localCtx)
+	}()
+	wg.Add(1)
 	go func() {
 		defer utilruntime.HandleCrash()
 		defer wg.Done()
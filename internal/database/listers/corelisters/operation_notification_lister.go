@@ -0,0 +1,53 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package corelisters
+
+import (
+	"context"
+
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/Azure/ARO-HCP/internal/api/coreapi"
+	"github.com/Azure/ARO-HCP/internal/database/listers/listerutils"
+)
+
+// OperationNotificationLister lists and gets OperationNotifications from an informer's indexer.
+type OperationNotificationLister interface {
+	List(ctx context.Context) ([]*coreapi.OperationNotification, error)
+	Get(ctx context.Context, subscriptionID, operationName string) (*coreapi.OperationNotification, error)
+}
+
+// operationNotificationLister implements OperationNotificationLister backed by a SharedIndexInformer.
+type operationNotificationLister struct {
+	indexer cache.Indexer
+}
+
+// NewOperationNotificationLister creates an OperationNotificationLister from a SharedIndexInformer's indexer.
+func NewOperationNotificationLister(indexer cache.Indexer) OperationNotificationLister {
+	return &operationNotificationLister{
+		indexer: indexer,
+	}
+}
+
+func (l *operationNotificationLister) List(ctx context.Context) ([]*coreapi.OperationNotification, error) {
+	return listerutils.ListAll[coreapi.OperationNotification](l.indexer)
+}
+
+// Get retrieves a single OperationNotification by subscription ID and the name of the
+// operation it reports on. The store key is the lowercased ResourceID string.
+func (l *operationNotificationLister) Get(ctx context.Context, subscriptionID, operationName string) (*coreapi.OperationNotification, error) {
+	key := coreapi.ToOperationNotificationResourceIDString(subscriptionID, operationName)
+	return listerutils.GetByKey[coreapi.OperationNotification](l.indexer, key)
+}
@@ -67,6 +67,12 @@ type ClusterServiceClientSpec interface {
 	// DeleteCluster sends a DELETE request to delete a cluster from Cluster Service.
 	DeleteCluster(ctx context.Context, internalID InternalID) error
 
+	// HibernateCluster sends a POST request to scale down a cluster's hosted control plane in Cluster Service.
+	HibernateCluster(ctx context.Context, internalID InternalID) error
+
+	// ResumeCluster sends a POST request to bring a hibernated cluster's hosted control plane back in Cluster Service.
+	ResumeCluster(ctx context.Context, internalID InternalID) error
+
 	// ListClusters prepares a GET request with the given search expression. Call Items() on
 	// the returned iterator in a for/range loop to execute the request and paginate over results,
 	// then call GetError() to check for an iteration error.
@@ -418,6 +424,24 @@ func (csc *clusterServiceClient) DeleteCluster(ctx context.Context, internalID I
 	return utils.TrackError(err)
 }
 
+func (csc *clusterServiceClient) HibernateCluster(ctx context.Context, internalID InternalID) error {
+	client, ok := getClusterClient(internalID, csc.conn)
+	if !ok {
+		return fmt.Errorf("OCM path is not a cluster: %s", internalID)
+	}
+	_, err := client.Hibernate().SendContext(ctx)
+	return utils.TrackError(err)
+}
+
+func (csc *clusterServiceClient) ResumeCluster(ctx context.Context, internalID InternalID) error {
+	client, ok := getClusterClient(internalID, csc.conn)
+	if !ok {
+		return fmt.Errorf("OCM path is not a cluster: %s", internalID)
+	}
+	_, err := client.Resume().SendContext(ctx)
+	return utils.TrackError(err)
+}
+
 func (csc *clusterServiceClient) ListClusters(searchExpression string) ClusterListIterator {
 	clustersListRequest := csc.conn.AroHCP().V1alpha1().Clusters().List()
 	if searchExpression != "" {
@@ -642,6 +642,34 @@ func BuildCSNodePool(ctx context.Context, nodePool *coreapi.HCPOpenShiftClusterN
 	return nodePoolBuilder, nil
 }
 
+// ReplacementNodePoolName returns the Cluster Service node pool ID to use for
+// the surge node pool when replacing a node pool currently backed by the
+// Cluster Service node pool current. Replacements alternate between the
+// lowercased node pool name and a suffixed variant, so the name freed by the
+// previous replacement is reused and the ID stays within the node pool name
+// length limit.
+func ReplacementNodePoolName(nodePoolName string, current *InternalID) string {
+	const suffix = "-rp"
+	const maxLength = 15
+
+	name := strings.ToLower(nodePoolName)
+	if current == nil || current.ID() != name {
+		return name
+	}
+	prefix := strings.TrimRight(name[:min(len(name), maxLength-len(suffix))], "-")
+	return prefix + suffix
+}
+
+// BuildCSReplacementNodePool creates a CS NodePoolBuilder object for the surge
+// node pool of a node pool replacement. The surge node pool matches nodePool
+// except for its ID, which is csNodePoolName, and its platform.
+func BuildCSReplacementNodePool(ctx context.Context, nodePool *coreapi.HCPOpenShiftClusterNodePool, platform *coreapi.NodePoolPlatformProfile, csNodePoolName string) (*arohcpv1alpha1.NodePoolBuilder, error) {
+	surgeNodePool := nodePool.DeepCopy()
+	surgeNodePool.Name = csNodePoolName
+	platform.DeepCopyInto(&surgeNodePool.Properties.Platform)
+	return BuildCSNodePool(ctx, surgeNodePool, false)
+}
+
 // BuildCSExternalAuth creates a CS ExternalAuthBuilder object from an HCPOpenShiftClusterExternalAuth object.
 func BuildCSExternalAuth(ctx context.Context, externalAuth *coreapi.HCPOpenShiftClusterExternalAuth, updating bool) (*arohcpv1alpha1.ExternalAuthBuilder, error) {
 	externalAuthBuilder := arohcpv1alpha1.NewExternalAuth()
@@ -465,6 +465,74 @@ func TestBuildCSNodePool(t *testing.T) {
 	}
 }
 
+func TestReplacementNodePoolName(t *testing.T) {
+	csID := func(name string) *InternalID {
+		id := metadataapi.Must(metadataapi.NewInternalID(GenerateAROHCPNodePoolHREF("abc", name)))
+		return &id
+	}
+
+	testCases := []struct {
+		name         string
+		nodePoolName string
+		current      *InternalID
+		expected     string
+	}{
+		{
+			name:         "no current node pool",
+			nodePoolName: "Workers",
+			current:      nil,
+			expected:     "workers",
+		},
+		{
+			name:         "first replacement adds suffix",
+			nodePoolName: "Workers",
+			current:      csID("workers"),
+			expected:     "workers-rp",
+		},
+		{
+			name:         "second replacement reuses node pool name",
+			nodePoolName: "Workers",
+			current:      csID("workers-rp"),
+			expected:     "workers",
+		},
+		{
+			name:         "long name is truncated",
+			nodePoolName: "abcdefghijklmno",
+			current:      csID("abcdefghijklmno"),
+			expected:     "abcdefghijkl-rp",
+		},
+		{
+			name:         "truncation does not leave a double hyphen",
+			nodePoolName: "abcdefghijk-mno",
+			current:      csID("abcdefghijk-mno"),
+			expected:     "abcdefghijk-rp",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ReplacementNodePoolName(tc.nodePoolName, tc.current))
+		})
+	}
+}
+
+func TestBuildCSReplacementNodePool(t *testing.T) {
+	ctx := context.Background()
+	nodePool := getHCPNodePoolResource()
+	platform := nodePool.Properties.Platform.DeepCopy()
+	platform.VMSize = "Standard_D8s_v3"
+
+	builder, err := BuildCSReplacementNodePool(ctx, nodePool, platform, "workers-rp")
+	require.NoError(t, err)
+	csNodePool, err := builder.Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, "workers-rp", csNodePool.ID())
+	assert.Equal(t, "workers-rp", csNodePool.AzureNodePool().ResourceName())
+	assert.Equal(t, "Standard_D8s_v3", csNodePool.AzureNodePool().VMSize())
+	// The node pool itself is left untouched.
+	assert.Empty(t, nodePool.Properties.Platform.VMSize)
+}
+
 func externalAuthResource(opts ...func(*coreapi.HCPOpenShiftClusterExternalAuth)) *coreapi.HCPOpenShiftClusterExternalAuth {
 	externalAuth := coreapi.NewDefaultHCPOpenShiftClusterExternalAuth(nil)
 
@@ -661,6 +661,44 @@ func (c *MockClusterServiceClientSpecGetVersionCall) DoAndReturn(f func(context.
 	return c
 }
 
+// HibernateCluster mocks base method.
+func (m *MockClusterServiceClientSpec) HibernateCluster(ctx context.Context, internalID InternalID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HibernateCluster", ctx, internalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HibernateCluster indicates an expected call of HibernateCluster.
+func (mr *MockClusterServiceClientSpecMockRecorder) HibernateCluster(ctx, internalID any) *MockClusterServiceClientSpecHibernateClusterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HibernateCluster", reflect.TypeOf((*MockClusterServiceClientSpec)(nil).HibernateCluster), ctx, internalID)
+	return &MockClusterServiceClientSpecHibernateClusterCall{Call: call}
+}
+
+// MockClusterServiceClientSpecHibernateClusterCall wrap *gomock.Call
+type MockClusterServiceClientSpecHibernateClusterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterServiceClientSpecHibernateClusterCall) Return(arg0 error) *MockClusterServiceClientSpecHibernateClusterCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterServiceClientSpecHibernateClusterCall) Do(f func(context.Context, InternalID) error) *MockClusterServiceClientSpecHibernateClusterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterServiceClientSpecHibernateClusterCall) DoAndReturn(f func(context.Context, InternalID) error) *MockClusterServiceClientSpecHibernateClusterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListBreakGlassCredentials mocks base method.
 func (m *MockClusterServiceClientSpec) ListBreakGlassCredentials(clusterInternalID InternalID, searchExpression string) BreakGlassCredentialListIterator {
 	m.ctrl.T.Helper()
@@ -1238,6 +1276,44 @@ func (c *MockClusterServiceClientSpecPostProvisionShardCall) DoAndReturn(f func(
 	return c
 }
 
+// ResumeCluster mocks base method.
+func (m *MockClusterServiceClientSpec) ResumeCluster(ctx context.Context, internalID InternalID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeCluster", ctx, internalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeCluster indicates an expected call of ResumeCluster.
+func (mr *MockClusterServiceClientSpecMockRecorder) ResumeCluster(ctx, internalID any) *MockClusterServiceClientSpecResumeClusterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeCluster", reflect.TypeOf((*MockClusterServiceClientSpec)(nil).ResumeCluster), ctx, internalID)
+	return &MockClusterServiceClientSpecResumeClusterCall{Call: call}
+}
+
+// MockClusterServiceClientSpecResumeClusterCall wrap *gomock.Call
+type MockClusterServiceClientSpecResumeClusterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockClusterServiceClientSpecResumeClusterCall) Return(arg0 error) *MockClusterServiceClientSpecResumeClusterCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockClusterServiceClientSpecResumeClusterCall) Do(f func(context.Context, InternalID) error) *MockClusterServiceClientSpecResumeClusterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockClusterServiceClientSpecResumeClusterCall) DoAndReturn(f func(context.Context, InternalID) error) *MockClusterServiceClientSpecResumeClusterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateCluster mocks base method.
 func (m *MockClusterServiceClientSpec) UpdateCluster(ctx context.Context, internalID InternalID, builder *v1alpha1.ClusterBuilder) (*v1alpha1.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +140,36 @@ func (csc *clusterServiceClientWithTracing) DeleteCluster(ctx context.Context, i
 	return err
 }
 
+func (csc *clusterServiceClientWithTracing) HibernateCluster(ctx context.Context, internalID InternalID) error {
+	ctx, span := csc.startChildSpan(ctx, "ClusterServiceClient.HibernateCluster")
+	defer span.End()
+
+	span.SetAttributes(
+		tracing.ClusterIDKey.String(internalID.ID()),
+	)
+	err := csc.csc.HibernateCluster(ctx, internalID)
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	return err
+}
+
+func (csc *clusterServiceClientWithTracing) ResumeCluster(ctx context.Context, internalID InternalID) error {
+	ctx, span := csc.startChildSpan(ctx, "ClusterServiceClient.ResumeCluster")
+	defer span.End()
+
+	span.SetAttributes(
+		tracing.ClusterIDKey.String(internalID.ID()),
+	)
+	err := csc.csc.ResumeCluster(ctx, internalID)
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	return err
+}
+
 func (csc *clusterServiceClientWithTracing) ListClusters(searchExpression string) ClusterListIterator {
 	return csc.csc.ListClusters(searchExpression)
 }
@@ -22,10 +22,21 @@ import (
 
 // CancelOperation updates relevant fields to indicate the operation is canceled.
 func CancelOperation(operation *coreapi.Operation, now time.Time) {
+	cancelOperation(operation, now, "This operation was superseded by another")
+}
+
+// CancelOperationOnRequest updates relevant fields to indicate the operation was
+// canceled by an explicit cancel request from the client.
+func CancelOperationOnRequest(operation *coreapi.Operation, now time.Time) {
+	cancelOperation(operation, now, "This operation was canceled on request")
+}
+
+func cancelOperation(operation *coreapi.Operation, now time.Time, message string) {
 	operation.LastTransitionTime = now
 	operation.Status = coreapi.ProvisioningStateCanceled
 	operation.Error = &coreapi.CloudErrorBody{
 		Code:    coreapi.CloudErrorCodeCanceled,
-		Message: "This operation was superseded by another",
+		Message: message,
 	}
+	operation.StatusMessage = ""
 }
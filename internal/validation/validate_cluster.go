@@ -238,6 +238,9 @@ var (
 	toCryptoRestrictions = func(oldObj *coreapi.HCPOpenShiftClusterCustomerProperties) *metadataapi.CryptoRestrictions {
 		return &oldObj.CryptoRestrictions
 	}
+	toMaintenanceWindow = func(oldObj *coreapi.HCPOpenShiftClusterCustomerProperties) *coreapi.MaintenanceWindowProfile {
+		return oldObj.MaintenanceWindow
+	}
 )
 
 func validateClusterCustomerProperties(ctx context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *coreapi.HCPOpenShiftClusterCustomerProperties) field.ErrorList {
@@ -290,6 +293,9 @@ func validateClusterCustomerProperties(ctx context.Context, op operation.Operati
 	errs = append(errs, immutableByCompare(ctx, op, fldPath.Child("cryptoRestrictions"), &newObj.CryptoRestrictions, safe.Field(oldObj, toCryptoRestrictions))...)
 	errs = append(errs, validate.Enum(ctx, op, fldPath.Child("cryptoRestrictions"), &newObj.CryptoRestrictions, nil, metadataapi.ValidCryptoRestrictions, nil)...)
 
+	// MaintenanceWindow *MaintenanceWindowProfile `json:"maintenanceWindow,omitempty"`
+	errs = append(errs, validateMaintenanceWindowProfile(ctx, op, fldPath.Child("maintenanceWindow"), newObj.MaintenanceWindow, safe.Field(oldObj, toMaintenanceWindow))...)
+
 	return errs
 }
 
@@ -1023,6 +1029,56 @@ func validateImageDigestMirror(ctx context.Context, op operation.Operation, fldP
 	return errs
 }
 
+var (
+	toMaintenanceWindowDaysOfWeek     = func(oldObj *coreapi.MaintenanceWindowProfile) []metadataapi.DayOfWeek { return oldObj.DaysOfWeek }
+	toMaintenanceWindowStartTime      = func(oldObj *coreapi.MaintenanceWindowProfile) *string { return &oldObj.StartTime }
+	toMaintenanceWindowDurationHours  = func(oldObj *coreapi.MaintenanceWindowProfile) *int32 { return &oldObj.DurationHours }
+	toMaintenanceWindowTimeZone       = func(oldObj *coreapi.MaintenanceWindowProfile) *string { return &oldObj.TimeZone }
+	toMaintenanceWindowExclusionDates = func(oldObj *coreapi.MaintenanceWindowProfile) []string { return oldObj.ExclusionDates }
+)
+
+func validateMaintenanceWindowProfile(ctx context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *coreapi.MaintenanceWindowProfile) field.ErrorList {
+	if newObj == nil {
+		return nil
+	}
+
+	errs := field.ErrorList{}
+
+	//DaysOfWeek []DayOfWeek `json:"daysOfWeek,omitempty"`
+	errs = append(errs, validate.RequiredSlice(ctx, op, fldPath.Child("daysOfWeek"), newObj.DaysOfWeek, safe.Field(oldObj, toMaintenanceWindowDaysOfWeek))...)
+	errs = append(errs, MaxItems(ctx, op, fldPath.Child("daysOfWeek"), newObj.DaysOfWeek, safe.Field(oldObj, toMaintenanceWindowDaysOfWeek), 7)...)
+	errs = append(errs, validate.EachSliceVal(
+		ctx, op, fldPath.Child("daysOfWeek"),
+		newObj.DaysOfWeek, safe.Field(oldObj, toMaintenanceWindowDaysOfWeek),
+		nil, nil,
+		func(ctx context.Context, op operation.Operation, fldPath *field.Path, newValue, oldValue *metadataapi.DayOfWeek) field.ErrorList {
+			return validate.Enum(ctx, op, fldPath, newValue, oldValue, metadataapi.ValidDaysOfWeek, nil)
+		},
+	)...)
+
+	//StartTime string `json:"startTime,omitempty"`
+	errs = append(errs, validate.RequiredValue(ctx, op, fldPath.Child("startTime"), &newObj.StartTime, safe.Field(oldObj, toMaintenanceWindowStartTime))...)
+	errs = append(errs, MatchesRegex(ctx, op, fldPath.Child("startTime"), &newObj.StartTime, safe.Field(oldObj, toMaintenanceWindowStartTime), maintenanceWindowStartTimeRegex, maintenanceWindowStartTimeErrorString)...)
+
+	//DurationHours int32 `json:"durationHours,omitempty"`
+	errs = append(errs, validate.Minimum(ctx, op, fldPath.Child("durationHours"), &newObj.DurationHours, safe.Field(oldObj, toMaintenanceWindowDurationHours), 1)...)
+	errs = append(errs, Maximum(ctx, op, fldPath.Child("durationHours"), &newObj.DurationHours, safe.Field(oldObj, toMaintenanceWindowDurationHours), coreapi.MaxMaintenanceWindowDurationHours)...)
+
+	//TimeZone string `json:"timeZone,omitempty"`
+	errs = append(errs, TimeZone(ctx, op, fldPath.Child("timeZone"), &newObj.TimeZone, safe.Field(oldObj, toMaintenanceWindowTimeZone))...)
+
+	//ExclusionDates []string `json:"exclusionDates,omitempty"`
+	errs = append(errs, MaxItems(ctx, op, fldPath.Child("exclusionDates"), newObj.ExclusionDates, safe.Field(oldObj, toMaintenanceWindowExclusionDates), coreapi.MaxMaintenanceWindowExclusionDates)...)
+	errs = append(errs, validate.EachSliceVal(
+		ctx, op, fldPath.Child("exclusionDates"),
+		newObj.ExclusionDates, safe.Field(oldObj, toMaintenanceWindowExclusionDates),
+		nil, nil,
+		Date,
+	)...)
+
+	return errs
+}
+
 var (
 	toManagedServiceIdentityPrincipalID            = func(oldObj *coreapi.ManagedServiceIdentity) *string { return &oldObj.PrincipalID }
 	toManagedServiceIdentityTenantID               = func(oldObj *coreapi.ManagedServiceIdentity) *string { return &oldObj.TenantID }
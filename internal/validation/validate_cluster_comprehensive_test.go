@@ -1283,6 +1283,55 @@ func TestValidateClusterCreate(t *testing.T) {
 				{Message: "Unsupported value", FieldPath: "customerProperties.ingress.type"},
 			},
 		},
+		{
+			name: "valid maintenance window - create",
+			cluster: func() *coreapi.HCPOpenShiftCluster {
+				c := createValidCluster()
+				c.CustomerProperties.MaintenanceWindow = &coreapi.MaintenanceWindowProfile{
+					DaysOfWeek:     []metadataapi.DayOfWeek{metadataapi.DayOfWeekSaturday, metadataapi.DayOfWeekSunday},
+					StartTime:      "02:30",
+					DurationHours:  4,
+					TimeZone:       "Europe/Berlin",
+					ExclusionDates: []string{"2026-12-26"},
+				}
+				return c
+			}(),
+			expectErrors: []utils.ExpectedError{},
+		},
+		{
+			name: "empty maintenance window - create",
+			cluster: func() *coreapi.HCPOpenShiftCluster {
+				c := createValidCluster()
+				c.CustomerProperties.MaintenanceWindow = &coreapi.MaintenanceWindowProfile{}
+				return c
+			}(),
+			expectErrors: []utils.ExpectedError{
+				{Message: "Required value", FieldPath: "customerProperties.maintenanceWindow.daysOfWeek"},
+				{Message: "Required value", FieldPath: "customerProperties.maintenanceWindow.startTime"},
+				{Message: "must be greater than or equal to 1", FieldPath: "customerProperties.maintenanceWindow.durationHours"},
+			},
+		},
+		{
+			name: "invalid maintenance window fields - create",
+			cluster: func() *coreapi.HCPOpenShiftCluster {
+				c := createValidCluster()
+				c.CustomerProperties.MaintenanceWindow = &coreapi.MaintenanceWindowProfile{
+					DaysOfWeek:     []metadataapi.DayOfWeek{"Caturday"},
+					StartTime:      "24:00",
+					DurationHours:  25,
+					TimeZone:       "Mars/Olympus_Mons",
+					ExclusionDates: []string{"2026-02-30"},
+				}
+				return c
+			}(),
+			expectErrors: []utils.ExpectedError{
+				{Message: "Unsupported value", FieldPath: "customerProperties.maintenanceWindow.daysOfWeek[0]"},
+				{Message: "must be a 24-hour time in HH:MM format", FieldPath: "customerProperties.maintenanceWindow.startTime"},
+				{Message: "must be less than or equal to 24", FieldPath: "customerProperties.maintenanceWindow.durationHours"},
+				{Message: "must be a valid IANA time zone name", FieldPath: "customerProperties.maintenanceWindow.timeZone"},
+				{Message: "must be a date in YYYY-MM-DD format", FieldPath: "customerProperties.maintenanceWindow.exclusionDates[0]"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
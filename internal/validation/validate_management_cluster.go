@@ -72,6 +72,18 @@ var (
 	toManagementClusterSpecSchedulingPolicy = func(oldObj *fleetapi.ManagementClusterSpec) *fleetapi.ManagementClusterSchedulingPolicy {
 		return &oldObj.SchedulingPolicy
 	}
+	toManagementClusterSpecDrain = func(oldObj *fleetapi.ManagementClusterSpec) *fleetapi.ManagementClusterDrain {
+		return oldObj.Drain
+	}
+	toManagementClusterDrainReason = func(oldObj *fleetapi.ManagementClusterDrain) *string {
+		return &oldObj.Reason
+	}
+	toManagementClusterDrainMaxConcurrentMigrations = func(oldObj *fleetapi.ManagementClusterDrain) *int32 {
+		return &oldObj.MaxConcurrentMigrations
+	}
+	toManagementClusterDrainPreviousSchedulingPolicy = func(oldObj *fleetapi.ManagementClusterDrain) *fleetapi.ManagementClusterSchedulingPolicy {
+		return &oldObj.PreviousSchedulingPolicy
+	}
 )
 
 func validateManagementClusterSpec(ctx context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *fleetapi.ManagementClusterSpec) field.ErrorList {
@@ -81,6 +93,24 @@ func validateManagementClusterSpec(ctx context.Context, op operation.Operation,
 	errs = append(errs, validate.RequiredValue(ctx, op, fldPath.Child("schedulingPolicy"), &newObj.SchedulingPolicy, safe.Field(oldObj, toManagementClusterSpecSchedulingPolicy))...)
 	errs = append(errs, validate.Enum(ctx, op, fldPath.Child("schedulingPolicy"), &newObj.SchedulingPolicy, safe.Field(oldObj, toManagementClusterSpecSchedulingPolicy), fleetapi.ValidManagementClusterSchedulingPolicies, nil)...)
 
+	// Drain — optional
+	if newObj.Drain != nil {
+		errs = append(errs, validateManagementClusterDrain(ctx, op, fldPath.Child("drain"), newObj.Drain, safe.Field(oldObj, toManagementClusterSpecDrain))...)
+	}
+
+	return errs
+}
+
+func validateManagementClusterDrain(ctx context.Context, op operation.Operation, fldPath *field.Path, newObj, oldObj *fleetapi.ManagementClusterDrain) field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, validate.RequiredValue(ctx, op, fldPath.Child("reason"), &newObj.Reason, safe.Field(oldObj, toManagementClusterDrainReason))...)
+	errs = append(errs, validate.Minimum(ctx, op, fldPath.Child("maxConcurrentMigrations"), &newObj.MaxConcurrentMigrations, safe.Field(oldObj, toManagementClusterDrainMaxConcurrentMigrations), 1)...)
+	errs = append(errs, Maximum(ctx, op, fldPath.Child("maxConcurrentMigrations"), &newObj.MaxConcurrentMigrations, safe.Field(oldObj, toManagementClusterDrainMaxConcurrentMigrations), fleetapi.MaxManagementClusterDrainConcurrentMigrations)...)
+	if len(newObj.PreviousSchedulingPolicy) > 0 {
+		errs = append(errs, validate.Enum(ctx, op, fldPath.Child("previousSchedulingPolicy"), &newObj.PreviousSchedulingPolicy, safe.Field(oldObj, toManagementClusterDrainPreviousSchedulingPolicy), fleetapi.ValidManagementClusterSchedulingPolicies, nil)...)
+	}
+
 	return errs
 }
 
@@ -19,6 +19,7 @@ import (
 	"strings"
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
@@ -156,6 +157,52 @@ func TestValidateManagementClusterCreate(t *testing.T) {
 			},
 			expectErrors: nil,
 		},
+		// Drain
+		{
+			name: "valid drain accepted",
+			modify: func(t *testing.T, mc *fleetapi.ManagementCluster) {
+				mc.Spec.Drain = &fleetapi.ManagementClusterDrain{
+					Reason:                  "decommission",
+					RequestedTime:           metav1.Now(),
+					MaxConcurrentMigrations: fleetapi.DefaultManagementClusterDrainConcurrentMigrations,
+				}
+			},
+			expectErrors: nil,
+		},
+		{
+			name: "drain without reason rejected",
+			modify: func(t *testing.T, mc *fleetapi.ManagementCluster) {
+				mc.Spec.Drain = &fleetapi.ManagementClusterDrain{MaxConcurrentMigrations: 1}
+			},
+			expectErrors: []expectedError{
+				{fieldPath: "spec.drain.reason", message: "Required"},
+			},
+		},
+		{
+			name: "drain concurrency out of range rejected",
+			modify: func(t *testing.T, mc *fleetapi.ManagementCluster) {
+				mc.Spec.Drain = &fleetapi.ManagementClusterDrain{
+					Reason:                  "incident",
+					MaxConcurrentMigrations: fleetapi.MaxManagementClusterDrainConcurrentMigrations + 1,
+				}
+			},
+			expectErrors: []expectedError{
+				{fieldPath: "spec.drain.maxConcurrentMigrations", message: "must be less than or equal to"},
+			},
+		},
+		{
+			name: "drain with unknown previous scheduling policy rejected",
+			modify: func(t *testing.T, mc *fleetapi.ManagementCluster) {
+				mc.Spec.Drain = &fleetapi.ManagementClusterDrain{
+					Reason:                   "incident",
+					MaxConcurrentMigrations:  1,
+					PreviousSchedulingPolicy: "Invalid",
+				}
+			},
+			expectErrors: []expectedError{
+				{fieldPath: "spec.drain.previousSchedulingPolicy", message: "Unsupported value"},
+			},
+		},
 		// Status — all fields required
 		{
 			name: "empty status rejected",
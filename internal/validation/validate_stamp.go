@@ -25,12 +25,38 @@ import (
 func ValidateStampCreate(_ context.Context, stamp *fleetapi.Stamp) field.ErrorList {
 	var errs field.ErrorList
 	errs = append(errs, validateStampIdentifier(stamp)...)
+	errs = append(errs, validateStampApprovalPolicy(stamp.Spec.ApprovalPolicy, field.NewPath("spec", "approvalPolicy"))...)
 	return errs
 }
 
 func ValidateStampUpdate(_ context.Context, newStamp *fleetapi.Stamp, _ *fleetapi.Stamp) field.ErrorList {
 	var errs field.ErrorList
 	errs = append(errs, validateStampIdentifier(newStamp)...)
+	errs = append(errs, validateStampApprovalPolicy(newStamp.Spec.ApprovalPolicy, field.NewPath("spec", "approvalPolicy"))...)
+	return errs
+}
+
+func validateStampApprovalPolicy(policy *fleetapi.StampApprovalPolicy, fldPath *field.Path) field.ErrorList {
+	if policy == nil {
+		return nil
+	}
+	var errs field.ErrorList
+	if policy.RequiredApprovals < 1 {
+		errs = append(errs, field.Invalid(fldPath.Child("requiredApprovals"), policy.RequiredApprovals, "must be at least 1"))
+	}
+	if policy.SoakDuration.Duration < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("soakDuration"), policy.SoakDuration.Duration.String(), "must not be negative"))
+	}
+	seen := map[string]bool{}
+	for i, conditionType := range policy.RequiredManagementClusterConditions {
+		switch {
+		case len(conditionType) == 0:
+			errs = append(errs, field.Required(fldPath.Child("requiredManagementClusterConditions").Index(i), "condition type must not be empty"))
+		case seen[conditionType]:
+			errs = append(errs, field.Duplicate(fldPath.Child("requiredManagementClusterConditions").Index(i), conditionType))
+		}
+		seen[conditionType] = true
+	}
 	return errs
 }
 
@@ -18,6 +18,9 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 
@@ -92,7 +95,43 @@ func TestValidateStampCreate(t *testing.T) {
 			},
 			expectErrors: nil,
 		},
+		{
+			name: "valid approval policy",
+			modify: func(t *testing.T, s *fleetapi.Stamp) {
+				s.Spec.ApprovalPolicy = &fleetapi.StampApprovalPolicy{
+					RequiredApprovals:                   2,
+					RequestedBy:                         "alice@example.com",
+					SoakDuration:                        metav1.Duration{Duration: 24 * time.Hour},
+					RequiredManagementClusterConditions: []string{"ClustersServiceRegistered", "MaestroRegistered"},
+				}
+			},
+			expectErrors: nil,
+		},
 		// Invalid cases
+		{
+			name: "approval policy without approvals rejected",
+			modify: func(t *testing.T, s *fleetapi.Stamp) {
+				s.Spec.ApprovalPolicy = &fleetapi.StampApprovalPolicy{}
+			},
+			expectErrors: []expectedError{
+				{fieldPath: "spec.approvalPolicy.requiredApprovals", message: "must be at least 1"},
+			},
+		},
+		{
+			name: "approval policy with negative soak and bad conditions rejected",
+			modify: func(t *testing.T, s *fleetapi.Stamp) {
+				s.Spec.ApprovalPolicy = &fleetapi.StampApprovalPolicy{
+					RequiredApprovals:                   1,
+					SoakDuration:                        metav1.Duration{Duration: -time.Minute},
+					RequiredManagementClusterConditions: []string{"MaestroRegistered", "", "MaestroRegistered"},
+				}
+			},
+			expectErrors: []expectedError{
+				{fieldPath: "spec.approvalPolicy.soakDuration", message: "must not be negative"},
+				{fieldPath: "spec.approvalPolicy.requiredManagementClusterConditions[1]", message: "must not be empty"},
+				{fieldPath: "spec.approvalPolicy.requiredManagementClusterConditions[2]", message: "Duplicate value"},
+			},
+		},
 		{
 			name: "empty stamp identifier rejected",
 			modify: func(t *testing.T, s *fleetapi.Stamp) {
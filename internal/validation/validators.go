@@ -23,6 +23,7 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/blang/semver/v4"
 	"github.com/google/uuid"
@@ -370,6 +371,10 @@ var (
 	diskEncryptionSetName            = `^[a-zA-Z0-9_-]+$`
 	diskEncryptionSetNameRegex       = regexp.MustCompile(diskEncryptionSetName)
 	diskEncryptionSetNameErrorString = `(must contain only alphanumeric characters, underscores, and hyphens)`
+
+	maintenanceWindowStartTime            = `^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	maintenanceWindowStartTimeRegex       = regexp.MustCompile(maintenanceWindowStartTime)
+	maintenanceWindowStartTimeErrorString = `(must be a 24-hour time in HH:MM format)`
 )
 
 func MatchesRegex(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string, regex *regexp.Regexp, errorString string) field.ErrorList {
@@ -497,6 +502,32 @@ func URL(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _
 	return nil
 }
 
+// TimeZone validates that the value is an IANA time zone name known to the
+// time zone database.
+func TimeZone(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if len(*value) == 0 {
+		return nil
+	}
+	if _, err := time.LoadLocation(*value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a valid IANA time zone name")}
+	}
+	return nil
+}
+
+// Date validates that the value is a calendar date in YYYY-MM-DD format.
+func Date(_ context.Context, _ operation.Operation, fldPath *field.Path, value, _ *string) field.ErrorList {
+	if value == nil {
+		return nil
+	}
+	if _, err := time.Parse(time.DateOnly, *value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, *value, "must be a date in YYYY-MM-DD format")}
+	}
+	return nil
+}
+
 // isIpAddress checks if the host is a valid IP address (IPv4 or IPv6)
 func isIpAddress(host string) bool {
 	// Trim brackets for IPv6 addresses
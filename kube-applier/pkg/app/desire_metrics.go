@@ -87,7 +87,7 @@ func (c *desireCollector) collect() {
 
 // initCounts pre-seeds all label combinations to 0 so gauges go to zero when desires disappear.
 func initCounts() map[string]map[string]float64 {
-	condTypes := []string{kubeapplierapi.ConditionTypeSuccessful, kubeapplierapi.ConditionTypeDegraded}
+	condTypes := []string{kubeapplierapi.ConditionTypeSuccessful, kubeapplierapi.ConditionTypeDegraded, kubeapplierapi.ConditionTypeDrifted}
 	counts := map[string]map[string]float64{}
 	for _, t := range []string{"apply", "read"} {
 		counts[t] = map[string]float64{}
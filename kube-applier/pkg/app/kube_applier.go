@@ -38,6 +38,7 @@ import (
 	"github.com/Azure/ARO-HCP/internal/utils"
 	"github.com/Azure/ARO-HCP/internal/version"
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/apply_desire"
+	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/kubeinformerpool"
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/read_desire_manager"
 )
 
@@ -149,11 +150,12 @@ func (o *Options) runControllersUnderLeaderElection(
 		o.metricsRegisterer(),
 	)
 
-	applyCtl, err := apply_desire.NewApplyDesireController(applyInformer, o.DynamicClient, o.KubeApplierDBClient, apply_desire.Config{})
+	applyCtl, err := apply_desire.NewApplyDesireController(applyInformer, o.DynamicClient, o.KubeApplierDBClient, o.metricsRegisterer(), apply_desire.Config{})
 	if err != nil {
 		return fmt.Errorf("apply controller: %w", err)
 	}
-	readMgr, err := read_desire_manager.NewReadDesireInformerManagingController(readInformer, o.DynamicClient, o.KubeApplierDBClient, read_desire_manager.Config{})
+	kubeInformers := kubeinformerpool.New(o.DynamicClient, o.metricsRegisterer())
+	readMgr, err := read_desire_manager.NewReadDesireInformerManagingController(readInformer, kubeInformers, o.KubeApplierDBClient, read_desire_manager.Config{})
 	if err != nil {
 		return fmt.Errorf("read manager: %w", err)
 	}
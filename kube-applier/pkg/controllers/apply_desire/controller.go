@@ -24,8 +24,18 @@
 //     reports WaitingForDeletion until the target disappears (finalizers
 //     complete).
 //
+// Desires with .spec.dependsOn are held back until every dependency
+// succeeded and passes its readiness check; see dependencies.go.
+//
+// Before re-applying an unchanged ServerSideApply desire, the controller
+// compares a dry-run apply against the live object to detect hand edits and
+// corrects or only reports them per .spec.serverSideApply.driftPolicy; see
+// drift.go.
+//
 // The outcome is recorded on .status.conditions["Successful"] / ["Degraded"]
-// and persisted via the StatusWriter.
+// (plus ["WaitingForDependencies"] for desires with dependencies and
+// ["Drifted"] for ServerSideApply desires) and persisted via the
+// StatusWriter.
 package apply_desire
 
 import (
@@ -34,6 +44,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -72,18 +84,33 @@ const ApplyDesireControllerName = "ApplyDesireController"
 // after this duration so drift from the desired state is detected.
 const DefaultResyncPeriod = 10 * time.Minute
 
+// DefaultDependencyRecheckInterval is how long a desire held back by its
+// dependencies waits before they are checked again. Dependencies becoming
+// ready on the management cluster produce no Cosmos change for the
+// dependent desire, so waiting desires are polled rather than only
+// reconciled on resync.
+const DefaultDependencyRecheckInterval = 15 * time.Second
+
 // Config tunes the ApplyDesireController's resync behavior. Zero-valued
 // fields take the Default* constants below; tests pass shorter durations.
 type Config struct {
 	// ResyncPeriod is the maximum time between two reconciles of an
 	// unchanged desire. See DefaultResyncPeriod for the rationale.
 	ResyncPeriod time.Duration
+
+	// DependencyRecheckInterval is the time between two checks of the
+	// dependencies of a desire that is held back by them. See
+	// DefaultDependencyRecheckInterval for the rationale.
+	DependencyRecheckInterval time.Duration
 }
 
 func (c Config) withDefaults() Config {
 	if c.ResyncPeriod == 0 {
 		c.ResyncPeriod = DefaultResyncPeriod
 	}
+	if c.DependencyRecheckInterval == 0 {
+		c.DependencyRecheckInterval = DefaultDependencyRecheckInterval
+	}
 	return c
 }
 
@@ -101,6 +128,7 @@ type ApplyDesireController struct {
 	applyDesireInformer cache.SharedIndexInformer
 	fetcher             desirestatuswriter.Fetcher[kubeapplierapi.ApplyDesire, keys.ApplyDesireKey]
 	dyn                 dynamic.Interface
+	metrics             *driftMetrics
 	writer              desirestatuswriter.StatusWriter[kubeapplierapi.ApplyDesire, keys.ApplyDesireKey]
 	queue               workqueue.TypedRateLimitingInterface[keys.ApplyDesireKey]
 
@@ -115,12 +143,16 @@ type ApplyDesireController struct {
 // status replaces can be issued under the desire's own cluster/nodepool
 // resource ID rather than a sentinel parent.
 //
+// Drift metrics are registered with registerer; a nil registerer leaves
+// them unregistered.
+//
 // cfg's zero values get the Default* constants. Production callers may pass
 // Config{} directly; tests substitute shorter durations.
 func NewApplyDesireController(
 	applyDesireInformer cache.SharedIndexInformer,
 	dyn dynamic.Interface,
 	crudByParent kubeappliercosmosstorage.KubeApplierApplyDesireCRUD,
+	registerer prometheus.Registerer,
 	cfg Config,
 ) (*ApplyDesireController, error) {
 	cfg = cfg.withDefaults()
@@ -130,6 +162,7 @@ func NewApplyDesireController(
 		applyDesireInformer: applyDesireInformer,
 		fetcher:             fetcher,
 		dyn:                 dyn,
+		metrics:             newDriftMetrics(registerer),
 		writer: desirestatuswriter.New[kubeapplierapi.ApplyDesire, keys.ApplyDesireKey, *kubeapplierapi.ApplyDesire](
 			fetcher,
 			&applyDesireReplacer{crudByParent: crudByParent},
@@ -235,6 +268,10 @@ func (c *ApplyDesireController) processNext(ctx context.Context) bool {
 //   - ServerSideApply: SSA-applies .spec.serverSideApply.kubeContent.
 //   - Delete: deletes .spec.targetItem and reports WaitingForDeletion
 //     until the target disappears.
+//
+// Either operation only runs once every entry of .spec.dependsOn succeeded
+// and is ready. Until then SyncOnce records WaitingForDependencies=True and
+// requeues the key after cfg.DependencyRecheckInterval.
 func (c *ApplyDesireController) SyncOnce(ctx context.Context, key keys.ApplyDesireKey) error {
 	desire, err := c.fetcher.Fetch(ctx, key)
 	if cosmosstorageutils.IsNotFoundError(err) {
@@ -247,32 +284,97 @@ func (c *ApplyDesireController) SyncOnce(ctx context.Context, key keys.ApplyDesi
 		return nil
 	}
 
-	switch desire.Spec.Type {
-	case kubeapplierapi.ApplyDesireTypeServerSideApply:
-		applied, syncErr := c.applyDesired(ctx, desire)
-
-		// Capture the metadata.generation of the Kubernetes object returned by
-		// the SSA apply call so the closure below records the right value.
-		var appliedKubeGeneration *int64
-		if syncErr == nil && applied != nil {
-			gen := applied.GetGeneration()
-			appliedKubeGeneration = &gen
+	hasDependencies := len(desire.Spec.DependsOn) > 0
+	if hasDependencies {
+		pending, depErr := c.evaluateDependencies(ctx, desire)
+		var preCheck *conditions.PreCheckError
+		switch {
+		case errors.As(depErr, &preCheck):
+			// SetSuccessful only classifies an unwrapped *PreCheckError.
+			return c.writer.UpdateStatus(ctx, key, func(d *kubeapplierapi.ApplyDesire) {
+				conditions.SetSuccessful(&d.Status.Conditions, preCheck)
+				conditions.SetDegraded(&d.Status.Conditions, classifyAsDegraded(preCheck))
+			})
+		case depErr != nil:
+			return depErr
+		case len(pending) > 0:
+			c.queue.AddAfter(key, c.cfg.DependencyRecheckInterval)
+			return c.writer.UpdateStatus(ctx, key, func(d *kubeapplierapi.ApplyDesire) {
+				conditions.SetWaitingForDependencies(&d.Status.Conditions, pending)
+			})
 		}
+	}
 
-		return c.writer.UpdateStatus(ctx, key, func(d *kubeapplierapi.ApplyDesire) {
-			conditions.SetSuccessful(&d.Status.Conditions, syncErr)
-			conditions.SetDegraded(&d.Status.Conditions, classifyAsDegraded(syncErr))
-			d.Status.AppliedKubeGeneration = appliedKubeGeneration
-		})
+	var mutate desirestatuswriter.MutateFunc[kubeapplierapi.ApplyDesire]
+	switch desire.Spec.Type {
+	case kubeapplierapi.ApplyDesireTypeServerSideApply:
+		mutate = c.evaluateServerSideApply(ctx, desire)
 	case kubeapplierapi.ApplyDesireTypeDelete:
-		mutate := c.evaluateDelete(ctx, desire)
-		return c.writer.UpdateStatus(ctx, key, mutate)
+		mutate = c.evaluateDelete(ctx, desire)
 	default:
 		syncErr := conditions.NewPreCheckError(fmt.Errorf("unknown desire type %q", desire.Spec.Type))
-		return c.writer.UpdateStatus(ctx, key, func(d *kubeapplierapi.ApplyDesire) {
+		mutate = func(d *kubeapplierapi.ApplyDesire) {
 			conditions.SetSuccessful(&d.Status.Conditions, syncErr)
 			conditions.SetDegraded(&d.Status.Conditions, classifyAsDegraded(syncErr))
-		})
+		}
+	}
+
+	if hasDependencies {
+		reconciled := mutate
+		mutate = func(d *kubeapplierapi.ApplyDesire) {
+			reconciled(d)
+			conditions.SetWaitingForDependencies(&d.Status.Conditions, nil)
+		}
+	}
+	return c.writer.UpdateStatus(ctx, key, mutate)
+}
+
+// evaluateServerSideApply checks a ServerSideApply desire for drift, applies
+// it unless its drift policy says to leave the drift in place, and returns
+// the status mutation function that records the outcome.
+func (c *ApplyDesireController) evaluateServerSideApply(ctx context.Context, d *kubeapplierapi.ApplyDesire) desirestatuswriter.MutateFunc[kubeapplierapi.ApplyDesire] {
+	drifted, checked, err := c.detectDrift(ctx, d)
+	if err != nil {
+		return func(d *kubeapplierapi.ApplyDesire) {
+			conditions.SetSuccessful(&d.Status.Conditions, err)
+			conditions.SetDegraded(&d.Status.Conditions, classifyAsDegraded(err))
+			d.Status.AppliedKubeGeneration = nil
+		}
+	}
+	policy := driftPolicy(d)
+	if len(drifted) > 0 {
+		c.metrics.observeDrift(d, policy, drifted)
+	}
+	if len(drifted) > 0 && policy == kubeapplierapi.DriftPolicyReportOnly {
+		return func(d *kubeapplierapi.ApplyDesire) {
+			setDrift(d, drifted, false)
+			conditions.SetDegraded(&d.Status.Conditions, nil)
+		}
+	}
+
+	applied, syncErr := c.applyDesired(ctx, d)
+
+	// Capture the metadata.generation of the Kubernetes object returned by
+	// the SSA apply call so the closure below records the right value.
+	var appliedKubeGeneration *int64
+	if syncErr == nil && applied != nil {
+		gen := applied.GetGeneration()
+		appliedKubeGeneration = &gen
+	}
+	contentHash := kubeContentHash(d)
+
+	return func(d *kubeapplierapi.ApplyDesire) {
+		conditions.SetSuccessful(&d.Status.Conditions, syncErr)
+		conditions.SetDegraded(&d.Status.Conditions, classifyAsDegraded(syncErr))
+		d.Status.AppliedKubeGeneration = appliedKubeGeneration
+		if syncErr == nil {
+			d.Status.AppliedKubeContentHash = contentHash
+		}
+		// Without a drift check the live object is known to match only
+		// once the apply went through.
+		if checked || syncErr == nil {
+			setDrift(d, drifted, syncErr == nil)
+		}
 	}
 }
 
@@ -285,16 +387,36 @@ func (c *ApplyDesireController) SyncOnce(ctx context.Context, key keys.ApplyDesi
 // so they classify as PreCheckFailed; everything else is treated as a
 // kube-apiserver error.
 func (c *ApplyDesireController) applyDesired(ctx context.Context, d *kubeapplierapi.ApplyDesire) (*unstructured.Unstructured, error) {
+	kubeResourceAccessor, obj, err := c.prepareApply(d)
+	if err != nil {
+		return nil, err
+	}
+	result, applyErr := kubeResourceAccessor.Apply(ctx, d.Spec.TargetItem.Name, obj, metav1.ApplyOptions{
+		FieldManager: FieldManager,
+		Force:        true,
+	})
+	if applyErr != nil {
+		// Wrap with a contextual prefix; keep the original kind so SetSuccessful
+		// classifies it as a kube-apiserver error (NOT a *PreCheckError).
+		return nil, fmt.Errorf("server-side apply: %w", applyErr)
+	}
+	return result, nil
+}
+
+// prepareApply validates a ServerSideApply desire and returns the resource
+// client for its target together with the decoded kubeContent. It is shared
+// by applyDesired and the dry-run apply of detectDrift.
+func (c *ApplyDesireController) prepareApply(d *kubeapplierapi.ApplyDesire) (dynamic.ResourceInterface, *unstructured.Unstructured, error) {
 	target := d.Spec.TargetItem
 	if len(target.Resource) == 0 || len(target.Version) == 0 || len(target.Name) == 0 {
-		return nil, conditions.NewPreCheckError(errors.New("spec.targetItem requires version, resource, and name"))
+		return nil, nil, conditions.NewPreCheckError(errors.New("spec.targetItem requires version, resource, and name"))
 	}
 	if d.Spec.ServerSideApply == nil || d.Spec.ServerSideApply.KubeContent == nil || len(d.Spec.ServerSideApply.KubeContent.Raw) == 0 {
-		return nil, conditions.NewPreCheckError(errors.New("spec.serverSideApply.kubeContent is empty"))
+		return nil, nil, conditions.NewPreCheckError(errors.New("spec.serverSideApply.kubeContent is empty"))
 	}
 	obj := &unstructured.Unstructured{}
 	if err := obj.UnmarshalJSON(d.Spec.ServerSideApply.KubeContent.Raw); err != nil {
-		return nil, conditions.NewPreCheckError(fmt.Errorf("decode kubeContent: %w", err))
+		return nil, nil, conditions.NewPreCheckError(fmt.Errorf("decode kubeContent: %w", err))
 	}
 
 	gvr := schema.GroupVersionResource{Group: target.Group, Version: target.Version, Resource: target.Resource}
@@ -303,17 +425,7 @@ func (c *ApplyDesireController) applyDesired(ctx context.Context, d *kubeapplier
 	if len(target.Namespace) > 0 {
 		kubeResourceAccessor = resource.Namespace(target.Namespace)
 	}
-
-	result, applyErr := kubeResourceAccessor.Apply(ctx, target.Name, obj, metav1.ApplyOptions{
-		FieldManager: FieldManager,
-		Force:        true,
-	})
-	if applyErr != nil {
-		// Wrap with a contextual prefix; keep the original kind so SetSuccessful
-		// classifies it as a kube-apiserver error (NOT a *PreCheckError).
-		return nil, fmt.Errorf("server-side apply: %w", applyErr)
-	}
-	return result, nil
+	return kubeResourceAccessor, obj, nil
 }
 
 // evaluateDelete runs the state machine for one ApplyDesire with Type=Delete
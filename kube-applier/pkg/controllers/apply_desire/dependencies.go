@@ -0,0 +1,246 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply_desire
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/jsonpath"
+
+	azcorearm "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/Azure/ARO-HCP/internal/api/kubeapplierapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/conditions"
+	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/keys"
+)
+
+// evaluateDependencies checks every entry of d.Spec.DependsOn and returns a
+// human-readable reason for each one that is not ready yet. An empty result
+// means d may be reconciled.
+//
+// Malformed dependencies (missing or unparsable resource IDs, a desire that
+// depends on itself directly or through other desires, a dependency chain
+// deeper than maxDependencyDepth, a dependency on another management cluster,
+// invalid readiness checks) are returned as an unwrapped
+// *conditions.PreCheckError; waiting would never resolve them. Cosmos and
+// kube-apiserver errors are returned as-is so the caller requeues with
+// backoff.
+//
+// Dependencies are read straight from Cosmos rather than from the informer
+// cache so a dependency that just succeeded is observed on the next recheck
+// without waiting for the informer to catch up.
+func (c *ApplyDesireController) evaluateDependencies(ctx context.Context, d *kubeapplierapi.ApplyDesire) ([]string, error) {
+	depKeys := make([]keys.ApplyDesireKey, len(d.Spec.DependsOn))
+	for i, dep := range d.Spec.DependsOn {
+		if dep.ApplyDesire == nil {
+			return nil, conditions.NewPreCheckError(fmt.Errorf("spec.dependsOn[%d].applyDesire is required", i))
+		}
+		if strings.EqualFold(dep.ApplyDesire.String(), d.GetResourceID().String()) {
+			return nil, conditions.NewPreCheckError(fmt.Errorf("spec.dependsOn[%d]: an ApplyDesire cannot depend on itself", i))
+		}
+		depKey, err := keys.ApplyDesireKeyFromResourceID(dep.ApplyDesire)
+		if err != nil {
+			return nil, conditions.NewPreCheckError(fmt.Errorf("spec.dependsOn[%d].applyDesire: %w", i, err))
+		}
+		depKeys[i] = depKey
+	}
+	if err := c.checkDependencyCycle(ctx, d); err != nil {
+		return nil, err
+	}
+
+	var pending []string
+	for i, dep := range d.Spec.DependsOn {
+		depKey := depKeys[i]
+		reason, err := c.evaluateDependency(ctx, d, depKey, dep)
+		var preCheck *conditions.PreCheckError
+		if errors.As(err, &preCheck) {
+			return nil, conditions.NewPreCheckError(fmt.Errorf("spec.dependsOn[%d]: %w", i, preCheck.Err))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("dependency %s: %w", dep.ApplyDesire, err)
+		}
+		if len(reason) > 0 {
+			pending = append(pending, fmt.Sprintf("%s %s", dep.ApplyDesire, reason))
+		}
+	}
+	return pending, nil
+}
+
+// evaluateDependency returns why a single dependency is not ready yet, or
+// the empty string if it is.
+func (c *ApplyDesireController) evaluateDependency(ctx context.Context, d *kubeapplierapi.ApplyDesire, depKey keys.ApplyDesireKey, dep kubeapplierapi.ApplyDesireDependency) (string, error) {
+	depDesire, err := c.fetcher.Fetch(ctx, depKey)
+	if cosmosstorageutils.IsNotFoundError(err) {
+		return "does not exist", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if depDesire == nil {
+		return "does not exist", nil
+	}
+	// Each kube-applier only reconciles the desires of its own management
+	// cluster, so a dependency placed elsewhere would never be observed here.
+	if !sameManagementCluster(depDesire.Spec.ManagementCluster, d.Spec.ManagementCluster) {
+		return "", conditions.NewPreCheckError(fmt.Errorf("dependency targets management cluster %v, not %v",
+			depDesire.Spec.ManagementCluster, d.Spec.ManagementCluster))
+	}
+
+	successful := meta.FindStatusCondition(depDesire.Status.Conditions, kubeapplierapi.ConditionTypeSuccessful)
+	if successful == nil || successful.Status != metav1.ConditionTrue {
+		return "has not succeeded", nil
+	}
+	if dep.Readiness == nil {
+		return "", nil
+	}
+
+	target := depDesire.Spec.TargetItem
+	if len(target.Resource) == 0 || len(target.Version) == 0 || len(target.Name) == 0 {
+		return "", conditions.NewPreCheckError(errors.New("readiness check requires the dependency's spec.targetItem to name version, resource, and name"))
+	}
+	gvr := schema.GroupVersionResource{Group: target.Group, Version: target.Version, Resource: target.Resource}
+	resource := c.dyn.Resource(gvr)
+	var kubeResourceAccessor dynamic.ResourceInterface = resource
+	if len(target.Namespace) > 0 {
+		kubeResourceAccessor = resource.Namespace(target.Namespace)
+	}
+
+	obj, getErr := kubeResourceAccessor.Get(ctx, target.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(getErr) {
+		return "target does not exist", nil
+	}
+	if getErr != nil {
+		return "", fmt.Errorf("get target: %w", getErr)
+	}
+
+	ready, err := evaluateReadiness(obj, dep.Readiness)
+	if err != nil {
+		return "", err
+	}
+	if !ready {
+		return "is not ready", nil
+	}
+	return "", nil
+}
+
+// maxDependencyDepth bounds how many levels of dependencies
+// checkDependencyCycle follows before giving up on a chain.
+const maxDependencyDepth = 32
+
+// checkDependencyCycle walks the dependencies of d transitively and returns
+// a *conditions.PreCheckError if the walk leads back to d or descends more
+// than maxDependencyDepth levels. Dependencies that do not exist yet or have
+// malformed entries end the walk along their branch; they are reported when
+// d or the dependency itself is evaluated.
+func (c *ApplyDesireController) checkDependencyCycle(ctx context.Context, d *kubeapplierapi.ApplyDesire) error {
+	visited := map[keys.ApplyDesireKey]bool{}
+
+	var walk func(current *kubeapplierapi.ApplyDesire, path []string) error
+	walk = func(current *kubeapplierapi.ApplyDesire, path []string) error {
+		for _, dep := range current.Spec.DependsOn {
+			if dep.ApplyDesire == nil {
+				continue
+			}
+			depPath := append(slices.Clip(path), dep.ApplyDesire.Name)
+			if strings.EqualFold(dep.ApplyDesire.String(), d.GetResourceID().String()) {
+				return conditions.NewPreCheckError(fmt.Errorf("dependency cycle: %s", strings.Join(depPath, " -> ")))
+			}
+			if len(depPath)-1 > maxDependencyDepth {
+				return conditions.NewPreCheckError(fmt.Errorf("dependency chain is deeper than %d levels: %s", maxDependencyDepth, strings.Join(depPath, " -> ")))
+			}
+			depKey, err := keys.ApplyDesireKeyFromResourceID(dep.ApplyDesire)
+			if err != nil || visited[depKey] {
+				continue
+			}
+			visited[depKey] = true
+
+			depDesire, err := c.fetcher.Fetch(ctx, depKey)
+			if cosmosstorageutils.IsNotFoundError(err) || (err == nil && depDesire == nil) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("dependency %s: %w", dep.ApplyDesire, err)
+			}
+			if err := walk(depDesire, depPath); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return walk(d, []string{d.GetResourceID().Name})
+}
+
+func sameManagementCluster(a, b *azcorearm.ResourceID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return strings.EqualFold(a.String(), b.String())
+}
+
+// evaluateReadiness reports whether obj passes check.
+func evaluateReadiness(obj *unstructured.Unstructured, check *kubeapplierapi.ReadinessCheck) (bool, error) {
+	switch check.Type {
+	case kubeapplierapi.ReadinessCheckTypeCondition:
+		if check.Condition == nil || len(check.Condition.Type) == 0 {
+			return false, conditions.NewPreCheckError(errors.New("readiness check of type Condition requires condition.type"))
+		}
+		wantStatus := check.Condition.Status
+		if len(wantStatus) == 0 {
+			wantStatus = metav1.ConditionTrue
+		}
+		statusConditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+		if err != nil {
+			return false, nil
+		}
+		for _, raw := range statusConditions {
+			cond, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			if cond["type"] == check.Condition.Type {
+				return cond["status"] == string(wantStatus), nil
+			}
+		}
+		return false, nil
+	case kubeapplierapi.ReadinessCheckTypeJSONPath:
+		if check.JSONPath == nil || len(check.JSONPath.Path) == 0 {
+			return false, conditions.NewPreCheckError(errors.New("readiness check of type JSONPath requires jsonPath.path"))
+		}
+		jp := jsonpath.New("readiness").AllowMissingKeys(true)
+		if err := jp.Parse(check.JSONPath.Path); err != nil {
+			return false, conditions.NewPreCheckError(fmt.Errorf("parse readiness jsonPath %q: %w", check.JSONPath.Path, err))
+		}
+		var out bytes.Buffer
+		if err := jp.Execute(&out, obj.Object); err != nil {
+			return false, nil
+		}
+		return out.String() == check.JSONPath.Value, nil
+	default:
+		return false, conditions.NewPreCheckError(fmt.Errorf("unknown readiness check type %q", check.Type))
+	}
+}
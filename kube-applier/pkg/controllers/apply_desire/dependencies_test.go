@@ -0,0 +1,371 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply_desire
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/Azure/ARO-HCP/internal/api/kubeapplierapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/cosmosstorageutils"
+	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/conditions"
+	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/desirestatuswriter"
+	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/keys"
+)
+
+// mapFetcher implements desirestatuswriter.Fetcher over a fixed set of
+// desires so SyncOnce can look up dependencies by key. Unknown keys return a
+// cosmos not-found error.
+type mapFetcher map[keys.ApplyDesireKey]*kubeapplierapi.ApplyDesire
+
+func (f mapFetcher) Fetch(_ context.Context, key keys.ApplyDesireKey) (*kubeapplierapi.ApplyDesire, error) {
+	d, ok := f[key]
+	if !ok {
+		return nil, cosmosstorageutils.NewNotFoundError()
+	}
+	return d.DeepCopy(), nil
+}
+
+func withSuccessful(d *kubeapplierapi.ApplyDesire, status metav1.ConditionStatus) *kubeapplierapi.ApplyDesire {
+	d.Status.Conditions = append(d.Status.Conditions, metav1.Condition{
+		Type:   kubeapplierapi.ConditionTypeSuccessful,
+		Status: status,
+		Reason: "Test",
+	})
+	return d
+}
+
+func withDependency(d *kubeapplierapi.ApplyDesire, dep *kubeapplierapi.ApplyDesire, readiness *kubeapplierapi.ReadinessCheck) *kubeapplierapi.ApplyDesire {
+	d.Spec.DependsOn = append(d.Spec.DependsOn, kubeapplierapi.ApplyDesireDependency{
+		ApplyDesire: dep.GetResourceID(),
+		Readiness:   readiness,
+	})
+	return d
+}
+
+// readyConfigMap is the live object of the "dep" desire's target. ConfigMaps
+// carry no status, but the fake dynamic client stores whatever it is given,
+// which lets the tests exercise both readiness check types on one GVR.
+func readyConfigMap(conditionStatus string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "dep", "namespace": "default"},
+		"status": map[string]any{
+			"phase": "Bound",
+			"conditions": []any{
+				map[string]any{"type": "Established", "status": conditionStatus},
+			},
+		},
+	}}
+}
+
+func TestSyncOnce_Dependencies(t *testing.T) {
+	const depContent = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"dep","namespace":"default"}}`
+	const appContent = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"app","namespace":"default"}}`
+
+	tests := []struct {
+		name string
+		// dep is registered with the fetcher unless nil; desire depends on
+		// newApplyDesire(t, "dep", ...) regardless.
+		dep       func(t *testing.T) *kubeapplierapi.ApplyDesire
+		readiness *kubeapplierapi.ReadinessCheck
+		liveDep   *unstructured.Unstructured
+		selfDep   bool
+
+		wantApplied       bool
+		wantRequeued      bool
+		wantWaiting       metav1.ConditionStatus
+		wantWaitingMsg    string
+		wantSuccessReason string
+	}{
+		{
+			name:           "missing dependency waits",
+			wantRequeued:   true,
+			wantWaiting:    metav1.ConditionTrue,
+			wantWaitingMsg: "does not exist",
+		},
+		{
+			name: "dependency that has not succeeded waits",
+			dep: func(t *testing.T) *kubeapplierapi.ApplyDesire {
+				return withSuccessful(newApplyDesire(t, "dep", configMapTarget("dep"), []byte(depContent)), metav1.ConditionFalse)
+			},
+			wantRequeued:   true,
+			wantWaiting:    metav1.ConditionTrue,
+			wantWaitingMsg: "has not succeeded",
+		},
+		{
+			name: "succeeded dependency whose target is not established waits",
+			dep: func(t *testing.T) *kubeapplierapi.ApplyDesire {
+				return withSuccessful(newApplyDesire(t, "dep", configMapTarget("dep"), []byte(depContent)), metav1.ConditionTrue)
+			},
+			readiness: &kubeapplierapi.ReadinessCheck{
+				Type:      kubeapplierapi.ReadinessCheckTypeCondition,
+				Condition: &kubeapplierapi.ConditionReadinessCheck{Type: "Established"},
+			},
+			liveDep:        readyConfigMap("False"),
+			wantRequeued:   true,
+			wantWaiting:    metav1.ConditionTrue,
+			wantWaitingMsg: "is not ready",
+		},
+		{
+			name: "succeeded dependency whose target is missing waits",
+			dep: func(t *testing.T) *kubeapplierapi.ApplyDesire {
+				return withSuccessful(newApplyDesire(t, "dep", configMapTarget("dep"), []byte(depContent)), metav1.ConditionTrue)
+			},
+			readiness: &kubeapplierapi.ReadinessCheck{
+				Type:      kubeapplierapi.ReadinessCheckTypeCondition,
+				Condition: &kubeapplierapi.ConditionReadinessCheck{Type: "Established"},
+			},
+			wantRequeued:   true,
+			wantWaiting:    metav1.ConditionTrue,
+			wantWaitingMsg: "target does not exist",
+		},
+		{
+			name: "succeeded dependency without readiness check applies",
+			dep: func(t *testing.T) *kubeapplierapi.ApplyDesire {
+				return withSuccessful(newApplyDesire(t, "dep", configMapTarget("dep"), []byte(depContent)), metav1.ConditionTrue)
+			},
+			wantApplied:       true,
+			wantWaiting:       metav1.ConditionFalse,
+			wantSuccessReason: kubeapplierapi.ConditionReasonNoErrors,
+		},
+		{
+			name: "established dependency applies",
+			dep: func(t *testing.T) *kubeapplierapi.ApplyDesire {
+				return withSuccessful(newApplyDesire(t, "dep", configMapTarget("dep"), []byte(depContent)), metav1.ConditionTrue)
+			},
+			readiness: &kubeapplierapi.ReadinessCheck{
+				Type:      kubeapplierapi.ReadinessCheckTypeCondition,
+				Condition: &kubeapplierapi.ConditionReadinessCheck{Type: "Established"},
+			},
+			liveDep:           readyConfigMap("True"),
+			wantApplied:       true,
+			wantWaiting:       metav1.ConditionFalse,
+			wantSuccessReason: kubeapplierapi.ConditionReasonNoErrors,
+		},
+		{
+			name: "JSONPath-ready dependency applies",
+			dep: func(t *testing.T) *kubeapplierapi.ApplyDesire {
+				return withSuccessful(newApplyDesire(t, "dep", configMapTarget("dep"), []byte(depContent)), metav1.ConditionTrue)
+			},
+			readiness: &kubeapplierapi.ReadinessCheck{
+				Type:     kubeapplierapi.ReadinessCheckTypeJSONPath,
+				JSONPath: &kubeapplierapi.JSONPathReadinessCheck{Path: "{.status.phase}", Value: "Bound"},
+			},
+			liveDep:           readyConfigMap("False"),
+			wantApplied:       true,
+			wantWaiting:       metav1.ConditionFalse,
+			wantSuccessReason: kubeapplierapi.ConditionReasonNoErrors,
+		},
+		{
+			name: "invalid JSONPath is a pre-check failure",
+			dep: func(t *testing.T) *kubeapplierapi.ApplyDesire {
+				return withSuccessful(newApplyDesire(t, "dep", configMapTarget("dep"), []byte(depContent)), metav1.ConditionTrue)
+			},
+			readiness: &kubeapplierapi.ReadinessCheck{
+				Type:     kubeapplierapi.ReadinessCheckTypeJSONPath,
+				JSONPath: &kubeapplierapi.JSONPathReadinessCheck{Path: "{.status.phase", Value: "Bound"},
+			},
+			liveDep:           readyConfigMap("True"),
+			wantSuccessReason: kubeapplierapi.ConditionReasonPreCheckFailed,
+		},
+		{
+			name: "dependency on another management cluster is a pre-check failure",
+			dep: func(t *testing.T) *kubeapplierapi.ApplyDesire {
+				dep := withSuccessful(newApplyDesire(t, "dep", configMapTarget("dep"), []byte(depContent)), metav1.ConditionTrue)
+				dep.Spec.ManagementCluster = mustParseID(t, "/providers/microsoft.redhatopenshift/stamps/2/managementclusters/mgmt-2")
+				return dep
+			},
+			wantSuccessReason: kubeapplierapi.ConditionReasonPreCheckFailed,
+		},
+		{
+			name: "dependency cycle is a pre-check failure",
+			dep: func(t *testing.T) *kubeapplierapi.ApplyDesire {
+				dep := withSuccessful(newApplyDesire(t, "dep", configMapTarget("dep"), []byte(depContent)), metav1.ConditionTrue)
+				return withDependency(dep, newApplyDesire(t, "app", configMapTarget("app"), nil), nil)
+			},
+			wantSuccessReason: kubeapplierapi.ConditionReasonPreCheckFailed,
+		},
+		{
+			name:              "self dependency is a pre-check failure",
+			selfDep:           true,
+			wantSuccessReason: kubeapplierapi.ConditionReasonPreCheckFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+			dyn := fakeDynamic(t, map[schema.GroupVersionResource]string{gvr: "ConfigMapList"})
+			dyn.PrependReactor("patch", "configmaps", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				obj := &unstructured.Unstructured{}
+				obj.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"})
+				obj.SetName(action.(clienttesting.PatchAction).GetName())
+				obj.SetNamespace(action.GetNamespace())
+				return true, obj, nil
+			})
+			if tt.liveDep != nil {
+				if err := dyn.Tracker().Add(tt.liveDep); err != nil {
+					t.Fatalf("seed live dependency: %v", err)
+				}
+			}
+
+			desire := newApplyDesire(t, "app", configMapTarget("app"), []byte(appContent))
+			fetcher := mapFetcher{}
+			if tt.selfDep {
+				withDependency(desire, desire, nil)
+			} else {
+				withDependency(desire, newApplyDesire(t, "dep", configMapTarget("dep"), nil), tt.readiness)
+			}
+			if tt.dep != nil {
+				dep := tt.dep(t)
+				fetcher[mustKey(t, dep)] = dep
+			}
+			key := mustKey(t, desire)
+			fetcher[key] = desire
+
+			replacer := &capturingReplacer{}
+			c := newCadenceController(t, Config{DependencyRecheckInterval: time.Millisecond})
+			c.dyn = dyn
+			c.fetcher = fetcher
+			c.writer = desirestatuswriter.New[kubeapplierapi.ApplyDesire, keys.ApplyDesireKey, *kubeapplierapi.ApplyDesire](
+				fetcher, replacer,
+			)
+
+			if err := c.SyncOnce(ctx, key); err != nil {
+				t.Fatalf("SyncOnce: %v", err)
+			}
+			if replacer.last == nil {
+				t.Fatal("replacer was not called; status was not written")
+			}
+
+			applied := false
+			for _, action := range dyn.Actions() {
+				if action.GetVerb() == "patch" {
+					applied = true
+				}
+			}
+			if applied != tt.wantApplied {
+				t.Errorf("applied = %v, want %v", applied, tt.wantApplied)
+			}
+
+			requeued := false
+			for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+				if c.queue.Len() > 0 {
+					requeued = true
+					break
+				}
+				if !tt.wantRequeued {
+					break
+				}
+			}
+			if requeued != tt.wantRequeued {
+				t.Errorf("requeued = %v, want %v", requeued, tt.wantRequeued)
+			}
+
+			waiting := findCond(replacer.last.Status.Conditions, kubeapplierapi.ConditionTypeWaitingForDependencies)
+			if len(tt.wantWaiting) > 0 {
+				if waiting == nil {
+					t.Fatalf("WaitingForDependencies condition missing; got %+v", replacer.last.Status.Conditions)
+				}
+				if waiting.Status != tt.wantWaiting {
+					t.Errorf("WaitingForDependencies status = %q, want %q", waiting.Status, tt.wantWaiting)
+				}
+				if !strings.Contains(waiting.Message, tt.wantWaitingMsg) {
+					t.Errorf("WaitingForDependencies message = %q, want it to contain %q", waiting.Message, tt.wantWaitingMsg)
+				}
+			}
+
+			successful := findCond(replacer.last.Status.Conditions, kubeapplierapi.ConditionTypeSuccessful)
+			if len(tt.wantSuccessReason) == 0 {
+				if successful != nil {
+					t.Errorf("Successful condition written while waiting: %+v", successful)
+				}
+				return
+			}
+			if successful == nil {
+				t.Fatalf("Successful condition missing; got %+v", replacer.last.Status.Conditions)
+			}
+			if successful.Reason != tt.wantSuccessReason {
+				t.Errorf("Successful reason = %q, want %q (message %q)", successful.Reason, tt.wantSuccessReason, successful.Message)
+			}
+		})
+	}
+}
+
+func TestEvaluateDependencies_DepthBound(t *testing.T) {
+	// dependencyChain returns the head of a chain of succeeded desires that
+	// is depth levels deep.
+	dependencyChain := func(t *testing.T, depth int) (*kubeapplierapi.ApplyDesire, mapFetcher) {
+		fetcher := mapFetcher{}
+		chain := make([]*kubeapplierapi.ApplyDesire, depth+1)
+		for i := range chain {
+			name := fmt.Sprintf("d%d", i)
+			chain[i] = withSuccessful(newApplyDesire(t, name, configMapTarget(name), nil), metav1.ConditionTrue)
+		}
+		for i := range chain {
+			if i+1 < len(chain) {
+				withDependency(chain[i], chain[i+1], nil)
+			}
+			fetcher[mustKey(t, chain[i])] = chain[i]
+		}
+		return chain[0], fetcher
+	}
+
+	for _, tt := range []struct {
+		name      string
+		depth     int
+		wantError bool
+	}{
+		{name: "chain at the bound", depth: maxDependencyDepth},
+		{name: "chain beyond the bound", depth: maxDependencyDepth + 1, wantError: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			head, fetcher := dependencyChain(t, tt.depth)
+			c := newCadenceController(t, Config{})
+			c.fetcher = fetcher
+
+			pending, err := c.evaluateDependencies(context.Background(), head)
+			if !tt.wantError {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(pending) != 0 {
+					t.Errorf("unexpected pending dependencies %v", pending)
+				}
+				return
+			}
+			var preCheck *conditions.PreCheckError
+			if !errors.As(err, &preCheck) {
+				t.Fatalf("expected a PreCheckError, got %v", err)
+			}
+			if !strings.Contains(err.Error(), "deeper than") {
+				t.Errorf("unexpected error %q", err)
+			}
+		})
+	}
+}
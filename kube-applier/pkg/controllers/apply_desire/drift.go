@@ -0,0 +1,192 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply_desire
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-HCP/internal/api/kubeapplierapi"
+	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/conditions"
+)
+
+// driftedObjectMissing is the drifted "field" reported when the target of
+// a previously applied desire no longer exists.
+const driftedObjectMissing = "<object missing>"
+
+// ignoredDriftFields are the paths the kube-apiserver maintains itself. They
+// differ between a dry-run apply and the live object without anyone having
+// touched the object.
+var ignoredDriftFields = map[string]struct{}{
+	".metadata.managedFields":     {},
+	".metadata.resourceVersion":   {},
+	".metadata.generation":        {},
+	".metadata.creationTimestamp": {},
+	".metadata.uid":               {},
+	".metadata.selfLink":          {},
+	".status":                     {},
+}
+
+// detectDrift compares the live target of a ServerSideApply desire with the
+// result of a dry-run apply of its kubeContent, and returns the sorted paths
+// of the fields in which they differ. Because the dry run is a server-side
+// apply under FieldManager, fields owned only by other managers are left
+// as they are in both and never count as drift.
+//
+// checked is false when drift was not looked for: before the first
+// successful apply and after the desire's kubeContent changed, any
+// difference is the desire rolling out rather than drift.
+func (c *ApplyDesireController) detectDrift(ctx context.Context, d *kubeapplierapi.ApplyDesire) (drifted []string, checked bool, err error) {
+	if len(d.Status.AppliedKubeContentHash) == 0 || d.Status.AppliedKubeContentHash != kubeContentHash(d) {
+		return nil, false, nil
+	}
+	kubeResourceAccessor, obj, err := c.prepareApply(d)
+	if err != nil {
+		return nil, false, err
+	}
+	name := d.Spec.TargetItem.Name
+
+	live, err := kubeResourceAccessor.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return []string{driftedObjectMissing}, true, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("get target for drift check: %w", err)
+	}
+	desired, err := kubeResourceAccessor.Apply(ctx, name, obj, metav1.ApplyOptions{
+		FieldManager: FieldManager,
+		Force:        true,
+		DryRun:       []string{metav1.DryRunAll},
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("dry-run server-side apply: %w", err)
+	}
+
+	diffFields("", live.Object, desired.Object, &drifted)
+	sort.Strings(drifted)
+	return drifted, true, nil
+}
+
+// diffFields appends to out the path of every field in which live and
+// desired differ. Maps are compared key by key; any other value, lists
+// included, is compared as a whole.
+func diffFields(path string, live, desired any, out *[]string) {
+	if _, ignored := ignoredDriftFields[path]; ignored {
+		return
+	}
+	liveMap, liveIsMap := live.(map[string]any)
+	desiredMap, desiredIsMap := desired.(map[string]any)
+	if !liveIsMap || !desiredIsMap {
+		if !reflect.DeepEqual(live, desired) {
+			*out = append(*out, path)
+		}
+		return
+	}
+	for key, liveValue := range liveMap {
+		diffFields(fieldPath(path, key), liveValue, desiredMap[key], out)
+	}
+	for key, desiredValue := range desiredMap {
+		if _, ok := liveMap[key]; !ok {
+			diffFields(fieldPath(path, key), nil, desiredValue, out)
+		}
+	}
+}
+
+// fieldPath appends key to path in JSONPath-like notation, bracketing keys
+// such as annotation names that contain dots themselves.
+func fieldPath(path, key string) string {
+	if strings.ContainsAny(key, ".[]") {
+		return fmt.Sprintf("%s['%s']", path, key)
+	}
+	return path + "." + key
+}
+
+// setDrift records the outcome of a drift check on the desire's status.
+func setDrift(d *kubeapplierapi.ApplyDesire, drifted []string, corrected bool) {
+	conditions.SetDrifted(&d.Status.Conditions, drifted, corrected)
+	d.Status.DriftedFields = nil
+	if len(drifted) > 0 {
+		d.Status.DriftedFields = drifted[:min(len(drifted), kubeapplierapi.MaxDriftedFields)]
+	}
+}
+
+// kubeContentHash returns the hex SHA-256 of the desire's kubeContent, or ""
+// when it has none.
+func kubeContentHash(d *kubeapplierapi.ApplyDesire) string {
+	if d.Spec.ServerSideApply == nil || d.Spec.ServerSideApply.KubeContent == nil || len(d.Spec.ServerSideApply.KubeContent.Raw) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(d.Spec.ServerSideApply.KubeContent.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// driftPolicy returns the desire's drift policy, defaulting to Correct.
+func driftPolicy(d *kubeapplierapi.ApplyDesire) kubeapplierapi.DriftPolicy {
+	if d.Spec.ServerSideApply == nil || len(d.Spec.ServerSideApply.DriftPolicy) == 0 {
+		return kubeapplierapi.DriftPolicyCorrect
+	}
+	return d.Spec.ServerSideApply.DriftPolicy
+}
+
+// driftMetrics counts drift found by the controller. A nil *driftMetrics
+// is valid and records nothing, which keeps hand-built test controllers
+// simple.
+type driftMetrics struct {
+	detected *prometheus.CounterVec
+	fields   *prometheus.CounterVec
+}
+
+func newDriftMetrics(registerer prometheus.Registerer) *driftMetrics {
+	return &driftMetrics{
+		detected: promauto.With(registerer).NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "kube_applier_apply_desire_drift_detected_total",
+				Help: "Number of syncs that found an ApplyDesire's live object drifted from the desired state, by management cluster and drift policy.",
+			},
+			[]string{"management_cluster", "policy"},
+		),
+		fields: promauto.With(registerer).NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "kube_applier_apply_desire_drifted_fields_total",
+				Help: "Number of drifted fields found across all drift checks, by management cluster and drift policy.",
+			},
+			[]string{"management_cluster", "policy"},
+		),
+	}
+}
+
+func (m *driftMetrics) observeDrift(d *kubeapplierapi.ApplyDesire, policy kubeapplierapi.DriftPolicy, drifted []string) {
+	if m == nil {
+		return
+	}
+	var managementCluster string
+	if d.Spec.ManagementCluster != nil {
+		managementCluster = strings.ToLower(d.Spec.ManagementCluster.String())
+	}
+	labels := prometheus.Labels{"management_cluster": managementCluster, "policy": string(policy)}
+	m.detected.With(labels).Inc()
+	m.fields.With(labels).Add(float64(len(drifted)))
+}
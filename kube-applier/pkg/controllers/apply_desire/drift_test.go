@@ -0,0 +1,221 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apply_desire
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+
+	"github.com/Azure/ARO-HCP/internal/api/kubeapplierapi"
+	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/desirestatuswriter"
+	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/keys"
+)
+
+const driftKubeContent = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"hello","namespace":"default"},"data":{"k":"v"}}`
+
+// driftConfigMap returns the hello ConfigMap with the given data value, as
+// either the live object or the result of an apply.
+func driftConfigMap(value, resourceVersion string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"})
+	obj.SetName("hello")
+	obj.SetNamespace("default")
+	obj.SetResourceVersion(resourceVersion)
+	_ = unstructured.SetNestedStringMap(obj.Object, map[string]string{"k": value}, "data")
+	return obj
+}
+
+// TestSyncOnce_Drift drives SyncOnce for a ServerSideApply desire against a
+// live object and checks the Drifted condition, the recorded fields, and
+// whether the desire was re-applied. The fake dynamic client answers every
+// apply, dry-run or not, with the desired object, so the number of patch
+// actions tells a dry run followed by an apply from a dry run alone.
+func TestSyncOnce_Drift(t *testing.T) {
+	appliedHash := kubeContentHash(newApplyDesire(t, "drift", configMapTarget("hello"), []byte(driftKubeContent)))
+
+	cases := []struct {
+		name        string
+		live        *unstructured.Unstructured
+		appliedHash string
+		policy      kubeapplierapi.DriftPolicy
+
+		wantPatches    int
+		wantDrifted    metav1.ConditionStatus
+		wantReason     string
+		wantFields     []string
+		wantSuccessful bool
+	}{
+		{
+			name:           "first apply is not checked for drift",
+			live:           driftConfigMap("edited", "5"),
+			wantPatches:    1,
+			wantDrifted:    metav1.ConditionFalse,
+			wantReason:     kubeapplierapi.ConditionReasonNoDrift,
+			wantSuccessful: true,
+		},
+		{
+			name:           "unchanged live object",
+			live:           driftConfigMap("v", "5"),
+			appliedHash:    appliedHash,
+			wantPatches:    2,
+			wantDrifted:    metav1.ConditionFalse,
+			wantReason:     kubeapplierapi.ConditionReasonNoDrift,
+			wantSuccessful: true,
+		},
+		{
+			name:           "hand edit is corrected by default",
+			live:           driftConfigMap("edited", "5"),
+			appliedHash:    appliedHash,
+			wantPatches:    2,
+			wantDrifted:    metav1.ConditionTrue,
+			wantReason:     kubeapplierapi.ConditionReasonDriftCorrected,
+			wantFields:     []string{".data.k"},
+			wantSuccessful: true,
+		},
+		{
+			name:        "hand edit is only reported under ReportOnly",
+			live:        driftConfigMap("edited", "5"),
+			appliedHash: appliedHash,
+			policy:      kubeapplierapi.DriftPolicyReportOnly,
+			wantPatches: 1,
+			wantDrifted: metav1.ConditionTrue,
+			wantReason:  kubeapplierapi.ConditionReasonDriftDetected,
+			wantFields:  []string{".data.k"},
+		},
+		{
+			name:        "deleted object is only reported under ReportOnly",
+			appliedHash: appliedHash,
+			policy:      kubeapplierapi.DriftPolicyReportOnly,
+			wantPatches: 0,
+			wantDrifted: metav1.ConditionTrue,
+			wantReason:  kubeapplierapi.ConditionReasonDriftDetected,
+			wantFields:  []string{driftedObjectMissing},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			var objects []runtime.Object
+			if tc.live != nil {
+				objects = append(objects, tc.live)
+			}
+			dyn := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+				{Version: "v1", Resource: "configmaps"}: "ConfigMapList",
+			}, objects...)
+			dyn.PrependReactor("patch", "configmaps", func(clienttesting.Action) (bool, runtime.Object, error) {
+				return true, driftConfigMap("v", "6"), nil
+			})
+
+			desire := newApplyDesire(t, "drift", configMapTarget("hello"), []byte(driftKubeContent))
+			desire.Spec.ServerSideApply.DriftPolicy = tc.policy
+			desire.Status.AppliedKubeContentHash = tc.appliedHash
+			fetcher := &staticFetcher{desire: desire}
+			replacer := &capturingReplacer{}
+			c := &ApplyDesireController{
+				dyn:     dyn,
+				fetcher: fetcher,
+				metrics: newDriftMetrics(nil),
+				writer: desirestatuswriter.New[kubeapplierapi.ApplyDesire, keys.ApplyDesireKey, *kubeapplierapi.ApplyDesire](
+					fetcher, replacer,
+				),
+			}
+
+			if err := c.SyncOnce(ctx, mustKey(t, desire)); err != nil {
+				t.Fatalf("SyncOnce: %v", err)
+			}
+
+			var patches int
+			for _, a := range dyn.Actions() {
+				if a.GetVerb() == "patch" {
+					patches++
+				}
+			}
+			if patches != tc.wantPatches {
+				t.Errorf("patch actions = %d, want %d", patches, tc.wantPatches)
+			}
+			if replacer.last == nil {
+				t.Fatal("replacer was not called; status was not written")
+			}
+			drifted := findCond(replacer.last.Status.Conditions, kubeapplierapi.ConditionTypeDrifted)
+			if drifted == nil {
+				t.Fatal("Drifted condition not set")
+			}
+			if drifted.Status != tc.wantDrifted || drifted.Reason != tc.wantReason {
+				t.Errorf("Drifted = %v/%q, want %v/%q", drifted.Status, drifted.Reason, tc.wantDrifted, tc.wantReason)
+			}
+			if !reflect.DeepEqual(replacer.last.Status.DriftedFields, tc.wantFields) {
+				t.Errorf("DriftedFields = %v, want %v", replacer.last.Status.DriftedFields, tc.wantFields)
+			}
+			successful := findCond(replacer.last.Status.Conditions, kubeapplierapi.ConditionTypeSuccessful)
+			if tc.wantSuccessful != (successful != nil && successful.Status == metav1.ConditionTrue) {
+				t.Errorf("Successful = %v, want True only when re-applied (%v)", successful, tc.wantSuccessful)
+			}
+			if tc.wantSuccessful && replacer.last.Status.AppliedKubeContentHash != appliedHash {
+				t.Errorf("AppliedKubeContentHash = %q after apply, want %q", replacer.last.Status.AppliedKubeContentHash, appliedHash)
+			}
+		})
+	}
+}
+
+func TestDiffFields(t *testing.T) {
+	live := map[string]any{
+		"metadata": map[string]any{
+			"name":            "hello",
+			"resourceVersion": "5",
+			"annotations":     map[string]any{"example.com/owner": "someone-else"},
+			"labels":          map[string]any{"app": "hello", "extra": "x"},
+		},
+		"spec": map[string]any{
+			"replicas": int64(2),
+			"ports":    []any{int64(80)},
+		},
+		"status": map[string]any{"ready": false},
+	}
+	desired := map[string]any{
+		"metadata": map[string]any{
+			"name":            "hello",
+			"resourceVersion": "6",
+			"annotations":     map[string]any{"example.com/owner": "kube-applier"},
+			"labels":          map[string]any{"app": "hello"},
+		},
+		"spec": map[string]any{
+			"replicas": int64(3),
+			"ports":    []any{int64(80), int64(443)},
+		},
+		"status": map[string]any{"ready": true},
+	}
+
+	var got []string
+	diffFields("", live, desired, &got)
+	want := []string{
+		".metadata.annotations['example.com/owner']",
+		".metadata.labels.extra",
+		".spec.ports",
+		".spec.replicas",
+	}
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diffFields = %v, want %v", got, want)
+	}
+}
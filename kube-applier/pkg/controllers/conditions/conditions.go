@@ -13,7 +13,9 @@
 // limitations under the License.
 
 // Package conditions provides typed setters for the well-known
-// kube-applier *Desire conditions (Successful, Degraded).
+// kube-applier *Desire conditions (Successful, Degraded, WaitingForDependencies
+// for ApplyDesires with dependencies, and Drifted for server-side-applied
+// ApplyDesires).
 //
 // All setters go through meta.SetStatusCondition, which preserves
 // LastTransitionTime when the condition's Status, Reason, and Message are
@@ -22,6 +24,7 @@ package conditions
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -87,6 +90,69 @@ func SetSuccessfulWaitingForDeletion(conds *[]metav1.Condition, deletionTime met
 	})
 }
 
+// SetWaitingForDependencies records whether an ApplyDesire with
+// .spec.dependsOn is held back by its dependencies. An empty pending list
+// means every dependency succeeded and is ready; otherwise the message lists
+// what each pending dependency is waiting for.
+func SetWaitingForDependencies(conds *[]metav1.Condition, pending []string) {
+	if len(pending) == 0 {
+		meta.SetStatusCondition(conds, metav1.Condition{
+			Type:    kubeapplierapi.ConditionTypeWaitingForDependencies,
+			Status:  metav1.ConditionFalse,
+			Reason:  kubeapplierapi.ConditionReasonDependenciesReady,
+			Message: "As expected.",
+		})
+		return
+	}
+	meta.SetStatusCondition(conds, metav1.Condition{
+		Type:    kubeapplierapi.ConditionTypeWaitingForDependencies,
+		Status:  metav1.ConditionTrue,
+		Reason:  kubeapplierapi.ConditionReasonDependenciesNotReady,
+		Message: fmt.Sprintf("waiting for dependencies: %s", strings.Join(pending, "; ")),
+	})
+}
+
+// driftMessageFields is how many drifted field paths SetDrifted names in the
+// condition message; the rest are only counted.
+const driftMessageFields = 5
+
+// SetDrifted records whether the live object of a server-side-applied
+// ApplyDesire was found to differ from the desired state. An empty fields
+// list means no drift; otherwise corrected selects between the
+// DriftCorrected and DriftDetected reasons and the message names the first
+// few drifted field paths.
+func SetDrifted(conds *[]metav1.Condition, fields []string, corrected bool) {
+	if len(fields) == 0 {
+		meta.SetStatusCondition(conds, metav1.Condition{
+			Type:    kubeapplierapi.ConditionTypeDrifted,
+			Status:  metav1.ConditionFalse,
+			Reason:  kubeapplierapi.ConditionReasonNoDrift,
+			Message: "As expected.",
+		})
+		return
+	}
+	reason := kubeapplierapi.ConditionReasonDriftDetected
+	verb := "differ from"
+	if corrected {
+		reason = kubeapplierapi.ConditionReasonDriftCorrected
+		verb = "were reset to"
+	}
+	named := fields
+	if len(named) > driftMessageFields {
+		named = named[:driftMessageFields]
+	}
+	message := fmt.Sprintf("%d field(s) %s the desired state: %s", len(fields), verb, strings.Join(named, ", "))
+	if more := len(fields) - len(named); more > 0 {
+		message += fmt.Sprintf(" and %d more", more)
+	}
+	meta.SetStatusCondition(conds, metav1.Condition{
+		Type:    kubeapplierapi.ConditionTypeDrifted,
+		Status:  metav1.ConditionTrue,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
 // SetDegraded records controller-level health. Convention matches the
 // existing backend controllers: nil -> NoErrors/False, non-nil -> Failed/True.
 func SetDegraded(conds *[]metav1.Condition, err error) {
@@ -122,6 +122,83 @@ func TestSetDegraded(t *testing.T) {
 	}
 }
 
+func TestSetWaitingForDependencies(t *testing.T) {
+	var conds []metav1.Condition
+	SetWaitingForDependencies(&conds, []string{"crd has not succeeded", "namespace is not ready"})
+	c := findCondition(conds, kubeapplierapi.ConditionTypeWaitingForDependencies)
+	if c == nil {
+		t.Fatal("WaitingForDependencies not set")
+	}
+	if c.Status != metav1.ConditionTrue {
+		t.Errorf("Status = %v, want True", c.Status)
+	}
+	if c.Reason != kubeapplierapi.ConditionReasonDependenciesNotReady {
+		t.Errorf("Reason = %q, want %q", c.Reason, kubeapplierapi.ConditionReasonDependenciesNotReady)
+	}
+	if !contains(c.Message, "crd has not succeeded; namespace is not ready") {
+		t.Errorf("Message = %q does not list the pending dependencies", c.Message)
+	}
+	SetWaitingForDependencies(&conds, nil)
+	c = findCondition(conds, kubeapplierapi.ConditionTypeWaitingForDependencies)
+	if c.Status != metav1.ConditionFalse {
+		t.Errorf("Status = %v once dependencies are ready, want False", c.Status)
+	}
+	if c.Reason != kubeapplierapi.ConditionReasonDependenciesReady {
+		t.Errorf("Reason = %q, want %q", c.Reason, kubeapplierapi.ConditionReasonDependenciesReady)
+	}
+}
+
+func TestSetDrifted(t *testing.T) {
+	cases := []struct {
+		name        string
+		fields      []string
+		corrected   bool
+		wantStatus  metav1.ConditionStatus
+		wantReason  string
+		wantMessage string
+	}{
+		{
+			name:       "no drift",
+			wantStatus: metav1.ConditionFalse,
+			wantReason: kubeapplierapi.ConditionReasonNoDrift,
+		},
+		{
+			name:        "detected",
+			fields:      []string{".data.k", ".metadata.labels.app"},
+			wantStatus:  metav1.ConditionTrue,
+			wantReason:  kubeapplierapi.ConditionReasonDriftDetected,
+			wantMessage: "2 field(s) differ from the desired state: .data.k, .metadata.labels.app",
+		},
+		{
+			name:        "corrected, more fields than the message names",
+			fields:      []string{".a", ".b", ".c", ".d", ".e", ".f", ".g"},
+			corrected:   true,
+			wantStatus:  metav1.ConditionTrue,
+			wantReason:  kubeapplierapi.ConditionReasonDriftCorrected,
+			wantMessage: "7 field(s) were reset to the desired state: .a, .b, .c, .d, .e and 2 more",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var conds []metav1.Condition
+			SetDrifted(&conds, tc.fields, tc.corrected)
+			c := findCondition(conds, kubeapplierapi.ConditionTypeDrifted)
+			if c == nil {
+				t.Fatal("Drifted not set")
+			}
+			if c.Status != tc.wantStatus {
+				t.Errorf("Status = %v, want %v", c.Status, tc.wantStatus)
+			}
+			if c.Reason != tc.wantReason {
+				t.Errorf("Reason = %q, want %q", c.Reason, tc.wantReason)
+			}
+			if len(tc.wantMessage) > 0 && c.Message != tc.wantMessage {
+				t.Errorf("Message = %q, want %q", c.Message, tc.wantMessage)
+			}
+		})
+	}
+}
+
 func contains(s, substr string) bool {
 	for i := 0; i+len(substr) <= len(s); i++ {
 		if s[i:i+len(substr)] == substr {
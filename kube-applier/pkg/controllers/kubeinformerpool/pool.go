@@ -0,0 +1,289 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubeinformerpool shares kube informers between ReadDesires.
+//
+// A management cluster hosts thousands of HCPs, each with a handful of
+// ReadDesires. Giving every ReadDesire its own single-object informer means
+// one watch connection per desire against the management cluster's
+// kube-apiserver. The Pool instead runs one informer per
+// (GVR, namespace, selectors), fans its events out to every
+// subscribed ReadDesire, and ref-counts the informer so it stops when the
+// last subscriber goes away.
+package kubeinformerpool
+
+import (
+	"context"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+)
+
+// InformerKey identifies one shared informer. Namespace is empty for
+// cluster-scoped resources and for informers spanning all namespaces.
+// LabelSelector and FieldSelector are empty to watch every object of the GVR
+// in Namespace.
+type InformerKey struct {
+	GVR           schema.GroupVersionResource
+	Namespace     string
+	LabelSelector string
+	FieldSelector string
+}
+
+// AllObjects is the object key to Subscribe with to be notified about every
+// object the informer observes rather than a single named one.
+const AllObjects = ""
+
+// listWatchWithoutWatchListSemantics opts out of the WatchList streaming mode
+// enabled by default in client-go v0.35+. Mirrors the unexported wrapper in
+// client-go/tools/cache/listwatch.go. The dynamic client's Watch (whether
+// against an apiserver without WatchList support or against a fake) does not
+// emit the bookmark events WatchList requires, so the reflector would never
+// reach Synced.
+type listWatchWithoutWatchListSemantics struct {
+	*cache.ListWatch
+}
+
+func (listWatchWithoutWatchListSemantics) IsWatchListSemanticsUnSupported() bool { return true }
+
+// Pool hands out Subscriptions to shared informers. The zero value is not
+// usable; construct with New.
+type Pool struct {
+	dyn dynamic.Interface
+
+	// mu guards informers and every sharedInformer's refs.
+	mu        sync.Mutex
+	informers map[InformerKey]*sharedInformer
+
+	activeWatches *prometheus.GaugeVec
+	subscribers   *prometheus.GaugeVec
+}
+
+// New returns a Pool that builds informers against dyn. Metrics are
+// registered with registerer; pass nil to skip registration (tests).
+func New(dyn dynamic.Interface, registerer prometheus.Registerer) *Pool {
+	return &Pool{
+		dyn:       dyn,
+		informers: map[InformerKey]*sharedInformer{},
+		activeWatches: promauto.With(registerer).NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "kube_applier_read_desire_active_watches",
+				Help: "Number of shared informers (one watch connection each) the kube-applier runs for ReadDesires, by GVR.",
+			},
+			[]string{"group", "version", "resource"},
+		),
+		subscribers: promauto.With(registerer).NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "kube_applier_read_desire_watch_subscribers",
+				Help: "Number of ReadDesires subscribed to shared informers, by GVR.",
+			},
+			[]string{"group", "version", "resource"},
+		),
+	}
+}
+
+// sharedInformer is one running informer and the subscribers it fans out to.
+type sharedInformer struct {
+	informer cache.SharedIndexInformer
+	cancel   context.CancelFunc
+	refs     int
+
+	// handlersMu guards handlers. It is separate from Pool.mu so event
+	// delivery never contends with subscribe/release on other informers.
+	handlersMu sync.RWMutex
+	// handlers maps an object key (namespace/name, or name for
+	// cluster-scoped objects; AllObjects for every object) to the
+	// subscriptions interested in it.
+	handlers map[string]map[*Subscription]struct{}
+}
+
+// Subscription is one consumer's handle on a shared informer. Its methods
+// are safe for concurrent use.
+type Subscription struct {
+	pool      *Pool
+	key       InformerKey
+	objectKey string
+	notify    func()
+	shared    *sharedInformer
+
+	releaseOnce sync.Once
+}
+
+// Subscribe registers notify to be called whenever the object identified by
+// objectKey (namespace/name, or name for cluster-scoped objects) is added,
+// updated, or deleted, or for every object when objectKey is AllObjects.
+// notify must not block; enqueueing a workqueue key is the intended use.
+//
+// The first subscriber for key starts the informer. The informer outlives
+// ctx: it runs until the last subscriber calls Release. ctx only supplies
+// the logger and other values for the informer.
+func (p *Pool) Subscribe(ctx context.Context, key InformerKey, objectKey string, notify func()) *Subscription {
+	sub := &Subscription{pool: p, key: key, objectKey: objectKey, notify: notify}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	shared, ok := p.informers[key]
+	if !ok {
+		shared = p.startInformer(ctx, key)
+		p.informers[key] = shared
+		p.activeWatches.With(gvrLabels(key.GVR)).Inc()
+	}
+	shared.refs++
+	p.subscribers.With(gvrLabels(key.GVR)).Inc()
+	sub.shared = shared
+
+	shared.handlersMu.Lock()
+	if shared.handlers[objectKey] == nil {
+		shared.handlers[objectKey] = map[*Subscription]struct{}{}
+	}
+	shared.handlers[objectKey][sub] = struct{}{}
+	shared.handlersMu.Unlock()
+
+	return sub
+}
+
+// startInformer builds and runs the informer for key. Callers hold p.mu.
+func (p *Pool) startInformer(ctx context.Context, key InformerKey) *sharedInformer {
+	resource := p.dyn.Resource(key.GVR)
+	var kubeResourceAccessor dynamic.ResourceInterface = resource
+	if len(key.Namespace) > 0 {
+		kubeResourceAccessor = resource.Namespace(key.Namespace)
+	}
+	listWatch := &cache.ListWatch{
+		ListWithContextFunc: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			options.LabelSelector = key.LabelSelector
+			options.FieldSelector = key.FieldSelector
+			return kubeResourceAccessor.List(ctx, options)
+		},
+		WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+			options.LabelSelector = key.LabelSelector
+			options.FieldSelector = key.FieldSelector
+			return kubeResourceAccessor.Watch(ctx, options)
+		},
+	}
+
+	shared := &sharedInformer{
+		// No informer resync: subscribers run their own periodic tick, and a
+		// resync here would re-deliver every object in the namespace to every
+		// subscriber of the informer.
+		informer: cache.NewSharedIndexInformerWithOptions(
+			&listWatchWithoutWatchListSemantics{ListWatch: listWatch},
+			&unstructured.Unstructured{},
+			cache.SharedIndexInformerOptions{ObjectDescription: "ReadDesireKubernetes"},
+		),
+		handlers: map[string]map[*Subscription]struct{}{},
+	}
+	// A freshly built informer accepts handlers until it is started, so this
+	// cannot fail.
+	if _, err := shared.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    shared.dispatch,
+		UpdateFunc: func(_, newObj any) { shared.dispatch(newObj) },
+		DeleteFunc: shared.dispatch,
+	}); err != nil {
+		utilruntime.HandleErrorWithContext(ctx, err, "register shared informer handler", "gvr", key.GVR.String(), "namespace", key.Namespace)
+	}
+
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	shared.cancel = cancel
+	go func() {
+		defer utilruntime.HandleCrash()
+		shared.informer.RunWithContext(runCtx)
+	}()
+	return shared
+}
+
+// dispatch notifies the subscribers of the object obj refers to and every
+// AllObjects subscriber.
+func (s *sharedInformer) dispatch(obj any) {
+	objectKey, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	s.handlersMu.RLock()
+	notify := make([]func(), 0, len(s.handlers[objectKey])+len(s.handlers[AllObjects]))
+	for sub := range s.handlers[objectKey] {
+		notify = append(notify, sub.notify)
+	}
+	if objectKey != AllObjects {
+		for sub := range s.handlers[AllObjects] {
+			notify = append(notify, sub.notify)
+		}
+	}
+	s.handlersMu.RUnlock()
+
+	for _, fn := range notify {
+		fn()
+	}
+}
+
+// Release unsubscribes. The informer stops when its last subscriber
+// releases. Release is idempotent.
+func (s *Subscription) Release() {
+	s.releaseOnce.Do(func() {
+		p := s.pool
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		s.shared.handlersMu.Lock()
+		delete(s.shared.handlers[s.objectKey], s)
+		if len(s.shared.handlers[s.objectKey]) == 0 {
+			delete(s.shared.handlers, s.objectKey)
+		}
+		s.shared.handlersMu.Unlock()
+
+		p.subscribers.With(gvrLabels(s.key.GVR)).Dec()
+		s.shared.refs--
+		if s.shared.refs > 0 {
+			return
+		}
+		s.shared.cancel()
+		delete(p.informers, s.key)
+		p.activeWatches.With(gvrLabels(s.key.GVR)).Dec()
+	})
+}
+
+// HasSynced reports whether the shared informer finished its initial list.
+// Until then a missing object is indistinguishable from one not yet
+// observed.
+func (s *Subscription) HasSynced() bool {
+	return s.shared.informer.HasSynced()
+}
+
+// GetByKey returns the cached object for objectKey (namespace/name, or name
+// for cluster-scoped objects).
+func (s *Subscription) GetByKey(objectKey string) (any, bool, error) {
+	return s.shared.informer.GetStore().GetByKey(objectKey)
+}
+
+// List returns every cached object of the shared informer.
+func (s *Subscription) List() []any {
+	return s.shared.informer.GetStore().List()
+}
+
+func gvrLabels(gvr schema.GroupVersionResource) prometheus.Labels {
+	return prometheus.Labels{"group": gvr.Group, "version": gvr.Version, "resource": gvr.Resource}
+}
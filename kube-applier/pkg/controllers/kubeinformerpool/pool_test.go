@@ -0,0 +1,168 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubeinformerpool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+var configMapsGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+func newFakeDynamic() *fake.FakeDynamicClient {
+	return fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{configMapsGVR: "ConfigMapList"})
+}
+
+func configMap(namespace, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("ConfigMap")
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	return obj
+}
+
+func TestPool_SharesAndReleasesInformers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := New(newFakeDynamic(), nil)
+	inDefault := InformerKey{GVR: configMapsGVR, Namespace: "default"}
+	inOther := InformerKey{GVR: configMapsGVR, Namespace: "other"}
+
+	a := p.Subscribe(ctx, inDefault, "default/a", func() {})
+	b := p.Subscribe(ctx, inDefault, "default/b", func() {})
+	c := p.Subscribe(ctx, inOther, "other/c", func() {})
+
+	assertCounts := func(step string, wantWatches, wantSubscribers float64) {
+		t.Helper()
+		labels := gvrLabels(configMapsGVR)
+		if got := testutil.ToFloat64(p.activeWatches.With(labels)); got != wantWatches {
+			t.Errorf("%s: active watches = %v, want %v", step, got, wantWatches)
+		}
+		if got := testutil.ToFloat64(p.subscribers.With(labels)); got != wantSubscribers {
+			t.Errorf("%s: subscribers = %v, want %v", step, got, wantSubscribers)
+		}
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if got := float64(len(p.informers)); got != wantWatches {
+			t.Errorf("%s: running informers = %v, want %v", step, got, wantWatches)
+		}
+	}
+
+	assertCounts("subscribed", 2, 3)
+	if a.shared != b.shared {
+		t.Error("subscriptions to the same namespace do not share an informer")
+	}
+
+	a.Release()
+	assertCounts("released a", 2, 2)
+	a.Release()
+	assertCounts("released a twice", 2, 2)
+	b.Release()
+	assertCounts("released b", 1, 1)
+	c.Release()
+	assertCounts("released c", 0, 0)
+
+	// A new subscriber after the last release starts a fresh informer.
+	d := p.Subscribe(ctx, inDefault, "default/a", func() {})
+	defer d.Release()
+	if d.shared == a.shared {
+		t.Error("subscription after release reused a stopped informer")
+	}
+	assertCounts("resubscribed", 1, 1)
+}
+
+func TestPool_FansOutEventsToMatchingSubscribers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dyn := newFakeDynamic()
+	p := New(dyn, nil)
+	key := InformerKey{GVR: configMapsGVR, Namespace: "default"}
+
+	notified := map[string]chan struct{}{
+		"a":   make(chan struct{}, 10),
+		"b":   make(chan struct{}, 10),
+		"all": make(chan struct{}, 10),
+	}
+	subs := []*Subscription{
+		p.Subscribe(ctx, key, "default/a", func() { notified["a"] <- struct{}{} }),
+		p.Subscribe(ctx, key, "default/b", func() { notified["b"] <- struct{}{} }),
+		p.Subscribe(ctx, key, AllObjects, func() { notified["all"] <- struct{}{} }),
+	}
+	for _, sub := range subs {
+		defer sub.Release()
+	}
+
+	syncCtx, syncCancel := context.WithTimeout(ctx, 5*time.Second)
+	defer syncCancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), subs[0].HasSynced) {
+		t.Fatal("informer did not sync within 5s")
+	}
+	// The fake tracker does not replay events to a watch opened after they
+	// happened, so wait for the reflector's watch before creating objects.
+	for !hasWatch(dyn) {
+		select {
+		case <-syncCtx.Done():
+			t.Fatal("informer did not start watching within 5s")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	if _, err := dyn.Resource(configMapsGVR).Namespace("default").Create(ctx, configMap("default", "a"), metav1.CreateOptions{}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	for _, name := range []string{"a", "all"} {
+		select {
+		case <-notified[name]:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("subscriber %q was not notified", name)
+		}
+	}
+	select {
+	case <-notified["b"]:
+		t.Error("subscriber of default/b was notified about default/a")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if _, exists, err := subs[1].GetByKey("default/a"); err != nil || !exists {
+		t.Errorf("GetByKey(default/a) = exists %v, err %v; want the shared cache to hold it", exists, err)
+	}
+	if got := len(subs[2].List()); got != 1 {
+		t.Errorf("List() returned %d objects, want 1", got)
+	}
+}
+
+func hasWatch(dyn *fake.FakeDynamicClient) bool {
+	for _, action := range dyn.Actions() {
+		if action.GetVerb() == "watch" {
+			return true
+		}
+	}
+	return false
+}
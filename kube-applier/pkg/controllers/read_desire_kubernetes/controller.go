@@ -14,9 +14,11 @@
 
 // Package read_desire_kubernetes implements the per-ReadDesire kubernetes
 // reflector. One instance is created for each ReadDesire by the manager
-// (see ../read_desire_manager). It list/watches a single named object via
-// the dynamic client and mirrors its observed state into the ReadDesire's
-// .status.kubeContent.
+// (see ../read_desire_manager). It subscribes to the shared informer for its
+// target's GVR and namespace (see ../kubeinformerpool) and mirrors the
+// observed state of the named object — or, for a ReadDesire with
+// .spec.targetCollection, a trimmed list of the matching objects — into the
+// ReadDesire's .status.kubeContent.
 package read_desire_kubernetes
 
 import (
@@ -25,16 +27,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/apimachinery/pkg/watch"
-	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
@@ -45,6 +47,7 @@ import (
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/conditions"
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/desirestatuswriter"
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/keys"
+	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/kubeinformerpool"
 )
 
 // ResyncDuration is how often a ReadDesireKubernetesController re-evaluates
@@ -58,37 +61,42 @@ const ResyncDuration = 60 * time.Second
 // supplied by the key via utils.AddLoggerValues.
 const ReadDesireKubernetesControllerName = "ReadDesireKubernetesController"
 
-// listWatchWithoutWatchListSemantics opts out of the WatchList streaming mode
-// enabled by default in client-go v0.35+. Mirrors the unexported wrapper in
-// client-go/tools/cache/listwatch.go. The dynamic client's Watch (whether
-// against an apiserver without WatchList support or against a fake) does not
-// emit the bookmark events WatchList requires, so the reflector would never
-// reach Synced.
-type listWatchWithoutWatchListSemantics struct {
-	*cache.ListWatch
-}
-
-func (listWatchWithoutWatchListSemantics) IsWatchListSemanticsUnSupported() bool { return true }
-
-// ReadDesireKubernetesController reflects a single named kube object into a
-// ReadDesire's status. One instance per ReadDesire is owned by the manager.
+// ReadDesireKubernetesController reflects a single named kube object, or a
+// collection of them, into a ReadDesire's status. One instance per
+// ReadDesire is owned by the manager.
 type ReadDesireKubernetesController struct {
-	key        keys.ReadDesireKey
-	target     kubeapplierapi.ResourceReference
-	gvr        schema.GroupVersionResource
-	namespaced bool
-
-	dyn      dynamic.Interface
-	informer cache.SharedIndexInformer
-	fetcher  *readDesireFetcher
-	writer   desirestatuswriter.StatusWriter[kubeapplierapi.ReadDesire, keys.ReadDesireKey]
+	key    keys.ReadDesireKey
+	target kubeapplierapi.ResourceReference
+	// collection is non-nil for a ReadDesire with .spec.targetCollection;
+	// target is then unused.
+	collection *kubeapplierapi.CollectionReference
+	// maxContentBytes bounds the serialized items of a collection.
+	maxContentBytes int
+	gvr             schema.GroupVersionResource
+	// secrets is true when the target GVR is core/v1 Secrets, whose content
+	// is redacted before it is published.
+	secrets bool
+
+	// informerKey and objectKey identify the shared informer and the store
+	// key of the target within it (kubeinformerpool.AllObjects for a
+	// collection).
+	informerKey kubeinformerpool.InformerKey
+	objectKey   string
+
+	informers *kubeinformerpool.Pool
+	// subscription is set by subscribe when Run starts and released when
+	// Run returns. SyncOnce skips while it is nil.
+	subscription *kubeinformerpool.Subscription
+	fetcher      *readDesireFetcher
+	writer       desirestatuswriter.StatusWriter[kubeapplierapi.ReadDesire, keys.ReadDesireKey]
 
 	queue workqueue.TypedRateLimitingInterface[keys.ReadDesireKey]
 }
 
 // NewReadDesireKubernetesController constructs a per-ReadDesire kubernetes
-// reflector. It builds a single-object ListWatch so the per-instance informer
-// touches only the named object — never the whole resource type.
+// reflector. It does not watch anything by itself: Run subscribes to the
+// informers pool's shared informer for the target's GVR and namespace, so
+// every ReadDesire for the same GVR and namespace shares one watch.
 //
 // We deliberately do not consult the RESTMapper here: the GVR is taken
 // straight from the ReadDesire's targetItem, and the dynamic client is
@@ -102,23 +110,80 @@ type ReadDesireKubernetesController struct {
 func NewReadDesireKubernetesController(
 	key keys.ReadDesireKey,
 	target kubeapplierapi.ResourceReference,
-	dyn dynamic.Interface,
+	informers *kubeinformerpool.Pool,
 	crudByParent kubeappliercosmosstorage.KubeApplierReadDesireCRUD,
 ) (*ReadDesireKubernetesController, error) {
 	if len(target.Resource) == 0 || len(target.Version) == 0 || len(target.Name) == 0 {
 		return nil, conditions.NewPreCheckError(errors.New("spec.targetItem requires version, resource, and name"))
 	}
 
+	c := newController(key, informers, crudByParent)
+	c.target = target
+	c.gvr = schema.GroupVersionResource{Group: target.Group, Version: target.Version, Resource: target.Resource}
+	c.secrets = isSecret(target)
+	c.informerKey = kubeinformerpool.InformerKey{GVR: c.gvr, Namespace: target.Namespace}
+	c.objectKey = target.Name
+	if len(target.Namespace) > 0 {
+		c.objectKey = target.Namespace + "/" + target.Name
+	}
+	return c, nil
+}
+
+// NewReadDesireKubernetesCollectionController constructs the reflector for a
+// ReadDesire with .spec.targetCollection. It subscribes to the shared
+// informer for the collection's GVR, namespace, and selectors, and publishes
+// every object that informer holds, up to collection.MaxItems.
+//
+// Selectors are only checked for syntax here; a field the resource does not
+// support for selection fails the informer's list, which the kube-apiserver
+// reports and the reflector retries.
+func NewReadDesireKubernetesCollectionController(
+	key keys.ReadDesireKey,
+	collection kubeapplierapi.CollectionReference,
+	informers *kubeinformerpool.Pool,
+	crudByParent kubeappliercosmosstorage.KubeApplierReadDesireCRUD,
+) (*ReadDesireKubernetesController, error) {
+	if len(collection.Resource) == 0 || len(collection.Version) == 0 {
+		return nil, conditions.NewPreCheckError(errors.New("spec.targetCollection requires version and resource"))
+	}
+	if _, err := labels.Parse(collection.LabelSelector); err != nil {
+		return nil, conditions.NewPreCheckError(fmt.Errorf("spec.targetCollection.labelSelector: %w", err))
+	}
+	if _, err := fields.ParseSelector(collection.FieldSelector); err != nil {
+		return nil, conditions.NewPreCheckError(fmt.Errorf("spec.targetCollection.fieldSelector: %w", err))
+	}
+	if collection.MaxItems < 0 || collection.MaxItems > kubeapplierapi.MaxCollectionMaxItems {
+		return nil, conditions.NewPreCheckError(fmt.Errorf("spec.targetCollection.maxItems must be between 0 and %d, got %d",
+			kubeapplierapi.MaxCollectionMaxItems, collection.MaxItems))
+	}
+
+	c := newController(key, informers, crudByParent)
+	c.collection = &collection
+	c.maxContentBytes = kubeapplierapi.MaxCollectionContentBytes
+	c.gvr = schema.GroupVersionResource{Group: collection.Group, Version: collection.Version, Resource: collection.Resource}
+	c.secrets = isSecret(kubeapplierapi.ResourceReference{Group: collection.Group, Version: collection.Version, Resource: collection.Resource})
+	c.informerKey = kubeinformerpool.InformerKey{
+		GVR:           c.gvr,
+		Namespace:     collection.Namespace,
+		LabelSelector: collection.LabelSelector,
+		FieldSelector: collection.FieldSelector,
+	}
+	c.objectKey = kubeinformerpool.AllObjects
+	return c, nil
+}
+
+// newController holds the construction shared by the single-object and
+// collection reflectors.
+func newController(
+	key keys.ReadDesireKey,
+	informers *kubeinformerpool.Pool,
+	crudByParent kubeappliercosmosstorage.KubeApplierReadDesireCRUD,
+) *ReadDesireKubernetesController {
 	fetcher := &readDesireFetcher{crudByParent: crudByParent}
-	c := &ReadDesireKubernetesController{
-		key:    key,
-		target: target,
-		gvr: schema.GroupVersionResource{
-			Group: target.Group, Version: target.Version, Resource: target.Resource,
-		},
-		namespaced: len(target.Namespace) > 0,
-		dyn:        dyn,
-		fetcher:    fetcher,
+	return &ReadDesireKubernetesController{
+		key:       key,
+		informers: informers,
+		fetcher:   fetcher,
 		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
 			workqueue.DefaultTypedControllerRateLimiter[keys.ReadDesireKey](),
 			workqueue.TypedRateLimitingQueueConfig[keys.ReadDesireKey]{
@@ -132,32 +197,11 @@ func NewReadDesireKubernetesController(
 			&readDesireReplacer{crudByParent: crudByParent},
 		),
 	}
-
-	c.informer = cache.NewSharedIndexInformerWithOptions(
-		// listWatchWithoutWatchListSemantics opts out of the v0.35+ default that
-		// uses streaming WatchList. The dynamic client's Watch on top of the
-		// fake doesn't speak the bookmark protocol that mode requires, so the
-		// reflector would never reach Synced. Production hits the same issue
-		// with non-watchable backends, hence this wrapper for both paths.
-		&listWatchWithoutWatchListSemantics{ListWatch: c.singleObjectListWatch()},
-		&unstructured.Unstructured{},
-		cache.SharedIndexInformerOptions{ResyncPeriod: ResyncDuration, ObjectDescription: "ReadDesireKubernetes"},
-	)
-
-	// Register the event handler at construction so the SharedIndexInformer
-	// has it before its reflector pumps the first List response. Adding it
-	// in Run() races with the initial sync.
-	if _, err := c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    func(obj any) { c.queue.Add(c.key) },
-		UpdateFunc: func(_, _ any) { c.queue.Add(c.key) },
-		DeleteFunc: func(obj any) { c.queue.Add(c.key) },
-	}); err != nil {
-		return nil, fmt.Errorf("register informer handler: %w", err)
-	}
-	return c, nil
 }
 
-// Run starts the per-instance informer and worker. It blocks until ctx is cancelled.
+// Run subscribes to the shared informer and starts the worker. It blocks
+// until ctx is cancelled, then releases the subscription so the shared
+// informer stops once no other ReadDesire needs it.
 func (c *ReadDesireKubernetesController) Run(ctx context.Context) {
 	defer utilruntime.HandleCrash()
 	defer c.queue.ShutDown()
@@ -173,21 +217,23 @@ func (c *ReadDesireKubernetesController) Run(ctx context.Context) {
 	logger.Info("starting controller")
 	defer logger.Info("stopped controller")
 
-	go c.informer.RunWithContext(ctx)
+	subscription := c.subscribe(ctx)
+	defer subscription.Release()
 
-	// Wait for the per-instance informer to sync before letting the worker
-	// pull from the queue. Standard client-go pattern: a worker that runs
-	// against an unsynced cache will see the target as absent and incorrectly
+	// Wait for the shared informer to sync before letting the worker pull
+	// from the queue. Standard client-go pattern: a worker that runs against
+	// an unsynced cache will see the target as absent and incorrectly
 	// publish an empty Status.KubeContent until events finally arrive.
-	if !cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
-		logger.Info("per-instance informer cache failed to sync; exiting controller",
+	if !cache.WaitForCacheSync(ctx.Done(), subscription.HasSynced) {
+		logger.Info("shared informer cache failed to sync; exiting controller",
 			"gvr", c.gvr.String(),
-			"namespace", c.target.Namespace,
-			"name", c.target.Name)
+			"namespace", c.informerKey.Namespace,
+			"object", c.objectKey)
 		return
 	}
 
-	// Periodic tick so a missing target gets reported even when no event fires.
+	// Periodic tick so a missing target gets reported even when no event
+	// fires. The shared informer deliberately has no resync of its own.
 	ticker := time.NewTicker(ResyncDuration)
 	defer ticker.Stop()
 	go func() {
@@ -203,9 +249,9 @@ func (c *ReadDesireKubernetesController) Run(ctx context.Context) {
 	}()
 
 	// Seed the queue so the first sync runs without waiting for the first
-	// event or tick. Safe to do here: the informer's AddEventHandler was
-	// registered in the constructor and has already replayed cache contents
-	// once HasSynced returned true above.
+	// event or tick. This matters when the shared informer was already
+	// running for another ReadDesire: it has synced long ago and will not
+	// replay its cache for a new subscriber.
 	c.queue.Add(c.key)
 
 	go wait.UntilWithContext(ctx, c.runWorker, time.Second)
@@ -242,7 +288,15 @@ func (c *ReadDesireKubernetesController) processNext(ctx context.Context) bool {
 	return true
 }
 
-// SyncOnce reads the live object from the per-instance informer cache and
+// subscribe registers with the shared informer for the target's GVR,
+// namespace, and selectors, asking to be notified about the target object
+// only, or about every object for a collection.
+func (c *ReadDesireKubernetesController) subscribe(ctx context.Context) *kubeinformerpool.Subscription {
+	c.subscription = c.informers.Subscribe(ctx, c.informerKey, c.objectKey, func() { c.queue.Add(c.key) })
+	return c.subscription
+}
+
+// SyncOnce reads the live object from the shared informer cache and
 // updates the ReadDesire's status if its KubeContent differs.
 //
 // The HasSynced check below is the same defensive guard kube core controllers
@@ -255,11 +309,11 @@ func (c *ReadDesireKubernetesController) processNext(ctx context.Context) bool {
 func (c *ReadDesireKubernetesController) SyncOnce(ctx context.Context) error {
 	// we wait until we've synced at least once so that we can be sure a "not found" means the content doesn't exist
 	// instead of "we haven't observed the content yet".
-	if !c.informer.HasSynced() {
-		utils.LoggerFromContext(ctx).Info("per-instance informer not yet synced; skipping",
+	if c.subscription == nil || !c.subscription.HasSynced() {
+		utils.LoggerFromContext(ctx).Info("shared informer not yet synced; skipping",
 			"gvr", c.gvr.String(),
-			"namespace", c.target.Namespace,
-			"name", c.target.Name)
+			"namespace", c.informerKey.Namespace,
+			"object", c.objectKey)
 		return nil
 	}
 
@@ -274,53 +328,24 @@ func (c *ReadDesireKubernetesController) SyncOnce(ctx context.Context) error {
 		return nil
 	}
 
-	// Pull the live object from the per-instance informer cache. The store key
-	// for an Unstructured is namespace/name (or just name for cluster-scoped).
-	//
 	// We read from the informer cache rather than re-Getting against
 	// kube-apiserver so a noisy ReadDesire doesn't load the apiserver. The
-	// cache is fed by an informer scoped to a single (gvr, namespace, name),
-	// so it tracks at most one object. Don't change the resource pointed at
-	// by spec.targetItem out from under a running ReadDesire — but if you do,
-	// the next published .status.kubeContent will reflect what was observed
-	// for the new target.
-	storeKey := c.target.Name
-	if c.namespaced {
-		storeKey = c.target.Namespace + "/" + c.target.Name
-	}
-	rawObj, exists, err := c.informer.GetStore().GetByKey(storeKey)
+	// cache is shared by every ReadDesire targeting the same (gvr,
+	// namespace, selectors). Don't change the resource pointed at by
+	// spec.targetItem out from under a running ReadDesire — the manager
+	// restarts this controller when it does, and the next published
+	// .status.kubeContent will reflect what was observed for the new target.
+	observe := c.observeItem
+	if c.collection != nil {
+		observe = c.observeCollection
+	}
+	newRaw, err := observe()
 	if err != nil {
 		return c.writer.UpdateStatus(ctx, c.key, func(d *kubeapplierapi.ReadDesire) {
-			conditions.SetSuccessful(&d.Status.Conditions, fmt.Errorf("read cache: %w", err))
+			conditions.SetSuccessful(&d.Status.Conditions, err)
 		})
 	}
 
-	// newRaw left nil when exists==false. A nil slice is the signal for
-	// "kube object does not exist": the .status.kubeContent publish branch
-	// below clears the pointer to nil, which the API contract treats as
-	// "absent or not yet observed" (disambiguated by the Successful
-	// condition).
-	var newRaw []byte
-	if exists {
-		obj, ok := rawObj.(*unstructured.Unstructured)
-		if !ok {
-			return c.writer.UpdateStatus(ctx, c.key, func(d *kubeapplierapi.ReadDesire) {
-				conditions.SetSuccessful(&d.Status.Conditions, conditions.NewPreCheckError(
-					fmt.Errorf("informer cached unexpected type %T", rawObj)))
-			})
-		}
-		if isSecret(c.target) {
-			obj = obj.DeepCopy()
-			redactSecret(obj)
-		}
-		newRaw, err = json.Marshal(obj)
-		if err != nil {
-			return c.writer.UpdateStatus(ctx, c.key, func(d *kubeapplierapi.ReadDesire) {
-				conditions.SetSuccessful(&d.Status.Conditions, fmt.Errorf("marshal observed object: %w", err))
-			})
-		}
-	}
-
 	// No-op if the new payload is byte-equal to the existing status. A nil
 	// pointer collapses to a nil Raw for the comparison so "absent stays
 	// absent" doesn't trip the publish branch.
@@ -346,26 +371,98 @@ func (c *ReadDesireKubernetesController) SyncOnce(ctx context.Context) error {
 	})
 }
 
-// singleObjectListWatch builds a ListWatch scoped to the single named object
-// using metav1.SingleObject. The reflector treats this as a 1-element collection
-// and only reflects that object.
-func (c *ReadDesireKubernetesController) singleObjectListWatch() *cache.ListWatch {
-	resource := c.dyn.Resource(c.gvr)
-	var kubeResourceAccessor dynamic.ResourceInterface = resource
-	if c.namespaced {
-		kubeResourceAccessor = resource.Namespace(c.target.Namespace)
-	}
-	fieldSelector := metav1.SingleObject(metav1.ObjectMeta{Name: c.target.Name}).FieldSelector
-	return &cache.ListWatch{
-		ListWithContextFunc: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
-			options.FieldSelector = fieldSelector
-			return kubeResourceAccessor.List(ctx, options)
-		},
-		WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
-			options.FieldSelector = fieldSelector
-			return kubeResourceAccessor.Watch(ctx, options)
-		},
+// observeItem returns the JSON of the target object in the shared informer
+// cache, redacted if it is a Secret. The store key for an Unstructured is
+// namespace/name (or just name for cluster-scoped).
+//
+// The result is nil when the object does not exist. A nil slice is the
+// signal for "kube object does not exist": SyncOnce's publish branch clears
+// the pointer to nil, which the API contract treats as "absent or not yet
+// observed" (disambiguated by the Successful condition).
+func (c *ReadDesireKubernetesController) observeItem() ([]byte, error) {
+	rawObj, exists, err := c.subscription.GetByKey(c.objectKey)
+	if err != nil {
+		return nil, fmt.Errorf("read cache: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	obj, ok := rawObj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, conditions.NewPreCheckError(fmt.Errorf("informer cached unexpected type %T", rawObj))
+	}
+	if c.secrets {
+		obj = obj.DeepCopy()
+		redactSecret(obj)
+	}
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("marshal observed object: %w", err)
+	}
+	return raw, nil
+}
+
+// observeCollection returns the JSON of a v1 List holding every object in
+// the shared informer cache, sorted by namespace and name so an unchanged
+// collection serializes byte-for-byte the same. Items are trimmed of
+// metadata.managedFields, which is large and of no use to consumers, and
+// redacted if they are Secrets. Only the first MaxItems items that fit in
+// maxContentBytes are kept; metadata.remainingItemCount reports how many
+// were dropped.
+func (c *ReadDesireKubernetesController) observeCollection() ([]byte, error) {
+	cached := c.subscription.List()
+	items := make([]*unstructured.Unstructured, 0, len(cached))
+	for _, rawObj := range cached {
+		obj, ok := rawObj.(*unstructured.Unstructured)
+		if !ok {
+			return nil, conditions.NewPreCheckError(fmt.Errorf("informer cached unexpected type %T", rawObj))
+		}
+		items = append(items, obj)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].GetNamespace() != items[j].GetNamespace() {
+			return items[i].GetNamespace() < items[j].GetNamespace()
+		}
+		return items[i].GetName() < items[j].GetName()
+	})
+
+	maxItems := int(c.collection.MaxItems)
+	if maxItems == 0 {
+		maxItems = kubeapplierapi.DefaultCollectionMaxItems
+	}
+	list := &unstructured.UnstructuredList{Object: map[string]any{"apiVersion": "v1", "kind": "List"}}
+	list.Items = make([]unstructured.Unstructured, 0, min(len(items), maxItems))
+	contentBytes := 0
+	for _, obj := range items {
+		if len(list.Items) == maxItems {
+			break
+		}
+		obj = obj.DeepCopy()
+		unstructured.RemoveNestedField(obj.Object, "metadata", "managedFields")
+		if c.secrets {
+			redactSecret(obj)
+		}
+		// Stop at the first item that does not fit so the published list
+		// stays a prefix of the sorted collection.
+		itemRaw, err := obj.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("marshal observed item: %w", err)
+		}
+		if contentBytes+len(itemRaw) > c.maxContentBytes {
+			break
+		}
+		contentBytes += len(itemRaw)
+		list.Items = append(list.Items, *obj)
+	}
+	if remaining := int64(len(items) - len(list.Items)); remaining > 0 {
+		list.SetRemainingItemCount(&remaining)
+	}
+
+	raw, err := list.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("marshal observed collection: %w", err)
 	}
+	return raw, nil
 }
 
 // readDesireFetcher implements desirestatuswriter.Fetcher by going to a
@@ -33,10 +33,12 @@ import (
 	"github.com/Azure/ARO-HCP/internal/api/coreapi"
 	"github.com/Azure/ARO-HCP/internal/api/kubeapplierapi"
 	"github.com/Azure/ARO-HCP/internal/api/metadataapi"
+	"github.com/Azure/ARO-HCP/internal/database/cosmosstorage/kubeappliercosmosstorage"
 	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/kubeappliercosmosstoragetesting"
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/conditions"
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/desirestatuswriter"
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/keys"
+	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/kubeinformerpool"
 )
 
 const (
@@ -116,8 +118,8 @@ func dynamicForTestdata(t *testing.T, dir string) dynamic.Interface {
 	return dyn
 }
 
-// startSyncedController builds the controller via the real constructor, starts
-// its informer, and waits for the cache to sync. The test owns the cancel
+// startSyncedController builds the controller via the real constructor,
+// subscribes it to a fresh informer pool, and waits for the cache to sync. The test owns the cancel
 // function and runs SyncOnce against a deterministic cache state.
 func startSyncedController(
 	t *testing.T,
@@ -127,6 +129,33 @@ func startSyncedController(
 	dyn dynamic.Interface,
 ) (*ReadDesireKubernetesController, *recordingWriter) {
 	t.Helper()
+	return startSyncedControllerInPool(t, ctx, target, desire, kubeinformerpool.New(dyn, nil))
+}
+
+// startSyncedControllerInPool is startSyncedController for a caller-supplied
+// pool, so several controllers can share its informers.
+func startSyncedControllerInPool(
+	t *testing.T,
+	ctx context.Context,
+	target kubeapplierapi.ResourceReference,
+	desire *kubeapplierapi.ReadDesire,
+	pool *kubeinformerpool.Pool,
+) (*ReadDesireKubernetesController, *recordingWriter) {
+	t.Helper()
+	return startSyncedControllerWith(t, ctx, desire, func(key keys.ReadDesireKey, crud kubeappliercosmosstorage.KubeApplierReadDesireCRUD) (*ReadDesireKubernetesController, error) {
+		return NewReadDesireKubernetesController(key, target, pool, crud)
+	})
+}
+
+// startSyncedControllerWith seeds desire into a mock Cosmos client, builds
+// the controller with build, and subscribes it as startSyncedController does.
+func startSyncedControllerWith(
+	t *testing.T,
+	ctx context.Context,
+	desire *kubeapplierapi.ReadDesire,
+	build func(keys.ReadDesireKey, kubeappliercosmosstorage.KubeApplierReadDesireCRUD) (*ReadDesireKubernetesController, error),
+) (*ReadDesireKubernetesController, *recordingWriter) {
+	t.Helper()
 
 	key, err := keys.ReadDesireKeyFromResourceID(desire.GetResourceID())
 	if err != nil {
@@ -144,21 +173,22 @@ func startSyncedController(
 		t.Fatalf("seed Create: %v", err)
 	}
 
-	c, err := NewReadDesireKubernetesController(key, target, dyn, mock)
+	c, err := build(key, mock)
 	if err != nil {
-		t.Fatalf("NewReadDesireKubernetesController: %v", err)
+		t.Fatalf("build controller: %v", err)
 	}
 	// Replace the writer with a recorder so tests can assert on status updates
 	// without exercising the full desirestatuswriter -> CRUD chain.
 	w := &recordingWriter{desire: desire}
 	c.writer = w
 
-	// Run the per-instance informer just long enough for it to sync against the
-	// manifestclient-backed list. The test's parent ctx will cancel everything.
-	go c.informer.RunWithContext(ctx)
+	// Subscribe to the shared informer and wait for it to sync against the
+	// manifestclient-backed list. Releasing the subscription stops it.
+	subscription := c.subscribe(ctx)
+	t.Cleanup(subscription.Release)
 	syncCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
-	if !cache.WaitForCacheSync(syncCtx.Done(), c.informer.HasSynced) {
+	if !cache.WaitForCacheSync(syncCtx.Done(), subscription.HasSynced) {
 		t.Fatal("informer did not sync within 5s")
 	}
 	return c, w
@@ -196,6 +226,208 @@ func TestSyncOnce_TargetExists_PopulatesKubeContent(t *testing.T) {
 	}
 }
 
+// TestSyncOnce_SharedInformer_ReflectsOwnTarget runs two controllers whose
+// targets live in the same namespace. They share one informer, and each
+// must still publish only its own target.
+func TestSyncOnce_SharedInformer_ReflectsOwnTarget(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pool := kubeinformerpool.New(dynamicForTestdata(t, "testdata/configmap_present"), nil)
+	for _, name := range []string{"hello", "other"} {
+		target := configMapTarget(name)
+		c, w := startSyncedControllerInPool(t, ctx, target, newReadDesire(t, target), pool)
+		if err := c.SyncOnce(ctx); err != nil {
+			t.Fatalf("SyncOnce %s: %v", name, err)
+		}
+		if len(w.updates) == 0 {
+			t.Fatalf("%s: no status update recorded", name)
+		}
+		last := w.updates[len(w.updates)-1]
+		if last.Status.KubeContent == nil {
+			t.Fatalf("%s: KubeContent is empty after sync", name)
+		}
+		var got struct {
+			Metadata metav1.ObjectMeta `json:"metadata"`
+		}
+		if err := json.Unmarshal(last.Status.KubeContent.Raw, &got); err != nil {
+			t.Fatalf("unmarshal kubeContent: %v", err)
+		}
+		if got.Metadata.Name != name {
+			t.Errorf("published %q, want %q", got.Metadata.Name, name)
+		}
+	}
+}
+
+// TestSyncOnce_Collection publishes a trimmed, sorted, capped list of every
+// object the shared informer holds, redacting Secrets item by item.
+func TestSyncOnce_Collection(t *testing.T) {
+	tests := []struct {
+		name       string
+		testdata   string
+		collection kubeapplierapi.CollectionReference
+		// maxContentBytes overrides the byte budget when set.
+		maxContentBytes int
+		wantNames       []string
+		wantRemaining   int64
+		wantRedacted    bool
+	}{
+		{
+			name:       "all configmaps in the namespace",
+			testdata:   "testdata/configmap_present",
+			collection: kubeapplierapi.CollectionReference{Version: "v1", Resource: "configmaps", Namespace: testTargetNs},
+			wantNames:  []string{"hello", "other"},
+		},
+		{
+			name:          "capped at maxItems",
+			testdata:      "testdata/configmap_present",
+			collection:    kubeapplierapi.CollectionReference{Version: "v1", Resource: "configmaps", Namespace: testTargetNs, MaxItems: 1},
+			wantNames:     []string{"hello"},
+			wantRemaining: 1,
+		},
+		{
+			name:            "capped at the byte budget",
+			testdata:        "testdata/configmap_present",
+			collection:      kubeapplierapi.CollectionReference{Version: "v1", Resource: "configmaps", Namespace: testTargetNs},
+			maxContentBytes: 1,
+			wantNames:       []string{},
+			wantRemaining:   2,
+		},
+		{
+			name:       "empty collection is an empty list",
+			testdata:   "testdata/configmap_absent",
+			collection: kubeapplierapi.CollectionReference{Version: "v1", Resource: "configmaps", Namespace: testTargetNs},
+			wantNames:  []string{},
+		},
+		{
+			name:         "secrets are redacted",
+			testdata:     "testdata/secret_present",
+			collection:   kubeapplierapi.CollectionReference{Version: "v1", Resource: "secrets", Namespace: testTargetNs},
+			wantNames:    []string{"my-tls-secret"},
+			wantRedacted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			desire := newReadDesire(t, kubeapplierapi.ResourceReference{})
+			desire.Spec.TargetCollection = &tt.collection
+			pool := kubeinformerpool.New(dynamicForTestdata(t, tt.testdata), nil)
+			c, w := startSyncedControllerWith(t, ctx, desire, func(key keys.ReadDesireKey, crud kubeappliercosmosstorage.KubeApplierReadDesireCRUD) (*ReadDesireKubernetesController, error) {
+				c, err := NewReadDesireKubernetesCollectionController(key, tt.collection, pool, crud)
+				if err == nil && tt.maxContentBytes > 0 {
+					c.maxContentBytes = tt.maxContentBytes
+				}
+				return c, err
+			})
+			if err := c.SyncOnce(ctx); err != nil {
+				t.Fatalf("SyncOnce: %v", err)
+			}
+			if len(w.updates) == 0 {
+				t.Fatal("no status update recorded")
+			}
+			last := w.updates[len(w.updates)-1]
+			if last.Status.KubeContent == nil {
+				t.Fatal("KubeContent is nil for a collection")
+			}
+
+			var got struct {
+				Kind     string `json:"kind"`
+				Metadata struct {
+					RemainingItemCount *int64 `json:"remainingItemCount"`
+				} `json:"metadata"`
+				Items []map[string]any `json:"items"`
+			}
+			if err := json.Unmarshal(last.Status.KubeContent.Raw, &got); err != nil {
+				t.Fatalf("unmarshal kubeContent: %v", err)
+			}
+			if got.Kind != "List" {
+				t.Errorf("kind = %q, want List", got.Kind)
+			}
+			var gotRemaining int64
+			if got.Metadata.RemainingItemCount != nil {
+				gotRemaining = *got.Metadata.RemainingItemCount
+			}
+			if gotRemaining != tt.wantRemaining {
+				t.Errorf("remainingItemCount = %d, want %d", gotRemaining, tt.wantRemaining)
+			}
+			gotNames := []string{}
+			for _, item := range got.Items {
+				metadata, _ := item["metadata"].(map[string]any)
+				name, _ := metadata["name"].(string)
+				gotNames = append(gotNames, name)
+				if _, ok := metadata["managedFields"]; ok {
+					t.Errorf("item %s still carries managedFields", name)
+				}
+				if tt.wantRedacted {
+					data, _ := item["data"].(map[string]any)
+					if _, ok := data["tls.key"]; ok {
+						t.Errorf("item %s leaks tls.key", name)
+					}
+					if _, ok := data["tls.crt"]; !ok {
+						t.Errorf("item %s lost tls.crt", name)
+					}
+				}
+			}
+			if strings.Join(gotNames, ",") != strings.Join(tt.wantNames, ",") {
+				t.Errorf("items = %v, want %v", gotNames, tt.wantNames)
+			}
+			cond := findCond(last.Status.Conditions, kubeapplierapi.ConditionTypeSuccessful)
+			if cond == nil || cond.Status != metav1.ConditionTrue {
+				t.Errorf("Successful=%v, want True", cond)
+			}
+		})
+	}
+}
+
+// TestNewReadDesireKubernetesCollectionController_RejectsInvalidCollection
+// exercises the constructor's pre-flight validation of targetCollection.
+func TestNewReadDesireKubernetesCollectionController_RejectsInvalidCollection(t *testing.T) {
+	cases := []struct {
+		name       string
+		collection kubeapplierapi.CollectionReference
+		wantErr    string
+	}{
+		{
+			name:       "missing resource",
+			collection: kubeapplierapi.CollectionReference{Version: "v1"},
+			wantErr:    "version and resource",
+		},
+		{
+			name:       "invalid label selector",
+			collection: kubeapplierapi.CollectionReference{Version: "v1", Resource: "pods", LabelSelector: "app in (a"},
+			wantErr:    "labelSelector",
+		},
+		{
+			name:       "invalid field selector",
+			collection: kubeapplierapi.CollectionReference{Version: "v1", Resource: "pods", FieldSelector: "status.phase"},
+			wantErr:    "fieldSelector",
+		},
+		{
+			name:       "maxItems too large",
+			collection: kubeapplierapi.CollectionReference{Version: "v1", Resource: "pods", MaxItems: kubeapplierapi.MaxCollectionMaxItems + 1},
+			wantErr:    "maxItems",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewReadDesireKubernetesCollectionController(keys.ReadDesireKey{}, tc.collection, nil, nil)
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if _, ok := err.(*conditions.PreCheckError); !ok {
+				t.Errorf("error %v is not *PreCheckError", err)
+			}
+			if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("error %q lacks expected substring %q", err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
+
 func TestSyncOnce_TargetAbsent_ReportsSuccessful(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
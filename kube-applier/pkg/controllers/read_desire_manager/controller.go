@@ -16,19 +16,20 @@
 //
 // It watches the ReadDesire informer and, for every key, owns the lifecycle of a
 // per-ReadDesire ReadDesireKubernetesController. When a ReadDesire's TargetItem
-// changes, the manager stops the old per-instance controller (waiting for its
-// goroutine to exit) and starts a fresh one.
+// or TargetCollection changes, the manager stops the old per-instance controller (waiting for its
+// goroutine to exit) and starts a fresh one. Per-instance controllers share
+// their kube watches through a kubeinformerpool.Pool.
 package read_desire_manager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
@@ -39,6 +40,7 @@ import (
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/conditions"
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/desirestatuswriter"
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/keys"
+	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/kubeinformerpool"
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/read_desire_kubernetes"
 )
 
@@ -74,11 +76,12 @@ type PerInstanceController interface {
 	Run(ctx context.Context)
 }
 
-// PerInstanceFactory builds a per-ReadDesire controller. The default factory
-// constructs a ReadDesireKubernetesController via realPerInstanceFactory;
-// tests pass a recording fake.
+// PerInstanceFactory builds a per-ReadDesire controller. collection is
+// non-nil for a ReadDesire with .spec.targetCollection, in which case target
+// is ignored. The default factory constructs a ReadDesireKubernetesController
+// via realPerInstanceFactory; tests pass a recording fake.
 type PerInstanceFactory interface {
-	Build(key keys.ReadDesireKey, target kubeapplierapi.ResourceReference) (PerInstanceController, error)
+	Build(key keys.ReadDesireKey, target kubeapplierapi.ResourceReference, collection *kubeapplierapi.CollectionReference) (PerInstanceController, error)
 }
 
 // ReadDesireInformerManagingController watches ReadDesires and manages the
@@ -104,7 +107,7 @@ type ReadDesireInformerManagingController struct {
 	// running tracks the live per-instance ReadDesireKubernetesController for
 	// each ReadDesire by its key. The map is mutated only under mu. SyncOnce
 	// reads it to decide whether to spawn a fresh per-instance controller,
-	// stop+respawn when TargetItem changed, or no-op when the running entry
+	// stop+respawn when TargetItem or TargetCollection changed, or no-op when the running entry
 	// already matches the desire.
 	mu      sync.Mutex
 	running map[keys.ReadDesireKey]*runningInstance
@@ -112,12 +115,16 @@ type ReadDesireInformerManagingController struct {
 
 type runningInstance struct {
 	target kubeapplierapi.ResourceReference
-	cancel context.CancelFunc
-	done   chan struct{}
+	// collection is a copy of the desire's TargetCollection, nil for a
+	// single-object ReadDesire.
+	collection *kubeapplierapi.CollectionReference
+	cancel     context.CancelFunc
+	done       chan struct{}
 }
 
-// NewReadDesireInformerManagingController constructs a manager that uses the
-// supplied dynamic client for every per-instance controller it spawns.
+// NewReadDesireInformerManagingController constructs a manager whose
+// per-instance controllers subscribe to the supplied informer pool, so
+// ReadDesires targeting the same GVR and namespace share one watch.
 //
 // crudByParent provides a parent-scoped ResourceCRUD per ReadDesire so status
 // replaces from each spawned per-instance controller can be issued under
@@ -130,7 +137,7 @@ type runningInstance struct {
 // Config{} directly; tests substitute shorter durations and a fake clock.
 func NewReadDesireInformerManagingController(
 	readDesireInformer cache.SharedIndexInformer,
-	dyn dynamic.Interface,
+	informers *kubeinformerpool.Pool,
 	crudByParent kubeappliercosmosstorage.KubeApplierReadDesireCRUD,
 	cfg Config,
 ) (*ReadDesireInformerManagingController, error) {
@@ -140,7 +147,7 @@ func NewReadDesireInformerManagingController(
 		name:               ReadDesireInformerManagingControllerName,
 		readDesireInformer: readDesireInformer,
 		fetcher:            fetcher,
-		factory:            &realPerInstanceFactory{dyn: dyn, crudByParent: crudByParent},
+		factory:            &realPerInstanceFactory{informers: informers, crudByParent: crudByParent},
 		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
 			workqueue.DefaultTypedControllerRateLimiter[keys.ReadDesireKey](),
 			workqueue.TypedRateLimitingQueueConfig[keys.ReadDesireKey]{Name: ReadDesireInformerManagingControllerName},
@@ -173,19 +180,22 @@ func NewReadDesireInformerManagingController(
 func (c *ReadDesireInformerManagingController) SetFactory(f PerInstanceFactory) { c.factory = f }
 
 // realPerInstanceFactory is the production PerInstanceFactory: it builds a
-// real ReadDesireKubernetesController against the supplied dynamic client
+// real ReadDesireKubernetesController against the supplied informer pool
 // and CRUD provider.
 type realPerInstanceFactory struct {
-	dyn          dynamic.Interface
+	informers    *kubeinformerpool.Pool
 	crudByParent kubeappliercosmosstorage.KubeApplierReadDesireCRUD
 }
 
 var _ PerInstanceFactory = &realPerInstanceFactory{}
 
 func (f *realPerInstanceFactory) Build(
-	key keys.ReadDesireKey, target kubeapplierapi.ResourceReference,
+	key keys.ReadDesireKey, target kubeapplierapi.ResourceReference, collection *kubeapplierapi.CollectionReference,
 ) (PerInstanceController, error) {
-	return read_desire_kubernetes.NewReadDesireKubernetesController(key, target, f.dyn, f.crudByParent)
+	if collection != nil {
+		return read_desire_kubernetes.NewReadDesireKubernetesCollectionController(key, *collection, f.informers, f.crudByParent)
+	}
+	return read_desire_kubernetes.NewReadDesireKubernetesController(key, target, f.informers, f.crudByParent)
 }
 
 // Run starts the workers. Threadiness > 1 is supported but not necessary —
@@ -284,7 +294,7 @@ func (c *ReadDesireInformerManagingController) processNext(ctx context.Context)
 }
 
 // SyncOnce reconciles one ReadDesire by ensuring its per-instance controller
-// is running with the desired TargetItem.
+// is running with the desired TargetItem or TargetCollection.
 func (c *ReadDesireInformerManagingController) SyncOnce(ctx context.Context, key keys.ReadDesireKey) error {
 	desire, err := c.fetcher.Fetch(ctx, key)
 	if err != nil && !cosmosstorageutils.IsNotFoundError(err) {
@@ -300,7 +310,8 @@ func (c *ReadDesireInformerManagingController) SyncOnce(ctx context.Context, key
 	c.mu.Unlock()
 
 	target := desire.Spec.TargetItem
-	if exists && cur.target == target {
+	collection := desire.Spec.TargetCollection.DeepCopy()
+	if exists && cur.target == target && sameCollection(cur.collection, collection) {
 		// Already running with the right target — nothing to do.
 		return nil
 	}
@@ -308,7 +319,16 @@ func (c *ReadDesireInformerManagingController) SyncOnce(ctx context.Context, key
 		c.stopByKey(key)
 	}
 
-	per, err := c.factory.Build(key, target)
+	// A collection would silently shadow the item, so a desire naming both
+	// is rejected rather than reading one of them.
+	if collection != nil && target != (kubeapplierapi.ResourceReference{}) {
+		return c.writer.UpdateStatus(ctx, key, func(d *kubeapplierapi.ReadDesire) {
+			conditions.SetSuccessful(&d.Status.Conditions, conditions.NewPreCheckError(
+				errors.New("spec.targetItem and spec.targetCollection are mutually exclusive")))
+		})
+	}
+
+	per, err := c.factory.Build(key, target, collection)
 	if err != nil {
 		// PreCheckError or any other construction failure: record it on status,
 		// don't enter a Running state.
@@ -320,7 +340,7 @@ func (c *ReadDesireInformerManagingController) SyncOnce(ctx context.Context, key
 	childCtx, cancel := context.WithCancel(ctx)
 	done := make(chan struct{})
 	c.mu.Lock()
-	c.running[key] = &runningInstance{target: target, cancel: cancel, done: done}
+	c.running[key] = &runningInstance{target: target, collection: collection, cancel: cancel, done: done}
 	c.mu.Unlock()
 
 	go func() {
@@ -339,6 +359,15 @@ func (c *ReadDesireInformerManagingController) SyncOnce(ctx context.Context, key
 	return nil
 }
 
+// sameCollection reports whether two TargetCollections select the same
+// objects with the same limit. Two nils are the same.
+func sameCollection(a, b *kubeapplierapi.CollectionReference) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
 func (c *ReadDesireInformerManagingController) stopByKey(key keys.ReadDesireKey) {
 	c.mu.Lock()
 	cur, ok := c.running[key]
@@ -82,11 +82,12 @@ func keyFor(t *testing.T, d *kubeapplierapi.ReadDesire) keys.ReadDesireKey {
 // fakePerInstance is a stand-in for ReadDesireKubernetesController that
 // records its lifecycle so the manager test can assert on start/stop ordering.
 type fakePerInstance struct {
-	target  kubeapplierapi.ResourceReference
-	mu      sync.Mutex
-	running bool
-	started chan struct{}
-	stopped chan struct{}
+	target     kubeapplierapi.ResourceReference
+	collection *kubeapplierapi.CollectionReference
+	mu         sync.Mutex
+	running    bool
+	started    chan struct{}
+	stopped    chan struct{}
 }
 
 func newFakePerInstance(t kubeapplierapi.ResourceReference) *fakePerInstance {
@@ -184,9 +185,10 @@ type recordingFakeFactory struct {
 }
 
 func (f *recordingFakeFactory) Build(
-	_ keys.ReadDesireKey, target kubeapplierapi.ResourceReference,
+	_ keys.ReadDesireKey, target kubeapplierapi.ResourceReference, collection *kubeapplierapi.CollectionReference,
 ) (PerInstanceController, error) {
 	fake := newFakePerInstance(target)
+	fake.collection = collection
 	*f.fakes = append(*f.fakes, fake)
 	return fake, nil
 }
@@ -256,6 +258,76 @@ func TestManagerSyncOnce_RestartsOnTargetChange(t *testing.T) {
 	}
 }
 
+func TestManagerSyncOnce_RestartsOnCollectionChange(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c1 := &kubeapplierapi.CollectionReference{Version: "v1", Resource: "pods", Namespace: "default", LabelSelector: "app=a"}
+	desire := newReadDesire(t, kubeapplierapi.ResourceReference{})
+	desire.Spec.TargetCollection = c1
+	mock := kubeappliercosmosstoragetesting.NewMockKubeApplierDBClient()
+	loadDesires(t, mock, desire)
+	var fakes []*fakePerInstance
+	c := newTestController(mock, &fakes)
+	key := keyFor(t, desire)
+
+	if err := c.SyncOnce(ctx, key); err != nil {
+		t.Fatalf("first SyncOnce: %v", err)
+	}
+	<-fakes[0].started
+	if fakes[0].collection == nil || *fakes[0].collection != *c1 {
+		t.Fatalf("first factory got collection %v, want %v", fakes[0].collection, c1)
+	}
+
+	// An unchanged collection is a no-op.
+	if err := c.SyncOnce(ctx, key); err != nil {
+		t.Fatalf("second SyncOnce: %v", err)
+	}
+	if len(fakes) != 1 {
+		t.Fatalf("factory called %d times for an unchanged collection, want 1", len(fakes))
+	}
+
+	// Changing the selector restarts the per-instance controller.
+	c2 := *c1
+	c2.LabelSelector = "app=b"
+	desire.Spec.TargetCollection = &c2
+	replaceDesire(t, mock, desire)
+	if err := c.SyncOnce(ctx, key); err != nil {
+		t.Fatalf("third SyncOnce: %v", err)
+	}
+	<-fakes[0].stopped
+	if len(fakes) != 2 {
+		t.Fatalf("expected 2 factory calls (start, restart), got %d", len(fakes))
+	}
+	<-fakes[1].started
+	if fakes[1].collection == nil || *fakes[1].collection != c2 {
+		t.Errorf("second factory got collection %v, want %v", fakes[1].collection, c2)
+	}
+}
+
+func TestManagerSyncOnce_RejectsItemAndCollection(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	desire := newReadDesire(t, kubeapplierapi.ResourceReference{Version: "v1", Resource: "configmaps", Namespace: "default", Name: "x"})
+	desire.Spec.TargetCollection = &kubeapplierapi.CollectionReference{Version: "v1", Resource: "configmaps", Namespace: "default"}
+	mock := kubeappliercosmosstoragetesting.NewMockKubeApplierDBClient()
+	loadDesires(t, mock, desire)
+	var fakes []*fakePerInstance
+	c := newTestController(mock, &fakes)
+	key := keyFor(t, desire)
+
+	if err := c.SyncOnce(ctx, key); err != nil {
+		t.Fatalf("SyncOnce: %v", err)
+	}
+	if len(fakes) != 0 {
+		t.Errorf("factory called %d times for a desire naming both an item and a collection, want 0", len(fakes))
+	}
+	if c.Running(key) {
+		t.Errorf("manager.Running(%v) = true; want false", key)
+	}
+}
+
 func TestManagerSyncOnce_NoOpWhenTargetUnchanged(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -19,10 +19,13 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"log/slog"
 	"os"
 	"sync"
 	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/microsoft/go-otel-audit/audit/base"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/cobra"
 
@@ -43,8 +46,10 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 
+	otelaudit "github.com/Azure/ARO-HCP/internal/audit"
 	sharedleaderelection "github.com/Azure/ARO-HCP/internal/leaderelection"
 	sessiongatev1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+	"github.com/Azure/ARO-HCP/sessiongate/pkg/audit"
 	"github.com/Azure/ARO-HCP/sessiongate/pkg/controller"
 	clientset "github.com/Azure/ARO-HCP/sessiongate/pkg/generated/clientset/versioned"
 	informers "github.com/Azure/ARO-HCP/sessiongate/pkg/generated/informers/externalversions"
@@ -65,6 +70,9 @@ type RawControllerOptions struct {
 	LeaderElectionLeaseDuration time.Duration
 	LeaderElectionRenewDeadline time.Duration
 	LeaderElectionRetryPeriod   time.Duration
+	AuditLogQueueSize           int
+	AuditConnectSocket          bool
+	AuditLogDir                 string
 }
 
 func DefaultControllerOptions() *RawControllerOptions {
@@ -74,6 +82,8 @@ func DefaultControllerOptions() *RawControllerOptions {
 		LeaderElectionRenewDeadline: sharedleaderelection.RecommendedRenewDeadline,
 		LeaderElectionRetryPeriod:   sharedleaderelection.RecommendedRetryPeriod,
 		Workers:                     5,
+		AuditLogQueueSize:           2048,
+		AuditConnectSocket:          os.Getenv("AUDIT_CONNECT_SOCKET") == "true",
 	}
 }
 
@@ -89,6 +99,9 @@ func (o *RawControllerOptions) BindFlags(cmd *cobra.Command) error {
 	cmd.Flags().DurationVar(&o.LeaderElectionLeaseDuration, "leader-election-lease-duration", o.LeaderElectionLeaseDuration, "Leader election lease duration")
 	cmd.Flags().DurationVar(&o.LeaderElectionRenewDeadline, "leader-election-renew-deadline", o.LeaderElectionRenewDeadline, "Leader election renew deadline")
 	cmd.Flags().DurationVar(&o.LeaderElectionRetryPeriod, "leader-election-retry-period", o.LeaderElectionRetryPeriod, "Leader election retry period")
+	cmd.Flags().IntVar(&o.AuditLogQueueSize, "audit-log-queue-size", o.AuditLogQueueSize, "Log queue size for audit logging client.")
+	cmd.Flags().BoolVar(&o.AuditConnectSocket, "audit-connect-socket", o.AuditConnectSocket, "Connect to mdsd audit socket.")
+	cmd.Flags().StringVar(&o.AuditLogDir, "audit-log-dir", o.AuditLogDir, "Directory to additionally write each session's audit events to as JSON lines, one file per session. Intended for local testing.")
 	cmd.Flags().AddGoFlagSet(flag.CommandLine)
 
 	return nil
@@ -129,6 +142,9 @@ func (o *RawControllerOptions) Validate(ctx context.Context) (*ValidatedControll
 	if o.IngressBaseURL == "" {
 		return nil, fmt.Errorf("ingress-base-url is required")
 	}
+	if o.AuditLogQueueSize <= 0 {
+		return nil, fmt.Errorf("audit-log-queue-size must be positive")
+	}
 	return &ValidatedControllerOptions{
 		validatedControllerOptions: &validatedControllerOptions{
 			RawControllerOptions: o,
@@ -175,8 +191,30 @@ func (o *ValidatedControllerOptions) Complete(ctx context.Context) (*ControllerO
 
 	klog.V(4).Info("Successfully built kubeconfig and clientsets")
 
+	// create audit sinks for proxied session requests
+	auditClient, err := otelaudit.NewOtelAuditClient(
+		ctx,
+		otelaudit.CreateConn(o.AuditConnectSocket),
+		prometheus.DefaultRegisterer,
+		base.WithLogger(slog.New(logr.ToSlogHandler(logger))),
+		base.WithSettings(base.Settings{
+			QueueSize: o.AuditLogQueueSize,
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create audit client: %w", err)
+	}
+	auditSink := audit.NewOtelSink(auditClient)
+	if o.AuditLogDir != "" {
+		fileSink, err := audit.NewFileSink(o.AuditLogDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create audit file sink: %w", err)
+		}
+		auditSink = audit.NewMultiSink(auditSink, fileSink)
+	}
+
 	// create server
-	srv := server.NewServer(o.BindAddress, o.IngressBaseURL, prometheus.DefaultRegisterer)
+	srv := server.NewServer(o.BindAddress, o.IngressBaseURL, prometheus.DefaultRegisterer, auditSink)
 
 	// setup leader election lock
 	hostname, err := os.Hostname()
@@ -14,7 +14,12 @@
 
 package v1alpha1
 
-import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+import (
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 type SessionConditionType string
 
@@ -23,6 +28,7 @@ const (
 	SessionConditionTypeHostedControlPlaneAvailable SessionConditionType = "HostedControlPlaneAvailable"
 	SessionConditionTypeCredentialsAvailable        SessionConditionType = "CredentialsAvailable"
 	SessionConditionTypeNetworkPathAvailable        SessionConditionType = "NetworkPathAvailable"
+	SessionConditionTypePendingApproval             SessionConditionType = "PendingApproval"
 
 	SessionReadyReason    string = "Ready"
 	SessionNotReadyReason string = "NotReady"
@@ -42,6 +48,12 @@ const (
 	PrivateKeyGenerationFailedReason string = "PrivateKeyGenerationFailed"
 
 	NetworkPathAvailableReason string = "NetworkPathAvailable"
+
+	AwaitingApprovalReason     string = "AwaitingApproval"
+	SelfApprovalRejectedReason string = "SelfApprovalRejected"
+	ApprovedReason             string = "Approved"
+
+	SessionRevokedReason string = "Revoked"
 )
 
 func (session *Session) IsReady() bool {
@@ -60,3 +72,44 @@ func (session *Session) GetCondition(conditionType SessionConditionType) *metav1
 	}
 	return nil
 }
+
+// RequiresApproval returns true if the session must be approved before credentials are provisioned.
+func (session *Session) RequiresApproval() bool {
+	return session.Spec.Approval != nil
+}
+
+// IsApproved returns true if the session does not require approval or has been approved by
+// a principal other than its owner.
+func (session *Session) IsApproved() bool {
+	if !session.RequiresApproval() {
+		return true
+	}
+	return session.Status.Approval != nil && !session.IsOwner(session.Status.Approval.Approver)
+}
+
+// IsOwner returns true if the principal is the owner of the session.
+func (session *Session) IsOwner(principal Principal) bool {
+	return principal.Type == session.Spec.Owner.Type && strings.EqualFold(principal.Name, session.Spec.Owner.Name)
+}
+
+// ApprovalDeadline returns the time by which a session that requires approval must be approved.
+func (session *Session) ApprovalDeadline() time.Time {
+	if !session.RequiresApproval() {
+		return time.Time{}
+	}
+	return session.CreationTimestamp.Add(session.Spec.Approval.Timeout.Duration)
+}
+
+// IsRevoked returns true if the session has been revoked.
+func (session *Session) IsRevoked() bool {
+	return session.Status.Revocation != nil
+}
+
+// TotalTTL returns the session's TTL including all of its extensions.
+func (session *Session) TotalTTL() time.Duration {
+	ttl := session.Spec.TTL.Duration
+	for _, extension := range session.Status.Extensions {
+		ttl += extension.Duration.Duration
+	}
+	return ttl
+}
@@ -49,7 +49,10 @@ type Session struct {
 	Spec SessionSpec `json:"spec"`
 
 	// +optional
-	// +kubebuilder:validation:XValidation:rule="!has(oldSelf.expiresAt) || (has(self.expiresAt) && self.expiresAt == oldSelf.expiresAt)",message="expiresAt is immutable once set"
+	// +kubebuilder:validation:XValidation:rule="!has(oldSelf.expiresAt) || (has(self.expiresAt) && self.expiresAt >= oldSelf.expiresAt)",message="expiresAt cannot be moved earlier once set"
+	// +kubebuilder:validation:XValidation:rule="!has(oldSelf.approval) || (has(self.approval) && self.approval == oldSelf.approval)",message="approval is immutable once set"
+	// +kubebuilder:validation:XValidation:rule="!has(oldSelf.extensions) || (has(self.extensions) && size(self.extensions) >= size(oldSelf.extensions) && oldSelf.extensions.all(e, e in self.extensions))",message="extensions can only be appended to"
+	// +kubebuilder:validation:XValidation:rule="!has(oldSelf.revocation) || (has(self.revocation) && self.revocation == oldSelf.revocation)",message="revocation is immutable once set"
 	// status contains the observed state of the Session, including provisioned resources,
 	// the session endpoint URL, expiration time, and condition status.
 	Status SessionStatus `json:"status,omitempty,omitzero"`
@@ -93,6 +96,31 @@ type SessionSpec struct {
 	// whose JWT token contains ALL matching claims can access the HCP through this session.
 	// The identityName field is informational only and is NOT used for authentication decisions.
 	Owner Principal `json:"owner"`
+
+	// +optional
+	// approval, when set, holds the session until a principal other than the owner approves
+	// it. No credentials are provisioned while the session is pending approval, and the TTL
+	// only starts once the session has been approved. Sessions that are not approved within
+	// the approval timeout are deleted by the controller.
+	Approval *ApprovalRequest `json:"approval,omitempty"`
+}
+
+// ApprovalRequest carries the information an approver needs to decide on a session.
+type ApprovalRequest struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// justification explains why the owner needs access to the HCP.
+	Justification string `json:"justification"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// incidentId is the identifier of the incident the session is requested for.
+	IncidentID string `json:"incidentId"`
+
+	// +kubebuilder:validation:Required
+	// timeout is how long after its creation the session may wait for approval. Sessions
+	// that are not approved in time are deleted.
+	Timeout metav1.Duration `json:"timeout"`
 }
 
 // ManagementCluster identifies an Azure Kubernetes Service (AKS) management cluster that hosts
@@ -133,8 +161,37 @@ type AccessLevel struct {
 	// the session owner will inherit when accessing the HCP. The group must exist on the
 	// target HCP and have appropriate RoleBindings or ClusterRoleBindings configured.
 	Group string `json:"group"`
+
+	// +optional
+	// +kubebuilder:default=Full
+
+	// policy restricts, at the sessiongate proxy, which requests the session owner may send
+	// to the HCP. It is enforced before requests are forwarded and independently of the RBAC
+	// granted to the group on the HCP, so a misconfigured RoleBinding cannot widen a session.
+	// Valid values are:
+	// - "Full": all requests are forwarded
+	// - "ReadOnly": only get, list and watch requests are forwarded; exec, attach,
+	//   port-forward, proxy and any other connection upgrade are rejected
+	// - "ReadOnlyNoSecrets": like ReadOnly, and Secrets cannot be read either
+	// Defaults to "Full".
+	Policy AccessPolicy `json:"policy,omitempty"`
 }
 
+// AccessPolicy specifies which requests the sessiongate proxy forwards for a session.
+// +kubebuilder:validation:Enum=Full;ReadOnly;ReadOnlyNoSecrets
+type AccessPolicy string
+
+const (
+	// AccessPolicyFull forwards every request, leaving authorization to RBAC on the HCP.
+	AccessPolicyFull AccessPolicy = "Full"
+
+	// AccessPolicyReadOnly forwards only read requests and rejects connection upgrades.
+	AccessPolicyReadOnly AccessPolicy = "ReadOnly"
+
+	// AccessPolicyReadOnlyNoSecrets forwards only read requests that do not touch Secrets.
+	AccessPolicyReadOnlyNoSecrets AccessPolicy = "ReadOnlyNoSecrets"
+)
+
 // PrincipalType specifies the type of Azure identity that owns a session.
 // +kubebuilder:validation:Enum=azureUser;azureServicePrincipal
 type PrincipalType string
@@ -177,14 +234,17 @@ type SessionStatus struct {
 	// - "Ready": True when the session is fully provisioned and accessible
 	// - "CredentialsAvailable": True when session credentials have been created
 	// - "NetworkPathAvailable": True when the network path to the HCP is established
+	// - "PendingApproval": True while a session that requires approval waits for it
 	// The status of each condition is one of True, False, or Unknown.
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 
 	// +optional
 	// expiresAt is the timestamp when the session will expire and become invalid.
-	// This is calculated as the session's creation timestamp plus the TTL specified in spec.ttl.
+	// This is calculated as the session's creation timestamp, or its approval timestamp for
+	// sessions that require approval, plus the TTL specified in spec.ttl and the durations
+	// of all extensions.
 	// After this time, the controller will clean up session resources and the session
-	// endpoint will no longer accept connections. This field is immutable once set.
+	// endpoint will no longer accept connections. This field can only be moved later once set.
 	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 
 	// +optional
@@ -207,6 +267,70 @@ type SessionStatus struct {
 	// port-forward endpoint or internal service URL. This field is set by the controller
 	// based on the HCP's network configuration.
 	BackendKASURL string `json:"backendKASURL,omitempty"`
+
+	// +optional
+	// approval records who approved a session that requires approval, see spec.approval.
+	// It is set by the API that accepts the approval rather than by the controller, and is
+	// immutable once set. An approval recorded for the session owner is not honoured.
+	Approval *Approval `json:"approval,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	// extensions records every extension of the session's TTL in the order they were made.
+	// They are appended by the API that accepts the extension rather than by the controller,
+	// and existing entries can't be changed or removed. The controller moves expiresAt
+	// accordingly.
+	Extensions []Extension `json:"extensions,omitempty"`
+
+	// +optional
+	// revocation records who revoked the session. It is set by the API that accepts the
+	// revocation rather than by the controller, and is immutable once set. The controller
+	// closes all connections of a revoked session, deletes its credentials and then
+	// deletes the session.
+	Revocation *Revocation `json:"revocation,omitempty"`
+}
+
+// Approval records the approval of a session.
+type Approval struct {
+	// +kubebuilder:validation:Required
+	// approver is the principal that approved the session.
+	Approver Principal `json:"approver"`
+
+	// +kubebuilder:validation:Required
+	// approvedAt is the time the session was approved. The session's TTL starts at this time.
+	ApprovedAt metav1.Time `json:"approvedAt"`
+}
+
+// Extension records an extension of a session's TTL.
+type Extension struct {
+	// +kubebuilder:validation:Required
+	// extendedBy is the principal that extended the session.
+	ExtendedBy Principal `json:"extendedBy"`
+
+	// +kubebuilder:validation:Required
+	// duration is the time added to the session's TTL.
+	Duration metav1.Duration `json:"duration"`
+
+	// +kubebuilder:validation:Required
+	// extendedAt is the time the session was extended.
+	ExtendedAt metav1.Time `json:"extendedAt"`
+}
+
+// Revocation records the revocation of a session.
+type Revocation struct {
+	// +kubebuilder:validation:Required
+	// revokedBy is the principal that revoked the session.
+	RevokedBy Principal `json:"revokedBy"`
+
+	// +kubebuilder:validation:Required
+	// revokedAt is the time the session was revoked.
+	RevokedAt metav1.Time `json:"revokedAt"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// reason explains why the session was revoked.
+	Reason string `json:"reason"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
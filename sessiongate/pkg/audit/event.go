@@ -0,0 +1,105 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit records every request proxied through a sessiongate session
+// as a Kubernetes audit event and forwards it to the configured sinks.
+package audit
+
+import (
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// The event types below mirror audit.k8s.io/v1 so that recorded sessions can
+// be read with the same tooling as kube-apiserver audit logs. They are copied
+// rather than imported to keep k8s.io/apiserver out of sessiongate.
+
+const (
+	// EventAPIVersion and EventKind are set on every recorded Event.
+	EventAPIVersion = "audit.k8s.io/v1"
+	EventKind       = "Event"
+)
+
+// MaxRecordedRequestBytes caps the request body recorded in an Event. Larger
+// bodies are still proxied but only noted in the Event's annotations.
+const MaxRecordedRequestBytes = 256 * 1024
+
+// Level is the amount of detail recorded for a request.
+type Level string
+
+const (
+	// LevelMetadata records request metadata but not the request body.
+	LevelMetadata Level = "Metadata"
+	// LevelRequest records request metadata and the request body.
+	LevelRequest Level = "Request"
+)
+
+// Stage is the point of request handling at which an Event was recorded.
+type Stage string
+
+const (
+	// StageRequestReceived is recorded before a long-running connection such
+	// as exec or port-forward is handed to the HCP.
+	StageRequestReceived Stage = "RequestReceived"
+	// StageResponseComplete is recorded once the response has been sent or the
+	// long-running connection has been closed.
+	StageResponseComplete Stage = "ResponseComplete"
+)
+
+// Annotation keys sessiongate sets on every Event.
+const (
+	AnnotationSession        = "sessiongate.aro-hcp.azure.com/session"
+	AnnotationHCPResourceID  = "sessiongate.aro-hcp.azure.com/hcp-resource-id"
+	AnnotationOwner          = "sessiongate.aro-hcp.azure.com/owner"
+	AnnotationOwnerType      = "sessiongate.aro-hcp.azure.com/owner-type"
+	AnnotationAccessPolicy   = "sessiongate.aro-hcp.azure.com/access-policy"
+	AnnotationCommand        = "sessiongate.aro-hcp.azure.com/command"
+	AnnotationContainer      = "sessiongate.aro-hcp.azure.com/container"
+	AnnotationRequestOmitted = "sessiongate.aro-hcp.azure.com/request-omitted"
+	AnnotationCallerNotOwner = "sessiongate.aro-hcp.azure.com/caller-not-owner"
+)
+
+// Event is a single audit record of a request made through a session.
+type Event struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Level      Level                     `json:"level"`
+	AuditID    types.UID                 `json:"auditID"`
+	Stage      Stage                     `json:"stage"`
+	RequestURI string                    `json:"requestURI"`
+	Verb       string                    `json:"verb"`
+	User       authenticationv1.UserInfo `json:"user"`
+	SourceIPs  []string                  `json:"sourceIPs,omitempty"`
+	UserAgent  string                    `json:"userAgent,omitempty"`
+	ObjectRef  *ObjectReference          `json:"objectRef,omitempty"`
+	// ResponseStatus is only set once the response is complete.
+	ResponseStatus *metav1.Status `json:"responseStatus,omitempty"`
+	// RequestObject is the JSON body of a mutating request, when it was recorded.
+	RequestObject            *runtime.Unknown  `json:"requestObject,omitempty"`
+	RequestReceivedTimestamp metav1.MicroTime  `json:"requestReceivedTimestamp"`
+	StageTimestamp           metav1.MicroTime  `json:"stageTimestamp"`
+	Annotations              map[string]string `json:"annotations,omitempty"`
+}
+
+// ObjectReference identifies the object a request was made against.
+type ObjectReference struct {
+	Resource    string `json:"resource,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	Name        string `json:"name,omitempty"`
+	APIGroup    string `json:"apiGroup,omitempty"`
+	APIVersion  string `json:"apiVersion,omitempty"`
+	Subresource string `json:"subresource,omitempty"`
+}
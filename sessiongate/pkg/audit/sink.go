@@ -0,0 +1,225 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/microsoft/go-otel-audit/audit/msgs"
+
+	otelaudit "github.com/Azure/ARO-HCP/internal/audit"
+	sessiongatev1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+)
+
+const (
+	operationCategoryDescription = "HCP access through a sessiongate session"
+
+	targetResourceTypeHCP     = "Microsoft.RedHatOpenShift/hcpOpenShiftClusters"
+	targetResourceTypeSession = "sessiongate.aro-hcp.azure.com/sessions"
+)
+
+// Sink receives the audit events of proxied session requests.
+type Sink interface {
+	Record(ctx context.Context, event *Event) error
+}
+
+type multiSink []Sink
+
+// NewMultiSink returns a Sink that records every event to all of the given
+// sinks, even when one of them fails.
+func NewMultiSink(sinks ...Sink) Sink {
+	return multiSink(sinks)
+}
+
+func (m multiSink) Record(ctx context.Context, event *Event) error {
+	var errs []error
+	for _, sink := range m {
+		if err := sink.Record(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type otelSink struct {
+	client otelaudit.Client
+}
+
+// NewOtelSink returns a Sink that sends events through the OTel audit client.
+// The OTel audit schema has no room for request bodies, so those are only kept
+// by sinks that store the full Event, such as the file sink.
+func NewOtelSink(client otelaudit.Client) Sink {
+	return &otelSink{client: client}
+}
+
+func (s *otelSink) Record(ctx context.Context, event *Event) error {
+	return s.client.Send(ctx, toOtelMsg(event))
+}
+
+// toOtelMsg maps an Event onto an OTel audit record. The session name and HCP
+// resource ID are recorded as target resources so that a session's requests
+// can be found after the Session itself has been deleted.
+func toOtelMsg(event *Event) msgs.Msg {
+	record := msgs.Record{
+		OperationCategories:          []msgs.OperationCategory{msgs.ResourceManagement},
+		OperationCategoryDescription: operationCategoryDescription,
+		OperationAccessLevel:         strings.Join(event.User.Groups, ","),
+		CallerAccessLevels:           event.User.Groups,
+		OperationName:                fmt.Sprintf("%s %s", event.Verb, event.RequestURI),
+		CallerAgent:                  event.UserAgent,
+		OperationType:                operationType(event.Verb),
+		OperationResult:              msgs.Success,
+		TargetResources: map[string][]msgs.TargetResourceEntry{
+			targetResourceTypeHCP: {
+				{Name: event.Annotations[AnnotationHCPResourceID], Region: otelaudit.Unknown},
+			},
+			targetResourceTypeSession: {
+				{Name: event.Annotations[AnnotationSession], Region: otelaudit.Unknown},
+			},
+		},
+	}
+
+	if len(event.SourceIPs) > 0 {
+		if addr, err := msgs.ParseAddr(event.SourceIPs[0]); err == nil {
+			record.CallerIpAddress = addr
+		}
+	}
+
+	identityType := msgs.UPN
+	if event.Annotations[AnnotationOwnerType] == string(sessiongatev1alpha1.PrincipalTypeAzureServicePrincipal) {
+		identityType = msgs.ApplicationID
+	}
+	description := "session owner"
+	if event.Annotations[AnnotationCallerNotOwner] == "true" {
+		description = "caller rejected as not the session owner"
+	}
+	record.CallerIdentities = map[msgs.CallerIdentityType][]msgs.CallerIdentityEntry{
+		identityType: {
+			{Identity: event.User.Username, Description: description},
+		},
+	}
+
+	if event.ResponseStatus != nil && event.ResponseStatus.Code >= http.StatusBadRequest {
+		record.OperationResult = msgs.Failure
+		record.OperationResultDescription = fmt.Sprintf("Status code: %d", event.ResponseStatus.Code)
+		if len(event.ResponseStatus.Message) > 0 {
+			record.OperationResultDescription += ", " + event.ResponseStatus.Message
+		}
+	}
+
+	return msgs.Msg{
+		Type:   msgs.ControlPlane,
+		Record: record,
+	}
+}
+
+func operationType(verb string) msgs.OperationType {
+	switch verb {
+	case "get", "list", "watch":
+		return msgs.Read
+	case "create":
+		return msgs.Create
+	case "update", "patch":
+		return msgs.Update
+	case "delete", "deletecollection":
+		return msgs.Delete
+	default:
+		return msgs.UnknownOperationType
+	}
+}
+
+type fileSink struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileSink returns a Sink that appends every event as a JSON line to
+// <dir>/<session>.log, so a session's trail can be read back with
+// ReadSessionEvents once the session has expired.
+func NewFileSink(dir string) (Sink, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+	return &fileSink{dir: dir}, nil
+}
+
+func (s *fileSink) Record(ctx context.Context, event *Event) error {
+	path, err := sessionLogPath(s.dir, event.Annotations[AnnotationSession])
+	if err != nil {
+		return err
+	}
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write audit event: %w", err)
+	}
+	return f.Close()
+}
+
+// ReadSessionEvents returns the events a file sink recorded for a session, in
+// the order they were recorded.
+func ReadSessionEvents(dir, sessionName string) ([]Event, error) {
+	path, err := sessionLogPath(dir, sessionName)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	var events []Event
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*MaxRecordedRequestBytes)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal audit event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return events, nil
+}
+
+func sessionLogPath(dir, sessionName string) (string, error) {
+	if len(sessionName) == 0 || sessionName != filepath.Base(sessionName) || sessionName == "." || sessionName == ".." {
+		return "", fmt.Errorf("invalid session name %q", sessionName)
+	}
+	return filepath.Join(dir, sessionName+".log"), nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/microsoft/go-otel-audit/audit/base"
+	"github.com/microsoft/go-otel-audit/audit/msgs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	sessiongatev1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+)
+
+const testHCPResourceID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.RedHatOpenShift/hcpOpenShiftClusters/hcp"
+
+func testEvent(session, verb string, code int32) *Event {
+	return &Event{
+		TypeMeta:   metav1.TypeMeta{APIVersion: EventAPIVersion, Kind: EventKind},
+		Level:      LevelMetadata,
+		AuditID:    "audit-1",
+		Stage:      StageResponseComplete,
+		RequestURI: "/api/v1/namespaces/ns/pods/p",
+		Verb:       verb,
+		User: authenticationv1.UserInfo{
+			Username: "alice@example.com",
+			Groups:   []string{"aro-sre-pso"},
+		},
+		SourceIPs:      []string{"10.0.0.1"},
+		ObjectRef:      &ObjectReference{Resource: "pods", Namespace: "ns", Name: "p", APIVersion: "v1"},
+		ResponseStatus: &metav1.Status{Code: code},
+		Annotations: map[string]string{
+			AnnotationSession:       session,
+			AnnotationHCPResourceID: testHCPResourceID,
+			AnnotationOwnerType:     string(sessiongatev1alpha1.PrincipalTypeAzureUser),
+		},
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := NewFileSink(dir)
+	require.NoError(t, err)
+
+	created := testEvent("s1", "create", 201)
+	created.Level = LevelRequest
+	created.RequestObject = &runtime.Unknown{Raw: []byte(`{"kind":"ConfigMap"}`), ContentType: runtime.ContentTypeJSON}
+
+	require.NoError(t, sink.Record(context.Background(), testEvent("s1", "get", 200)))
+	require.NoError(t, sink.Record(context.Background(), testEvent("s2", "list", 200)))
+	require.NoError(t, sink.Record(context.Background(), created))
+
+	events, err := ReadSessionEvents(dir, "s1")
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "get", events[0].Verb)
+	assert.Equal(t, "create", events[1].Verb)
+	assert.Equal(t, EventAPIVersion, events[1].APIVersion)
+	require.NotNil(t, events[1].RequestObject)
+	assert.JSONEq(t, `{"kind":"ConfigMap"}`, string(events[1].RequestObject.Raw))
+
+	events, err = ReadSessionEvents(dir, "s2")
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+
+	assert.Error(t, sink.Record(context.Background(), testEvent("../escape", "get", 200)))
+}
+
+type recordingClient struct {
+	msgs []msgs.Msg
+	err  error
+}
+
+func (c *recordingClient) Send(ctx context.Context, msg msgs.Msg, options ...base.SendOption) error {
+	c.msgs = append(c.msgs, msg)
+	return c.err
+}
+
+func TestOtelSink(t *testing.T) {
+	tests := []struct {
+		name            string
+		event           *Event
+		wantType        msgs.OperationType
+		wantResult      msgs.OperationResult
+		wantDescription string
+	}{
+		{
+			name:       "read",
+			event:      testEvent("s1", "get", 200),
+			wantType:   msgs.Read,
+			wantResult: msgs.Success,
+		},
+		{
+			name:       "forbidden patch",
+			event:      testEvent("s1", "patch", 403),
+			wantType:   msgs.Update,
+			wantResult: msgs.Failure,
+		},
+		{
+			name: "rejected non-owner",
+			event: func() *Event {
+				event := testEvent("s1", "patch", 401)
+				event.Annotations[AnnotationCallerNotOwner] = "true"
+				return event
+			}(),
+			wantType:        msgs.Update,
+			wantResult:      msgs.Failure,
+			wantDescription: "caller rejected as not the session owner",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &recordingClient{}
+			require.NoError(t, NewOtelSink(client).Record(context.Background(), tt.event))
+			require.Len(t, client.msgs, 1)
+
+			record := client.msgs[0].Record
+			assert.Equal(t, tt.wantType, record.OperationType)
+			assert.Equal(t, tt.wantResult, record.OperationResult)
+			assert.Equal(t, fmt.Sprintf("%s /api/v1/namespaces/ns/pods/p", tt.event.Verb), record.OperationName)
+			assert.Equal(t, "aro-sre-pso", record.OperationAccessLevel)
+			assert.Equal(t, "alice@example.com", record.CallerIdentities[msgs.UPN][0].Identity)
+			wantDescription := "session owner"
+			if len(tt.wantDescription) > 0 {
+				wantDescription = tt.wantDescription
+			}
+			assert.Equal(t, wantDescription, record.CallerIdentities[msgs.UPN][0].Description)
+			assert.Equal(t, "10.0.0.1", record.CallerIpAddress.NetIP().String())
+			assert.Equal(t, testHCPResourceID, record.TargetResources[targetResourceTypeHCP][0].Name)
+			assert.Equal(t, "s1", record.TargetResources[targetResourceTypeSession][0].Name)
+		})
+	}
+}
+
+func TestMultiSinkRecordsToAllSinks(t *testing.T) {
+	failing := &recordingClient{err: fmt.Errorf("socket closed")}
+	working := &recordingClient{}
+
+	err := NewMultiSink(NewOtelSink(failing), NewOtelSink(working)).Record(context.Background(), testEvent("s1", "get", 200))
+	require.Error(t, err)
+	assert.Len(t, failing.msgs, 1)
+	assert.Len(t, working.msgs, 1)
+}
@@ -20,6 +20,7 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	corev1apply "k8s.io/client-go/applyconfigurations/core/v1"
@@ -86,6 +87,23 @@ func (c *CredentialSecret) GetCertificate() ([]byte, bool) {
 	return c.certificate, true
 }
 
+// GetCertificateNotAfter returns the expiration time of the certificate in the secret.
+func (c *CredentialSecret) GetCertificateNotAfter() (time.Time, bool) {
+	certificateBytes, exists := c.GetCertificate()
+	if !exists {
+		return time.Time{}, false
+	}
+	block, _ := pem.Decode(certificateBytes)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return time.Time{}, false
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return certificate.NotAfter, true
+}
+
 func (c *CredentialSecret) ApplyConfigurationForPrivateKey(session *sessiongatev1alpha1.Session, privateKey *rsa.PrivateKey) *corev1apply.SecretApplyConfiguration {
 	return c.applyConfiguration(session, encodePrivateKey(privateKey), nil)
 }
@@ -72,13 +72,6 @@ func createCSRApplyConfiguration(session *sessiongatev1alpha1.Session, privateKe
 	if err != nil {
 		return nil, fmt.Errorf("failed to create CSR request body: %w", err)
 	}
-	// Kubernetes requires CSR expirationSeconds to be at least MinCSRExpirationSeconds.
-	// We use max(TTL, MinCSRExpirationSeconds) here because session access is controlled
-	// by session expiration, not certificate expiration. Once a session expires, the
-	// authorization policy is removed and access to the HCP is blocked regardless of
-	// certificate validity.
-	csrExpirationSeconds := max(int32(session.Spec.TTL.Seconds()), MinCSRExpirationSeconds)
-
 	return certapplyv1.CertificateSigningRequest(getCSRNameForSession(session)).
 		WithLabels(map[string]string{
 			LabelManagedBy: ControllerAgentName,
@@ -89,13 +82,24 @@ func createCSRApplyConfiguration(session *sessiongatev1alpha1.Session, privateKe
 		WithSpec(certapplyv1.CertificateSigningRequestSpec().
 			WithRequest(csrPEM...).
 			WithSignerName(CSRSignerName(session.Spec.HostedControlPlane.Namespace)).
-			WithExpirationSeconds(csrExpirationSeconds).
+			WithExpirationSeconds(csrExpirationSeconds(session)).
 			WithUsages(
 				certificatesv1.UsageClientAuth,
 				certificatesv1.UsageDigitalSignature,
 			)), nil
 }
 
+// csrExpirationSeconds returns the requested lifetime of the session's certificate.
+// Kubernetes requires CSR expirationSeconds to be at least MinCSRExpirationSeconds.
+// We use max(TTL, MinCSRExpirationSeconds) here because session access is controlled
+// by session expiration, not certificate expiration. Once a session expires, the
+// authorization policy is removed and access to the HCP is blocked regardless of
+// certificate validity. The TTL includes extensions, so that a certificate renewed
+// for an extended session outlives it.
+func csrExpirationSeconds(session *sessiongatev1alpha1.Session) int32 {
+	return max(int32(session.TotalTTL().Seconds()), MinCSRExpirationSeconds)
+}
+
 func CSRCommonName(user string) string {
 	return fmt.Sprintf("system:sre-break-glass:%s", user)
 }
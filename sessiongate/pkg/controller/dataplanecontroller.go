@@ -169,6 +169,9 @@ func (c *DataplaneController) isReadyForRegistration(session *sessiongatev1alpha
 	if !session.DeletionTimestamp.IsZero() {
 		return false, "being deleted"
 	}
+	if session.IsRevoked() {
+		return false, "revoked"
+	}
 	if !session.IsReady() {
 		return false, "not ready"
 	}
@@ -207,7 +210,7 @@ func (c *DataplaneController) registerSession(session *sessiongatev1alpha1.Sessi
 		},
 	}
 
-	_, err = c.registry.RegisterSession(session.Name, session.Spec.HostedControlPlane.ResourceID, session.Spec.Owner, restConfig)
+	_, err = c.registry.RegisterSession(session.Name, session.Spec.HostedControlPlane.ResourceID, session.Spec.Owner, session.Spec.AccessLevel, restConfig)
 	if err != nil {
 		return fmt.Errorf("failed to register session: %w", err)
 	}
@@ -236,6 +236,14 @@ func (c *SessionController) processNextSessionWorkItem(ctx context.Context) bool
 		}
 	}
 
+	// requeue for the approval deadline of sessions still waiting for approval
+	if !session.IsApproved() {
+		requeueAfter := time.Until(session.ApprovalDeadline())
+		if requeueAfter > 0 {
+			c.workqueue.AddAfter(objRef, requeueAfter)
+		}
+	}
+
 	// get the management cluster provider
 	mc, ok := c.getManagementClusterProvider(session.Spec.ManagementCluster.ResourceID)
 	if !ok {
@@ -279,6 +287,8 @@ func (c *SessionController) syncSession(ctx context.Context, session *sessiongat
 			_, err = c.sessiongateClient.SessiongateV1alpha1().Sessions(session.Namespace).ApplyStatus(ctx, action.Session, metav1.ApplyOptions{FieldManager: ControllerAgentName})
 		case action.Secret != nil:
 			_, err = c.kubeClient.CoreV1().Secrets(*action.Secret.Namespace).Apply(ctx, action.Secret, metav1.ApplyOptions{FieldManager: ControllerAgentName, Force: true})
+		case action.DeleteSecret:
+			err = c.kubeClient.CoreV1().Secrets(session.Namespace).Delete(ctx, credentialSecretNameForSession(session), metav1.DeleteOptions{})
 		case action.CSR != nil:
 			_, err = mc.KubeClient.CertificatesV1().CertificateSigningRequests().Apply(ctx, action.CSR, metav1.ApplyOptions{FieldManager: ControllerAgentName, Force: true})
 		case action.CSRApproval != nil:
@@ -302,6 +312,7 @@ type actions struct {
 	Session       *sessiongatv1alpha1applyconfigurations.SessionApplyConfiguration
 	DeleteSession bool
 	Secret        *corev1applyconfigurations.SecretApplyConfiguration
+	DeleteSecret  bool
 	CSR           *certapplyv1.CertificateSigningRequestApplyConfiguration
 	CSRApproval   *csrApprovalAction
 	DeleteCSR     bool
@@ -320,6 +331,9 @@ func (a *actions) validate() error {
 	if a.Secret != nil {
 		set += 1
 	}
+	if a.DeleteSecret {
+		set += 1
+	}
 	if a.DeleteSession {
 		set += 1
 	}
@@ -355,8 +369,12 @@ func (c *SessionController) processSession(ctx context.Context, session *session
 	for _, step := range []sessionStep{
 		// this is a new session, so we need to manifest the expiration timestamp
 		c.handleExpiration,
+		// take revoked sessions out of service before anything else is provisioned
+		c.handleRevocation,
 		// verify the hosted control plane is ready
 		c.verifyHostedControlPlaneReady,
+		// hold sessions that require approval until they are approved
+		c.handleApproval,
 		// generate credentials
 		c.generateCredentials,
 		// ensure network path is available
@@ -418,8 +436,16 @@ func (c *SessionController) handleRetryableError(session *sessiongatev1alpha1.Se
 
 // handleExpiration calculates session expiration time and deletes expired sessions.
 // Sets ExpiresAt on first reconcile, then checks on subsequent reconciles and deletes when TTL is exceeded.
+// The TTL of sessions that require approval starts when they are approved, so expiration is left to
+// handleApproval until then. Extensions move ExpiresAt later.
 func (c *SessionController) handleExpiration(ctx context.Context, session *sessiongatev1alpha1.Session, mc ManagementClusterQuerier) (bool, *actions, error) {
-	expiresAt := metav1.NewTime(session.CreationTimestamp.Add(session.Spec.TTL.Duration))
+	if !session.IsApproved() {
+		return false, nil, nil
+	}
+	expiresAt := metav1.NewTime(session.CreationTimestamp.Add(session.TotalTTL()))
+	if session.RequiresApproval() {
+		expiresAt = metav1.NewTime(session.Status.Approval.ApprovedAt.Add(session.TotalTTL()))
+	}
 	if c.clock.Now().After(expiresAt.Time) {
 		e := event("SessionExpiration", "Session has expired, deleting %s/%s.", session.Namespace, session.Name)
 		return true, &actions{Event: e, DeleteSession: true}, nil
@@ -428,11 +454,106 @@ func (c *SessionController) handleExpiration(ctx context.Context, session *sessi
 		WithExpiresAt(expiresAt).
 		AsApplyConfiguration(session)
 	if needsUpdate {
+		// an existing expiration timestamp only moves when the session has been extended
+		if session.Status.ExpiresAt != nil && len(session.Status.Extensions) > 0 {
+			extension := session.Status.Extensions[len(session.Status.Extensions)-1]
+			e := event("SessionExtended", "Session %s/%s extended by %s %s by %s, expires at %s.",
+				session.Namespace, session.Name, extension.ExtendedBy.Type, extension.ExtendedBy.Name, extension.Duration, expiresAt.UTC().Format(time.RFC3339))
+			return true, &actions{Event: e, Session: sessionUpdate}, nil
+		}
 		return true, &actions{Session: sessionUpdate}, nil
 	}
 	return false, nil, nil
 }
 
+// handleRevocation takes revoked sessions out of service. The session is marked as not ready first,
+// which makes the data plane unregister it and close all of its connections. Then the client
+// certificate is invalidated by deleting every copy of it, the credentials secret holding it and its
+// private key and the CSR it was issued through, before the session itself is deleted.
+func (c *SessionController) handleRevocation(ctx context.Context, session *sessiongatev1alpha1.Session, mc ManagementClusterQuerier) (bool, *actions, error) {
+	if !session.IsRevoked() {
+		return false, nil, nil
+	}
+
+	revocation := session.Status.Revocation
+	sessionUpdate, needsUpdate := NewStatus(session.Status).
+		WithConditions(
+			RevokedCondition(revocation.RevokedBy, session.Generation, c.clock.Now()),
+		).AsApplyConfiguration(session)
+	if needsUpdate {
+		e := event("SessionRevoked", "Session %s/%s revoked by %s %s with reason %q.", session.Namespace, session.Name, revocation.RevokedBy.Type, revocation.RevokedBy.Name, revocation.Reason)
+		return true, &actions{Event: e, Session: sessionUpdate}, nil
+	}
+
+	secret, err := c.getSecret(session.Namespace, credentialSecretNameForSession(session))
+	if err != nil && !apierrors.IsNotFound(err) {
+		return c.handleTransientError(err)
+	}
+	if secret != nil && err == nil {
+		e := event("CredentialsRevoked", "Deleting credentials of revoked session %s/%s.", session.Namespace, session.Name)
+		return true, &actions{Event: e, DeleteSecret: true}, nil
+	}
+
+	csr, err := mc.GetCSR(getCSRNameForSession(session))
+	if err != nil && !apierrors.IsNotFound(err) {
+		return c.handleTransientError(err)
+	}
+	if csr != nil && err == nil {
+		e := event("CSRRevoked", "Deleting CSR of revoked session %s/%s on management cluster.", session.Namespace, session.Name)
+		return true, &actions{Event: e, DeleteCSR: true}, nil
+	}
+
+	e := event("SessionRevocation", "Session has been revoked, deleting %s/%s.", session.Namespace, session.Name)
+	return true, &actions{Event: e, DeleteSession: true}, nil
+}
+
+// handleApproval holds sessions that require approval until a principal other than the owner
+// approves them, and deletes sessions that are not approved before their approval deadline.
+func (c *SessionController) handleApproval(ctx context.Context, session *sessiongatev1alpha1.Session, mc ManagementClusterQuerier) (bool, *actions, error) {
+	if !session.RequiresApproval() {
+		return false, nil, nil
+	}
+
+	if session.IsApproved() {
+		approver := session.Status.Approval.Approver
+		sessionUpdate, needsUpdate := NewStatus(session.Status).
+			WithConditions(
+				ApprovedCondition(approver, session.Generation, c.clock.Now()),
+			).AsApplyConfiguration(session)
+		if needsUpdate {
+			e := event("SessionApproved", "Session %s/%s approved by %s %s.", session.Namespace, session.Name, approver.Type, approver.Name)
+			return true, &actions{Event: e, Session: sessionUpdate}, nil
+		}
+		return false, nil, nil
+	}
+
+	deadline := session.ApprovalDeadline()
+	if c.clock.Now().After(deadline) {
+		e := event("ApprovalExpired", "Session was not approved within %s, deleting %s/%s.", session.Spec.Approval.Timeout, session.Namespace, session.Name)
+		return true, &actions{Event: e, DeleteSession: true}, nil
+	}
+
+	condition := PendingApprovalCondition(sessiongatev1alpha1.AwaitingApprovalReason,
+		fmt.Sprintf("Waiting for approval by a principal other than the owner until %s", deadline.UTC().Format(time.RFC3339)),
+		session.Generation, c.clock.Now())
+	if session.Status.Approval != nil {
+		// the approval API already rejects self-approval, but the status may be
+		// written by other clients too
+		condition = PendingApprovalCondition(sessiongatev1alpha1.SelfApprovalRejectedReason,
+			"Session was approved by its owner, which is not allowed",
+			session.Generation, c.clock.Now())
+	}
+	sessionUpdate, needsUpdate := NewStatus(session.Status).
+		WithConditions(
+			condition,
+			NotReadyCondition(session.Generation, c.clock.Now()),
+		).AsApplyConfiguration(session)
+	if needsUpdate {
+		return true, &actions{Session: sessionUpdate}, nil
+	}
+	return true, nil, nil // the informer lets us know about the approval, the deadline is requeued
+}
+
 func (c *SessionController) verifyHostedControlPlaneReady(ctx context.Context, session *sessiongatev1alpha1.Session, mc ManagementClusterQuerier) (bool, *actions, error) {
 	logger := klog.FromContext(ctx)
 	hcp, err := mc.GetHostedControlPlane(session.Spec.HostedControlPlane.Namespace)
@@ -505,6 +626,10 @@ func (c *SessionController) getCredentialSecret(session *sessiongatev1alpha1.Ses
 
 func (c *SessionController) generateCredentials(ctx context.Context, session *sessiongatev1alpha1.Session, mc ManagementClusterQuerier) (bool, *actions, error) {
 	logger := klog.FromContext(ctx)
+	if !session.IsApproved() {
+		// handleApproval holds unapproved sessions, this only guards against step reordering
+		return true, nil, nil
+	}
 	credentialSecret, err := c.getCredentialSecret(session)
 	if err != nil {
 		switch {
@@ -532,8 +657,13 @@ func (c *SessionController) generateCredentials(ctx context.Context, session *se
 		}
 	}
 
+	// a certificate issued before the session was extended may expire before the session does,
+	// it is renewed while the session keeps serving with the current one
+	_, certExists := credentialSecret.GetCertificate()
+	renewing := certExists && c.certificateExpiresBeforeSession(credentialSecret, session)
+
 	// if there is already a certificate in the secret, nothing to do
-	if _, certExists := credentialSecret.GetCertificate(); certExists {
+	if certExists && !renewing {
 		sessionUpdate, needsUpdate := NewStatus(session.Status).
 			WithConditions(
 				applyv1.Condition().
@@ -586,6 +716,11 @@ func (c *SessionController) generateCredentials(ctx context.Context, session *se
 			e := event("CSRInvalid", "CSR for %s/%s is invalid, deleting to regenerate.", session.Namespace, session.Name)
 			return true, &actions{Event: e, DeleteCSR: true}, nil
 		}
+		// ... if it was requested before the session was extended, it is deleted to request a new one
+		if renewing && (csr.Spec.ExpirationSeconds == nil || *csr.Spec.ExpirationSeconds < csrExpirationSeconds(session)) {
+			e := event("CertificateRenewal", "Certificate for %s/%s expires before the extended session, deleting CSR to renew it.", session.Namespace, session.Name)
+			return true, &actions{Event: e, DeleteCSR: true}, nil
+		}
 		// ... if it has a certificate, we can bring it to the secret
 		if len(csr.Status.Certificate) > 0 {
 			return true, &actions{Secret: credentialSecret.ApplyConfigurationForCertificate(session, csr.Status.Certificate)}, nil
@@ -602,7 +737,7 @@ func (c *SessionController) generateCredentials(ctx context.Context, session *se
 					CredentialsNotAvailableCondition(sessiongatev1alpha1.CertificateSigningRequestPendingReason, "Certificate signing request pending, waiting for approval", session.Generation, c.clock.Now()),
 					NotReadyCondition(session.Generation, c.clock.Now()),
 				).AsApplyConfiguration(session)
-			if needsUpdate {
+			if needsUpdate && !renewing {
 				return true, &actions{Session: sessionUpdate}, nil
 			}
 
@@ -655,7 +790,7 @@ func (c *SessionController) generateCredentials(ctx context.Context, session *se
 				CredentialsNotAvailableCondition("PrivateKeyCreated", "Private key created, waiting for CSR to be created", session.Generation, c.clock.Now()),
 				NotReadyCondition(session.Generation, c.clock.Now()),
 			).AsApplyConfiguration(session)
-		if needsUpdate {
+		if needsUpdate && !renewing {
 			return true, &actions{Session: sessionUpdate}, nil
 		}
 
@@ -697,6 +832,16 @@ func (c *SessionController) generateCredentials(ctx context.Context, session *se
 	return true, &actions{Session: sessionUpdate}, nil
 }
 
+// certificateExpiresBeforeSession returns true if the session has been extended beyond the
+// expiration of the certificate in the credentials secret.
+func (c *SessionController) certificateExpiresBeforeSession(credentialSecret *CredentialSecret, session *sessiongatev1alpha1.Session) bool {
+	if len(session.Status.Extensions) == 0 || session.Status.ExpiresAt == nil {
+		return false
+	}
+	notAfter, ok := credentialSecret.GetCertificateNotAfter()
+	return ok && notAfter.Before(session.Status.ExpiresAt.Time)
+}
+
 func (c *SessionController) ensureNetworkPath(ctx context.Context, session *sessiongatev1alpha1.Session, mc ManagementClusterQuerier) (bool, *actions, error) {
 	// right now we only have public HCPs, so we just use the public HCP API endpoint as
 	// network path and set it in the status
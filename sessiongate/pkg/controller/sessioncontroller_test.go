@@ -20,6 +20,7 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
+	"math/big"
 	"testing"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 
 	certificatesv1alpha1 "github.com/openshift/hypershift/api/certificates/v1alpha1"
 	hypershiftv1beta1 "github.com/openshift/hypershift/api/hypershift/v1beta1"
@@ -79,6 +81,24 @@ var secretWithFullTestCredentials = &corev1.Secret{
 	},
 }
 
+// secretWithCertificateExpiringAt returns a credentials secret holding the test private key and a
+// certificate for it that is valid until notAfter.
+func secretWithCertificateExpiringAt(t *testing.T, notAfter time.Time) *corev1.Secret {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    fixedTime,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &testPrivateKey.PublicKey, testPrivateKey)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	secret := secretWithTestPrivateKey.DeepCopy()
+	secret.Data["certificate"] = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return secret
+}
+
 func buildHCP(available bool) *hypershiftv1beta1.HostedControlPlane {
 	status := metav1.ConditionTrue
 	if !available {
@@ -241,6 +261,36 @@ func TestSessionController_processSession_handleExpiration(t *testing.T) {
 			},
 			expectAction: true,
 		},
+		{
+			name:         "extended session",
+			creationTime: fixedTime.Add(-23 * time.Hour),
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				ExpiresAt: &metav1.Time{Time: fixedTime.Add(time.Hour)},
+				Extensions: []sessiongatev1alpha1.Extension{
+					{
+						ExtendedBy: sessiongatev1alpha1.Principal{Type: sessiongatev1alpha1.PrincipalTypeAzureUser, Name: "user@example.com"},
+						Duration:   metav1.Duration{Duration: 2 * time.Hour},
+						ExtendedAt: metav1.Time{Time: fixedTime},
+					},
+				},
+			},
+			expectAction: true,
+		},
+		{
+			name:         "session expired despite its extension",
+			creationTime: fixedTime.Add(-27 * time.Hour),
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				ExpiresAt: &metav1.Time{Time: fixedTime.Add(-time.Hour)},
+				Extensions: []sessiongatev1alpha1.Extension{
+					{
+						ExtendedBy: sessiongatev1alpha1.Principal{Type: sessiongatev1alpha1.PrincipalTypeAzureUser, Name: "user@example.com"},
+						Duration:   metav1.Duration{Duration: 2 * time.Hour},
+						ExtendedAt: metav1.Time{Time: fixedTime.Add(-3 * time.Hour)},
+					},
+				},
+			},
+			expectAction: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -290,6 +340,323 @@ func TestSessionController_processSession_handleExpiration(t *testing.T) {
 	}
 }
 
+func TestSessionController_processSession_handleApproval(t *testing.T) {
+	approver := sessiongatev1alpha1.Principal{
+		Type: sessiongatev1alpha1.PrincipalTypeAzureUser,
+		Name: "approver@example.com",
+	}
+	pendingApprovalCondition := metav1.Condition{
+		Type:               string(sessiongatev1alpha1.SessionConditionTypePendingApproval),
+		Status:             metav1.ConditionTrue,
+		Reason:             sessiongatev1alpha1.AwaitingApprovalReason,
+		Message:            "Waiting for approval by a principal other than the owner until 2025-01-07T13:00:00Z",
+		ObservedGeneration: sampleSession.Generation,
+		LastTransitionTime: metav1.Time{Time: fixedTime},
+	}
+
+	tests := []struct {
+		name          string
+		creationTime  time.Time
+		sessionStatus sessiongatev1alpha1.SessionStatus
+		expectAction  bool
+		expectedErr   bool
+	}{
+		{
+			name: "session awaiting approval",
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				Conditions: []metav1.Condition{
+					hostedControlPlaneAvailableCondition,
+				},
+			},
+			expectAction: true,
+		},
+		{
+			name: "session awaiting approval with up to date conditions",
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				Conditions: []metav1.Condition{
+					pendingApprovalCondition,
+					sessionNotReadyCondition,
+					hostedControlPlaneAvailableCondition,
+				},
+			},
+			expectAction: false,
+		},
+		{
+			name:         "session not approved before the deadline",
+			creationTime: fixedTime.Add(-2 * time.Hour),
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				Conditions: []metav1.Condition{
+					pendingApprovalCondition,
+					sessionNotReadyCondition,
+					hostedControlPlaneAvailableCondition,
+				},
+			},
+			expectAction: true,
+		},
+		{
+			name: "session approved by its owner",
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				Approval: &sessiongatev1alpha1.Approval{
+					Approver:   sampleSession.Spec.Owner,
+					ApprovedAt: metav1.Time{Time: fixedTime},
+				},
+				Conditions: []metav1.Condition{
+					pendingApprovalCondition,
+					sessionNotReadyCondition,
+					hostedControlPlaneAvailableCondition,
+				},
+			},
+			expectAction: true,
+		},
+		{
+			name: "approved session without expiration timestamp",
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				Approval: &sessiongatev1alpha1.Approval{
+					Approver:   approver,
+					ApprovedAt: metav1.Time{Time: fixedTime.Add(-time.Hour)},
+				},
+				Conditions: []metav1.Condition{
+					pendingApprovalCondition,
+					sessionNotReadyCondition,
+					hostedControlPlaneAvailableCondition,
+				},
+			},
+			expectAction: true,
+		},
+		{
+			name: "approved session with pending approval condition",
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				Approval: &sessiongatev1alpha1.Approval{
+					Approver:   approver,
+					ApprovedAt: metav1.Time{Time: fixedTime.Add(-time.Hour)},
+				},
+				ExpiresAt: &metav1.Time{Time: fixedTime.Add(23 * time.Hour)},
+				Conditions: []metav1.Condition{
+					pendingApprovalCondition,
+					sessionNotReadyCondition,
+					hostedControlPlaneAvailableCondition,
+				},
+			},
+			expectAction: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSession := sampleSession.DeepCopy()
+			testSession.Spec.Approval = &sessiongatev1alpha1.ApprovalRequest{
+				Justification: "investigate failing cluster operator",
+				IncidentID:    "123456789",
+				Timeout:       metav1.Duration{Duration: time.Hour},
+			}
+			testSession.Status = tt.sessionStatus
+			if !tt.creationTime.IsZero() {
+				testSession.CreationTimestamp = metav1.Time{Time: tt.creationTime}
+			}
+
+			// Setup controller with mock getters
+			controller := &SessionController{
+				clock:            clocktesting.NewFakeClock(fixedTime),
+				endpointProvider: &mockEndpointProvider{},
+				getSecret: func(namespace, name string) (*corev1.Secret, error) {
+					return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+				},
+				newPrivateKey: func(size int) (*rsa.PrivateKey, error) {
+					return nil, errors.New("should not be called in these tests")
+				},
+			}
+
+			// Execute
+			action, err := controller.processSession(t.Context(), testSession, &mockManagementClusterQuerier{
+				hostedControlPlane: buildHCP(true),
+			})
+
+			// Verify error expectation
+			if tt.expectedErr && err == nil {
+				t.Errorf("expected error but got none")
+			} else if !tt.expectedErr && err != nil {
+				t.Errorf("expected no error but got: %v", err)
+			}
+
+			// Verify action
+			if !tt.expectAction && action != nil {
+				t.Errorf("expected no action but got: %+v", action)
+			} else if tt.expectAction && action == nil {
+				t.Errorf("expected action but got none")
+			} else if action != nil {
+				// Validate action
+				if err := action.validate(); err != nil {
+					t.Errorf("action validation failed: %v", err)
+				}
+				// Compare with golden fixture
+				CompareWithFixture(t, action, compareActions()...)
+			}
+		})
+	}
+}
+
+func TestSessionController_processSession_handleRevocation(t *testing.T) {
+	revocation := &sessiongatev1alpha1.Revocation{
+		RevokedBy: sessiongatev1alpha1.Principal{
+			Type: sessiongatev1alpha1.PrincipalTypeAzureUser,
+			Name: "revoker@example.com",
+		},
+		RevokedAt: metav1.Time{Time: fixedTime},
+		Reason:    "incident resolved",
+	}
+	revokedCondition := metav1.Condition{
+		Type:               string(sessiongatev1alpha1.SessionConditionTypeReady),
+		Status:             metav1.ConditionFalse,
+		Reason:             sessiongatev1alpha1.SessionRevokedReason,
+		Message:            "Session revoked by revoker@example.com",
+		ObservedGeneration: sampleSession.Generation,
+		LastTransitionTime: metav1.Time{Time: fixedTime},
+	}
+	csr := &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "sessiongate-9b1f64c3",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		sessionStatus  sessiongatev1alpha1.SessionStatus
+		existingSecret *corev1.Secret
+		getSecretErr   error
+		mq             ManagementClusterQuerier
+		expectAction   bool
+		expectedErr    bool
+	}{
+		{
+			name: "ready session is revoked",
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				ExpiresAt:            &metav1.Time{Time: fixedTime.Add(24 * time.Hour)},
+				CredentialsSecretRef: "sessiongate-9b1f64c3",
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(sessiongatev1alpha1.SessionConditionTypeReady),
+						Status:             metav1.ConditionTrue,
+						Reason:             sessiongatev1alpha1.SessionReadyReason,
+						Message:            "Session is ready",
+						ObservedGeneration: sampleSession.Generation,
+						LastTransitionTime: metav1.Time{Time: fixedTime},
+					},
+					hostedControlPlaneAvailableCondition,
+				},
+				Revocation: revocation,
+			},
+			existingSecret: secretWithFullTestCredentials,
+			mq:             &mockManagementClusterQuerier{csr: csr},
+			expectAction:   true,
+		},
+		{
+			name: "revoked session with credentials secret",
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				ExpiresAt:            &metav1.Time{Time: fixedTime.Add(24 * time.Hour)},
+				CredentialsSecretRef: "sessiongate-9b1f64c3",
+				Conditions:           []metav1.Condition{revokedCondition},
+				Revocation:           revocation,
+			},
+			existingSecret: secretWithFullTestCredentials,
+			mq:             &mockManagementClusterQuerier{csr: csr},
+			expectAction:   true,
+		},
+		{
+			name: "revoked session with CSR",
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				ExpiresAt:            &metav1.Time{Time: fixedTime.Add(24 * time.Hour)},
+				CredentialsSecretRef: "sessiongate-9b1f64c3",
+				Conditions:           []metav1.Condition{revokedCondition},
+				Revocation:           revocation,
+			},
+			mq:           &mockManagementClusterQuerier{csr: csr},
+			expectAction: true,
+		},
+		{
+			name: "revoked session without credentials",
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				ExpiresAt:  &metav1.Time{Time: fixedTime.Add(24 * time.Hour)},
+				Conditions: []metav1.Condition{revokedCondition},
+				Revocation: revocation,
+			},
+			mq:           &mockManagementClusterQuerier{},
+			expectAction: true,
+		},
+		{
+			name: "transient error retrieving credential secret",
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				ExpiresAt:  &metav1.Time{Time: fixedTime.Add(24 * time.Hour)},
+				Conditions: []metav1.Condition{revokedCondition},
+				Revocation: revocation,
+			},
+			getSecretErr: apierrors.NewServiceUnavailable("service unavailable"),
+			mq:           &mockManagementClusterQuerier{},
+			expectAction: false,
+			expectedErr:  true,
+		},
+		{
+			name: "transient error retrieving CSR",
+			sessionStatus: sessiongatev1alpha1.SessionStatus{
+				ExpiresAt:  &metav1.Time{Time: fixedTime.Add(24 * time.Hour)},
+				Conditions: []metav1.Condition{revokedCondition},
+				Revocation: revocation,
+			},
+			mq:           &mockManagementClusterQuerier{getCSRErr: apierrors.NewServiceUnavailable("service unavailable")},
+			expectAction: false,
+			expectedErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSession := sampleSession.DeepCopy()
+			testSession.Status = tt.sessionStatus
+
+			// Setup controller with mock getters
+			controller := &SessionController{
+				clock:            clocktesting.NewFakeClock(fixedTime),
+				endpointProvider: &mockEndpointProvider{},
+				getSecret: func(namespace, name string) (*corev1.Secret, error) {
+					if tt.getSecretErr != nil {
+						return nil, tt.getSecretErr
+					}
+					if tt.existingSecret != nil && tt.existingSecret.Namespace == namespace && tt.existingSecret.Name == name {
+						return tt.existingSecret, nil
+					}
+					return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+				},
+				newPrivateKey: func(size int) (*rsa.PrivateKey, error) {
+					return nil, errors.New("not implemented")
+				},
+			}
+
+			// Execute
+			action, err := controller.processSession(t.Context(), testSession, tt.mq)
+
+			// Verify error expectation
+			if tt.expectedErr && err == nil {
+				t.Errorf("expected error but got none")
+			} else if !tt.expectedErr && err != nil {
+				t.Errorf("expected no error but got: %v", err)
+			}
+
+			// Verify action
+			if !tt.expectAction && action != nil {
+				t.Errorf("expected no action but got: %+v", action)
+			} else if tt.expectAction && action == nil {
+				t.Errorf("expected action but got none")
+			} else if action != nil {
+				// Validate action
+				if err := action.validate(); err != nil {
+					t.Errorf("action validation failed: %v", err)
+				}
+				// Compare with golden fixture
+				CompareWithFixture(t, action, compareActions()...)
+			}
+		})
+	}
+}
+
 func TestSessionController_processSession_generateCredentials(t *testing.T) {
 	csrRequestBody, err := createCSRRequestBody(sampleSession, testPrivateKey)
 	if err != nil {
@@ -341,6 +708,28 @@ func TestSessionController_processSession_generateCredentials(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to generate different private key: %v", err)
 	}
+	// the session below was extended by 2h, which outlives the certificate issued for its original TTL
+	extendedSessionStatus := sessiongatev1alpha1.SessionStatus{
+		ExpiresAt:            &metav1.Time{Time: fixedTime.Add(26 * time.Hour)},
+		CredentialsSecretRef: "sessiongate-9b1f64c3",
+		Conditions: []metav1.Condition{
+			sessionNotReadyCondition,
+			hostedControlPlaneAvailableCondition,
+			credentialsAvailableCondition,
+		},
+		Extensions: []sessiongatev1alpha1.Extension{
+			{
+				ExtendedBy: sessiongatev1alpha1.Principal{Type: sessiongatev1alpha1.PrincipalTypeAzureUser, Name: "user@example.com"},
+				Duration:   metav1.Duration{Duration: 2 * time.Hour},
+				ExtendedAt: metav1.Time{Time: fixedTime},
+			},
+		},
+	}
+	secretWithExpiringCertificate := secretWithCertificateExpiringAt(t, fixedTime.Add(24*time.Hour))
+	signedCSRForTTL := signedCSR.DeepCopy()
+	signedCSRForTTL.Spec.ExpirationSeconds = ptr.To[int32](86400)
+	signedCSRForExtendedSession := signedCSR.DeepCopy()
+	signedCSRForExtendedSession.Spec.ExpirationSeconds = ptr.To[int32](93600)
 
 	tests := []struct {
 		name             string
@@ -815,6 +1204,28 @@ func TestSessionController_processSession_generateCredentials(t *testing.T) {
 			},
 			expectAction: true,
 		},
+		{
+			name:           "extended session with certificate expiring before the session",
+			sessionStatus:  extendedSessionStatus,
+			existingSecret: secretWithExpiringCertificate,
+			mq: &mockManagementClusterQuerier{
+				hostedControlPlane: buildHCP(true),
+				csr:                signedCSRForTTL,
+				csrApproval:        csrApproval,
+			},
+			expectAction: true,
+		},
+		{
+			name:           "extended session with renewed certificate signed",
+			sessionStatus:  extendedSessionStatus,
+			existingSecret: secretWithExpiringCertificate,
+			mq: &mockManagementClusterQuerier{
+				hostedControlPlane: buildHCP(true),
+				csr:                signedCSRForExtendedSession,
+				csrApproval:        csrApproval,
+			},
+			expectAction: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -119,7 +119,7 @@ func (s *Status) WithBackendKASURL(url string) *Status {
 func (s *Status) AsApplyConfiguration(session *sessiongatev1alpha1.Session) (*sessiongatv1alpha1applyconfigurations.SessionApplyConfiguration, bool) {
 	var needsUpdate bool
 
-	// Compare ExpiresAt (only needs to be set once, immutable after that)
+	// Compare ExpiresAt (set once, only moved later when the session is extended)
 	if s.applyConfig.ExpiresAt != nil {
 		if session.Status.ExpiresAt == nil {
 			needsUpdate = true
@@ -267,3 +267,33 @@ func CredentialsNotAvailableCondition(reason, message string, generation int64,
 		WithObservedGeneration(generation).
 		WithLastTransitionTime(metav1.NewTime(now))
 }
+
+func PendingApprovalCondition(reason, message string, generation int64, now time.Time) *applyv1.ConditionApplyConfiguration {
+	return applyv1.Condition().
+		WithType(string(sessiongatev1alpha1.SessionConditionTypePendingApproval)).
+		WithStatus(metav1.ConditionTrue).
+		WithReason(reason).
+		WithMessage(message).
+		WithObservedGeneration(generation).
+		WithLastTransitionTime(metav1.NewTime(now))
+}
+
+func ApprovedCondition(approver sessiongatev1alpha1.Principal, generation int64, now time.Time) *applyv1.ConditionApplyConfiguration {
+	return applyv1.Condition().
+		WithType(string(sessiongatev1alpha1.SessionConditionTypePendingApproval)).
+		WithStatus(metav1.ConditionFalse).
+		WithReason(sessiongatev1alpha1.ApprovedReason).
+		WithMessage(fmt.Sprintf("Session approved by %s", approver.Name)).
+		WithObservedGeneration(generation).
+		WithLastTransitionTime(metav1.NewTime(now))
+}
+
+func RevokedCondition(revokedBy sessiongatev1alpha1.Principal, generation int64, now time.Time) *applyv1.ConditionApplyConfiguration {
+	return applyv1.Condition().
+		WithType(string(sessiongatev1alpha1.SessionConditionTypeReady)).
+		WithStatus(metav1.ConditionFalse).
+		WithReason(sessiongatev1alpha1.SessionRevokedReason).
+		WithMessage(fmt.Sprintf("Session revoked by %s", revokedBy.Name)).
+		WithObservedGeneration(generation).
+		WithLastTransitionTime(metav1.NewTime(now))
+}
@@ -43,6 +43,46 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+    - name: policy
+      type:
+        scalar: string
+- name: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.Approval
+  map:
+    fields:
+    - name: approvedAt
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: approver
+      type:
+        namedType: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.Principal
+      default: {}
+- name: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.ApprovalRequest
+  map:
+    fields:
+    - name: incidentId
+      type:
+        scalar: string
+      default: ""
+    - name: justification
+      type:
+        scalar: string
+      default: ""
+    - name: timeout
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.Extension
+  map:
+    fields:
+    - name: duration
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+    - name: extendedAt
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: extendedBy
+      type:
+        namedType: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.Principal
+      default: {}
 - name: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.HostedControlPlane
   map:
     fields:
@@ -72,6 +112,20 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.Revocation
+  map:
+    fields:
+    - name: reason
+      type:
+        scalar: string
+      default: ""
+    - name: revokedAt
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: revokedBy
+      type:
+        namedType: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.Principal
+      default: {}
 - name: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.Session
   map:
     fields:
@@ -100,6 +154,9 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.AccessLevel
       default: {}
+    - name: approval
+      type:
+        namedType: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.ApprovalRequest
     - name: hostedControlPlane
       type:
         namedType: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.HostedControlPlane
@@ -118,6 +175,9 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.SessionStatus
   map:
     fields:
+    - name: approval
+      type:
+        namedType: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.Approval
     - name: backendKASURL
       type:
         scalar: string
@@ -138,6 +198,15 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: expiresAt
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: extensions
+      type:
+        list:
+          elementType:
+            namedType: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.Extension
+          elementRelationship: atomic
+    - name: revocation
+      type:
+        namedType: com.github.Azure.ARO-HCP.sessiongate.pkg.apis.sessiongate.v1alpha1.Revocation
 - name: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
   map:
     fields:
@@ -15,6 +15,10 @@
 
 package v1alpha1
 
+import (
+	sessiongatev1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+)
+
 // AccessLevelApplyConfiguration represents a declarative configuration of the AccessLevel type for use
 // with apply.
 //
@@ -26,6 +30,16 @@ type AccessLevelApplyConfiguration struct {
 	// the session owner will inherit when accessing the HCP. The group must exist on the
 	// target HCP and have appropriate RoleBindings or ClusterRoleBindings configured.
 	Group *string `json:"group,omitempty"`
+	// policy restricts, at the sessiongate proxy, which requests the session owner may send
+	// to the HCP. It is enforced before requests are forwarded and independently of the RBAC
+	// granted to the group on the HCP, so a misconfigured RoleBinding cannot widen a session.
+	// Valid values are:
+	// - "Full": all requests are forwarded
+	// - "ReadOnly": only get, list and watch requests are forwarded; exec, attach,
+	// port-forward, proxy and any other connection upgrade are rejected
+	// - "ReadOnlyNoSecrets": like ReadOnly, and Secrets cannot be read either
+	// Defaults to "Full".
+	Policy *sessiongatev1alpha1.AccessPolicy `json:"policy,omitempty"`
 }
 
 // AccessLevelApplyConfiguration constructs a declarative configuration of the AccessLevel type for use with
@@ -41,3 +55,11 @@ func (b *AccessLevelApplyConfiguration) WithGroup(value string) *AccessLevelAppl
 	b.Group = &value
 	return b
 }
+
+// WithPolicy sets the Policy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Policy field is set to the value of the last call.
+func (b *AccessLevelApplyConfiguration) WithPolicy(value sessiongatev1alpha1.AccessPolicy) *AccessLevelApplyConfiguration {
+	b.Policy = &value
+	return b
+}
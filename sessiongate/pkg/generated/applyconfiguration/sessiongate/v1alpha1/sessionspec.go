@@ -51,6 +51,11 @@ type SessionSpecApplyConfiguration struct {
 	// whose JWT token contains ALL matching claims can access the HCP through this session.
 	// The identityName field is informational only and is NOT used for authentication decisions.
 	Owner *PrincipalApplyConfiguration `json:"owner,omitempty"`
+	// approval, when set, holds the session until a principal other than the owner approves
+	// it. No credentials are provisioned while the session is pending approval, and the TTL
+	// only starts once the session has been approved. Sessions that are not approved within
+	// the approval timeout are deleted by the controller.
+	Approval *ApprovalRequestApplyConfiguration `json:"approval,omitempty"`
 }
 
 // SessionSpecApplyConfiguration constructs a declarative configuration of the SessionSpec type for use with
@@ -98,3 +103,11 @@ func (b *SessionSpecApplyConfiguration) WithOwner(value *PrincipalApplyConfigura
 	b.Owner = value
 	return b
 }
+
+// WithApproval sets the Approval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Approval field is set to the value of the last call.
+func (b *SessionSpecApplyConfiguration) WithApproval(value *ApprovalRequestApplyConfiguration) *SessionSpecApplyConfiguration {
+	b.Approval = value
+	return b
+}
@@ -31,12 +31,15 @@ type SessionStatusApplyConfiguration struct {
 	// - "Ready": True when the session is fully provisioned and accessible
 	// - "CredentialsAvailable": True when session credentials have been created
 	// - "NetworkPathAvailable": True when the network path to the HCP is established
+	// - "PendingApproval": True while a session that requires approval waits for it
 	// The status of each condition is one of True, False, or Unknown.
 	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
 	// expiresAt is the timestamp when the session will expire and become invalid.
-	// This is calculated as the session's creation timestamp plus the TTL specified in spec.ttl.
+	// This is calculated as the session's creation timestamp, or its approval timestamp for
+	// sessions that require approval, plus the TTL specified in spec.ttl and the durations
+	// of all extensions.
 	// After this time, the controller will clean up session resources and the session
-	// endpoint will no longer accept connections. This field is immutable once set.
+	// endpoint will no longer accept connections. This field can only be moved later once set.
 	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 	// endpoint is the URL that the session owner uses to access the HCP's Kubernetes
 	// API server. This endpoint acts as an authenticated proxy, forwarding requests to the
@@ -53,6 +56,20 @@ type SessionStatusApplyConfiguration struct {
 	// port-forward endpoint or internal service URL. This field is set by the controller
 	// based on the HCP's network configuration.
 	BackendKASURL *string `json:"backendKASURL,omitempty"`
+	// approval records who approved a session that requires approval, see spec.approval.
+	// It is set by the API that accepts the approval rather than by the controller, and is
+	// immutable once set. An approval recorded for the session owner is not honoured.
+	Approval *ApprovalApplyConfiguration `json:"approval,omitempty"`
+	// extensions records every extension of the session's TTL in the order they were made.
+	// They are appended by the API that accepts the extension rather than by the controller,
+	// and existing entries can't be changed or removed. The controller moves expiresAt
+	// accordingly.
+	Extensions []ExtensionApplyConfiguration `json:"extensions,omitempty"`
+	// revocation records who revoked the session. It is set by the API that accepts the
+	// revocation rather than by the controller, and is immutable once set. The controller
+	// closes all connections of a revoked session, deletes its credentials and then
+	// deletes the session.
+	Revocation *RevocationApplyConfiguration `json:"revocation,omitempty"`
 }
 
 // SessionStatusApplyConfiguration constructs a declarative configuration of the SessionStatus type for use with
@@ -105,3 +122,32 @@ func (b *SessionStatusApplyConfiguration) WithBackendKASURL(value string) *Sessi
 	b.BackendKASURL = &value
 	return b
 }
+
+// WithApproval sets the Approval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Approval field is set to the value of the last call.
+func (b *SessionStatusApplyConfiguration) WithApproval(value *ApprovalApplyConfiguration) *SessionStatusApplyConfiguration {
+	b.Approval = value
+	return b
+}
+
+// WithExtensions adds the given value to the Extensions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Extensions field.
+func (b *SessionStatusApplyConfiguration) WithExtensions(values ...*ExtensionApplyConfiguration) *SessionStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithExtensions")
+		}
+		b.Extensions = append(b.Extensions, *values[i])
+	}
+	return b
+}
+
+// WithRevocation sets the Revocation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Revocation field is set to the value of the last call.
+func (b *SessionStatusApplyConfiguration) WithRevocation(value *RevocationApplyConfiguration) *SessionStatusApplyConfiguration {
+	b.Revocation = value
+	return b
+}
@@ -32,12 +32,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	// Group=sessiongate.aro-hcp.azure.com, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithKind("AccessLevel"):
 		return &sessiongatev1alpha1.AccessLevelApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Approval"):
+		return &sessiongatev1alpha1.ApprovalApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ApprovalRequest"):
+		return &sessiongatev1alpha1.ApprovalRequestApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Extension"):
+		return &sessiongatev1alpha1.ExtensionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("HostedControlPlane"):
 		return &sessiongatev1alpha1.HostedControlPlaneApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ManagementCluster"):
 		return &sessiongatev1alpha1.ManagementClusterApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Principal"):
 		return &sessiongatev1alpha1.PrincipalApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Revocation"):
+		return &sessiongatev1alpha1.RevocationApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Session"):
 		return &sessiongatev1alpha1.SessionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SessionSpec"):
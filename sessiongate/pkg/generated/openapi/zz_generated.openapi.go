@@ -27,9 +27,13 @@ import (
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
 		"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.AccessLevel":        schema_pkg_apis_sessiongate_v1alpha1_AccessLevel(ref),
+		"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Approval":           schema_pkg_apis_sessiongate_v1alpha1_Approval(ref),
+		"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.ApprovalRequest":    schema_pkg_apis_sessiongate_v1alpha1_ApprovalRequest(ref),
+		"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Extension":          schema_pkg_apis_sessiongate_v1alpha1_Extension(ref),
 		"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.HostedControlPlane": schema_pkg_apis_sessiongate_v1alpha1_HostedControlPlane(ref),
 		"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.ManagementCluster":  schema_pkg_apis_sessiongate_v1alpha1_ManagementCluster(ref),
 		"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Principal":          schema_pkg_apis_sessiongate_v1alpha1_Principal(ref),
+		"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Revocation":         schema_pkg_apis_sessiongate_v1alpha1_Revocation(ref),
 		"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Session":            schema_pkg_apis_sessiongate_v1alpha1_Session(ref),
 		"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.SessionList":        schema_pkg_apis_sessiongate_v1alpha1_SessionList(ref),
 		"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.SessionSpec":        schema_pkg_apis_sessiongate_v1alpha1_SessionSpec(ref),
@@ -105,6 +109,13 @@ func schema_pkg_apis_sessiongate_v1alpha1_AccessLevel(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "policy restricts, at the sessiongate proxy, which requests the session owner may send to the HCP. It is enforced before requests are forwarded and independently of the RBAC granted to the group on the HCP, so a misconfigured RoleBinding cannot widen a session. Valid values are: - \"Full\": all requests are forwarded - \"ReadOnly\": only get, list and watch requests are forwarded; exec, attach,\n  port-forward, proxy and any other connection upgrade are rejected\n- \"ReadOnlyNoSecrets\": like ReadOnly, and Secrets cannot be read either Defaults to \"Full\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"group"},
 			},
@@ -112,6 +123,108 @@ func schema_pkg_apis_sessiongate_v1alpha1_AccessLevel(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_sessiongate_v1alpha1_Approval(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Approval records the approval of a session.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"approver": {
+						SchemaProps: spec.SchemaProps{
+							Description: "approver is the principal that approved the session.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Principal"),
+						},
+					},
+					"approvedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "approvedAt is the time the session was approved. The session's TTL starts at this time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"approver", "approvedAt"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Principal", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_sessiongate_v1alpha1_ApprovalRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApprovalRequest carries the information an approver needs to decide on a session.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"justification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "justification explains why the owner needs access to the HCP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"incidentId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "incidentId is the identifier of the incident the session is requested for.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "timeout is how long after its creation the session may wait for approval. Sessions that are not approved in time are deleted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"justification", "incidentId", "timeout"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_sessiongate_v1alpha1_Extension(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Extension records an extension of a session's TTL.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"extendedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "extendedBy is the principal that extended the session.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Principal"),
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "duration is the time added to the session's TTL.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"extendedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "extendedAt is the time the session was extended.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"extendedBy", "duration", "extendedAt"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Principal", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_sessiongate_v1alpha1_HostedControlPlane(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -194,6 +307,43 @@ func schema_pkg_apis_sessiongate_v1alpha1_Principal(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_sessiongate_v1alpha1_Revocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Revocation records the revocation of a session.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revokedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "revokedBy is the principal that revoked the session.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Principal"),
+						},
+					},
+					"revokedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "revokedAt is the time the session was revoked.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "reason explains why the session was revoked.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"revokedBy", "revokedAt", "reason"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Principal", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_sessiongate_v1alpha1_Session(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -334,12 +484,18 @@ func schema_pkg_apis_sessiongate_v1alpha1_SessionSpec(ref common.ReferenceCallba
 							Ref:         ref("github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Principal"),
 						},
 					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "approval, when set, holds the session until a principal other than the owner approves it. No credentials are provisioned while the session is pending approval, and the TTL only starts once the session has been approved. Sessions that are not approved within the approval timeout are deleted by the controller.",
+							Ref:         ref("github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.ApprovalRequest"),
+						},
+					},
 				},
 				Required: []string{"ttl", "managementCluster", "hostedControlPlane", "accessLevel", "owner"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.AccessLevel", "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.HostedControlPlane", "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.ManagementCluster", "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Principal", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.AccessLevel", "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.ApprovalRequest", "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.HostedControlPlane", "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.ManagementCluster", "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Principal", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -362,7 +518,7 @@ func schema_pkg_apis_sessiongate_v1alpha1_SessionStatus(ref common.ReferenceCall
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represent the current state of the session. Known condition types are: - \"Ready\": True when the session is fully provisioned and accessible - \"CredentialsAvailable\": True when session credentials have been created - \"NetworkPathAvailable\": True when the network path to the HCP is established The status of each condition is one of True, False, or Unknown.",
+							Description: "conditions represent the current state of the session. Known condition types are: - \"Ready\": True when the session is fully provisioned and accessible - \"CredentialsAvailable\": True when session credentials have been created - \"NetworkPathAvailable\": True when the network path to the HCP is established - \"PendingApproval\": True while a session that requires approval waits for it The status of each condition is one of True, False, or Unknown.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -376,7 +532,7 @@ func schema_pkg_apis_sessiongate_v1alpha1_SessionStatus(ref common.ReferenceCall
 					},
 					"expiresAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "expiresAt is the timestamp when the session will expire and become invalid. This is calculated as the session's creation timestamp plus the TTL specified in spec.ttl. After this time, the controller will clean up session resources and the session endpoint will no longer accept connections. This field is immutable once set.",
+							Description: "expiresAt is the timestamp when the session will expire and become invalid. This is calculated as the session's creation timestamp, or its approval timestamp for sessions that require approval, plus the TTL specified in spec.ttl and the durations of all extensions. After this time, the controller will clean up session resources and the session endpoint will no longer accept connections. This field can only be moved later once set.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
@@ -401,11 +557,42 @@ func schema_pkg_apis_sessiongate_v1alpha1_SessionStatus(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "approval records who approved a session that requires approval, see spec.approval. It is set by the API that accepts the approval rather than by the controller, and is immutable once set. An approval recorded for the session owner is not honoured.",
+							Ref:         ref("github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Approval"),
+						},
+					},
+					"extensions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "extensions records every extension of the session's TTL in the order they were made. They are appended by the API that accepts the extension rather than by the controller, and existing entries can't be changed or removed. The controller moves expiresAt accordingly.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Extension"),
+									},
+								},
+							},
+						},
+					},
+					"revocation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "revocation records who revoked the session. It is set by the API that accepts the revocation rather than by the controller, and is immutable once set. The controller closes all connections of a revoked session, deletes its credentials and then deletes the session.",
+							Ref:         ref("github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Revocation"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Approval", "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Extension", "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1.Revocation", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
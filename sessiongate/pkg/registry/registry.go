@@ -25,8 +25,9 @@ import (
 // mock implementations.
 type SessionRegistry interface {
 	// RegisterSession registers a session with the given options and returns
-	// the public endpoint URL for accessing the session.
-	RegisterSession(sessionName, resourceID string, owner sessiongatev1alpha1.Principal, restConfig *rest.Config) (string, error)
+	// the public endpoint URL for accessing the session. Requests through the
+	// session are restricted to what the access level's policy allows.
+	RegisterSession(sessionName, resourceID string, owner sessiongatev1alpha1.Principal, accessLevel sessiongatev1alpha1.AccessLevel, restConfig *rest.Config) (string, error)
 
 	// UnregisterSession removes a session registration by its session ID.
 	UnregisterSession(sessionName string)
@@ -0,0 +1,200 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/klog/v2"
+
+	sessiongatev1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+)
+
+// connectSubresources open interactive or tunnelled connections into the HCP
+// and are never read-only, whatever the HTTP method.
+var connectSubresources = map[string]bool{
+	"exec":        true,
+	"attach":      true,
+	"portforward": true,
+	"proxy":       true,
+}
+
+// selfReviewResources are created with POST but only report what the caller
+// itself may do, so read-only sessions keep `kubectl auth can-i` and `whoami`.
+var selfReviewResources = map[string]bool{
+	"authorization.k8s.io/selfsubjectaccessreviews": true,
+	"authorization.k8s.io/selfsubjectrulesreviews":  true,
+	"authentication.k8s.io/selfsubjectreviews":      true,
+}
+
+// kubeRequest is the Kubernetes API request a proxied HTTP request resolves to.
+type kubeRequest struct {
+	verb        string
+	apiGroup    string
+	apiVersion  string
+	namespace   string
+	resource    string
+	name        string
+	subresource string
+	// isResourceRequest is false for non-resource URLs such as /version or /openapi/v2.
+	isResourceRequest bool
+}
+
+// parseKubeRequest classifies a request path relative to the KAS root, following
+// the URL layout the Kubernetes API server uses to build its RequestInfo.
+func parseKubeRequest(r *http.Request, path string) kubeRequest {
+	req := kubeRequest{verb: strings.ToLower(r.Method)}
+	if r.Method == http.MethodHead {
+		req.verb = "get"
+	}
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	var rest []string
+	switch {
+	case len(parts) >= 2 && parts[0] == "api":
+		req.apiVersion = parts[1]
+		rest = parts[2:]
+	case len(parts) >= 3 && parts[0] == "apis":
+		req.apiGroup = parts[1]
+		req.apiVersion = parts[2]
+		rest = parts[3:]
+	default:
+		return req
+	}
+	if len(rest) == 0 {
+		// discovery documents, e.g. /api/v1 or /apis/apps/v1
+		return req
+	}
+	req.isResourceRequest = true
+
+	watchPath := false
+	if rest[0] == "watch" {
+		watchPath = true
+		rest = rest[1:]
+	}
+	// namespaces/{name}/status and namespaces/{name}/finalize are subresources
+	// of the namespace itself rather than a namespaced collection.
+	if len(rest) > 2 && rest[0] == "namespaces" && rest[2] != "status" && rest[2] != "finalize" {
+		req.namespace = rest[1]
+		rest = rest[2:]
+	}
+
+	if len(rest) > 0 {
+		req.resource = rest[0]
+	}
+	if len(rest) > 1 {
+		req.name = rest[1]
+	}
+	if len(rest) > 2 {
+		req.subresource = rest[2]
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		switch {
+		case watchPath || r.URL.Query().Get("watch") == "true" || r.URL.Query().Get("watch") == "1":
+			req.verb = "watch"
+		case len(req.name) == 0:
+			req.verb = "list"
+		default:
+			req.verb = "get"
+		}
+	case http.MethodPost:
+		req.verb = "create"
+	case http.MethodPut:
+		req.verb = "update"
+	case http.MethodPatch:
+		req.verb = "patch"
+	case http.MethodDelete:
+		if len(req.name) == 0 {
+			req.verb = "deletecollection"
+		} else {
+			req.verb = "delete"
+		}
+	}
+	return req
+}
+
+// allowedByAccessPolicy reports whether the policy lets the request through,
+// and why not if it does not.
+func allowedByAccessPolicy(policy sessiongatev1alpha1.AccessPolicy, r *http.Request, req kubeRequest) (bool, string) {
+	switch policy {
+	case sessiongatev1alpha1.AccessPolicyFull, "":
+		return true, ""
+	case sessiongatev1alpha1.AccessPolicyReadOnly, sessiongatev1alpha1.AccessPolicyReadOnlyNoSecrets:
+	default:
+		return false, fmt.Sprintf("unknown access policy %q", policy)
+	}
+
+	if httpstream.IsUpgradeRequest(r) {
+		return false, "connection upgrades are not allowed"
+	}
+	if connectSubresources[req.subresource] {
+		return false, fmt.Sprintf("%s/%s is not allowed", req.resource, req.subresource)
+	}
+	if req.isResourceRequest && req.verb == "create" && selfReviewResources[req.apiGroup+"/"+req.resource] {
+		return true, ""
+	}
+	switch req.verb {
+	case "get", "list", "watch":
+	default:
+		return false, fmt.Sprintf("verb %q is not allowed", req.verb)
+	}
+	if policy == sessiongatev1alpha1.AccessPolicyReadOnlyNoSecrets && req.isResourceRequest && req.apiGroup == "" && req.resource == "secrets" {
+		return false, "reading secrets is not allowed"
+	}
+	return true, ""
+}
+
+// WithAccessPolicy rejects requests that the session's access policy does not
+// allow before they reach the HCP. stripPathPrefix is removed from the request
+// path to obtain the path on the KAS.
+func WithAccessPolicy(policy sessiongatev1alpha1.AccessPolicy, stripPathPrefix string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := parseKubeRequest(r, strings.TrimPrefix(r.URL.Path, stripPathPrefix))
+		if allowed, reason := allowedByAccessPolicy(policy, r, req); !allowed {
+			klog.FromContext(r.Context()).Info("request rejected by access policy",
+				"policy", policy,
+				"method", r.Method,
+				"verb", req.verb,
+				"resource", req.resource,
+				"subresource", req.subresource,
+				"reason", reason,
+			)
+			writeForbidden(w, fmt.Sprintf("access policy %s: %s", policy, reason))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// writeForbidden responds with a Kubernetes Status so kubectl shows the reason.
+func writeForbidden(w http.ResponseWriter, message string) {
+	status := metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Message:  message,
+		Reason:   metav1.StatusReasonForbidden,
+		Code:     http.StatusForbidden,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	_ = json.NewEncoder(w).Encode(status)
+}
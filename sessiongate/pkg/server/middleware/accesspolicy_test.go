@@ -0,0 +1,82 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sessiongatev1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+)
+
+func TestWithAccessPolicy(t *testing.T) {
+	const prefix = "/sessiongate/s1/kas"
+
+	tests := []struct {
+		name       string
+		policy     sessiongatev1alpha1.AccessPolicy
+		method     string
+		path       string
+		upgrade    bool
+		wantStatus int
+	}{
+		{name: "full allows exec", policy: sessiongatev1alpha1.AccessPolicyFull, method: http.MethodPost, path: "/api/v1/namespaces/ns/pods/p/exec", upgrade: true, wantStatus: http.StatusOK},
+		{name: "empty policy is full", policy: "", method: http.MethodDelete, path: "/api/v1/namespaces/ns/pods/p", wantStatus: http.StatusOK},
+		{name: "read-only allows get", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodGet, path: "/api/v1/namespaces/ns/pods/p", wantStatus: http.StatusOK},
+		{name: "read-only allows list across namespaces", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodGet, path: "/apis/apps/v1/deployments", wantStatus: http.StatusOK},
+		{name: "read-only allows watch", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodGet, path: "/api/v1/namespaces/ns/pods?watch=true", wantStatus: http.StatusOK},
+		{name: "read-only allows pod logs", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodGet, path: "/api/v1/namespaces/ns/pods/p/log", wantStatus: http.StatusOK},
+		{name: "read-only allows discovery", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodGet, path: "/apis", wantStatus: http.StatusOK},
+		{name: "read-only allows self subject access reviews", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodPost, path: "/apis/authorization.k8s.io/v1/selfsubjectaccessreviews", wantStatus: http.StatusOK},
+		{name: "read-only denies create", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodPost, path: "/api/v1/namespaces/ns/configmaps", wantStatus: http.StatusForbidden},
+		{name: "read-only denies patch", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodPatch, path: "/apis/apps/v1/namespaces/ns/deployments/d", wantStatus: http.StatusForbidden},
+		{name: "read-only denies delete", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodDelete, path: "/api/v1/namespaces/ns/pods/p", wantStatus: http.StatusForbidden},
+		{name: "read-only denies subject access reviews for others", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodPost, path: "/apis/authorization.k8s.io/v1/subjectaccessreviews", wantStatus: http.StatusForbidden},
+		{name: "read-only denies exec", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodGet, path: "/api/v1/namespaces/ns/pods/p/exec?command=sh", wantStatus: http.StatusForbidden},
+		{name: "read-only denies port-forward", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodGet, path: "/api/v1/namespaces/ns/pods/p/portforward", wantStatus: http.StatusForbidden},
+		{name: "read-only denies node proxy", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodGet, path: "/api/v1/nodes/n/proxy/metrics", wantStatus: http.StatusForbidden},
+		{name: "read-only denies upgrades", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodGet, path: "/api/v1/namespaces/ns/pods/p/log", upgrade: true, wantStatus: http.StatusForbidden},
+		{name: "read-only allows secrets", policy: sessiongatev1alpha1.AccessPolicyReadOnly, method: http.MethodGet, path: "/api/v1/namespaces/ns/secrets/s", wantStatus: http.StatusOK},
+		{name: "no-secrets denies get secret", policy: sessiongatev1alpha1.AccessPolicyReadOnlyNoSecrets, method: http.MethodGet, path: "/api/v1/namespaces/ns/secrets/s", wantStatus: http.StatusForbidden},
+		{name: "no-secrets denies watch secrets", policy: sessiongatev1alpha1.AccessPolicyReadOnlyNoSecrets, method: http.MethodGet, path: "/api/v1/watch/secrets", wantStatus: http.StatusForbidden},
+		{name: "no-secrets allows configmaps", policy: sessiongatev1alpha1.AccessPolicyReadOnlyNoSecrets, method: http.MethodGet, path: "/api/v1/namespaces/ns/configmaps", wantStatus: http.StatusOK},
+		{name: "unknown policy denies", policy: "Everything", method: http.MethodGet, path: "/api/v1/pods", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(tt.method, prefix+tt.path, nil)
+			if tt.upgrade {
+				req.Header.Set("Connection", "Upgrade")
+				req.Header.Set("Upgrade", "SPDY/3.1")
+			}
+			rec := httptest.NewRecorder()
+
+			WithAccessPolicy(tt.policy, prefix, next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus == http.StatusForbidden {
+				assert.Contains(t, rec.Body.String(), `"reason":"Forbidden"`)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/klog/v2"
+
+	sessiongatev1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+	"github.com/Azure/ARO-HCP/sessiongate/pkg/audit"
+)
+
+// AuditedSession identifies the session whose requests WithAudit records.
+type AuditedSession struct {
+	Name          string
+	HCPResourceID string
+	Owner         sessiongatev1alpha1.Principal
+	AccessLevel   sessiongatev1alpha1.AccessLevel
+}
+
+// auditResponseWriter captures the response status, including connections
+// the proxy hijacks to stream exec, attach and port-forward sessions.
+type auditResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	hijacked   bool
+}
+
+func (w *auditResponseWriter) WriteHeader(code int) {
+	w.statusCode = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *auditResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("response writer does not support hijacking")
+	}
+	w.hijacked = true
+	return hijacker.Hijack()
+}
+
+// WithAudit records every request made through the session to the sink as a
+// Kubernetes audit event. Long-running upgraded connections are recorded
+// when they are opened and again when they close. stripPathPrefix is removed
+// from the request path to obtain the path on the KAS.
+func WithAudit(sink audit.Sink, session AuditedSession, stripPathPrefix string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Recording must outlive the request so that closed or cancelled
+		// connections still leave a trail.
+		ctx := context.WithoutCancel(r.Context())
+		logger := klog.FromContext(ctx)
+
+		req := parseKubeRequest(r, strings.TrimPrefix(r.URL.Path, stripPathPrefix))
+		event := newAuditEvent(r, session, req)
+		if event.User.Username != session.Owner.Name {
+			// The authorization middleware rejects the request; record who
+			// tried, but not what they tried to write.
+			event.Annotations[audit.AnnotationCallerNotOwner] = "true"
+		} else if isMutatingVerb(req.verb) {
+			recordRequestBody(r, req, event)
+		}
+
+		if httpstream.IsUpgradeRequest(r) {
+			received := *event
+			received.Stage = audit.StageRequestReceived
+			if err := sink.Record(ctx, &received); err != nil {
+				logger.Error(err, "failed to record audit event", "auditID", event.AuditID, "stage", received.Stage)
+			}
+		}
+
+		aw := &auditResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(aw, r)
+
+		code := aw.statusCode
+		if aw.hijacked {
+			code = http.StatusSwitchingProtocols
+		}
+		event.Stage = audit.StageResponseComplete
+		event.StageTimestamp = metav1.NowMicro()
+		event.ResponseStatus = &metav1.Status{Code: int32(code)}
+		if code >= http.StatusBadRequest {
+			event.ResponseStatus.Status = metav1.StatusFailure
+		} else {
+			event.ResponseStatus.Status = metav1.StatusSuccess
+		}
+		if err := sink.Record(ctx, event); err != nil {
+			logger.Error(err, "failed to record audit event", "auditID", event.AuditID, "stage", event.Stage)
+		}
+	})
+}
+
+func newAuditEvent(r *http.Request, session AuditedSession, req kubeRequest) *audit.Event {
+	now := metav1.NowMicro()
+	event := &audit.Event{
+		TypeMeta:   metav1.TypeMeta{APIVersion: audit.EventAPIVersion, Kind: audit.EventKind},
+		Level:      audit.LevelMetadata,
+		AuditID:    types.UID(uuid.NewString()),
+		RequestURI: r.URL.RequestURI(),
+		Verb:       req.verb,
+		UserAgent:  r.UserAgent(),
+		Annotations: map[string]string{
+			audit.AnnotationSession:       session.Name,
+			audit.AnnotationHCPResourceID: session.HCPResourceID,
+			audit.AnnotationOwner:         session.Owner.Name,
+			audit.AnnotationOwnerType:     string(session.Owner.Type),
+			audit.AnnotationAccessPolicy:  string(session.AccessLevel.Policy),
+		},
+		RequestReceivedTimestamp: now,
+		StageTimestamp:           now,
+	}
+	// Record the identity the caller presented rather than the owner, so a
+	// rejected request is attributed to whoever made it.
+	if claimName := ownerClaimHeader(session.Owner.Type); len(claimName) > 0 {
+		event.User.Username = r.Header.Get(claimName)
+	}
+	if len(session.AccessLevel.Group) > 0 {
+		event.User.Groups = []string{session.AccessLevel.Group}
+	}
+	if len(event.Annotations[audit.AnnotationAccessPolicy]) == 0 {
+		event.Annotations[audit.AnnotationAccessPolicy] = string(sessiongatev1alpha1.AccessPolicyFull)
+	}
+
+	// Only the last X-Forwarded-For entry, appended by the ingress, can be
+	// trusted; earlier entries are supplied by the client. List it first and
+	// the immediate peer last.
+	if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		entries := strings.Split(forwarded[len(forwarded)-1], ",")
+		if ip := strings.TrimSpace(entries[len(entries)-1]); len(ip) > 0 {
+			event.SourceIPs = append(event.SourceIPs, ip)
+		}
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		event.SourceIPs = append(event.SourceIPs, host)
+	}
+
+	if req.isResourceRequest {
+		event.ObjectRef = &audit.ObjectReference{
+			Resource:    req.resource,
+			Namespace:   req.namespace,
+			Name:        req.name,
+			APIGroup:    req.apiGroup,
+			APIVersion:  req.apiVersion,
+			Subresource: req.subresource,
+		}
+	}
+
+	query := r.URL.Query()
+	switch req.subresource {
+	case "exec":
+		event.Annotations[audit.AnnotationCommand] = strings.Join(query["command"], " ")
+		fallthrough
+	case "attach", "log":
+		if container := query.Get("container"); len(container) > 0 {
+			event.Annotations[audit.AnnotationContainer] = container
+		}
+	}
+
+	return event
+}
+
+func isMutatingVerb(verb string) bool {
+	switch verb {
+	case "create", "update", "patch", "delete", "deletecollection":
+		return true
+	}
+	return false
+}
+
+// recordRequestBody copies a JSON request body into the event without
+// consuming it for the proxy. Secret bodies are never recorded.
+func recordRequestBody(r *http.Request, req kubeRequest, event *audit.Event) {
+	if req.apiGroup == "" && req.resource == "secrets" {
+		event.Annotations[audit.AnnotationRequestOmitted] = "secret content is not recorded"
+		return
+	}
+	if r.Body == nil || r.Body == http.NoBody {
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, audit.MaxRecordedRequestBytes+1))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+
+	switch {
+	case err != nil:
+		event.Annotations[audit.AnnotationRequestOmitted] = fmt.Sprintf("failed to read request body: %v", err)
+	case len(body) == 0:
+	case len(body) > audit.MaxRecordedRequestBytes:
+		event.Annotations[audit.AnnotationRequestOmitted] = fmt.Sprintf("request body exceeds %d bytes", audit.MaxRecordedRequestBytes)
+	case !json.Valid(body):
+		event.Annotations[audit.AnnotationRequestOmitted] = fmt.Sprintf("request body of type %q is not JSON", r.Header.Get("Content-Type"))
+	default:
+		event.Level = audit.LevelRequest
+		event.RequestObject = &runtime.Unknown{Raw: body, ContentType: runtime.ContentTypeJSON}
+	}
+}
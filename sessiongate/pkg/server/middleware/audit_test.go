@@ -0,0 +1,178 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sessiongatev1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+	"github.com/Azure/ARO-HCP/sessiongate/pkg/audit"
+)
+
+type fakeAuditSink struct {
+	events []audit.Event
+}
+
+func (f *fakeAuditSink) Record(ctx context.Context, event *audit.Event) error {
+	f.events = append(f.events, *event)
+	return nil
+}
+
+func TestWithAudit(t *testing.T) {
+	const prefix = "/sessiongate/s1/kas"
+	session := AuditedSession{
+		Name:          "s1",
+		HCPResourceID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.RedHatOpenShift/hcpOpenShiftClusters/hcp",
+		Owner:         sessiongatev1alpha1.Principal{Type: sessiongatev1alpha1.PrincipalTypeAzureUser, Name: "alice@example.com"},
+		AccessLevel:   sessiongatev1alpha1.AccessLevel{Group: "aro-sre-pso", Policy: sessiongatev1alpha1.AccessPolicyReadOnly},
+	}
+
+	tests := []struct {
+		name            string
+		method          string
+		path            string
+		body            string
+		caller          string
+		upgrade         bool
+		status          int
+		wantStages      []audit.Stage
+		wantUsername    string
+		wantLevel       audit.Level
+		wantBody        string
+		wantAnnotations map[string]string
+	}{
+		{
+			name:       "read is recorded at metadata level",
+			method:     http.MethodGet,
+			path:       "/api/v1/namespaces/ns/pods/p",
+			status:     http.StatusOK,
+			wantStages: []audit.Stage{audit.StageResponseComplete},
+			wantLevel:  audit.LevelMetadata,
+		},
+		{
+			name:       "mutating request records the body",
+			method:     http.MethodPost,
+			path:       "/api/v1/namespaces/ns/configmaps",
+			body:       `{"kind":"ConfigMap","metadata":{"name":"c"}}`,
+			status:     http.StatusForbidden,
+			wantStages: []audit.Stage{audit.StageResponseComplete},
+			wantLevel:  audit.LevelRequest,
+			wantBody:   `{"kind":"ConfigMap","metadata":{"name":"c"}}`,
+		},
+		{
+			name:            "secret bodies are never recorded",
+			method:          http.MethodPut,
+			path:            "/api/v1/namespaces/ns/secrets/s",
+			body:            `{"kind":"Secret","data":{"password":"aHVudGVyMg=="}}`,
+			status:          http.StatusOK,
+			wantStages:      []audit.Stage{audit.StageResponseComplete},
+			wantLevel:       audit.LevelMetadata,
+			wantAnnotations: map[string]string{audit.AnnotationRequestOmitted: "secret content is not recorded"},
+		},
+		{
+			name:            "non-owner caller is recorded without the body",
+			method:          http.MethodPost,
+			path:            "/api/v1/namespaces/ns/configmaps",
+			body:            `{"kind":"ConfigMap","metadata":{"name":"c"}}`,
+			caller:          "mallory@example.com",
+			status:          http.StatusUnauthorized,
+			wantStages:      []audit.Stage{audit.StageResponseComplete},
+			wantUsername:    "mallory@example.com",
+			wantLevel:       audit.LevelMetadata,
+			wantAnnotations: map[string]string{audit.AnnotationCallerNotOwner: "true"},
+		},
+		{
+			name:       "exec records the command when opened and closed",
+			method:     http.MethodPost,
+			path:       "/api/v1/namespaces/ns/pods/p/exec?command=cat&command=/etc/hosts&container=c",
+			upgrade:    true,
+			status:     http.StatusOK,
+			wantStages: []audit.Stage{audit.StageRequestReceived, audit.StageResponseComplete},
+			wantLevel:  audit.LevelMetadata,
+			wantAnnotations: map[string]string{
+				audit.AnnotationCommand:   "cat /etc/hosts",
+				audit.AnnotationContainer: "c",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &fakeAuditSink{}
+			var proxiedBody string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				proxiedBody = string(body)
+				w.WriteHeader(tt.status)
+			})
+
+			caller := session.Owner.Name
+			if len(tt.caller) > 0 {
+				caller = tt.caller
+			}
+			wantUsername := session.Owner.Name
+			if len(tt.wantUsername) > 0 {
+				wantUsername = tt.wantUsername
+			}
+
+			req := httptest.NewRequest(tt.method, prefix+tt.path, strings.NewReader(tt.body))
+			req.RemoteAddr = "10.128.0.5:41234"
+			req.Header.Set("X-JWT-Claim-Upn", caller)
+			req.Header.Set("X-Forwarded-For", "203.0.113.66, 198.51.100.7")
+			if tt.upgrade {
+				req.Header.Set("Connection", "Upgrade")
+				req.Header.Set("Upgrade", "SPDY/3.1")
+			}
+			WithAudit(sink, session, prefix, next).ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.body, proxiedBody, "the proxied body must be unchanged")
+			require.Len(t, sink.events, len(tt.wantStages))
+			for i, stage := range tt.wantStages {
+				assert.Equal(t, stage, sink.events[i].Stage)
+			}
+
+			event := sink.events[len(sink.events)-1]
+			assert.Equal(t, tt.wantLevel, event.Level)
+			assert.Equal(t, wantUsername, event.User.Username)
+			assert.Equal(t, []string{"198.51.100.7", "10.128.0.5"}, event.SourceIPs, "only the ingress-appended X-Forwarded-For entry is trusted")
+			assert.Equal(t, []string{"aro-sre-pso"}, event.User.Groups)
+			assert.Equal(t, "s1", event.Annotations[audit.AnnotationSession])
+			assert.Equal(t, session.HCPResourceID, event.Annotations[audit.AnnotationHCPResourceID])
+			assert.Equal(t, "ReadOnly", event.Annotations[audit.AnnotationAccessPolicy])
+			require.NotNil(t, event.ObjectRef)
+			assert.Equal(t, "ns", event.ObjectRef.Namespace)
+			require.NotNil(t, event.ResponseStatus)
+			assert.Equal(t, int32(tt.status), event.ResponseStatus.Code)
+			if len(tt.wantBody) > 0 {
+				require.NotNil(t, event.RequestObject)
+				assert.JSONEq(t, tt.wantBody, string(event.RequestObject.Raw))
+			} else {
+				assert.Nil(t, event.RequestObject)
+			}
+			for key, value := range tt.wantAnnotations {
+				assert.Equal(t, value, event.Annotations[key])
+			}
+		})
+	}
+}
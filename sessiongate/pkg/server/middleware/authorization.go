@@ -23,14 +23,21 @@ import (
 	sessiongatev1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
 )
 
-func WithSessionProxyClaimHeaderAuthorization(owner sessiongatev1alpha1.Principal, next http.Handler) http.Handler {
-	var claimName string
-	switch owner.Type {
+// ownerClaimHeader returns the header carrying the caller's identity claim
+// for an owner of the given type, or "" if the type is not supported.
+func ownerClaimHeader(ownerType sessiongatev1alpha1.PrincipalType) string {
+	switch ownerType {
 	case sessiongatev1alpha1.PrincipalTypeAzureUser:
-		claimName = "X-JWT-Claim-Upn"
+		return "X-JWT-Claim-Upn"
 	case sessiongatev1alpha1.PrincipalTypeAzureServicePrincipal:
-		claimName = "X-JWT-Claim-Oid"
-	default:
+		return "X-JWT-Claim-Oid"
+	}
+	return ""
+}
+
+func WithSessionProxyClaimHeaderAuthorization(owner sessiongatev1alpha1.Principal, next http.Handler) http.Handler {
+	claimName := ownerClaimHeader(owner.Type)
+	if len(claimName) == 0 {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, fmt.Sprintf("unauthorized: unexpected owner type: %s", string(owner.Type)), http.StatusUnauthorized)
 		})
@@ -28,7 +28,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/klog/v2"
 
-	sessiongatev1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+	"github.com/Azure/ARO-HCP/sessiongate/pkg/audit"
 	"github.com/Azure/ARO-HCP/sessiongate/pkg/server/middleware"
 )
 
@@ -57,6 +57,11 @@ func (rc *responseCapture) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 type kasProxySession struct {
 	handler http.Handler
 	cleanup func()
+	// certData is the client certificate the handler authenticates to the KAS with
+	certData []byte
+	// retired are the handlers of previous client certificates of the session, their
+	// connections are closed together with the session
+	retired []*kasProxySession
 }
 
 // ServeHTTP implements http.Handler
@@ -66,16 +71,21 @@ func (s *kasProxySession) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 // Close performs cleanup
 func (s *kasProxySession) Close() {
+	for _, retired := range s.retired {
+		retired.Close()
+	}
 	s.cleanup()
 }
 
 func newKASProxyHandler(
 	ctx context.Context,
 	restCfg *rest.Config,
-	sessionName string,
-	owner sessiongatev1alpha1.Principal,
+	session middleware.AuditedSession,
+	auditSink audit.Sink,
 	stripPathPrefix string,
 ) (*kasProxySession, error) {
+	sessionName, owner := session.Name, session.Owner
+
 	backendBase, err := url.Parse(restCfg.Host)
 	if err != nil {
 		return nil, err
@@ -145,7 +155,10 @@ func newKASProxyHandler(
 	})
 
 	return &kasProxySession{
-		handler: middleware.WithSessionProxyClaimHeaderAuthorization(owner, handler),
+		certData: restCfg.CertData,
+		handler: middleware.WithAudit(auditSink, session, stripPathPrefix,
+			middleware.WithSessionProxyClaimHeaderAuthorization(owner,
+				middleware.WithAccessPolicy(session.AccessLevel.Policy, stripPathPrefix, handler))),
 		cleanup: func() {
 			cancel()
 			// Kill all active connections immediately when the session expires.
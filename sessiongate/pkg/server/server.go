@@ -15,6 +15,7 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net"
@@ -31,6 +32,7 @@ import (
 	"k8s.io/klog/v2"
 
 	sessiongatev1alpha1 "github.com/Azure/ARO-HCP/sessiongate/pkg/apis/sessiongate/v1alpha1"
+	"github.com/Azure/ARO-HCP/sessiongate/pkg/audit"
 	"github.com/Azure/ARO-HCP/sessiongate/pkg/registry"
 	"github.com/Azure/ARO-HCP/sessiongate/pkg/server/middleware"
 )
@@ -52,6 +54,7 @@ type Server struct {
 	sessions       map[string]*kasProxySession
 	mu             sync.RWMutex
 	reg            prometheus.Registerer
+	auditSink      audit.Sink
 }
 
 // make sure Server implements the Registry interface
@@ -60,7 +63,8 @@ var _ registry.SessionRegistry = &Server{}
 // NewServer creates a new shared webserver instance
 // bindAddress is the local bind address (e.g., "localhost:8080" or ":8080")
 // ingressBaseURL is the externally-accessible base URL for session URLs
-func NewServer(bindAddress, ingressBaseURL string, reg prometheus.Registerer) *Server {
+// auditSink records every request proxied through a session
+func NewServer(bindAddress, ingressBaseURL string, reg prometheus.Registerer, auditSink audit.Sink) *Server {
 	mux := http.NewServeMux()
 	s := &Server{
 		bindAddress:    bindAddress,
@@ -68,6 +72,7 @@ func NewServer(bindAddress, ingressBaseURL string, reg prometheus.Registerer) *S
 		mux:            mux,
 		sessions:       make(map[string]*kasProxySession),
 		reg:            reg,
+		auditSink:      auditSink,
 		server: &http.Server{
 			Addr:    bindAddress,
 			Handler: mux,
@@ -150,27 +155,44 @@ func (s *Server) Run(ctx context.Context) error {
 }
 
 // RegisterSession registers session information and REST configuration.
-// If the session already exists, this is a no-op (returns existing endpoint).
-// Note: Sessions are immutable - we don't support updating REST config for existing sessions.
-// To update credentials, the session must be unregistered and re-registered.
-func (s *Server) RegisterSession(sessionName, resourceID string, owner sessiongatev1alpha1.Principal, restConfig *rest.Config) (string, error) {
+// If the session already exists with the same client certificate, this is a no-op (returns existing endpoint).
+// When the client certificate was renewed because the session was extended, new requests are served
+// with the new certificate, while connections opened with the previous one are kept until the session
+// is unregistered.
+func (s *Server) RegisterSession(sessionName, resourceID string, owner sessiongatev1alpha1.Principal, accessLevel sessiongatev1alpha1.AccessLevel, restConfig *rest.Config) (string, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	logger := klog.FromContext(context.Background()).WithValues("sessionName", sessionName, "resourceID", resourceID, "identity", owner.Name)
+	logger := klog.FromContext(context.Background()).WithValues("sessionName", sessionName, "resourceID", resourceID, "identity", owner.Name, "accessPolicy", accessLevel.Policy)
 
-	_, exists := s.sessions[sessionName]
-	if !exists {
+	existing, exists := s.sessions[sessionName]
+	switch {
+	case !exists:
 		logger.V(2).Info("Registering new session")
-		session, err := newKASProxyHandler(context.Background(), restConfig, sessionName, owner, BuildSessionKASUrlPath(sessionName))
-		if err != nil {
-			logger.Error(err, "Failed to create KAS proxy handler")
-			return "", fmt.Errorf("failed to create KAS proxy handler: %w", err)
-		}
-		s.sessions[sessionName] = session
-	} else {
+	case !bytes.Equal(existing.certData, restConfig.CertData):
+		logger.V(2).Info("Client certificate renewed, re-registering session")
+	default:
 		logger.V(4).Info("Session already registered, returning existing endpoint")
+		return s.GetSessionEndpoint(sessionName), nil
+	}
+
+	auditedSession := middleware.AuditedSession{
+		Name:          sessionName,
+		HCPResourceID: resourceID,
+		Owner:         owner,
+		AccessLevel:   accessLevel,
+	}
+	session, err := newKASProxyHandler(context.Background(), restConfig, auditedSession, s.auditSink, BuildSessionKASUrlPath(sessionName))
+	if err != nil {
+		logger.Error(err, "Failed to create KAS proxy handler")
+		return "", fmt.Errorf("failed to create KAS proxy handler: %w", err)
+	}
+	if exists {
+		// the connections of the previous certificate are closed with the session
+		session.retired = append(existing.retired, existing)
+		existing.retired = nil
 	}
+	s.sessions[sessionName] = session
 
 	endpoint := s.GetSessionEndpoint(sessionName)
 	return endpoint, nil
@@ -50,6 +50,7 @@ import (
 	"github.com/Azure/ARO-HCP/internal/database/cosmosstoragetesting/kubeappliercosmosstoragetesting"
 	"github.com/Azure/ARO-HCP/internal/database/informers/kubeapplierinformers"
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/apply_desire"
+	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/kubeinformerpool"
 	"github.com/Azure/ARO-HCP/kube-applier/pkg/controllers/read_desire_manager"
 )
 
@@ -238,9 +239,9 @@ func startControllers(parent context.Context, t *testing.T, kac kubeappliercosmo
 	applyInformer := kubeapplierinformers.NewApplyDesireInformerWithRelistDuration(listers.ApplyDesires(), kac, fastRelist)
 	readInformer := kubeapplierinformers.NewReadDesireInformerWithRelistDuration(listers.ReadDesires(), kac, fastRelist)
 
-	applyCtl, err := apply_desire.NewApplyDesireController(applyInformer, dyn, kac, apply_desire.Config{})
+	applyCtl, err := apply_desire.NewApplyDesireController(applyInformer, dyn, kac, nil, apply_desire.Config{})
 	require.NoError(t, err)
-	readMgr, err := read_desire_manager.NewReadDesireInformerManagingController(readInformer, dyn, kac, read_desire_manager.Config{})
+	readMgr, err := read_desire_manager.NewReadDesireInformerManagingController(readInformer, kubeinformerpool.New(dyn, nil), kac, read_desire_manager.Config{})
 	require.NoError(t, err)
 
 	wg := &sync.WaitGroup{}
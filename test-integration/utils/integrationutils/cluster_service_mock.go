@@ -230,6 +230,8 @@ func (s *ClusterServiceMock) setupMockClusterService(t *testing.T) {
 	}).AnyTimes()
 
 	s.MockClusterServiceClient.EXPECT().DeleteBreakGlassCredentials(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.MockClusterServiceClient.EXPECT().HibernateCluster(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.MockClusterServiceClient.EXPECT().ResumeCluster(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	s.MockClusterServiceClient.EXPECT().PostBreakGlassCredential(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, clusterID ocm.InternalID) (*cmv1.BreakGlassCredential, error) {
 		justID := rand.String(10)
 		credentialInternalID := clusterID.String() + "/break_glass_credentials/" + justID
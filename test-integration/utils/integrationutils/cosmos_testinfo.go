@@ -452,6 +452,7 @@ func initializeCosmosDBForFrontend(ctx context.Context, cosmosClient *azcosmos.C
 	}{
 		{"Resources", "/partitionKey", nil},
 		{"Billing", "/subscriptionId", nil},
+		{"BillingUsage", "/subscriptionId", nil},
 		{"Locks", "/id", &[]int32{10}[0]}, // 10 second TTL for locks
 		{"Fleet", "/partitionKey", nil},
 	}
@@ -34,6 +34,7 @@ import (
 	utilsclock "k8s.io/utils/clock"
 	"k8s.io/utils/set"
 
+	adminApiMiddleware "github.com/Azure/ARO-HCP/admin/server/middleware"
 	adminApiServer "github.com/Azure/ARO-HCP/admin/server/server"
 	operationcontrollers "github.com/Azure/ARO-HCP/backend/pkg/utils/operationutils"
 	"github.com/Azure/ARO-HCP/frontend/pkg/frontend"
@@ -134,7 +135,7 @@ func NewIntegrationTestInfoFromEnv(ctx context.Context, t *testing.T, withMock b
 	}
 	fakeAuditClient := &FakeOTELClient{}
 	metricsRegistry := prometheus.NewRegistry()
-	aroHCPFrontend := frontend.NewFrontend(logger, frontendListener, frontendMetricsListener, metricsRegistry, metricsRegistry, storageIntegrationTestInfo.ResourcesDBClient(), clusterServiceMockInfo.MockClusterServiceClient, fakeAuditClient, "fake-location", true)
+	aroHCPFrontend := frontend.NewFrontend(logger, frontendListener, frontendMetricsListener, metricsRegistry, metricsRegistry, storageIntegrationTestInfo.ResourcesDBClient(), clusterServiceMockInfo.MockClusterServiceClient, fakeAuditClient, "fake-location", true, frontend.Limits{})
 
 	// admin api setup
 	adminListener, err := net.Listen("tcp4", "127.0.0.1:0")
@@ -145,6 +146,11 @@ func NewIntegrationTestInfoFromEnv(ctx context.Context, t *testing.T, withMock b
 	if err != nil {
 		return nil, err
 	}
+	// Authorization is disabled, tests exercise the handlers.
+	adminAuthorizer, err := adminApiMiddleware.NewAuthorizer("")
+	if err != nil {
+		return nil, err
+	}
 	adminAPI := adminApiServer.NewAdminAPI(
 		logger,
 		"fake-location",
@@ -162,6 +168,7 @@ func NewIntegrationTestInfoFromEnv(ctx context.Context, t *testing.T, withMock b
 		10*time.Minute,
 		24*time.Hour,
 		set.New("aro-sre-pso", "aro-sre-csa"),
+		adminAuthorizer,
 		metricsRegistry,
 	)
 